@@ -0,0 +1,92 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindParagraphRangeByAnchorSplitRuns(t *testing.T) {
+	first := `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`
+	tests := []struct {
+		name   string
+		para   string
+		anchor string
+	}{
+		{
+			name:   "word split across runs",
+			para:   `<w:p><w:r><w:t>The qu</w:t></w:r><w:r><w:rPr><w:b/></w:rPr><w:t>ick bro</w:t></w:r><w:r><w:t>wn fox</w:t></w:r></w:p>`,
+			anchor: "quick brown",
+		},
+		{
+			name:   "space in its own preserved run",
+			para:   `<w:p><w:r><w:t>Hello</w:t></w:r><w:r><w:t xml:space="preserve"> </w:t></w:r><w:r><w:t>World</w:t></w:r></w:p>`,
+			anchor: "Hello World",
+		},
+		{
+			name:   "tab stops are not text",
+			para:   `<w:p><w:pPr><w:tabs><w:tab w:val="left" w:pos="720"/></w:tabs></w:pPr><w:r><w:t>Sig</w:t></w:r><w:r><w:t>nature</w:t></w:r></w:p>`,
+			anchor: "Signature",
+		},
+		{
+			name:   "tab between runs matches a space",
+			para:   `<w:p><w:r><w:t>Name</w:t></w:r><w:r><w:tab/></w:r><w:r><w:t>Value</w:t></w:r></w:p>`,
+			anchor: "Name Value",
+		},
+		{
+			name:   "carriage return between runs matches a space",
+			para:   `<w:p><w:r><w:t>Line</w:t><w:cr/></w:r><w:r><w:t>Two</w:t></w:r></w:p>`,
+			anchor: "Line Two",
+		},
+		{
+			name:   "character references",
+			para:   `<w:p><w:r><w:t>R&amp;</w:t></w:r><w:r><w:t>D&#160;team</w:t></w:r></w:p>`,
+			anchor: "R&D team",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := []byte(`<w:document><w:body>` + first + tt.para + `</w:body></w:document>`)
+			start, end, err := findParagraphRangeByAnchor(doc, tt.anchor)
+			if err != nil {
+				t.Fatalf("findParagraphRangeByAnchor: %v", err)
+			}
+			if got := string(doc[start:end]); got != tt.para {
+				t.Errorf("found paragraph %s, want %s", got, tt.para)
+			}
+		})
+	}
+
+	doc := []byte(`<w:document><w:body>` + first + `</w:body></w:document>`)
+	if _, _, err := findParagraphRangeByAnchor(doc, "Missing"); err == nil {
+		t.Error("expected error for missing anchor")
+	}
+}
+
+func TestAnchoredInsertsWithSplitRuns(t *testing.T) {
+	body := `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t xml:space="preserve">See the ann</w:t></w:r><w:r><w:rPr><w:i/></w:rPr><w:t>ual rep</w:t></w:r><w:r><w:t>ort.</w:t></w:r></w:p>`
+
+	t.Run("footnote", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		if err := u.InsertFootnote(FootnoteOptions{Text: "Published yearly.", Anchor: "annual report"}); err != nil {
+			t.Fatalf("InsertFootnote: %v", err)
+		}
+		doc := readDocXML(t, u)
+		if i := strings.Index(doc, "<w:footnoteReference"); i == -1 || i < strings.Index(doc, "ort.") {
+			t.Errorf("footnote reference should follow the split anchor:\n%s", doc)
+		}
+	})
+
+	t.Run("comment", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		if err := u.InsertComment(CommentOptions{Text: "Check figures", Author: "Reviewer", Anchor: "annual report"}); err != nil {
+			t.Fatalf("InsertComment: %v", err)
+		}
+		doc := readDocXML(t, u)
+		start := strings.Index(doc, "<w:commentRangeStart")
+		if start == -1 || start < strings.Index(doc, "Intro") || start > strings.Index(doc, "ann") {
+			t.Errorf("comment range should start in the anchor paragraph:\n%s", doc)
+		}
+	})
+}
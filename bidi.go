@@ -0,0 +1,133 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SetDefaultTextDirection makes right-to-left the default direction for the
+// document (rtl true) or restores left-to-right (rtl false). Paragraphs
+// inherit <w:bidi/> from the paragraph defaults in styles.xml, and the final
+// section is laid out right-to-left so that columns and page numbers follow
+// the text direction. Individual paragraphs and runs can still be set with
+// ParagraphOptions.BiDi and RunOptions.RTL.
+func (u *Updater) SetDefaultTextDirection(rtl bool) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+
+	bidi := ""
+	if rtl {
+		bidi = "<w:bidi/>"
+	}
+
+	stylesPath := filepath.Join(u.tempDir, "word", "styles.xml")
+	stylesRaw, err := os.ReadFile(stylesPath)
+	switch {
+	case err == nil:
+		updated, err := setDocDefaultParagraphProperty(stylesRaw, "bidi", bidi)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(updated, stylesRaw) {
+			if err := atomicWriteFile(stylesPath, updated, 0o644); err != nil {
+				return NewXMLWriteError("styles.xml", err)
+			}
+		}
+	case !os.IsNotExist(err):
+		return NewFileReadError("styles.xml", err)
+	case rtl:
+		defaults := []byte("<w:docDefaults><w:pPrDefault><w:pPr>" + bidi + "</w:pPr></w:pPrDefault></w:docDefaults>")
+		if err := atomicWriteFile(stylesPath, generateStylesDocument(defaults), 0o644); err != nil {
+			return NewXMLWriteError("styles.xml", err)
+		}
+		if err := u.ensureStylesRelationship(); err != nil {
+			return fmt.Errorf("ensure styles relationship: %w", err)
+		}
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+	updated, err := setBodySectPrChild(raw, "w:bidi", bidi)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(updated, raw) {
+		return nil
+	}
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// setDocDefaultParagraphProperty sets (or removes, when elemXML is empty) the
+// <w:name> child of the paragraph properties in <w:docDefaults>, creating the
+// docDefaults and pPrDefault elements as needed.
+func setDocDefaultParagraphProperty(stylesXML []byte, name, elemXML string) ([]byte, error) {
+	defaultsStart := findNextTagStart(stylesXML, 0, "w:docDefaults")
+	if defaultsStart == -1 {
+		if elemXML == "" {
+			return stylesXML, nil
+		}
+		rootStart := findNextTagStart(stylesXML, 0, "w:styles")
+		if rootStart == -1 {
+			return nil, NewMalformedXMLError("could not find <w:styles> root element")
+		}
+		rootEnd := bytes.IndexByte(stylesXML[rootStart:], '>')
+		if rootEnd == -1 {
+			return nil, NewMalformedXMLError("malformed <w:styles> element")
+		}
+		return spliceBytes(stylesXML, rootStart+rootEnd+1, rootStart+rootEnd+1,
+			"<w:docDefaults><w:pPrDefault><w:pPr>"+elemXML+"</w:pPr></w:pPrDefault></w:docDefaults>"), nil
+	}
+
+	defaultsEnd := findMatchingClose(stylesXML[defaultsStart:], "w:docDefaults")
+	if defaultsEnd == -1 {
+		return nil, NewMalformedXMLError("malformed docDefaults: closing tag not found")
+	}
+	defaultsEnd += defaultsStart
+
+	// CT_DocDefaults holds rPrDefault followed by pPrDefault.
+	pPrDefaultStart := findNextTagStart(stylesXML[:defaultsEnd], defaultsStart, "w:pPrDefault")
+	if pPrDefaultStart == -1 {
+		if elemXML == "" {
+			return stylesXML, nil
+		}
+		return spliceBytes(stylesXML, defaultsEnd, defaultsEnd,
+			"<w:pPrDefault><w:pPr>"+elemXML+"</w:pPr></w:pPrDefault>"), nil
+	}
+
+	var inner []byte
+	pPrDefaultEnd := pPrDefaultStart + bytes.IndexByte(stylesXML[pPrDefaultStart:], '>') + 1
+	if stylesXML[pPrDefaultEnd-2] != '/' {
+		closeIdx := findMatchingClose(stylesXML[pPrDefaultStart:], "w:pPrDefault")
+		if closeIdx == -1 {
+			return nil, NewMalformedXMLError("malformed pPrDefault: closing tag not found")
+		}
+		inner = stylesXML[pPrDefaultEnd : pPrDefaultStart+closeIdx]
+		pPrDefaultEnd = pPrDefaultStart + closeIdx + len("</w:pPrDefault>")
+	}
+
+	// Reuse the paragraph property writer, which keeps the CT_PPr order.
+	para, err := setParagraphProperty([]byte("<w:p>"+string(inner)+"</w:p>"), name, elemXML)
+	if err != nil {
+		return nil, err
+	}
+	pPr := para[len("<w:p>") : len(para)-len("</w:p>")]
+	return spliceBytes(stylesXML, pPrDefaultStart, pPrDefaultEnd, "<w:pPrDefault>"+string(pPr)+"</w:pPrDefault>"), nil
+}
+
+// spliceBytes returns data with data[start:end] replaced by replacement.
+func spliceBytes(data []byte, start, end int, replacement string) []byte {
+	result := make([]byte, 0, len(data)-(end-start)+len(replacement))
+	result = append(result, data[:start]...)
+	result = append(result, replacement...)
+	result = append(result, data[end:]...)
+	return result
+}
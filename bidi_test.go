@@ -0,0 +1,94 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInsertParagraphMixedDirectionRuns(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertParagraph(ParagraphOptions{
+		BiDi:      true,
+		Alignment: ParagraphAlignRight,
+		Position:  PositionEnd,
+		Runs: []RunOptions{
+			{Text: "مرحبا", RTL: true, Bold: true},
+			{Text: " Go ", Bold: true},
+			{Text: "بالعالم", RTL: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	doc := readDocXML(t, u)
+	want := `<w:p><w:pPr><w:bidi/><w:jc w:val="right"/></w:pPr>` +
+		`<w:r><w:rPr><w:b/><w:rtl/></w:rPr><w:t>مرحبا</w:t></w:r>` +
+		`<w:r><w:rPr><w:b/></w:rPr><w:t xml:space="preserve"> Go </w:t></w:r>` +
+		`<w:r><w:rPr><w:rtl/></w:rPr><w:t>بالعالم</w:t></w:r></w:p>`
+	if !strings.Contains(doc, want) {
+		t.Errorf("unexpected mixed direction paragraph:\n%s\nwant:\n%s", doc, want)
+	}
+	if strings.Count(doc, "<w:bidi/>") != 1 {
+		t.Errorf("only the RTL paragraph should carry <w:bidi/>:\n%s", doc)
+	}
+}
+
+func TestSetDefaultTextDirection(t *testing.T) {
+	t.Run("creates styles", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p><w:sectPr><w:pgSz w:w="12240" w:h="15840"/><w:docGrid w:linePitch="360"/></w:sectPr>`))
+		if err := u.SetDefaultTextDirection(true); err != nil {
+			t.Fatalf("SetDefaultTextDirection: %v", err)
+		}
+
+		styles := readStylesXML(t, u)
+		if !strings.Contains(styles, `<w:docDefaults><w:pPrDefault><w:pPr><w:bidi/></w:pPr></w:pPrDefault></w:docDefaults>`) {
+			t.Errorf("expected bidi paragraph default:\n%s", styles)
+		}
+		if doc := readDocXML(t, u); !strings.Contains(doc, `<w:pgSz w:w="12240" w:h="15840"/><w:bidi/><w:docGrid w:linePitch="360"/>`) {
+			t.Errorf("expected section bidi in schema order:\n%s", doc)
+		}
+
+		// AddText paragraphs inherit the direction from the defaults.
+		if err := u.AddText("نص", PositionEnd); err != nil {
+			t.Fatalf("AddText: %v", err)
+		}
+		if doc := readDocXML(t, u); !strings.Contains(doc, `<w:p><w:pPr></w:pPr><w:r><w:t>نص</w:t></w:r></w:p>`) {
+			t.Errorf("unexpected AddText paragraph:\n%s", doc)
+		}
+	})
+
+	t.Run("existing defaults", func(t *testing.T) {
+		stylesXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+			`<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+			`<w:docDefaults><w:rPrDefault><w:rPr><w:sz w:val="22"/></w:rPr></w:rPrDefault>` +
+			`<w:pPrDefault><w:pPr><w:spacing w:after="160"/></w:pPr></w:pPrDefault></w:docDefaults>` +
+			`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/></w:style>` +
+			`</w:styles>`
+		docXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+			`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body><w:p/></w:body></w:document>`
+		u := newUpdaterFromFixture(t, buildIntegrationDocxFromParts(t, docXML, stylesXML, ""))
+
+		if err := u.SetDefaultTextDirection(true); err != nil {
+			t.Fatalf("SetDefaultTextDirection: %v", err)
+		}
+		styles := readStylesXML(t, u)
+		if !strings.Contains(styles, `<w:pPrDefault><w:pPr><w:bidi/><w:spacing w:after="160"/></w:pPr></w:pPrDefault>`) {
+			t.Errorf("bidi should precede spacing in the paragraph defaults:\n%s", styles)
+		}
+		if !strings.Contains(readDocXML(t, u), `<w:sectPr><w:bidi/></w:sectPr>`) {
+			t.Errorf("expected a section with bidi:\n%s", readDocXML(t, u))
+		}
+
+		if err := u.SetDefaultTextDirection(false); err != nil {
+			t.Fatalf("SetDefaultTextDirection(false): %v", err)
+		}
+		if styles := readStylesXML(t, u); strings.Contains(styles, "<w:bidi/>") || !strings.Contains(styles, `<w:spacing w:after="160"/>`) {
+			t.Errorf("expected only bidi removed from the defaults:\n%s", styles)
+		}
+		if strings.Contains(readDocXML(t, u), "<w:bidi/>") {
+			t.Error("expected section bidi removed")
+		}
+	})
+}
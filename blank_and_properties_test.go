@@ -1,6 +1,7 @@
 package godocx_test
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -163,6 +164,19 @@ func TestNewFromBytesEmpty(t *testing.T) {
 	}
 }
 
+// TestNewFromBytesCorrupt verifies that NewFromBytes rejects data that is not
+// a zip archive with an INVALID_FILE error.
+func TestNewFromBytesCorrupt(t *testing.T) {
+	_, err := godocx.NewFromBytes([]byte("this is not a docx file"))
+	if err == nil {
+		t.Fatal("expected error for corrupt data")
+	}
+	var docxErr *godocx.DocxError
+	if !errors.As(err, &docxErr) || docxErr.Code != godocx.ErrCodeInvalidFile {
+		t.Errorf("expected %s error, got %v", godocx.ErrCodeInvalidFile, err)
+	}
+}
+
 // TestGetAppProperties verifies reading app properties.
 func TestGetAppProperties(t *testing.T) {
 	u, err := godocx.NewBlank()
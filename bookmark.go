@@ -38,7 +38,7 @@ func DefaultBookmarkOptions() BookmarkOptions {
 // If text is provided, the bookmark wraps the text; otherwise it's an empty bookmark marker
 func (u *Updater) CreateBookmark(name string, opts BookmarkOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if err := validateBookmarkName(name); err != nil {
 		return err
@@ -77,7 +77,7 @@ func (u *Updater) CreateBookmark(name string, opts BookmarkOptions) error {
 // CreateBookmarkWithText creates a bookmark that wraps specific text content
 func (u *Updater) CreateBookmarkWithText(name, text string, opts BookmarkOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if text == "" {
 		return NewValidationError("text", "bookmark text cannot be empty")
@@ -124,7 +124,7 @@ func (u *Updater) CreateBookmarkWithText(name, text string, opts BookmarkOptions
 // WrapTextInBookmark finds existing text in the document and wraps it with a bookmark
 func (u *Updater) WrapTextInBookmark(name, anchorText string) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if anchorText == "" {
 		return NewValidationError("anchorText", "anchor text cannot be empty")
@@ -165,7 +165,7 @@ func (u *Updater) getNextBookmarkID() (int, error) {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return 0, fmt.Errorf("read document: %w", err)
+		return 0, NewFileReadError("document", err)
 	}
 
 	// Find all bookmark IDs using the pattern from constants
@@ -239,7 +239,7 @@ func generateEmptyBookmarkXML(name string, id int, opts BookmarkOptions) []byte
 	// Add paragraph properties if style is specified
 	if opts.Style != "" {
 		buf.WriteString("<w:pPr>")
-		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, opts.Style))
+		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, xmlEscape(string(opts.Style))))
 		buf.WriteString("</w:pPr>")
 	}
 
@@ -261,7 +261,7 @@ func generateBookmarkWithTextXML(name, text string, id int, opts BookmarkOptions
 	// Add paragraph properties
 	if opts.Style != "" {
 		buf.WriteString("<w:pPr>")
-		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, opts.Style))
+		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, xmlEscape(string(opts.Style))))
 		buf.WriteString("</w:pPr>")
 	}
 
@@ -276,7 +276,7 @@ func generateBookmarkWithTextXML(name, text string, id int, opts BookmarkOptions
 		buf.WriteString(` xml:space="preserve"`)
 	}
 	buf.WriteString(">")
-	buf.WriteString(xmlEscape(text))
+	buf.WriteString(xmlEscapeContent(text))
 	buf.WriteString("</w:t>")
 	buf.WriteString("</w:r>")
 
@@ -295,26 +295,26 @@ func wrapExistingTextInBookmark(docXML []byte, name, anchorText string, id int)
 	// Find the anchor text
 	textIdx := strings.Index(docStr, anchorText)
 	if textIdx == -1 {
-		return nil, fmt.Errorf("anchor text not found in document: %s", anchorText)
+		return nil, NewTextNotFoundError(anchorText)
 	}
 
 	// Work backwards to find the opening <w:r> tag before the text
 	runStartIdx := strings.LastIndex(docStr[:textIdx], "<w:r")
 	if runStartIdx == -1 {
-		return nil, fmt.Errorf("could not find run tag before anchor text")
+		return nil, NewMalformedXMLError("could not find run tag before anchor text")
 	}
 
 	// Find the start of the opening <w:r> tag (could be <w:r> or <w:r ...)
 	runStartEnd := strings.Index(docStr[runStartIdx:], ">")
 	if runStartEnd == -1 {
-		return nil, fmt.Errorf("malformed run tag")
+		return nil, NewMalformedXMLError("malformed run tag")
 	}
 	runStartEnd += runStartIdx + 1
 
 	// Find the closing </w:r> tag after the text
 	runEndIdx := strings.Index(docStr[textIdx:], "</w:r>")
 	if runEndIdx == -1 {
-		return nil, fmt.Errorf("could not find closing run tag after anchor text")
+		return nil, NewMalformedXMLError("could not find closing run tag after anchor text")
 	}
 	runEndIdx += textIdx + len("</w:r>")
 
@@ -351,6 +351,9 @@ func insertBookmarkAtPosition(docXML, bookmarkXML []byte, opts BookmarkOptions)
 		}
 		return insertBeforeText(docXML, bookmarkXML, opts.Anchor)
 	default:
+		if n, ok := opts.Position.paragraphIndex(); ok {
+			return insertAtParagraphIndex(docXML, bookmarkXML, n)
+		}
 		return insertAtBodyEnd(docXML, bookmarkXML)
 	}
 }
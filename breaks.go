@@ -6,33 +6,48 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 )
 
-// InsertPageBreak inserts a page break into the document
+// InsertPageBreak inserts a page break into the document. Set opts.Kind to
+// insert a column break or text wrapping break instead.
 func (u *Updater) InsertPageBreak(opts BreakOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
-	// Generate page break XML
-	pageBreakXML := generatePageBreakXML()
+	// Default to a page break if not specified
+	if opts.Kind == "" {
+		opts.Kind = BreakPage
+	}
+	if err := validateBreakKind(opts.Kind); err != nil {
+		return err
+	}
+	if err := validateConditionalBreak(opts.Kind, opts.ConditionalBreak); err != nil {
+		return err
+	}
 
 	// Read document.xml
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	// Insert page break at the specified position
-	updated, err := insertBreakAtPosition(raw, pageBreakXML, opts)
+	var updated []byte
+	if opts.ConditionalBreak == BreakEvenPage || opts.ConditionalBreak == BreakOddPage {
+		updated, err = insertConditionalPageBreak(raw, opts)
+	} else {
+		updated, err = insertBreakAtPosition(raw, generateBreakXML(opts.Kind), opts)
+	}
 	if err != nil {
 		return fmt.Errorf("insert page break: %w", err)
 	}
 
 	// Write updated document
 	if err := os.WriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
@@ -41,7 +56,7 @@ func (u *Updater) InsertPageBreak(opts BreakOptions) error {
 // InsertSectionBreak inserts a section break into the document
 func (u *Updater) InsertSectionBreak(opts BreakOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	// Default to next page if not specified
@@ -54,6 +69,12 @@ func (u *Updater) InsertSectionBreak(opts BreakOptions) error {
 		return fmt.Errorf("invalid section break type: %w", err)
 	}
 
+	if opts.PageLayout != nil {
+		if err := validatePageLayout(opts.PageLayout); err != nil {
+			return err
+		}
+	}
+
 	// Generate section break XML with optional page layout
 	sectionBreakXML := generateSectionBreakXML(opts.SectionType, opts.PageLayout)
 
@@ -61,7 +82,7 @@ func (u *Updater) InsertSectionBreak(opts BreakOptions) error {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	// Insert section break at the specified position
@@ -72,12 +93,55 @@ func (u *Updater) InsertSectionBreak(opts BreakOptions) error {
 
 	// Write updated document
 	if err := os.WriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
 }
 
+// LandscapeSection returns options for a next-page section break with a US
+// Letter landscape layout, inserted at the end of the document. As in Word,
+// the section properties of a break describe the section that ends with it:
+// insert a PortraitSection break after the portrait content, then the wide
+// content, then a LandscapeSection break; the content after it continues with
+// the document's own (final) section layout.
+func LandscapeSection() SectionBreakOptions {
+	return SectionBreakOptions{
+		Position:    PositionEnd,
+		SectionType: SectionBreakNextPage,
+		PageLayout:  PageLayoutLetterLandscape(),
+	}
+}
+
+// PortraitSection returns options for a next-page section break with a US
+// Letter portrait layout, inserted at the end of the document.
+func PortraitSection() SectionBreakOptions {
+	return SectionBreakOptions{
+		Position:    PositionEnd,
+		SectionType: SectionBreakNextPage,
+		PageLayout:  PageLayoutLetterPortrait(),
+	}
+}
+
+// validatePageLayout checks that a section page layout has a page size and
+// no negative margins (Word allows negative top and bottom margins, which
+// fix the margin regardless of the header and footer).
+func validatePageLayout(layout *PageLayoutOptions) error {
+	if layout.PageWidth <= 0 || layout.PageHeight <= 0 {
+		return NewValidationError("PageLayout", fmt.Sprintf("page size must be positive, got %dx%d", layout.PageWidth, layout.PageHeight))
+	}
+	switch layout.Orientation {
+	case "", OrientationPortrait, OrientationLandscape:
+	default:
+		return NewValidationError("PageLayout.Orientation", fmt.Sprintf("invalid orientation: %s", layout.Orientation))
+	}
+	if layout.MarginLeft < 0 || layout.MarginRight < 0 || layout.MarginHeader < 0 ||
+		layout.MarginFooter < 0 || layout.MarginGutter < 0 {
+		return NewValidationError("PageLayout", "left, right, header, footer and gutter margins must not be negative")
+	}
+	return nil
+}
+
 // generatePageBreakXML creates the XML for a page break
 // A page break in Word is represented by a paragraph containing a run with a break element
 func generatePageBreakXML() []byte {
@@ -85,6 +149,109 @@ func generatePageBreakXML() []byte {
 	return []byte(`<w:p><w:r><w:br w:type="page"/></w:r></w:p>`)
 }
 
+// generateBreakXML creates a paragraph holding a single break of the given kind
+func generateBreakXML(kind BreakKind) []byte {
+	if kind == BreakPage {
+		return generatePageBreakXML()
+	}
+	return []byte(fmt.Sprintf(`<w:p><w:r><w:br w:type="%s"/></w:r></w:p>`, kind))
+}
+
+// validateBreakKind validates the break kind
+func validateBreakKind(kind BreakKind) error {
+	switch kind {
+	case BreakPage, BreakColumn, BreakTextWrap:
+		return nil
+	default:
+		return NewValidationError("Kind", fmt.Sprintf("invalid break kind: %s", kind))
+	}
+}
+
+// validateConditionalBreak validates the conditional break, which only
+// applies to page breaks
+func validateConditionalBreak(kind BreakKind, conditional ConditionalBreakKind) error {
+	switch conditional {
+	case "", BreakNextPage:
+		return nil
+	case BreakEvenPage, BreakOddPage:
+		if kind != BreakPage {
+			return NewValidationError("ConditionalBreak", fmt.Sprintf("%s break requires Kind %s, got %s", conditional, BreakPage, kind))
+		}
+		return nil
+	default:
+		return NewValidationError("ConditionalBreak", fmt.Sprintf("invalid conditional break: %s", conditional))
+	}
+}
+
+// conditionalBreakMarker stands in for the section properties of an even or
+// odd page break until the section it splits is known.
+const conditionalBreakMarker = `<w:sectPr w:rsidR="godocx-conditional-break"/>`
+
+// insertConditionalPageBreak splits the section at the break position: the
+// inserted paragraph ends the first part with a copy of the section's
+// properties, and the section's own properties, which now govern the second
+// part, start it on the next even or odd page. The section type belongs to
+// the section it starts, so it goes on the following sectPr.
+func insertConditionalPageBreak(raw []byte, opts BreakOptions) ([]byte, error) {
+	breakXML := []byte("<w:p><w:pPr>" + conditionalBreakMarker + "</w:pPr></w:p>")
+	updated, err := insertBreakAtPosition(raw, breakXML, opts)
+	if err != nil {
+		return nil, err
+	}
+	markerStart := bytes.Index(updated, []byte(conditionalBreakMarker))
+	if markerStart == -1 {
+		return nil, NewMalformedXMLError("inserted break paragraph not found")
+	}
+	markerEnd := markerStart + len(conditionalBreakMarker)
+	typeXML := fmt.Sprintf(`<w:type w:val="%s"/>`, opts.ConditionalBreak)
+
+	next := findNextTagStart(updated, markerEnd, "w:sectPr")
+	if next == -1 {
+		// No section properties follow: the document uses Word's defaults,
+		// which the body-level sectPr created here keeps.
+		updated, err = setBodySectPrChild(updated, "w:type", typeXML)
+		if err != nil {
+			return nil, err
+		}
+		return spliceBytes(updated, markerStart, markerEnd, "<w:sectPr/>"), nil
+	}
+
+	nextEnd := next + bytes.IndexByte(updated[next:], '>') + 1
+	if updated[nextEnd-2] != '/' {
+		closeIdx := findMatchingClose(updated[next:], "w:sectPr")
+		if closeIdx == -1 {
+			return nil, NewMalformedXMLError("malformed sectPr element")
+		}
+		nextEnd = next + closeIdx + len("</w:sectPr>")
+	}
+	sectPr := updated[next:nextEnd]
+	firstPart := string(setSectPrChild(sectPr, "w:sectPrChange", ""))
+
+	updated = spliceBytes(updated, next, nextEnd, string(setSectPrChild(sectPr, "w:type", typeXML)))
+	return spliceBytes(updated, markerStart, markerEnd, firstPart), nil
+}
+
+// setSectPrChild returns the sectPr element with its qname child set to
+// elemXML, keeping the CT_SectPr order; an empty elemXML removes the child.
+func setSectPrChild(sectPr []byte, qname, elemXML string) []byte {
+	openEnd := bytes.IndexByte(sectPr, '>') + 1
+	openTag := string(sectPr[:openEnd])
+	var inner []byte
+	if strings.HasSuffix(openTag, "/>") {
+		openTag = strings.TrimSuffix(openTag, "/>") + ">"
+	} else {
+		inner = sectPr[openEnd : len(sectPr)-len("</w:sectPr>")]
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(openTag)
+	for _, c := range upsertOrderedChild(splitXMLChildren(inner), qname, elemXML, sectPrChildOrder) {
+		buf.Write(c.xml)
+	}
+	buf.WriteString("</w:sectPr>")
+	return buf.Bytes()
+}
+
 // generateSectionBreakXML creates the XML for a section break
 // Section breaks are more complex and define how the next section starts
 func generateSectionBreakXML(breakType SectionBreakType, pageLayout *PageLayoutOptions) []byte {
@@ -136,35 +303,13 @@ func validateSectionBreakType(breakType SectionBreakType) error {
 	case SectionBreakNextPage, SectionBreakContinuous, SectionBreakEvenPage, SectionBreakOddPage:
 		return nil
 	default:
-		return fmt.Errorf("invalid section break type: %s", breakType)
+		return NewValidationError("breakType", fmt.Sprintf("invalid section break type: %s", breakType))
 	}
 }
 
 // insertBreakAtPosition inserts a break (page or section) at the specified position
 func insertBreakAtPosition(raw []byte, breakXML []byte, opts BreakOptions) ([]byte, error) {
-
-	switch opts.Position {
-	case PositionBeginning:
-		return insertAtBodyStart(raw, breakXML)
-
-	case PositionEnd:
-		return insertAtBodyEnd(raw, breakXML)
-
-	case PositionAfterText:
-		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionAfterText")
-		}
-		return insertAfterText(raw, breakXML, opts.Anchor)
-
-	case PositionBeforeText:
-		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionBeforeText")
-		}
-		return insertBeforeText(raw, breakXML, opts.Anchor)
-
-	default:
-		return nil, fmt.Errorf("invalid position: %d", opts.Position)
-	}
+	return insertElementAtPosition(raw, breakXML, opts.Position, opts.Anchor)
 }
 
 // insertBreakAfterAnchor inserts a break after the paragraph containing the anchor text
@@ -172,14 +317,14 @@ func insertBreakAfterAnchor(raw []byte, breakXML []byte, anchor string) ([]byte,
 	anchorBytes := []byte(anchor)
 	pos := bytes.Index(raw, anchorBytes)
 	if pos == -1 {
-		return nil, fmt.Errorf("anchor text not found: %s", anchor)
+		return nil, NewTextNotFoundError(anchor)
 	}
 
 	// Find the end of the paragraph containing the anchor
 	paraEnd := []byte("</w:p>")
 	endPos := bytes.Index(raw[pos:], paraEnd)
 	if endPos == -1 {
-		return nil, fmt.Errorf("paragraph end not found after anchor text")
+		return nil, NewMalformedXMLError("paragraph end not found after anchor text")
 	}
 	insertPos := pos + endPos + len(paraEnd)
 
@@ -195,7 +340,7 @@ func insertBreakBeforeAnchor(raw []byte, breakXML []byte, anchor string) ([]byte
 	anchorBytes := []byte(anchor)
 	before, _, ok := bytes.Cut(raw, anchorBytes)
 	if !ok {
-		return nil, fmt.Errorf("anchor text not found: %s", anchor)
+		return nil, NewTextNotFoundError(anchor)
 	}
 
 	// Find the start of the paragraph containing the anchor
@@ -207,7 +352,7 @@ func insertBreakBeforeAnchor(raw []byte, breakXML []byte, anchor string) ([]byte
 		paraStart = []byte("<w:p ")
 		startPos = bytes.LastIndex(before, paraStart)
 		if startPos == -1 {
-			return nil, fmt.Errorf("paragraph start not found before anchor text")
+			return nil, NewMalformedXMLError("paragraph start not found before anchor text")
 		}
 	}
 
@@ -238,14 +383,14 @@ func detectWMLNamespacePrefix(content string) string {
 // and the fallback when no explicit prefix mapping is found.
 func (u *Updater) SetPageLayout(pageLayout PageLayoutOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	// Read document.xml
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	content := string(raw)
@@ -283,7 +428,7 @@ func (u *Updater) SetPageLayout(pageLayout PageLayoutOptions) error {
 		// No sectPr found – insert one immediately before </body>.
 		bodyIdx := bytes.Index([]byte(content), []byte(bodyEnd))
 		if bodyIdx == -1 {
-			return fmt.Errorf("document body not found")
+			return NewMalformedXMLError("document body not found")
 		}
 		sectPrXML := generateSectionPropertiesXMLWithPrefix(ns, pageLayout)
 		content = content[:bodyIdx] + sectPrXML + content[bodyIdx:]
@@ -291,7 +436,7 @@ func (u *Updater) SetPageLayout(pageLayout PageLayoutOptions) error {
 		// Replace the existing sectPr entirely.
 		endIdx := bytes.Index([]byte(content[lastIdx:]), []byte(sectPrEnd))
 		if endIdx == -1 {
-			return fmt.Errorf("malformed section properties: closing tag not found")
+			return NewMalformedXMLError("malformed section properties: closing tag not found")
 		}
 		endIdx += lastIdx + len(sectPrEnd)
 		sectPrXML := generateSectionPropertiesXMLWithPrefix(ns, pageLayout)
@@ -300,7 +445,7 @@ func (u *Updater) SetPageLayout(pageLayout PageLayoutOptions) error {
 
 	// Write updated document
 	if err := os.WriteFile(docPath, []byte(content), 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
@@ -43,6 +43,56 @@ func TestInsertPageBreakAtEnd(t *testing.T) {
 	}
 }
 
+func TestInsertBreakKinds(t *testing.T) {
+	tests := []struct {
+		kind godocx.BreakKind
+		want string
+	}{
+		{godocx.BreakPage, `<w:p><w:r><w:br w:type="page"/></w:r></w:p>`},
+		{godocx.BreakColumn, `<w:p><w:r><w:br w:type="column"/></w:r></w:p>`},
+		{godocx.BreakTextWrap, `<w:p><w:r><w:br w:type="textWrapping"/></w:r></w:p>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.kind), func(t *testing.T) {
+			tempDir := t.TempDir()
+			inputPath := filepath.Join(tempDir, "input.docx")
+			outputPath := filepath.Join(tempDir, "output.docx")
+
+			if err := os.WriteFile(inputPath, buildFixtureDocx(t), 0o644); err != nil {
+				t.Fatalf("write input fixture: %v", err)
+			}
+
+			u, err := godocx.New(inputPath)
+			if err != nil {
+				t.Fatalf("New failed: %v", err)
+			}
+			defer u.Cleanup()
+
+			if err := u.InsertPageBreak(godocx.BreakOptions{Position: godocx.PositionEnd, Kind: tt.kind}); err != nil {
+				t.Fatalf("InsertPageBreak failed: %v", err)
+			}
+			if err := u.Save(outputPath); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+
+			docXML := readZipEntry(t, outputPath, "word/document.xml")
+			if !strings.Contains(docXML, tt.want) {
+				t.Errorf("expected %s in document.xml", tt.want)
+			}
+		})
+	}
+
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+	if err := u.InsertPageBreak(godocx.BreakOptions{Position: godocx.PositionEnd, Kind: "line"}); err == nil {
+		t.Error("expected error for invalid break kind")
+	}
+}
+
 func TestInsertPageBreakAtBeginning(t *testing.T) {
 	tempDir := t.TempDir()
 	inputPath := filepath.Join(tempDir, "input.docx")
@@ -419,3 +469,154 @@ func TestInsertPageBreakInvalidAnchor(t *testing.T) {
 		t.Error("Expected error for non-existent anchor, got nil")
 	}
 }
+
+func TestInsertConditionalPageBreak(t *testing.T) {
+	tests := []struct {
+		conditional godocx.ConditionalBreakKind
+		want        string
+	}{
+		{godocx.BreakEvenPage, `<w:type w:val="evenPage"/>`},
+		{godocx.BreakOddPage, `<w:type w:val="oddPage"/>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.conditional), func(t *testing.T) {
+			u, err := godocx.NewBlank()
+			if err != nil {
+				t.Fatalf("NewBlank failed: %v", err)
+			}
+			defer u.Cleanup()
+
+			if err := u.AddText("Chapter one", godocx.PositionEnd); err != nil {
+				t.Fatalf("AddText failed: %v", err)
+			}
+			if err := u.AddText("Chapter two", godocx.PositionEnd); err != nil {
+				t.Fatalf("AddText failed: %v", err)
+			}
+			if err := u.InsertPageBreak(godocx.BreakOptions{
+				Position:         godocx.PositionAfterText,
+				Anchor:           "Chapter one",
+				ConditionalBreak: tt.conditional,
+			}); err != nil {
+				t.Fatalf("InsertPageBreak failed: %v", err)
+			}
+
+			docXML, err := u.GetDocumentXML()
+			if err != nil {
+				t.Fatalf("GetDocumentXML failed: %v", err)
+			}
+			doc := string(docXML)
+			if strings.Contains(doc, `<w:br w:type="page"/>`) {
+				t.Error("conditional page break should not emit a plain page break")
+			}
+			breakIdx := strings.Index(doc, "<w:p><w:pPr><w:sectPr")
+			if breakIdx == -1 || breakIdx < strings.Index(doc, "Chapter one") || breakIdx > strings.Index(doc, "Chapter two") {
+				t.Fatalf("section break paragraph not found between the chapters:\n%s", doc)
+			}
+			if strings.Count(doc, "<w:sectPr") != 2 {
+				t.Errorf("expected the break and body section properties:\n%s", doc)
+			}
+			if !strings.Contains(doc[strings.Index(doc, "Chapter two"):], tt.want) {
+				t.Errorf("body section should start on the %s:\n%s", tt.conditional, doc)
+			}
+			if !strings.Contains(doc[breakIdx:strings.Index(doc, "Chapter two")], "<w:pgSz") {
+				t.Errorf("break section should keep the page layout:\n%s", doc)
+			}
+		})
+	}
+}
+
+func TestInsertConditionalPageBreakRequiresPageKind(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.InsertPageBreak(godocx.BreakOptions{
+		Position:         godocx.PositionEnd,
+		Kind:             godocx.BreakColumn,
+		ConditionalBreak: godocx.BreakOddPage,
+	}); err == nil {
+		t.Error("expected error for an odd page column break")
+	}
+	if err := u.InsertPageBreak(godocx.BreakOptions{
+		Position:         godocx.PositionEnd,
+		ConditionalBreak: "thirdPage",
+	}); err == nil {
+		t.Error("expected error for an invalid conditional break")
+	}
+}
+
+func TestInsertSectionBreakPerSectionLayout(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	for _, text := range []string{"Narrative", "Wide table"} {
+		if err := u.AddText(text, godocx.PositionEnd); err != nil {
+			t.Fatalf("AddText failed: %v", err)
+		}
+	}
+	portrait := godocx.PortraitSection()
+	portrait.Position = godocx.PositionAfterText
+	portrait.Anchor = "Narrative"
+	if err := u.InsertSectionBreak(portrait); err != nil {
+		t.Fatalf("InsertSectionBreak (portrait) failed: %v", err)
+	}
+	if err := u.InsertSectionBreak(godocx.LandscapeSection()); err != nil {
+		t.Fatalf("InsertSectionBreak (landscape) failed: %v", err)
+	}
+	if err := u.AddText("Closing", godocx.PositionEnd); err != nil {
+		t.Fatalf("AddText failed: %v", err)
+	}
+
+	docXML, err := u.GetDocumentXML()
+	if err != nil {
+		t.Fatalf("GetDocumentXML failed: %v", err)
+	}
+	doc := string(docXML)
+
+	wantPortrait := `<w:p><w:pPr><w:sectPr><w:type w:val="nextPage"/>` +
+		`<w:pgSz w:w="12240" w:h="15840"/>` +
+		`<w:pgMar w:top="1440" w:right="1440" w:bottom="1440" w:left="1440" w:header="720" w:footer="720" w:gutter="0"/>` +
+		`<w:cols w:space="720"/></w:sectPr></w:pPr></w:p>`
+	wantLandscape := `<w:p><w:pPr><w:sectPr><w:type w:val="nextPage"/>` +
+		`<w:pgSz w:w="15840" w:h="12240" w:orient="landscape"/>` +
+		`<w:pgMar w:top="1440" w:right="1440" w:bottom="1440" w:left="1440" w:header="720" w:footer="720" w:gutter="0"/>` +
+		`<w:cols w:space="720"/></w:sectPr></w:pPr></w:p>`
+
+	narrative := strings.Index(doc, "Narrative")
+	portraitIdx := strings.Index(doc, wantPortrait)
+	wide := strings.Index(doc, "Wide table")
+	landscapeIdx := strings.Index(doc, wantLandscape)
+	closing := strings.Index(doc, "Closing")
+	if portraitIdx == -1 || landscapeIdx == -1 {
+		t.Fatalf("expected portrait and landscape section breaks:\n%s", doc)
+	}
+	if !(narrative < portraitIdx && portraitIdx < wide && wide < landscapeIdx && landscapeIdx < closing) {
+		t.Errorf("section breaks are out of order:\n%s", doc)
+	}
+}
+
+func TestInsertSectionBreakInvalidPageLayout(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	for _, layout := range []*godocx.PageLayoutOptions{
+		{},
+		{PageWidth: 12240, PageHeight: 15840, Orientation: "sideways"},
+		{PageWidth: 12240, PageHeight: 15840, MarginLeft: -1},
+	} {
+		opts := godocx.LandscapeSection()
+		opts.PageLayout = layout
+		if err := u.InsertSectionBreak(opts); err == nil {
+			t.Errorf("expected error for page layout %+v", *layout)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package godocx
 import (
 	"bytes"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -46,6 +48,9 @@ type CaptionOptions struct {
 
 	// Optional: Manual number override (when AutoNumber is false)
 	ManualNumber int
+
+	// RestartNumbering restarts the sequence at 1 from this caption (AutoNumber only)
+	RestartNumbering bool
 }
 
 // DefaultCaptionOptions returns caption options with sensible defaults
@@ -107,7 +112,7 @@ func generateCaptionXML(opts CaptionOptions) []byte {
 	if opts.AutoNumber {
 		// Use SEQ field for automatic numbering
 		// This is how Word implements caption numbering
-		buf.WriteString(generateSEQFieldXML(opts.Type))
+		buf.WriteString(generateSEQFieldXML(opts.Type, opts.RestartNumbering))
 	} else if opts.ManualNumber > 0 {
 		// Manual number
 		buf.WriteString("<w:r>")
@@ -125,7 +130,7 @@ func generateCaptionXML(opts CaptionOptions) []byte {
 
 		buf.WriteString("<w:r>")
 		buf.WriteString("<w:t>")
-		buf.WriteString(xmlEscape(opts.Description))
+		buf.WriteString(xmlEscapeContent(opts.Description))
 		buf.WriteString("</w:t>")
 		buf.WriteString("</w:r>")
 	}
@@ -137,8 +142,8 @@ func generateCaptionXML(opts CaptionOptions) []byte {
 
 // generateSEQFieldXML creates a SEQ (sequence) field for auto-numbering
 // SEQ fields in Word are used for automatic figure/table numbering
-// Format: { SEQ Figure \* ARABIC }
-func generateSEQFieldXML(captionType CaptionType) string {
+// Format: { SEQ Figure \* ARABIC }, or { SEQ Figure \* ARABIC \r 1 } to restart
+func generateSEQFieldXML(captionType CaptionType, restart bool) string {
 	var buf bytes.Buffer
 
 	// Field begin
@@ -147,14 +152,18 @@ func generateSEQFieldXML(captionType CaptionType) string {
 	// Field instruction - SEQ field with the caption type identifier
 	buf.WriteString(`<w:r><w:instrText xml:space="preserve"> SEQ `)
 	buf.WriteString(string(captionType))
-	buf.WriteString(` \* ARABIC </w:instrText></w:r>`)
+	buf.WriteString(` \* ARABIC `)
+	if restart {
+		buf.WriteString(`\r 1 `)
+	}
+	buf.WriteString(`</w:instrText></w:r>`)
 
 	// Field separator
 	buf.WriteString(`<w:r><w:fldChar w:fldCharType="separate"/></w:r>`)
 
-	// Field result (placeholder - Word will calculate this)
-	// Default to "1" as placeholder; Word will recalculate on open
-	buf.WriteString(`<w:r><w:t>1</w:t></w:r>`)
+	// Field result (placeholder - numberCaptionField fills in the real
+	// number once the caption is placed; Word recalculates on field update)
+	buf.WriteString(`<w:r><w:t>` + seqResultPlaceholder + `</w:t></w:r>`)
 
 	// Field end
 	buf.WriteString(`<w:r><w:fldChar w:fldCharType="end"/></w:r>`)
@@ -188,12 +197,12 @@ func ValidateCaptionOptions(opts *CaptionOptions) error {
 
 	// Validate caption type
 	if opts.Type != CaptionFigure && opts.Type != CaptionTable {
-		return fmt.Errorf("invalid caption type: %s (must be 'Figure' or 'Table')", opts.Type)
+		return NewValidationError("Type", fmt.Sprintf("invalid caption type: %s (must be 'Figure' or 'Table')", opts.Type))
 	}
 
 	// Validate position
 	if opts.Position != "" && opts.Position != CaptionBefore && opts.Position != CaptionAfter {
-		return fmt.Errorf("invalid caption position: %s (must be 'before' or 'after')", opts.Position)
+		return NewValidationError("Position", fmt.Sprintf("invalid caption position: %s (must be 'before' or 'after')", opts.Position))
 	}
 
 	// Set default position if not specified
@@ -207,7 +216,7 @@ func ValidateCaptionOptions(opts *CaptionOptions) error {
 
 	// Description is optional but should be reasonable length if present
 	if len(opts.Description) > 500 {
-		return fmt.Errorf("caption description too long: %d characters (max 500)", len(opts.Description))
+		return NewValidationError("Description", fmt.Sprintf("caption description too long: %d characters (max 500)", len(opts.Description)))
 	}
 
 	return nil
@@ -234,3 +243,157 @@ func FormatCaptionText(opts CaptionOptions) string {
 
 	return buf.String()
 }
+
+// captionCounter tracks caption numbering state between insertions. The
+// numbers themselves are always derived from the SEQ fields in the document.
+type captionCounter struct {
+	// pendingRestart marks caption types whose next auto-numbered caption
+	// restarts the sequence at 1
+	pendingRestart map[CaptionType]bool
+}
+
+// ResetCaptionCounter restarts numbering for the given caption type: the next
+// auto-numbered caption of that type is numbered 1 (via a SEQ \r 1 switch),
+// and later captions continue from there.
+func (u *Updater) ResetCaptionCounter(captionType CaptionType) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if captionType != CaptionFigure && captionType != CaptionTable {
+		return NewValidationError("captionType", fmt.Sprintf("invalid caption type: %s (must be 'Figure' or 'Table')", captionType))
+	}
+	if u.captions.pendingRestart == nil {
+		u.captions.pendingRestart = make(map[CaptionType]bool)
+	}
+	u.captions.pendingRestart[captionType] = true
+	return nil
+}
+
+// applyCaptionCounter returns a copy of caption that restarts numbering if a
+// reset is pending for its type. The reset stays pending until
+// consumeCaptionReset is called once the caption has been written.
+func (u *Updater) applyCaptionCounter(caption *CaptionOptions) *CaptionOptions {
+	if caption == nil || !caption.AutoNumber || !u.captions.pendingRestart[caption.Type] {
+		return caption
+	}
+	c := *caption
+	c.RestartNumbering = true
+	return &c
+}
+
+// consumeCaptionReset clears the pending reset for caption's type after a
+// restarting caption has been written to the document.
+func (u *Updater) consumeCaptionReset(caption *CaptionOptions) {
+	if caption != nil && caption.AutoNumber && caption.RestartNumbering {
+		delete(u.captions.pendingRestart, caption.Type)
+	}
+}
+
+// seqFieldPattern matches the instruction of a complex field (instrText) or a
+// simple field (fldSimple w:instr).
+var seqFieldPattern = regexp.MustCompile(`<w:instrText(?:\s[^>]*)?>([^<]*)</w:instrText>|<w:fldSimple\s[^>]*w:instr="([^"]*)"`)
+
+// seqResultPattern matches the text of a field result run.
+var seqResultPattern = regexp.MustCompile(`<w:t(?:\s[^>]*)?>([^<]*)</w:t>`)
+
+// seqResultPlaceholder is the result text of a newly generated SEQ field
+// until numberCaptionField replaces it. It cannot occur in XML text, so it
+// never matches existing content.
+const seqResultPlaceholder = "\x00SEQ"
+
+// numberCaptionField fills in the result of the newly inserted SEQ field so
+// that static viewers show a number. The number continues from the cached
+// result of the previous field of the same sequence, which already reflects
+// Word's \s and \r handling; fields without a numeric result count as one
+// step. Existing fields keep their cached results until Word updates them.
+func numberCaptionField(docXML []byte) []byte {
+	placeholder := bytes.Index(docXML, []byte(seqResultPlaceholder))
+	if placeholder < 0 {
+		return docXML
+	}
+
+	matches := seqFieldPattern.FindAllSubmatchIndex(docXML[:placeholder], -1)
+	if len(matches) == 0 {
+		return docXML
+	}
+	id, switches, _ := parseSEQInstruction(docXML, matches[len(matches)-1])
+
+	n := 0
+	for _, m := range matches[:len(matches)-1] {
+		fieldID, fieldSwitches, ok := parseSEQInstruction(docXML, m)
+		if !ok || fieldID != id {
+			continue
+		}
+		if start, end, ok := seqFieldResult(docXML, m); ok {
+			if v, err := strconv.Atoi(strings.TrimSpace(string(docXML[start:end]))); err == nil {
+				n = v
+				continue
+			}
+		}
+		n = nextSEQValue(n, fieldSwitches)
+	}
+	n = nextSEQValue(n, switches)
+
+	return spliceBytes(docXML, placeholder, placeholder+len(seqResultPlaceholder), strconv.Itoa(n))
+}
+
+// parseSEQInstruction returns the sequence identifier and switches of the
+// field instruction matched by m, or false when it is not a SEQ field.
+func parseSEQInstruction(docXML []byte, m []int) (string, []string, bool) {
+	var instr string
+	if m[4] >= 0 {
+		instr = xmlUnescape(string(docXML[m[4]:m[5]]))
+	} else {
+		instr = string(docXML[m[2]:m[3]])
+	}
+	fields := strings.Fields(instr)
+	if len(fields) < 2 || !strings.EqualFold(fields[0], "SEQ") {
+		return "", nil, false
+	}
+	return fields[1], fields[2:], true
+}
+
+// nextSEQValue returns the value a SEQ field with switches takes after a
+// field of the same sequence with value n.
+func nextSEQValue(n int, switches []string) int {
+	next := n + 1
+	for i, sw := range switches {
+		switch sw {
+		case `\c`:
+			next = max(n, 1)
+		case `\r`:
+			if i+1 < len(switches) {
+				if v, err := strconv.Atoi(switches[i+1]); err == nil {
+					next = v
+				}
+			}
+		}
+	}
+	return next
+}
+
+// seqFieldResult returns the bounds of the result text of the field matched
+// by m: between separate and end for complex fields, or inside the
+// fldSimple element.
+func seqFieldResult(docXML []byte, m []int) (int, int, bool) {
+	rest := docXML[m[1]:]
+	var resultStart, resultEnd int
+	if m[4] >= 0 {
+		resultEnd = bytes.Index(rest, []byte("</w:fldSimple>"))
+	} else {
+		resultStart = bytes.Index(rest, []byte(`w:fldCharType="separate"`))
+		resultEnd = bytes.Index(rest, []byte(`w:fldCharType="end"`))
+		if resultStart < 0 || resultStart > resultEnd {
+			return 0, 0, false
+		}
+	}
+	if resultEnd < 0 {
+		return 0, 0, false
+	}
+	loc := seqResultPattern.FindSubmatchIndex(rest[resultStart:resultEnd])
+	if loc == nil {
+		return 0, 0, false
+	}
+	off := m[1] + resultStart
+	return off + loc[2], off + loc[3], true
+}
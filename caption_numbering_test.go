@@ -0,0 +1,163 @@
+package godocx
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var captionNumberPattern = regexp.MustCompile(`SEQ (\w+) [^<]*</w:instrText></w:r><w:r><w:fldChar w:fldCharType="separate"/></w:r><w:r><w:t>(\d+)</w:t>`)
+
+// captionNumbers lists "Type N" for every generated caption in document order.
+func captionNumbers(docXML string) []string {
+	var out []string
+	for _, m := range captionNumberPattern.FindAllStringSubmatch(docXML, -1) {
+		out = append(out, m[1]+" "+m[2])
+	}
+	return out
+}
+
+func TestCaptionNumbering_Sequential(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	chart := func(position InsertPosition, desc string) {
+		t.Helper()
+		err := u.InsertChart(ChartOptions{
+			Position:   position,
+			Categories: []string{"A"},
+			Series:     []SeriesOptions{{Name: "S", Values: []float64{1}}},
+			Caption:    &CaptionOptions{Type: CaptionFigure, AutoNumber: true, Description: desc},
+		})
+		if err != nil {
+			t.Fatalf("InsertChart: %v", err)
+		}
+	}
+	table := func(desc string) {
+		t.Helper()
+		err := u.InsertTable(TableOptions{
+			Position: PositionEnd,
+			Columns:  []ColumnDefinition{{Title: "Col"}},
+			Rows:     [][]string{{"v"}},
+			Caption:  &CaptionOptions{Type: CaptionTable, AutoNumber: true, Description: desc},
+		})
+		if err != nil {
+			t.Fatalf("InsertTable: %v", err)
+		}
+	}
+
+	chart(PositionEnd, "first")
+	table("first table")
+	chart(PositionEnd, "second")
+	table("second table")
+
+	want := []string{"Figure 1", "Table 1", "Figure 2", "Table 2"}
+	if got := captionNumbers(readDocXML(t, u)); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("caption numbers = %v, want %v", got, want)
+	}
+
+	// A figure inserted at the start is numbered 1; the existing captions
+	// keep their cached numbers until Word updates the fields.
+	chart(PositionBeginning, "new first")
+	want = []string{"Figure 1", "Figure 1", "Table 1", "Figure 2", "Table 2"}
+	if got := captionNumbers(readDocXML(t, u)); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("caption numbers after inserting at start = %v, want %v", got, want)
+	}
+}
+
+func TestResetCaptionCounter(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	if err := u.ResetCaptionCounter("Equation"); err == nil {
+		t.Error("expected error for invalid caption type")
+	}
+
+	for i := range 4 {
+		if i == 2 {
+			if err := u.ResetCaptionCounter(CaptionFigure); err != nil {
+				t.Fatalf("ResetCaptionCounter: %v", err)
+			}
+		}
+		err := u.InsertChart(ChartOptions{
+			Position:   PositionEnd,
+			Categories: []string{"A"},
+			Series:     []SeriesOptions{{Name: "S", Values: []float64{1}}},
+			Caption:    &CaptionOptions{Type: CaptionFigure, AutoNumber: true},
+		})
+		if err != nil {
+			t.Fatalf("InsertChart: %v", err)
+		}
+	}
+
+	// A failed insert leaves the reset pending.
+	if err := u.ResetCaptionCounter(CaptionFigure); err != nil {
+		t.Fatalf("ResetCaptionCounter: %v", err)
+	}
+	err := u.InsertChart(ChartOptions{
+		Position:   PositionAfterText,
+		Anchor:     "missing anchor",
+		Categories: []string{"A"},
+		Series:     []SeriesOptions{{Name: "S", Values: []float64{1}}},
+		Caption:    &CaptionOptions{Type: CaptionFigure, AutoNumber: true},
+	})
+	if err == nil {
+		t.Fatal("expected InsertChart to fail for a missing anchor")
+	}
+	if !u.captions.pendingRestart[CaptionFigure] {
+		t.Error("failed InsertChart consumed the pending caption reset")
+	}
+
+	docXML := readDocXML(t, u)
+	if n := strings.Count(docXML, `\r 1`); n != 1 {
+		t.Errorf("expected exactly one restart switch, found %d", n)
+	}
+	want := []string{"Figure 1", "Figure 2", "Figure 1", "Figure 2"}
+	if got := captionNumbers(docXML); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("caption numbers = %v, want %v", got, want)
+	}
+}
+
+func TestNumberCaptionField_ExistingFields(t *testing.T) {
+	field := func(instr, result string) string {
+		return `<w:p><w:r><w:fldChar w:fldCharType="begin"/></w:r><w:r><w:instrText xml:space="preserve">` + instr + `</w:instrText></w:r>` +
+			`<w:r><w:fldChar w:fldCharType="separate"/></w:r><w:r><w:t>` + result + `</w:t></w:r><w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>`
+	}
+	newField := field(` SEQ Figure \* ARABIC `, seqResultPlaceholder)
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"first field", `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>` + newField, "1"},
+		{
+			// Cached results are trusted, so chapter-relative (\s) numbering
+			// continues from what Word computed.
+			"continues from cached result",
+			`<w:p><w:fldSimple w:instr=" SEQ Figure \* ARABIC \s 1 "><w:r><w:t>4</w:t></w:r></w:fldSimple></w:p>` +
+				field(` SEQ Table \* ARABIC `, "9") + newField,
+			"5",
+		},
+		{
+			"counts fields without a numeric result",
+			field(` SEQ Figure \* ROMAN `, "II") + field(` SEQ Figure \h `, "") + newField,
+			"3",
+		},
+		{"restart switch", field(` SEQ Figure \* ARABIC `, "3") + field(` SEQ Figure \* ARABIC \r 1 `, seqResultPlaceholder), "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			docXML := `<w:body>` + field(` PAGE `, "7") + tt.body + field(` SEQ Figure \* ARABIC `, "8") + `</w:body>`
+			got := string(numberCaptionField([]byte(docXML)))
+			if strings.Contains(got, seqResultPlaceholder) {
+				t.Fatalf("placeholder left in XML: %s", got)
+			}
+			if !strings.Contains(got, `<w:t>`+tt.want+`</w:t></w:r><w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>`+field(` SEQ Figure \* ARABIC `, "8")) {
+				t.Errorf("expected the new field to read %s, got: %s", tt.want, got)
+			}
+			if !strings.Contains(got, field(` PAGE `, "7")) {
+				t.Errorf("other fields must keep their results: %s", got)
+			}
+		})
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // ChartKind defines the type of chart
@@ -27,6 +30,29 @@ const (
 	ChartKindScatter ChartKind = "scatterChart" // Scatter chart (XY chart)
 )
 
+// DuplicateSeriesResolution defines how InsertChart handles series names
+// that differ only in case or not at all
+type DuplicateSeriesResolution string
+
+const (
+	// DuplicateSeriesError rejects the chart with a validation error (default)
+	DuplicateSeriesError DuplicateSeriesResolution = "error"
+	// DuplicateSeriesSuffix numbers the duplicates, so two "Revenue" series
+	// become "Revenue (1)" and "Revenue (2)"
+	DuplicateSeriesSuffix DuplicateSeriesResolution = "suffix"
+)
+
+// ChartProgressFunc receives the current step of InsertChart and how much of
+// it is done: done counts up to total, which is fixed for each step.
+type ChartProgressFunc func(step string, done, total int)
+
+// InsertChart progress steps reported to a ChartProgressFunc
+const (
+	ChartProgressWorkbook = "workbook" // one call per worksheet row, header included
+	ChartProgressSeries   = "series"   // one call per series written to the chart XML
+	ChartProgressWriting  = "writing"  // one call once the chart part is written
+)
+
 // ChartOptions defines comprehensive options for chart creation
 type ChartOptions struct {
 	// Position where to insert the chart
@@ -57,6 +83,10 @@ type ChartOptions struct {
 	// Caption options (nil for no caption)
 	Caption *CaptionOptions
 
+	// FloatingAnchor inserts the chart as a floating drawing that text wraps
+	// around (nil for an inline chart)
+	FloatingAnchor *FloatingOptions
+
 	// Extended axis customization (nil = auto defaults)
 	CategoryAxis *AxisOptions
 	ValueAxis    *AxisOptions
@@ -70,21 +100,54 @@ type ChartOptions struct {
 	// Chart-level rendering properties (nil = library defaults)
 	Properties *ChartProperties
 
+	// Plot area fill and border (nil = no fill or border)
+	PlotAreaOptions *PlotAreaOptions
+
+	// Automatic colors for series without an explicit Color (nil = theme defaults)
+	ColorPalette *ChartPalette
+
 	// Bar/column-specific options (nil = clustered column defaults)
 	BarChartOptions *BarChartOptions
 
+	// Area chart-specific options (nil = standard, overlapping areas)
+	AreaChartOptions *AreaChartOptions
+
 	// Scatter chart-specific options (nil = marker defaults)
 	ScatterChartOptions *ScatterChartOptions
+
+	// Presentation build animation (nil = none); ignored by Word
+	Animation *ChartAnimationOptions
+
+	// Extra chart-level extensions keyed by extension URI; each value is the
+	// XML content of its <c:ext> element, written as-is. Namespace prefixes
+	// must be declared within the content.
+	ExtensionData map[string]string
+
+	// How series sharing a name (case-insensitive) are handled
+	// (default: DuplicateSeriesError)
+	DuplicateSeriesResolution DuplicateSeriesResolution
+
+	// Progress is called while the chart is generated (nil = no reports);
+	// see ChartProgressFunc
+	Progress ChartProgressFunc
+
+	// Validator checks the options before insertion (nil = DefaultChartValidator)
+	Validator ChartValidator
 }
 
 // InsertChart creates a new chart and inserts it into the document
 func (u *Updater) InsertChart(opts ChartOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
-	// Validate options
-	if err := validateChartOptions(opts); err != nil {
+	start := time.Now()
+	if opts.DuplicateSeriesResolution == DuplicateSeriesSuffix {
+		opts.Series = suffixDuplicateSeriesNames(opts.Series)
+	}
+
+	// Validate options, reporting every problem at once
+	if err := validateChartWith(opts.Validator, opts); err != nil {
 		return fmt.Errorf("invalid chart options: %w", err)
 	}
 
@@ -93,20 +156,26 @@ func (u *Updater) InsertChart(opts ChartOptions) error {
 
 	// Find next available chart index
 	chartIndex := u.findNextChartIndex()
+	chart := map[string]any{"chartIndex": chartIndex}
 
 	// Create chart XML file
+	step := time.Now()
 	chartPath := filepath.Join(u.tempDir, "word", "charts", fmt.Sprintf("chart%d.xml", chartIndex))
-	if err := u.createChartXML(chartPath, opts); err != nil {
+	if err := u.createChartXML(chartPath, chartIndex, opts); err != nil {
 		return fmt.Errorf("create chart xml: %w", err)
 	}
+	u.recordOperation(MetricInsertChartXML, step, chart)
 
-	// Create embedded workbook
-	workbookPath := filepath.Join(u.tempDir, "word", "embeddings", fmt.Sprintf("Microsoft_Excel_Worksheet%d.xlsx", chartIndex))
+	// Create embedded workbook under a name no other chart uses
+	step = time.Now()
+	workbookPath := u.uniqueWorkbookPath(chartIndex)
 	if err := u.createEmbeddedWorkbook(workbookPath, opts); err != nil {
 		return fmt.Errorf("create embedded workbook: %w", err)
 	}
+	u.recordOperation(MetricInsertChartWorkbook, step, chart)
 
 	// Create chart relationships file
+	step = time.Now()
 	chartRelsPath := filepath.Join(u.tempDir, "word", "charts", "_rels", fmt.Sprintf("chart%d.xml.rels", chartIndex))
 	if err := u.createChartRelationships(chartRelsPath, workbookPath); err != nil {
 		return fmt.Errorf("create chart relationships: %w", err)
@@ -117,81 +186,316 @@ func (u *Updater) InsertChart(opts ChartOptions) error {
 	if err != nil {
 		return fmt.Errorf("add chart relationship: %w", err)
 	}
+	u.recordOperation(MetricInsertChartRelationships, step, chart)
 
 	// Insert chart drawing into document
+	step = time.Now()
 	if err := u.insertChartDrawing(chartIndex, relID, opts); err != nil {
 		return fmt.Errorf("insert chart drawing: %w", err)
 	}
+	u.recordOperation(MetricInsertChartDrawing, step, chart)
 
 	// Update content types
+	step = time.Now()
 	if err := u.addContentTypeOverride(chartIndex); err != nil {
 		return fmt.Errorf("add content type: %w", err)
 	}
+	u.recordOperation(MetricInsertChartContentTypes, step, chart)
 
+	u.recordOperation(MetricInsertChart, start, map[string]any{
+		"chartIndex": chartIndex,
+		"series":     len(opts.Series),
+		"categories": len(opts.Categories),
+	})
 	return nil
 }
 
-// validateChartOptions validates chart creation options
+// reportProgress calls the Progress callback, if any
+func (opts ChartOptions) reportProgress(step string, done, total int) {
+	if opts.Progress != nil {
+		opts.Progress(step, done, total)
+	}
+}
+
+// validateChartOptions validates chart creation options, returning the
+// first problem found
 func validateChartOptions(opts ChartOptions) error {
+	if errs := chartOptionErrors(opts); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// chartOptionErrors runs every chart option check and returns all problems
+// found, in field order.
+func chartOptionErrors(opts ChartOptions) []error {
+	var errs []error
 	if len(opts.Categories) == 0 {
-		return fmt.Errorf("categories cannot be empty")
+		errs = append(errs, NewValidationError("Categories", "categories cannot be empty"))
 	}
 	if len(opts.Series) == 0 {
-		return fmt.Errorf("at least one series is required")
+		errs = append(errs, NewValidationError("Series", "at least one series is required"))
 	}
 
 	// Validate series
 	for i, series := range opts.Series {
 		if strings.TrimSpace(series.Name) == "" {
-			return fmt.Errorf("series[%d] name cannot be empty", i)
+			errs = append(errs, NewValidationError("Series", fmt.Sprintf("series[%d] name cannot be empty", i)))
+		}
+		if len(opts.Categories) > 0 && len(series.Values) != len(opts.Categories) {
+			errs = append(errs, NewValidationError("Series", fmt.Sprintf("series[%d] values length (%d) must match categories length (%d)", i, len(series.Values), len(opts.Categories))))
 		}
-		if len(series.Values) != len(opts.Categories) {
-			return fmt.Errorf("series[%d] values length (%d) must match categories length (%d)", i, len(series.Values), len(opts.Categories))
+		if err := validateColor(fmt.Sprintf("Series[%d].Color", i), series.Color); err != nil {
+			errs = append(errs, err)
 		}
+		if series.FillPattern != nil {
+			if err := validatePatternFill(fmt.Sprintf("Series[%d].FillPattern", i), series.FillPattern); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	switch opts.DuplicateSeriesResolution {
+	case "", DuplicateSeriesError:
+		if err := validateUniqueSeriesNames(opts.Series); err != nil {
+			errs = append(errs, err)
+		}
+	case DuplicateSeriesSuffix:
+	default:
+		errs = append(errs, NewValidationError("DuplicateSeriesResolution", fmt.Sprintf("unsupported resolution %q", opts.DuplicateSeriesResolution)))
 	}
 
 	// Validate axes if provided
 	if opts.CategoryAxis != nil {
 		if err := validateAxisOptions("CategoryAxis", opts.CategoryAxis); err != nil {
-			return err
+			errs = append(errs, err)
 		}
 	}
 	if opts.ValueAxis != nil {
 		if err := validateAxisOptions("ValueAxis", opts.ValueAxis); err != nil {
-			return err
+			errs = append(errs, err)
+		}
+	}
+
+	if opts.ColorPalette != nil {
+		if err := validateChartPalette(opts.ColorPalette); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
+	if opts.Legend != nil {
+		if err := validateLegendOptions(opts.Legend); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if opts.PlotAreaOptions != nil {
+		if err := validatePlotAreaOptions(opts.PlotAreaOptions); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if opts.Properties != nil {
+		if err := validateColor("Properties.ChartAreaBackground", opts.Properties.ChartAreaBackground); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if opts.FloatingAnchor != nil {
+		if err := validateFloatingOptions("FloatingAnchor", opts.FloatingAnchor); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if opts.Animation != nil {
+		if err := validateChartAnimation(opts.Animation); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := validateChartExtensionData(opts.ExtensionData, opts.Animation != nil); err != nil {
+		errs = append(errs, err)
+	}
+
 	// Validate bar chart options if provided
 	if opts.BarChartOptions != nil {
 		if opts.BarChartOptions.GapWidth < 0 || opts.BarChartOptions.GapWidth > 500 {
-			return fmt.Errorf("BarChartOptions.GapWidth must be between 0 and 500")
+			errs = append(errs, NewValidationError("BarChartOptions.GapWidth", "must be between 0 and 500"))
 		}
 		if opts.BarChartOptions.Overlap < -100 || opts.BarChartOptions.Overlap > 100 {
-			return fmt.Errorf("BarChartOptions.Overlap must be between -100 and 100")
+			errs = append(errs, NewValidationError("BarChartOptions.Overlap", "must be between -100 and 100"))
+		}
+	}
+
+	if opts.AreaChartOptions != nil {
+		switch opts.AreaChartOptions.Grouping {
+		case "", AreaGroupingStandard, AreaGroupingStacked, AreaGroupingPercentStacked:
+		default:
+			errs = append(errs, NewValidationError("AreaChartOptions.Grouping", fmt.Sprintf("unsupported area grouping %q", opts.AreaChartOptions.Grouping)))
 		}
 	}
 
+	return errs
+}
+
+// seriesNameKey returns the name under which series are compared for
+// duplicates
+func seriesNameKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// validateUniqueSeriesNames reports the first series whose name repeats an
+// earlier one, ignoring case. The error carries ValidationCodeDuplicateSeries
+// so DefaultChartValidator reports it under that code.
+func validateUniqueSeriesNames(series []SeriesOptions) error {
+	first := make(map[string]int, len(series))
+	for i, s := range series {
+		key := seriesNameKey(s.Name)
+		if key == "" {
+			continue
+		}
+		if j, ok := first[key]; ok {
+			err := NewValidationError("Series", fmt.Sprintf("series[%d] name %q duplicates series[%d]", i, s.Name, j))
+			return err.(*DocxError).WithContext("code", ValidationCodeDuplicateSeries)
+		}
+		first[key] = i
+	}
 	return nil
 }
 
+// suffixDuplicateSeriesNames returns a copy of series in which every name
+// shared by several series, ignoring case, is numbered in series order. A
+// number is skipped when the suffixed name is already used by another series.
+func suffixDuplicateSeriesNames(series []SeriesOptions) []SeriesOptions {
+	counts := make(map[string]int, len(series))
+	taken := make(map[string]bool, len(series))
+	for _, s := range series {
+		key := seriesNameKey(s.Name)
+		counts[key]++
+		taken[key] = true
+	}
+
+	resolved := make([]SeriesOptions, len(series))
+	next := make(map[string]int, len(series))
+	for i, s := range series {
+		resolved[i] = s
+		key := seriesNameKey(s.Name)
+		if key == "" || counts[key] < 2 {
+			continue
+		}
+		name := s.Name
+		for taken[seriesNameKey(name)] {
+			next[key]++
+			name = fmt.Sprintf("%s (%d)", s.Name, next[key])
+		}
+		taken[seriesNameKey(name)] = true
+		resolved[i].Name = name
+	}
+	return resolved
+}
+
 // validateAxisOptions validates axis options
 func validateAxisOptions(name string, axis *AxisOptions) error {
 	if axis.Min != nil && axis.Max != nil && *axis.Min >= *axis.Max {
-		return fmt.Errorf("%s: Min must be less than Max", name)
+		return NewValidationError(name, "Min must be less than Max")
 	}
 	if axis.MajorUnit != nil && *axis.MajorUnit <= 0 {
-		return fmt.Errorf("%s: MajorUnit must be positive", name)
+		return NewValidationError(name, "MajorUnit must be positive")
 	}
 	if axis.MinorUnit != nil && *axis.MinorUnit <= 0 {
-		return fmt.Errorf("%s: MinorUnit must be positive", name)
+		return NewValidationError(name, "MinorUnit must be positive")
 	}
 	if axis.MajorUnit != nil && axis.MinorUnit != nil && *axis.MinorUnit >= *axis.MajorUnit {
-		return fmt.Errorf("%s: MinorUnit must be less than MajorUnit", name)
+		return NewValidationError(name, "MinorUnit must be less than MajorUnit")
+	}
+	if axis.MajorGridlineStyle != nil {
+		if err := validateGridlineStyle(name+".MajorGridlineStyle", axis.MajorGridlineStyle); err != nil {
+			return err
+		}
+	}
+	if axis.MinorGridlineStyle != nil {
+		if err := validateGridlineStyle(name+".MinorGridlineStyle", axis.MinorGridlineStyle); err != nil {
+			return err
+		}
+	}
+	if err := validateNumberFormatCode(name+".NumberFormat", string(axis.NumberFormat)); err != nil {
+		return err
+	}
+	return validateNumberFormatCode(name+".CustomNumberFormat", axis.CustomNumberFormat)
+}
+
+func validatePlotAreaOptions(p *PlotAreaOptions) error {
+	if err := validateColor("PlotAreaOptions.BackgroundColor", p.BackgroundColor); err != nil {
+		return err
+	}
+	if err := validateColor("PlotAreaOptions.BorderColor", p.BorderColor); err != nil {
+		return err
+	}
+	if p.BorderWidth < 0 {
+		return NewValidationError("PlotAreaOptions.BorderWidth", "cannot be negative")
+	}
+	if _, ok := chartLineDash[p.BorderStyle]; p.BorderStyle != "" && !ok {
+		return NewValidationError("PlotAreaOptions.BorderStyle", fmt.Sprintf("unsupported style %q", p.BorderStyle))
 	}
 	return nil
 }
 
+func validateLegendOptions(l *LegendOptions) error {
+	if l.FontSize < 0 || l.FontSize > 400 {
+		return NewValidationError("Legend.FontSize", "must be 0 (use the default size) or between 1 and 400 points")
+	}
+	if err := validateColor("Legend.FontColor", l.FontColor); err != nil {
+		return err
+	}
+	if b := l.LegendBorderStyle; b != nil {
+		if err := validateColor("Legend.LegendBorderStyle.Color", b.Color); err != nil {
+			return err
+		}
+		if b.Width < 0 {
+			return NewValidationError("Legend.LegendBorderStyle.Width", "cannot be negative")
+		}
+	}
+	return nil
+}
+
+func validateGridlineStyle(name string, g *GridlineStyle) error {
+	if err := validateColor(name+".Color", g.Color); err != nil {
+		return err
+	}
+	if g.Width < 0 {
+		return NewValidationError(name, "Width cannot be negative")
+	}
+	if _, ok := chartLineDash[g.DashType]; g.DashType != "" && !ok {
+		return NewValidationError(name, fmt.Sprintf("unsupported DashType %q", g.DashType))
+	}
+	return nil
+}
+
+// validateNumberFormatCode checks that a format code can be stored in the
+// formatCode attribute. Markup characters such as '<', '&' and '"' are
+// escaped on output; control characters cannot be represented in XML 1.0.
+func validateNumberFormatCode(field, code string) error {
+	if len(code) > maxNumberFormatLength {
+		return NewValidationError(field, fmt.Sprintf("exceeds %d characters", maxNumberFormatLength))
+	}
+	for _, r := range code {
+		if r < 0x20 || r == 0x7F || r == utf8.RuneError {
+			return NewValidationError(field, fmt.Sprintf("%q contains a control or invalid character", code))
+		}
+	}
+	return nil
+}
+
+// axisNumberFormatCode returns the escaped format code for an axis.
+func axisNumberFormatCode(axis *AxisOptions) string {
+	code := string(axis.NumberFormat)
+	if axis.CustomNumberFormat != "" {
+		code = axis.CustomNumberFormat
+	}
+	if code == "" {
+		code = string(NumberFormatGeneral)
+	}
+	return xmlEscape(code)
+}
+
 // applyChartDefaults sets default values for unspecified options
 func applyChartDefaults(opts ChartOptions) ChartOptions {
 	// Basic defaults
@@ -271,6 +575,16 @@ func applyChartDefaults(opts ChartOptions) ChartOptions {
 		}
 	}
 
+	// Apply area chart defaults if chart is area type
+	if opts.ChartKind == ChartKindArea {
+		if opts.AreaChartOptions == nil {
+			opts.AreaChartOptions = &AreaChartOptions{}
+		}
+		if opts.AreaChartOptions.Grouping == "" {
+			opts.AreaChartOptions.Grouping = AreaGroupingStandard
+		}
+	}
+
 	// Apply data label defaults if specified
 	if opts.DataLabels != nil {
 		if opts.DataLabels.Position == "" {
@@ -282,22 +596,42 @@ func applyChartDefaults(opts ChartOptions) ChartOptions {
 }
 
 // createChartXML generates the chart XML file
-func (u *Updater) createChartXML(chartPath string, opts ChartOptions) error {
+func (u *Updater) createChartXML(chartPath string, chartIndex int, opts ChartOptions) error {
 	if err := os.MkdirAll(filepath.Dir(chartPath), 0o755); err != nil {
-		return fmt.Errorf("create charts directory: %w", err)
+		return NewFileWriteError("charts directory", err)
 	}
 
-	xml := generateChartXML(opts)
+	xml := generateChartXML(opts, newChartAxisIDs(chartIndex))
 
 	if err := atomicWriteFile(chartPath, xml, 0o644); err != nil {
-		return fmt.Errorf("write chart xml: %w", err)
+		return NewXMLWriteError("chart xml", err)
 	}
+	opts.reportProgress(ChartProgressWriting, 1, 1)
 
 	return nil
 }
 
+// chartAxisIDs holds the IDs that pair a chart's plot with its category and
+// value axes. The IDs only need to be unique within a chart part, but Word
+// and other consumers merging charts expect them to differ between charts.
+type chartAxisIDs struct {
+	category int
+	value    int
+}
+
+// newChartAxisIDs returns the axis IDs for the chart with the given index
+func newChartAxisIDs(chartIndex int) chartAxisIDs {
+	base := ChartAxisIDBase + chartIndex*ChartAxisIDIncrement
+	return chartAxisIDs{category: base, value: base + 1}
+}
+
+// xml returns the <c:axId> pair that a chart type element ends with
+func (ids chartAxisIDs) xml() string {
+	return fmt.Sprintf(`<c:axId val="%d"/><c:axId val="%d"/>`, ids.category, ids.value)
+}
+
 // generateChartXML creates the chart XML content with all extended options
-func generateChartXML(opts ChartOptions) []byte {
+func generateChartXML(opts ChartOptions, axisIDs chartAxisIDs) []byte {
 	var buf bytes.Buffer
 
 	// XML declaration with newline (Word requires this)
@@ -312,7 +646,7 @@ func generateChartXML(opts ChartOptions) []byte {
 
 	// Chart properties
 	buf.WriteString(fmt.Sprintf(`<c:date1904 val="%d"/>`, boolToInt(opts.Properties.Date1904)))
-	buf.WriteString(fmt.Sprintf(`<c:lang val="%s"/>`, opts.Properties.Language))
+	buf.WriteString(fmt.Sprintf(`<c:lang val="%s"/>`, xmlEscape(opts.Properties.Language)))
 	buf.WriteString(fmt.Sprintf(`<c:roundedCorners val="%d"/>`, boolToInt(opts.Properties.RoundedCorners)))
 
 	// Chart style (if not default)
@@ -334,23 +668,28 @@ func generateChartXML(opts ChartOptions) []byte {
 	// Generate chart type specific content
 	switch opts.ChartKind {
 	case ChartKindColumn, ChartKindBar: // both emit <c:barChart> with different barDir
-		buf.WriteString(generateBarChartXML(opts))
+		buf.WriteString(generateBarChartXML(opts, axisIDs))
 	case ChartKindLine:
-		buf.WriteString(generateLineChartXML(opts))
+		buf.WriteString(generateLineChartXML(opts, axisIDs))
 	case ChartKindPie:
 		buf.WriteString(generatePieChartXML(opts))
 	case ChartKindArea:
-		buf.WriteString(generateAreaChartXML(opts))
+		buf.WriteString(generateAreaChartXML(opts, axisIDs))
 	case ChartKindScatter:
-		buf.WriteString(generateScatterChartXML(opts))
+		buf.WriteString(generateScatterChartXML(opts, axisIDs))
 	default:
-		buf.WriteString(generateBarChartXML(opts)) // Default to bar/column
+		buf.WriteString(generateBarChartXML(opts, axisIDs)) // Default to bar/column
 	}
 
 	// Axes (category and value for most chart types, except pie)
 	if opts.ChartKind != ChartKindPie {
-		buf.WriteString(generateCategoryAxisXML(opts.CategoryAxis))
-		buf.WriteString(generateValueAxisXML(opts.ValueAxis))
+		buf.WriteString(generateCategoryAxisXML(opts.CategoryAxis, axisIDs))
+		buf.WriteString(generateValueAxisXML(opts.ValueAxis, axisIDs))
+	}
+
+	// Plot area fill and border follow the axes
+	if opts.PlotAreaOptions != nil {
+		buf.WriteString(generatePlotAreaShapeXML(opts.PlotAreaOptions))
 	}
 
 	buf.WriteString(`</c:plotArea>`)
@@ -366,18 +705,27 @@ func generateChartXML(opts ChartOptions) []byte {
 
 	buf.WriteString(`</c:chart>`)
 
+	// Chart area background
+	if opts.Properties.ChartAreaBackground != "" {
+		buf.WriteString(fmt.Sprintf(`<c:spPr><a:solidFill><a:srgbClr val="%s"/></a:solidFill></c:spPr>`,
+			normalizeHexColor(opts.Properties.ChartAreaBackground)))
+	}
+
 	// External data reference
 	buf.WriteString(`<c:externalData r:id="rId1">`)
 	buf.WriteString(`<c:autoUpdate val="0"/>`)
 	buf.WriteString(`</c:externalData>`)
 
+	// Extensions come last in chartSpace
+	buf.WriteString(generateChartExtListXML(opts))
+
 	buf.WriteString(`</c:chartSpace>`)
 
 	return buf.Bytes()
 }
 
 // generateBarChartXML generates bar/column chart XML with extended options
-func generateBarChartXML(opts ChartOptions) string {
+func generateBarChartXML(opts ChartOptions, axisIDs chartAxisIDs) string {
 	var buf bytes.Buffer
 
 	buf.WriteString(`<c:barChart>`)
@@ -388,6 +736,7 @@ func generateBarChartXML(opts ChartOptions) string {
 	// Series
 	for i, series := range opts.Series {
 		buf.WriteString(generateSeriesXML(i, series, opts))
+		opts.reportProgress(ChartProgressSeries, i+1, len(opts.Series))
 	}
 
 	// Data labels (chart-level default)
@@ -399,15 +748,14 @@ func generateBarChartXML(opts ChartOptions) string {
 
 	buf.WriteString(fmt.Sprintf(`<c:gapWidth val="%d"/>`, opts.BarChartOptions.GapWidth))
 	buf.WriteString(fmt.Sprintf(`<c:overlap val="%d"/>`, opts.BarChartOptions.Overlap))
-	buf.WriteString(`<c:axId val="2071991400"/>`)
-	buf.WriteString(`<c:axId val="2071991240"/>`)
+	buf.WriteString(axisIDs.xml())
 	buf.WriteString(`</c:barChart>`)
 
 	return buf.String()
 }
 
 // generateLineChartXML generates line chart XML with extended options
-func generateLineChartXML(opts ChartOptions) string {
+func generateLineChartXML(opts ChartOptions, axisIDs chartAxisIDs) string {
 	var buf bytes.Buffer
 
 	buf.WriteString(`<c:lineChart>`)
@@ -417,6 +765,7 @@ func generateLineChartXML(opts ChartOptions) string {
 	// Series
 	for i, series := range opts.Series {
 		buf.WriteString(generateSeriesXML(i, series, opts))
+		opts.reportProgress(ChartProgressSeries, i+1, len(opts.Series))
 	}
 
 	// Data labels
@@ -426,8 +775,7 @@ func generateLineChartXML(opts ChartOptions) string {
 		buf.WriteString(`<c:dLbls><c:showLegendKey val="0"/><c:showVal val="0"/><c:showCatName val="0"/><c:showSerName val="0"/><c:showPercent val="0"/><c:showBubbleSize val="0"/></c:dLbls>`)
 	}
 
-	buf.WriteString(`<c:axId val="2071991400"/>`)
-	buf.WriteString(`<c:axId val="2071991240"/>`)
+	buf.WriteString(axisIDs.xml())
 	buf.WriteString(`</c:lineChart>`)
 
 	return buf.String()
@@ -443,6 +791,7 @@ func generatePieChartXML(opts ChartOptions) string {
 	// Series (pie charts usually have one series)
 	for i, series := range opts.Series {
 		buf.WriteString(generateSeriesXML(i, series, opts))
+		opts.reportProgress(ChartProgressSeries, i+1, len(opts.Series))
 	}
 
 	// Data labels
@@ -459,16 +808,17 @@ func generatePieChartXML(opts ChartOptions) string {
 }
 
 // generateAreaChartXML generates area chart XML with extended options
-func generateAreaChartXML(opts ChartOptions) string {
+func generateAreaChartXML(opts ChartOptions, axisIDs chartAxisIDs) string {
 	var buf bytes.Buffer
 
 	buf.WriteString(`<c:areaChart>`)
-	buf.WriteString(`<c:grouping val="standard"/>`)
+	buf.WriteString(fmt.Sprintf(`<c:grouping val="%s"/>`, opts.AreaChartOptions.Grouping))
 	buf.WriteString(`<c:varyColors val="0"/>`)
 
 	// Series
 	for i, series := range opts.Series {
 		buf.WriteString(generateSeriesXML(i, series, opts))
+		opts.reportProgress(ChartProgressSeries, i+1, len(opts.Series))
 	}
 
 	// Data labels
@@ -478,8 +828,7 @@ func generateAreaChartXML(opts ChartOptions) string {
 		buf.WriteString(`<c:dLbls><c:showLegendKey val="0"/><c:showVal val="0"/><c:showCatName val="0"/><c:showSerName val="0"/><c:showPercent val="0"/><c:showBubbleSize val="0"/></c:dLbls>`)
 	}
 
-	buf.WriteString(`<c:axId val="2071991400"/>`)
-	buf.WriteString(`<c:axId val="2071991240"/>`)
+	buf.WriteString(axisIDs.xml())
 	buf.WriteString(`</c:areaChart>`)
 
 	return buf.String()
@@ -500,7 +849,7 @@ type ScatterChartOptions struct {
 }
 
 // generateScatterChartXML generates scatter chart XML with extended options
-func generateScatterChartXML(opts ChartOptions) string {
+func generateScatterChartXML(opts ChartOptions, axisIDs chartAxisIDs) string {
 	var buf bytes.Buffer
 
 	buf.WriteString(`<c:scatterChart>`)
@@ -523,6 +872,7 @@ func generateScatterChartXML(opts ChartOptions) string {
 	// Series - scatter charts use different X values (not categories)
 	for i, series := range opts.Series {
 		buf.WriteString(generateScatterSeriesXML(i, series, opts))
+		opts.reportProgress(ChartProgressSeries, i+1, len(opts.Series))
 	}
 
 	// Data labels
@@ -532,8 +882,7 @@ func generateScatterChartXML(opts ChartOptions) string {
 		buf.WriteString(`<c:dLbls><c:showLegendKey val="0"/><c:showVal val="0"/><c:showCatName val="0"/><c:showSerName val="0"/><c:showPercent val="0"/><c:showBubbleSize val="0"/></c:dLbls>`)
 	}
 
-	buf.WriteString(`<c:axId val="2071991400"/>`)
-	buf.WriteString(`<c:axId val="2071991240"/>`)
+	buf.WriteString(axisIDs.xml())
 	buf.WriteString(`</c:scatterChart>`)
 
 	return buf.String()
@@ -549,13 +898,12 @@ func generateScatterSeriesXML(index int, series SeriesOptions, opts ChartOptions
 	// Series name
 	buf.WriteString(fmt.Sprintf(`<c:tx><c:strRef><c:f>Sheet1!$%s$1</c:f>`, columnLetter(index+2)))
 	buf.WriteString(fmt.Sprintf(`<c:strCache><c:ptCount val="1"/><c:pt idx="0"><c:v>%s</c:v></c:pt></c:strCache></c:strRef></c:tx>`,
-		xmlEscape(series.Name)))
+		xmlEscapeContent(series.Name)))
 
-	// Shape properties (color)
-	if series.Color != "" {
+	// Shape properties (fill)
+	if fill := seriesFillXML(index, series, opts); fill != "" {
 		buf.WriteString(`<c:spPr>`)
-		color := normalizeHexColor(series.Color)
-		buf.WriteString(fmt.Sprintf(`<a:solidFill><a:srgbClr val="%s"/></a:solidFill>`, color))
+		buf.WriteString(fill)
 		buf.WriteString(`</c:spPr>`)
 	}
 
@@ -632,13 +980,13 @@ func columnLetter(col int) string {
 // createEmbeddedWorkbook creates the embedded Excel workbook with chart data
 func (u *Updater) createEmbeddedWorkbook(workbookPath string, opts ChartOptions) error {
 	if err := os.MkdirAll(filepath.Dir(workbookPath), 0o755); err != nil {
-		return fmt.Errorf("create embeddings directory: %w", err)
+		return NewFileWriteError("embeddings directory", err)
 	}
 
 	// Create a minimal XLSX file with the chart data
 	file, err := os.Create(workbookPath)
 	if err != nil {
-		return fmt.Errorf("create workbook file: %w", err)
+		return NewFileWriteError("workbook file", err)
 	}
 	defer file.Close()
 
@@ -682,10 +1030,10 @@ func (u *Updater) createEmbeddedWorkbook(workbookPath string, opts ChartOptions)
 func addZipFile(zipWriter *zip.Writer, name string, content []byte) error {
 	writer, err := zipWriter.Create(name)
 	if err != nil {
-		return fmt.Errorf("create zip entry %s: %w", name, err)
+		return NewFileWriteError(name, err)
 	}
 	if _, err := writer.Write(content); err != nil {
-		return fmt.Errorf("write zip entry %s: %w", name, err)
+		return NewFileWriteError(name, err)
 	}
 	return nil
 }
@@ -748,9 +1096,11 @@ func generateSheetXML(opts ChartOptions) []byte {
 	}
 	for i, series := range opts.Series {
 		col := columnLetter(i + 3) // Start from column C if XValues exist, otherwise B
-		buf.WriteString(fmt.Sprintf(`<c r="%s1" t="str"><v>%s</v></c>`, col, xmlEscape(series.Name)))
+		buf.WriteString(fmt.Sprintf(`<c r="%s1" t="str"><v>%s</v></c>`, col, xmlEscapeContent(series.Name)))
 	}
 	buf.WriteString(`</row>`)
+	rows := len(opts.Categories) + 1
+	opts.reportProgress(ChartProgressWorkbook, 1, rows)
 
 	// Data rows
 	for i, category := range opts.Categories {
@@ -758,7 +1108,7 @@ func generateSheetXML(opts ChartOptions) []byte {
 		buf.WriteString(fmt.Sprintf(`<row r="%d">`, rowNum))
 
 		// Category in column A
-		buf.WriteString(fmt.Sprintf(`<c r="A%d" t="str"><v>%s</v></c>`, rowNum, xmlEscape(category)))
+		buf.WriteString(fmt.Sprintf(`<c r="A%d" t="str"><v>%s</v></c>`, rowNum, xmlEscapeContent(category)))
 
 		// X values in column B (if applicable)
 		if hasXValues && len(opts.Series[0].XValues) > i {
@@ -774,6 +1124,7 @@ func generateSheetXML(opts ChartOptions) []byte {
 		}
 
 		buf.WriteString(`</row>`)
+		opts.reportProgress(ChartProgressWorkbook, rowNum, rows)
 	}
 
 	buf.WriteString(`</sheetData>
@@ -806,18 +1157,17 @@ func generateStylesXML() []byte {
 // createChartRelationships creates the chart relationships file
 func (u *Updater) createChartRelationships(relsPath, workbookPath string) error {
 	if err := os.MkdirAll(filepath.Dir(relsPath), 0o755); err != nil {
-		return fmt.Errorf("create chart _rels directory: %w", err)
+		return NewFileWriteError("chart _rels directory", err)
 	}
 
-	// Get relative path from charts directory to workbook
-	chartsDir := filepath.Join(u.tempDir, "word", "charts")
-	relPath, err := filepath.Rel(chartsDir, workbookPath)
+	// Relationship targets are package paths, so resolve the workbook against
+	// the package root and compute the target with forward slashes only. This
+	// does not depend on the charts directory existing or on the OS separator.
+	workbookPart, err := filepath.Rel(u.tempDir, workbookPath)
 	if err != nil {
-		return fmt.Errorf("calculate relative path: %w", err)
+		return NewRelationshipError("calculate package path of embedded workbook", err)
 	}
-
-	// Convert to forward slashes for XML
-	relPath = filepath.ToSlash(relPath)
+	relPath := relativePartTarget("word/charts", filepath.ToSlash(workbookPart))
 
 	xml := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
 <Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
@@ -825,7 +1175,7 @@ func (u *Updater) createChartRelationships(relsPath, workbookPath string) error
 </Relationships>`, relPath)
 
 	if err := atomicWriteFile(relsPath, []byte(xml), 0o644); err != nil {
-		return fmt.Errorf("write relationships file: %w", err)
+		return NewFileWriteError("relationships file", err)
 	}
 
 	return nil
@@ -836,17 +1186,18 @@ func (u *Updater) insertChartDrawing(chartIndex int, relID string, opts ChartOpt
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	// Generate chart drawing XML
-	drawingXML, err := u.generateChartDrawingWithSize(chartIndex, relID, opts.Width, opts.Height)
+	drawingXML, err := u.generateChartDrawingWithSize(chartIndex, relID, opts.Width, opts.Height, opts.FloatingAnchor)
 	if err != nil {
 		return fmt.Errorf("generate drawing xml: %w", err)
 	}
 
 	// Handle caption if specified
 	contentToInsert := drawingXML
+	opts.Caption = u.applyCaptionCounter(opts.Caption)
 	if opts.Caption != nil {
 		// Validate caption options
 		if err := ValidateCaptionOptions(opts.Caption); err != nil {
@@ -866,39 +1217,25 @@ func (u *Updater) insertChartDrawing(chartIndex int, relID string, opts ChartOpt
 	}
 
 	// Insert based on position
-	var updated []byte
-	switch opts.Position {
-	case PositionBeginning:
-		updated, err = insertAtBodyStart(raw, contentToInsert)
-	case PositionEnd:
-		updated, err = insertAtBodyEnd(raw, contentToInsert)
-	case PositionAfterText:
-		if opts.Anchor == "" {
-			return fmt.Errorf("anchor text required for PositionAfterText")
-		}
-		updated, err = insertAfterText(raw, contentToInsert, opts.Anchor)
-	case PositionBeforeText:
-		if opts.Anchor == "" {
-			return fmt.Errorf("anchor text required for PositionBeforeText")
-		}
-		updated, err = insertBeforeText(raw, contentToInsert, opts.Anchor)
-	default:
-		return fmt.Errorf("invalid insert position")
-	}
-
+	updated, err := insertElementAtPosition(raw, contentToInsert, opts.Position, opts.Anchor)
 	if err != nil {
 		return fmt.Errorf("insert chart: %w", err)
 	}
+	if opts.Caption != nil {
+		updated = numberCaptionField(updated)
+	}
 
 	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
+	u.consumeCaptionReset(opts.Caption)
 
 	return nil
 }
 
-// generateChartDrawingWithSize creates the inline drawing XML for a chart with custom dimensions
-func (u *Updater) generateChartDrawingWithSize(chartIndex int, relId string, width, height int) ([]byte, error) {
+// generateChartDrawingWithSize creates the drawing XML for a chart with custom
+// dimensions: inline by default, or a floating anchor when floating is set.
+func (u *Updater) generateChartDrawingWithSize(chartIndex int, relId string, width, height int, floating *FloatingOptions) ([]byte, error) {
 	// Get a unique docPr ID (document-wide drawing object ID)
 	docPrId, err := u.getNextDocPrId()
 	if err != nil {
@@ -909,6 +1246,12 @@ func (u *Updater) generateChartDrawingWithSize(chartIndex int, relId string, wid
 	anchorId := ChartAnchorIDBase + uint32(chartIndex)*ChartIDIncrement
 	editId := ChartEditIDBase + uint32(chartIndex)*ChartIDIncrement
 
+	if floating != nil {
+		graphic := fmt.Sprintf(`<a:graphic xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"><a:graphicData uri="http://schemas.openxmlformats.org/drawingml/2006/chart"><c:chart xmlns:c="http://schemas.openxmlformats.org/drawingml/2006/chart" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" r:id="%s"/></a:graphicData></a:graphic>`, relId)
+		drawing := generateAnchorDrawingXML(floatingOptionsPosition(floating, width, height), docPrId, fmt.Sprintf("Chart %d", chartIndex), graphic)
+		return []byte("<w:p><w:r>" + drawing + "</w:r></w:p>"), nil
+	}
+
 	template := `<w:p><w:r><w:drawing><wp:inline distT="0" distB="0" distL="0" distR="0" wp14:anchorId="%08X" wp14:editId="%08X"><wp:extent cx="%d" cy="%d"/><wp:effectExtent l="0" t="0" r="15875" b="12700"/><wp:docPr id="%d" name="Chart %d"/><wp:cNvGraphicFramePr/><a:graphic xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"><a:graphicData uri="http://schemas.openxmlformats.org/drawingml/2006/chart"><c:chart xmlns:c="http://schemas.openxmlformats.org/drawingml/2006/chart" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" r:id="%s"/></a:graphicData></a:graphic></wp:inline></w:drawing></w:r></w:p>`
 
 	return fmt.Appendf(nil, template, anchorId, editId, width, height, docPrId, chartIndex, relId), nil
@@ -940,7 +1283,7 @@ func applyAxisDefaults(axis *AxisOptions, isCategoryAxis bool) *AxisOptions {
 		axis.TickLabelPos = TickLabelNextTo
 	}
 	if axis.NumberFormat == "" {
-		axis.NumberFormat = "General"
+		axis.NumberFormat = NumberFormatGeneral
 	}
 
 	// Value axis has major gridlines by default
@@ -962,7 +1305,7 @@ func generateTitleXML(title string, overlay bool) string {
 	buf.WriteString(`<a:p>`)
 	buf.WriteString(`<a:pPr><a:defRPr/></a:pPr>`)
 	buf.WriteString(`<a:r><a:rPr lang="en-US"/><a:t>`)
-	buf.WriteString(xmlEscape(title))
+	buf.WriteString(xmlEscapeContent(title))
 	buf.WriteString(`</a:t></a:r>`)
 	buf.WriteString(`</a:p>`)
 	buf.WriteString(`</c:rich>`)
@@ -980,6 +1323,25 @@ func generateLegendXML(legend *LegendOptions) string {
 	buf.WriteString(fmt.Sprintf(`<c:legendPos val="%s"/>`, legend.Position))
 	buf.WriteString(`<c:layout/>`)
 	buf.WriteString(fmt.Sprintf(`<c:overlay val="%d"/>`, boolToInt(legend.Overlay)))
+	if legend.LegendBorderStyle != nil {
+		buf.WriteString(`<c:spPr>`)
+		buf.WriteString(generateChartLineXML(legend.LegendBorderStyle.Color, legend.LegendBorderStyle.Width, ""))
+		buf.WriteString(`</c:spPr>`)
+	}
+	if legend.FontSize > 0 || legend.FontFamily != "" || legend.FontColor != "" || legend.Bold || legend.Italic {
+		buf.WriteString(`<c:txPr><a:bodyPr/><a:lstStyle/><a:p><a:pPr><a:defRPr`)
+		if legend.FontSize > 0 {
+			buf.WriteString(fmt.Sprintf(` sz="%d"`, legend.FontSize*100))
+		}
+		buf.WriteString(fmt.Sprintf(` b="%d" i="%d">`, boolToInt(legend.Bold), boolToInt(legend.Italic)))
+		if legend.FontColor != "" {
+			buf.WriteString(fmt.Sprintf(`<a:solidFill><a:srgbClr val="%s"/></a:solidFill>`, normalizeHexColor(legend.FontColor)))
+		}
+		if legend.FontFamily != "" {
+			buf.WriteString(fmt.Sprintf(`<a:latin typeface="%s"/>`, xmlEscape(legend.FontFamily)))
+		}
+		buf.WriteString(`</a:defRPr></a:pPr><a:endParaRPr lang="en-US"/></a:p></c:txPr>`)
+	}
 	buf.WriteString(`</c:legend>`)
 	return buf.String()
 }
@@ -993,15 +1355,13 @@ func generateSeriesXML(index int, series SeriesOptions, opts ChartOptions) strin
 	// Series name
 	buf.WriteString(fmt.Sprintf(`<c:tx><c:strRef><c:f>Sheet1!$%s$1</c:f>`, columnLetter(index+2)))
 	buf.WriteString(fmt.Sprintf(`<c:strCache><c:ptCount val="1"/><c:pt idx="0"><c:v>%s</c:v></c:pt></c:strCache></c:strRef></c:tx>`,
-		xmlEscape(series.Name)))
+		xmlEscapeContent(series.Name)))
 
-	// Shape properties (color, etc.)
-	if series.Color != "" || series.InvertIfNegative {
+	// Shape properties (fill, etc.)
+	fill := seriesFillXML(index, series, opts)
+	if fill != "" || series.InvertIfNegative {
 		buf.WriteString(`<c:spPr>`)
-		if series.Color != "" {
-			color := normalizeHexColor(series.Color)
-			buf.WriteString(fmt.Sprintf(`<a:solidFill><a:srgbClr val="%s"/></a:solidFill>`, color))
-		}
+		buf.WriteString(fill)
 		buf.WriteString(`</c:spPr>`)
 	}
 
@@ -1010,11 +1370,14 @@ func generateSeriesXML(index int, series SeriesOptions, opts ChartOptions) strin
 		buf.WriteString(`<c:invertIfNegative val="1"/>`)
 	}
 
+	// Per-slice palette colors (pie charts)
+	buf.WriteString(generatePalettePointsXML(series, opts))
+
 	// Categories
 	buf.WriteString(fmt.Sprintf(`<c:cat><c:strRef><c:f>Sheet1!$A$2:$A$%d</c:f>`, len(opts.Categories)+1))
 	buf.WriteString(fmt.Sprintf(`<c:strCache><c:ptCount val="%d"/>`, len(opts.Categories)))
 	for j, cat := range opts.Categories {
-		buf.WriteString(fmt.Sprintf(`<c:pt idx="%d"><c:v>%s</c:v></c:pt>`, j, xmlEscape(cat)))
+		buf.WriteString(fmt.Sprintf(`<c:pt idx="%d"><c:v>%s</c:v></c:pt>`, j, xmlEscapeContent(cat)))
 	}
 	buf.WriteString(`</c:strCache></c:strRef></c:cat>`)
 
@@ -1050,6 +1413,55 @@ func generateSeriesXML(index int, series SeriesOptions, opts ChartOptions) strin
 	return buf.String()
 }
 
+// generatePlotAreaShapeXML generates the plot area shape properties
+func generatePlotAreaShapeXML(p *PlotAreaOptions) string {
+	hasBorder := p.BorderColor != "" || p.BorderWidth > 0 || p.BorderStyle != ""
+	if p.BackgroundColor == "" && !hasBorder {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`<c:spPr>`)
+	if p.BackgroundColor != "" {
+		buf.WriteString(fmt.Sprintf(`<a:solidFill><a:srgbClr val="%s"/></a:solidFill>`, normalizeHexColor(p.BackgroundColor)))
+	} else {
+		buf.WriteString(`<a:noFill/>`)
+	}
+	if hasBorder {
+		buf.WriteString(generateChartLineXML(p.BorderColor, p.BorderWidth, p.BorderStyle))
+	}
+	buf.WriteString(`</c:spPr>`)
+	return buf.String()
+}
+
+// generateGridlinesXML generates a majorGridlines or minorGridlines element.
+// A non-nil style turns the gridlines on even if show is false.
+func generateGridlinesXML(tag string, show bool, style *GridlineStyle) string {
+	if style != nil {
+		return fmt.Sprintf(`<c:%s><c:spPr>%s</c:spPr></c:%s>`, tag, generateChartLineXML(style.Color, style.Width, style.DashType), tag)
+	}
+	if show {
+		return fmt.Sprintf(`<c:%s/>`, tag)
+	}
+	return ""
+}
+
+// generateChartLineXML generates an <a:ln> element. Color defaults to black,
+// width (in points) to 1 and dash to solid.
+func generateChartLineXML(color string, widthPt int, dash string) string {
+	if color == "" {
+		color = "000000"
+	}
+	if widthPt == 0 {
+		widthPt = 1
+	}
+	if dash == "" {
+		dash = "solid"
+	}
+	return fmt.Sprintf(`<a:ln w="%d"><a:solidFill><a:srgbClr val="%s"/></a:solidFill><a:prstDash val="%s"/></a:ln>`,
+		widthPt*12700, normalizeHexColor(color), chartLineDash[dash])
+}
+
 // generateDataLabelsXML generates data labels XML
 func generateDataLabelsXML(labels *DataLabelOptions) string {
 	var buf bytes.Buffer
@@ -1071,11 +1483,11 @@ func generateDataLabelsXML(labels *DataLabelOptions) string {
 }
 
 // generateCategoryAxisXML generates category axis XML with extended options
-func generateCategoryAxisXML(axis *AxisOptions) string {
+func generateCategoryAxisXML(axis *AxisOptions, axisIDs chartAxisIDs) string {
 	var buf bytes.Buffer
 
 	buf.WriteString(`<c:catAx>`)
-	buf.WriteString(`<c:axId val="2071991400"/>`)
+	buf.WriteString(fmt.Sprintf(`<c:axId val="%d"/>`, axisIDs.category))
 
 	// Scaling
 	buf.WriteString(`<c:scaling>`)
@@ -1091,22 +1503,21 @@ func generateCategoryAxisXML(axis *AxisOptions) string {
 	buf.WriteString(fmt.Sprintf(`<c:delete val="%d"/>`, boolToInt(!axis.Visible)))
 	buf.WriteString(fmt.Sprintf(`<c:axPos val="%s"/>`, axis.Position))
 
-	// Major gridlines
-	if axis.MajorGridlines {
-		buf.WriteString(`<c:majorGridlines/>`)
-	}
+	// Gridlines (minor must directly follow major)
+	buf.WriteString(generateGridlinesXML("majorGridlines", axis.MajorGridlines, axis.MajorGridlineStyle))
+	buf.WriteString(generateGridlinesXML("minorGridlines", axis.MinorGridlines, axis.MinorGridlineStyle))
 
 	// Title
 	if axis.Title != "" {
 		buf.WriteString(generateAxisTitleXML(axis.Title, axis.TitleOverlay))
 	}
 
-	buf.WriteString(fmt.Sprintf(`<c:numFmt formatCode="%s" sourceLinked="0"/>`, axis.NumberFormat))
+	buf.WriteString(fmt.Sprintf(`<c:numFmt formatCode="%s" sourceLinked="0"/>`, axisNumberFormatCode(axis)))
 	buf.WriteString(fmt.Sprintf(`<c:majorTickMark val="%s"/>`, axis.MajorTickMark))
 	buf.WriteString(fmt.Sprintf(`<c:minorTickMark val="%s"/>`, axis.MinorTickMark))
 	buf.WriteString(fmt.Sprintf(`<c:tickLblPos val="%s"/>`, axis.TickLabelPos))
 
-	buf.WriteString(`<c:crossAx val="2071991240"/>`)
+	buf.WriteString(fmt.Sprintf(`<c:crossAx val="%d"/>`, axisIDs.value))
 
 	if axis.CrossesAt != nil {
 		buf.WriteString(fmt.Sprintf(`<c:crossesAt val="%g"/>`, *axis.CrossesAt))
@@ -1118,22 +1529,17 @@ func generateCategoryAxisXML(axis *AxisOptions) string {
 	buf.WriteString(`<c:lblAlgn val="ctr"/>`)
 	buf.WriteString(`<c:lblOffset val="100"/>`)
 
-	// Minor gridlines
-	if axis.MinorGridlines {
-		buf.WriteString(`<c:minorGridlines/>`)
-	}
-
 	buf.WriteString(`</c:catAx>`)
 
 	return buf.String()
 }
 
 // generateValueAxisXML generates value axis XML with extended options
-func generateValueAxisXML(axis *AxisOptions) string {
+func generateValueAxisXML(axis *AxisOptions, axisIDs chartAxisIDs) string {
 	var buf bytes.Buffer
 
 	buf.WriteString(`<c:valAx>`)
-	buf.WriteString(`<c:axId val="2071991240"/>`)
+	buf.WriteString(fmt.Sprintf(`<c:axId val="%d"/>`, axisIDs.value))
 
 	// Scaling
 	buf.WriteString(`<c:scaling>`)
@@ -1149,17 +1555,16 @@ func generateValueAxisXML(axis *AxisOptions) string {
 	buf.WriteString(fmt.Sprintf(`<c:delete val="%d"/>`, boolToInt(!axis.Visible)))
 	buf.WriteString(fmt.Sprintf(`<c:axPos val="%s"/>`, axis.Position))
 
-	// Major gridlines
-	if axis.MajorGridlines {
-		buf.WriteString(`<c:majorGridlines/>`)
-	}
+	// Gridlines (minor must directly follow major)
+	buf.WriteString(generateGridlinesXML("majorGridlines", axis.MajorGridlines, axis.MajorGridlineStyle))
+	buf.WriteString(generateGridlinesXML("minorGridlines", axis.MinorGridlines, axis.MinorGridlineStyle))
 
 	// Title
 	if axis.Title != "" {
 		buf.WriteString(generateAxisTitleXML(axis.Title, axis.TitleOverlay))
 	}
 
-	buf.WriteString(fmt.Sprintf(`<c:numFmt formatCode="%s" sourceLinked="0"/>`, axis.NumberFormat))
+	buf.WriteString(fmt.Sprintf(`<c:numFmt formatCode="%s" sourceLinked="0"/>`, axisNumberFormatCode(axis)))
 
 	if axis.MajorUnit != nil {
 		buf.WriteString(fmt.Sprintf(`<c:majorUnit val="%g"/>`, *axis.MajorUnit))
@@ -1172,7 +1577,7 @@ func generateValueAxisXML(axis *AxisOptions) string {
 	buf.WriteString(fmt.Sprintf(`<c:minorTickMark val="%s"/>`, axis.MinorTickMark))
 	buf.WriteString(fmt.Sprintf(`<c:tickLblPos val="%s"/>`, axis.TickLabelPos))
 
-	buf.WriteString(`<c:crossAx val="2071991400"/>`)
+	buf.WriteString(fmt.Sprintf(`<c:crossAx val="%d"/>`, axisIDs.category))
 
 	if axis.CrossesAt != nil {
 		buf.WriteString(fmt.Sprintf(`<c:crossesAt val="%g"/>`, *axis.CrossesAt))
@@ -1182,11 +1587,6 @@ func generateValueAxisXML(axis *AxisOptions) string {
 
 	buf.WriteString(`<c:crossBetween val="between"/>`)
 
-	// Minor gridlines
-	if axis.MinorGridlines {
-		buf.WriteString(`<c:minorGridlines/>`)
-	}
-
 	buf.WriteString(`</c:valAx>`)
 
 	return buf.String()
@@ -1199,7 +1599,7 @@ func generateAxisTitleXML(title string, overlay bool) string {
 	buf.WriteString(`<c:tx><c:rich><a:bodyPr/><a:lstStyle/><a:p>`)
 	buf.WriteString(`<a:pPr><a:defRPr/></a:pPr>`)
 	buf.WriteString(`<a:r><a:rPr lang="en-US"/><a:t>`)
-	buf.WriteString(xmlEscape(title))
+	buf.WriteString(xmlEscapeContent(title))
 	buf.WriteString(`</a:t></a:r>`)
 	buf.WriteString(`</a:p></c:rich></c:tx>`)
 	buf.WriteString(`<c:layout/>`)
@@ -1216,6 +1616,25 @@ func boolToInt(b bool) int {
 	return 0
 }
 
+// relativePartTarget returns the relationship target that points from a
+// source directory to a part, both given as slash-separated package paths
+// (e.g. "word/charts" and "word/embeddings/book.xlsx" give
+// "../embeddings/book.xlsx").
+func relativePartTarget(fromDir, toPart string) string {
+	from := strings.Split(path.Clean(fromDir), "/")
+	to := strings.Split(path.Clean(toPart), "/")
+	common := 0
+	for common < len(from) && common < len(to)-1 && from[common] == to[common] {
+		common++
+	}
+	parts := make([]string, 0, len(from)-common+len(to)-common)
+	for range from[common:] {
+		parts = append(parts, "..")
+	}
+	parts = append(parts, to[common:]...)
+	return strings.Join(parts, "/")
+}
+
 // findNextChartIndex finds the next available chart index by scanning chart files.
 func (u *Updater) findNextChartIndex() int {
 	chartsDir := filepath.Join(u.tempDir, "word", "charts")
@@ -1245,7 +1664,7 @@ func (u *Updater) addChartRelationship(chartIndex int) (string, error) {
 	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
 	raw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return "", fmt.Errorf("read document relationships: %w", err)
+		return "", NewFileReadError("document relationships", err)
 	}
 
 	nextRelId, err := u.getNextDocumentRelId()
@@ -1257,7 +1676,7 @@ func (u *Updater) addChartRelationship(chartIndex int) (string, error) {
 	closer := []byte("</Relationships>")
 	pos := bytes.LastIndex(raw, closer)
 	if pos == -1 {
-		return "", fmt.Errorf("invalid document.xml.rels: missing </Relationships>")
+		return "", NewMalformedXMLError("invalid document.xml.rels: missing </Relationships>")
 	}
 	result := make([]byte, len(raw)+len(insert))
 	n := copy(result, raw[:pos])
@@ -1265,7 +1684,7 @@ func (u *Updater) addChartRelationship(chartIndex int) (string, error) {
 	copy(result[n:], raw[pos:])
 
 	if err := atomicWriteFile(relsPath, result, 0o644); err != nil {
-		return "", fmt.Errorf("write relationships: %w", err)
+		return "", NewFileWriteError("relationships", err)
 	}
 	return nextRelId, nil
 }
@@ -1275,7 +1694,7 @@ func (u *Updater) addContentTypeOverride(chartIndex int) error {
 	contentTypesPath := filepath.Join(u.tempDir, "[Content_Types].xml")
 	raw, err := os.ReadFile(contentTypesPath)
 	if err != nil {
-		return fmt.Errorf("read content types: %w", err)
+		return NewFileReadError("content types", err)
 	}
 
 	chartPart := fmt.Sprintf("/word/charts/chart%d.xml", chartIndex)
@@ -1287,7 +1706,7 @@ func (u *Updater) addContentTypeOverride(chartIndex int) error {
 	closer := []byte("</Types>")
 	pos := bytes.LastIndex(raw, closer)
 	if pos == -1 {
-		return fmt.Errorf("invalid [Content_Types].xml: missing </Types>")
+		return NewMalformedXMLError("invalid [Content_Types].xml: missing </Types>")
 	}
 	result := make([]byte, len(raw)+len(insert))
 	n := copy(result, raw[:pos])
@@ -0,0 +1,104 @@
+package godocx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ChartBuildType defines how an animated chart is built up when the
+// document is presented, using the DrawingML chart build values.
+type ChartBuildType string
+
+const (
+	ChartBuildAllAtOnce  ChartBuildType = "allAtOnce" // Whole chart at once (default)
+	ChartBuildBySeries   ChartBuildType = "series"    // One series at a time
+	ChartBuildByCategory ChartBuildType = "category"  // One category at a time
+	ChartBuildByElement  ChartBuildType = "seriesEl"  // Each data point of each series in turn
+)
+
+// ChartAnimationOptions holds animation hints for a chart. Word does not
+// animate charts and ignores them; they are kept in the chart part for
+// tools that turn the document into a presentation.
+type ChartAnimationOptions struct {
+	Build    ChartBuildType // Build order (default: allAtOnce)
+	Duration int            // Duration of each build step in milliseconds (0 = presenter default)
+}
+
+// chartAnimationExtURI identifies the chart-level extension that stores
+// ChartAnimationOptions. DrawingML has no chart-part animation element, so
+// the build is written as an <a:bldChart> (the element PowerPoint uses in
+// slide build lists) inside an extension private to this package.
+const chartAnimationExtURI = "{7A1E3C52-94B8-4F0D-9C2A-6E5B1D8F3A47}"
+
+func validateChartAnimation(a *ChartAnimationOptions) error {
+	switch a.Build {
+	case "", ChartBuildAllAtOnce, ChartBuildBySeries, ChartBuildByCategory, ChartBuildByElement:
+	default:
+		return NewValidationError("Animation.Build", fmt.Sprintf("unsupported build type %q", a.Build))
+	}
+	if a.Duration < 0 {
+		return NewValidationError("Animation.Duration", "cannot be negative")
+	}
+	return nil
+}
+
+// validateChartExtensionData checks that every extension has a usable URI
+// and well-formed XML content.
+func validateChartExtensionData(ext map[string]string, hasAnimation bool) error {
+	for uri, content := range ext {
+		if strings.TrimSpace(uri) == "" || strings.ContainsAny(uri, `"<>&`) {
+			return NewValidationError("ExtensionData", fmt.Sprintf("invalid extension URI %q", uri))
+		}
+		if hasAnimation && uri == chartAnimationExtURI {
+			return NewValidationError("ExtensionData", fmt.Sprintf("extension URI %s is reserved for Animation", uri))
+		}
+		d := xml.NewDecoder(strings.NewReader("<ext>" + content + "</ext>"))
+		for {
+			_, err := d.Token()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return NewValidationError("ExtensionData", fmt.Sprintf("extension %s is not well-formed XML: %v", uri, err))
+			}
+		}
+	}
+	return nil
+}
+
+// generateChartExtListXML generates the chartSpace <c:extLst> holding the
+// animation hints and any caller-supplied extensions, ordered by URI.
+func generateChartExtListXML(opts ChartOptions) string {
+	if opts.Animation == nil && len(opts.ExtensionData) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`<c:extLst>`)
+	if a := opts.Animation; a != nil {
+		build := a.Build
+		if build == "" {
+			build = ChartBuildAllAtOnce
+		}
+		fmt.Fprintf(&buf, `<c:ext uri="%s"><a:bldChart bld="%s" animBg="1"`, chartAnimationExtURI, build)
+		if a.Duration > 0 {
+			fmt.Fprintf(&buf, ` xmlns:gdx="http://github.com/falcomza/go-docx/chart-animation" gdx:dur="%d"`, a.Duration)
+		}
+		buf.WriteString(`/></c:ext>`)
+	}
+	uris := make([]string, 0, len(opts.ExtensionData))
+	for uri := range opts.ExtensionData {
+		uris = append(uris, uri)
+	}
+	slices.Sort(uris)
+	for _, uri := range uris {
+		fmt.Fprintf(&buf, `<c:ext uri="%s">%s</c:ext>`, uri, opts.ExtensionData[uri])
+	}
+	buf.WriteString(`</c:extLst>`)
+	return buf.String()
+}
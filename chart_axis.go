@@ -0,0 +1,162 @@
+package godocx
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// AxisType selects an axis of an existing chart.
+type AxisType int
+
+const (
+	AxisCategory AxisType = iota // Category (horizontal) axis; the X axis of scatter charts
+	AxisValue                    // Primary value (vertical) axis
+)
+
+func (t AxisType) role() (axisRole, error) {
+	switch t {
+	case AxisCategory:
+		return axisRoleCategory, nil
+	case AxisValue:
+		return axisRoleValue, nil
+	}
+	return 0, NewValidationError("axisType", fmt.Sprintf("unsupported axis type %d", t))
+}
+
+// SetChartAxisRange sets the minimum and maximum of an axis of chart N
+// (1-based). A nil bound removes it, so that Word scales that end of the
+// axis automatically again.
+func (u *Updater) SetChartAxisRange(chartIndex int, axisType AxisType, min, max *float64) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	for name, v := range map[string]*float64{"min": min, "max": max} {
+		if v != nil && (math.IsNaN(*v) || math.IsInf(*v, 0)) {
+			return NewValidationError(name, "must be a finite number")
+		}
+	}
+	if min != nil && max != nil && *min >= *max {
+		return NewValidationError("min", "min must be less than max")
+	}
+
+	return u.editChartAxis(chartIndex, axisType, func(content string, axis axisRange, ns string) (string, error) {
+		block := content[axis.start:axis.end]
+		scalingOpen, scalingClose := "<"+ns+"scaling>", "</"+ns+"scaling>"
+		start := strings.Index(block, scalingOpen)
+		end := strings.Index(block, scalingClose)
+		if start < 0 || end < start {
+			return "", NewMalformedXMLError(fmt.Sprintf("%s has no scaling element", axis.kind))
+		}
+		scaling := block[start+len(scalingOpen) : end]
+		scaling = chartChildPattern(ns, "max").ReplaceAllString(scaling, "")
+		scaling = chartChildPattern(ns, "min").ReplaceAllString(scaling, "")
+
+		// The schema orders scaling as logBase, orientation, max, min.
+		var bounds string
+		if max != nil {
+			bounds += fmt.Sprintf(`<%smax val="%s"/>`, ns, formatAxisValue(*max))
+		}
+		if min != nil {
+			bounds += fmt.Sprintf(`<%smin val="%s"/>`, ns, formatAxisValue(*min))
+		}
+		pos := 0
+		for _, prev := range []string{"logBase", "orientation"} {
+			if loc := chartChildPattern(ns, prev).FindStringIndex(scaling); loc != nil {
+				pos = loc[1]
+			}
+		}
+		scaling = scaling[:pos] + bounds + scaling[pos:]
+
+		block = block[:start+len(scalingOpen)] + scaling + block[end:]
+		return content[:axis.start] + block + content[axis.end:], nil
+	})
+}
+
+// SetChartMajorUnit sets the interval between major tick marks and
+// gridlines of a value or date axis of chart N (1-based). A nil unit
+// removes it, restoring the automatic interval.
+func (u *Updater) SetChartMajorUnit(chartIndex int, axisType AxisType, unit *float64) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if unit != nil && (math.IsNaN(*unit) || math.IsInf(*unit, 0) || *unit <= 0) {
+		return NewValidationError("unit", "major unit must be a positive number")
+	}
+
+	return u.editChartAxis(chartIndex, axisType, func(content string, axis axisRange, ns string) (string, error) {
+		if axis.kind != "valAx" && axis.kind != "dateAx" {
+			return "", NewValidationError("axisType", fmt.Sprintf("a %s has no major unit", axis.kind))
+		}
+		order := valAxChildOrder
+		if axis.kind == "dateAx" {
+			order = dateAxChildOrder
+		}
+		innerStart := axis.start + len("<"+ns+axis.kind+">")
+		innerEnd := axis.end - len("</"+ns+axis.kind+">")
+		children := splitXMLChildren([]byte(content[innerStart:innerEnd]))
+
+		var elem string
+		if unit != nil {
+			elem = fmt.Sprintf(`<%smajorUnit val="%s"/>`, ns, formatAxisValue(*unit))
+		}
+		children = upsertOrderedChild(children, ns+"majorUnit", elem, order)
+
+		var buf strings.Builder
+		buf.WriteString(content[:innerStart])
+		for _, c := range children {
+			buf.Write(c.xml)
+		}
+		buf.WriteString(content[innerEnd:])
+		return buf.String(), nil
+	})
+}
+
+// valAxChildOrder and dateAxChildOrder are the CT_ValAx and CT_DateAx child
+// sequences: the shared CT_Axis elements followed by the axis-specific ones.
+var (
+	valAxChildOrder = append(slices.Clone(axisChildOrder),
+		"crosses", "crossesAt", "crossBetween", "majorUnit", "minorUnit", "dispUnits", "extLst")
+	dateAxChildOrder = append(slices.Clone(axisChildOrder),
+		"crosses", "crossesAt", "auto", "lblOffset", "baseTimeUnit", "majorUnit", "majorTimeUnit",
+		"minorUnit", "minorTimeUnit", "extLst")
+)
+
+// editChartAxis applies edit to the selected axis of chart N and writes the
+// chart back.
+func (u *Updater) editChartAxis(chartIndex int, axisType AxisType, edit func(content string, axis axisRange, ns string) (string, error)) error {
+	role, err := axisType.role()
+	if err != nil {
+		return err
+	}
+	content, chartPath, err := u.readChartPart(chartIndex)
+	if err != nil {
+		return err
+	}
+	ns := detectNamespacePrefix(content)
+	axis, ok := findAxis(content, ns, role)
+	if !ok {
+		return NewValidationError("axisType", fmt.Sprintf("chart %d has no %s", chartIndex, role))
+	}
+	content, err = edit(content, axis, ns)
+	if err != nil {
+		return err
+	}
+	if err := atomicWriteFile(chartPath, []byte(content), 0o644); err != nil {
+		return NewXMLWriteError(filepath.Base(chartPath), err)
+	}
+	return nil
+}
+
+// chartChildPattern matches an empty chart element such as <c:min val="0"/>.
+func chartChildPattern(ns, name string) *regexp.Regexp {
+	return regexp.MustCompile(`<` + regexp.QuoteMeta(ns+name) + `\b[^>]*/>`)
+}
+
+func formatAxisValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
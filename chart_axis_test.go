@@ -0,0 +1,113 @@
+package godocx_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	godocx "github.com/falcomza/go-docx"
+)
+
+func valueAxisXML(t *testing.T, u *godocx.Updater) string {
+	t.Helper()
+	chart := readChart1(t, u)
+	start := strings.Index(chart, "<c:valAx>")
+	end := strings.Index(chart, "</c:valAx>")
+	if start < 0 || end < start {
+		t.Fatalf("chart has no value axis:\n%s", chart)
+	}
+	return chart[start:end]
+}
+
+func TestSetChartAxisRange(t *testing.T) {
+	u := newChartTitleUpdater(t, "Range")
+	lo, hi := 0.0, 250.5
+
+	if err := u.SetChartAxisRange(1, godocx.AxisValue, &lo, &hi); err != nil {
+		t.Fatalf("SetChartAxisRange: %v", err)
+	}
+	axis := valueAxisXML(t, u)
+	if !strings.Contains(axis, `<c:scaling><c:orientation val="minMax"/><c:max val="250.5"/><c:min val="0"/></c:scaling>`) {
+		t.Errorf("unexpected scaling:\n%s", axis)
+	}
+
+	// nil restores automatic scaling for that bound only.
+	if err := u.SetChartAxisRange(1, godocx.AxisValue, nil, &hi); err != nil {
+		t.Fatalf("SetChartAxisRange: %v", err)
+	}
+	axis = valueAxisXML(t, u)
+	if strings.Contains(axis, "<c:min ") || !strings.Contains(axis, `<c:max val="250.5"/>`) {
+		t.Errorf("expected only max to remain:\n%s", axis)
+	}
+
+	if err := u.SetChartAxisRange(1, godocx.AxisCategory, nil, nil); err != nil {
+		t.Fatalf("SetChartAxisRange on category axis: %v", err)
+	}
+}
+
+func TestSetChartAxisRangeValidation(t *testing.T) {
+	u := newChartTitleUpdater(t, "Range")
+	lo, hi := 10.0, 5.0
+
+	if err := u.SetChartAxisRange(1, godocx.AxisValue, &lo, &hi); err == nil {
+		t.Error("expected error when min >= max")
+	}
+	if err := u.SetChartAxisRange(1, godocx.AxisType(7), nil, nil); err == nil {
+		t.Error("expected error for unknown axis type")
+	}
+	if err := u.SetChartAxisRange(2, godocx.AxisValue, nil, nil); err == nil {
+		t.Error("expected error for missing chart")
+	}
+}
+
+func TestSetChartMajorUnit(t *testing.T) {
+	u := newChartTitleUpdater(t, "Units")
+	unit := 25.0
+
+	if err := u.SetChartMajorUnit(1, godocx.AxisValue, &unit); err != nil {
+		t.Fatalf("SetChartMajorUnit: %v", err)
+	}
+	axis := valueAxisXML(t, u)
+	if strings.Count(axis, "<c:majorUnit") != 1 || !strings.Contains(axis, `<c:crossBetween val="between"/><c:majorUnit val="25"/>`) {
+		t.Errorf("majorUnit should follow crossBetween:\n%s", axis)
+	}
+
+	if err := u.SetChartMajorUnit(1, godocx.AxisValue, nil); err != nil {
+		t.Fatalf("SetChartMajorUnit(nil): %v", err)
+	}
+	if axis := valueAxisXML(t, u); strings.Contains(axis, "<c:majorUnit") {
+		t.Errorf("majorUnit should be removed:\n%s", axis)
+	}
+
+	zero := 0.0
+	if err := u.SetChartMajorUnit(1, godocx.AxisValue, &zero); err == nil {
+		t.Error("expected error for zero major unit")
+	}
+	if err := u.SetChartMajorUnit(1, godocx.AxisCategory, &unit); err == nil {
+		t.Error("expected error for category axis without major unit")
+	}
+}
+
+func TestSetChartMajorUnitBeforeDisplayUnits(t *testing.T) {
+	u := newChartTitleUpdater(t, "Display units")
+	chartPath := filepath.Join(u.TempDir(), "word", "charts", "chart1.xml")
+	chart := strings.Replace(readChart1(t, u), "</c:valAx>",
+		`<c:dispUnits><c:builtInUnit val="thousands"/></c:dispUnits>`+
+			`<c:extLst><c:ext uri="{test}"><c:marker/></c:ext></c:extLst></c:valAx>`, 1)
+	if err := os.WriteFile(chartPath, []byte(chart), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	unit := 1000.0
+	if err := u.SetChartMajorUnit(1, godocx.AxisValue, &unit); err != nil {
+		t.Fatalf("SetChartMajorUnit: %v", err)
+	}
+	axis := valueAxisXML(t, u)
+	if !strings.Contains(axis, `<c:crossBetween val="between"/><c:majorUnit val="1000"/><c:dispUnits>`) {
+		t.Errorf("majorUnit should precede non-empty dispUnits:\n%s", axis)
+	}
+	if !strings.HasSuffix(axis, `</c:dispUnits><c:extLst><c:ext uri="{test}"><c:marker/></c:ext></c:extLst>`) {
+		t.Errorf("dispUnits and extLst should stay last:\n%s", axis)
+	}
+}
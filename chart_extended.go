@@ -53,6 +53,22 @@ const (
 	TickLabelNone   TickLabelPosition = "none"   // No labels
 )
 
+// AxisNumberFormat is an Excel number format code for axis tick labels
+type AxisNumberFormat string
+
+const (
+	NumberFormatGeneral    AxisNumberFormat = "General"   // Default display
+	NumberFormatInteger    AxisNumberFormat = "0"         // 1234
+	NumberFormatDecimal2   AxisNumberFormat = "0.00"      // 1234.50
+	NumberFormatCurrency   AxisNumberFormat = "$#,##0.00" // $1,234.50
+	NumberFormatPercent    AxisNumberFormat = "0%"        // 12%
+	NumberFormatDate       AxisNumberFormat = "m/d/yyyy"  // 3/14/2026
+	NumberFormatScientific AxisNumberFormat = "0.00E+00"  // 1.23E+03
+)
+
+// maxNumberFormatLength is the longest format code Excel accepts.
+const maxNumberFormatLength = 255
+
 // BarGrouping defines how bars are grouped
 type BarGrouping string
 
@@ -63,6 +79,15 @@ const (
 	BarGroupingStandard       BarGrouping = "standard"       // Standard
 )
 
+// AreaGrouping defines how the series of an area chart are combined
+type AreaGrouping string
+
+const (
+	AreaGroupingStandard       AreaGrouping = "standard"       // Overlapping areas (default)
+	AreaGroupingStacked        AreaGrouping = "stacked"        // Areas stacked on top of each other
+	AreaGroupingPercentStacked AreaGrouping = "percentStacked" // Stacked and scaled to 100% per category
+)
+
 // BarDirection defines bar orientation
 type BarDirection string
 
@@ -106,12 +131,17 @@ type AxisOptions struct {
 	TickLabelPos TickLabelPosition // Tick label position (default: nextTo)
 
 	// Number format
-	NumberFormat string // Number format code (e.g., "0.00", "#,##0")
+	NumberFormat       AxisNumberFormat // Number format (e.g., NumberFormatPercent) (default: General)
+	CustomNumberFormat string           // Excel format code (e.g., "#,##0.00"); overrides NumberFormat
 
 	// Gridlines
 	MajorGridlines bool // Show major gridlines (default: true for value axis)
 	MinorGridlines bool // Show minor gridlines (default: false)
 
+	// Gridline appearance (nil = application default; non-nil also shows the gridlines)
+	MajorGridlineStyle *GridlineStyle
+	MinorGridlineStyle *GridlineStyle
+
 	// Crossing
 	CrossesAt *float64 // Where axis crosses (nil for auto)
 }
@@ -121,6 +151,22 @@ type LegendOptions struct {
 	Show     bool   // Show legend (default: true)
 	Position string // Position: "r" (right), "l" (left), "t" (top), "b" (bottom), "tr" (top right)
 	Overlay  bool   // Legend overlays chart (default: false)
+
+	// Text formatting of the legend entries (zero values keep the theme defaults)
+	FontSize   int    // Font size in points
+	FontFamily string // Font name (e.g., "Calibri")
+	FontColor  string // Hex color (e.g., "404040")
+	Bold       bool
+	Italic     bool
+
+	// Border around the legend (nil = no border)
+	LegendBorderStyle *LegendBorder
+}
+
+// LegendBorder defines the outline drawn around a chart legend
+type LegendBorder struct {
+	Color string // Hex color (default: "000000")
+	Width int    // Line width in points (default: 1)
 }
 
 // SeriesOptions defines per-series customization
@@ -133,6 +179,7 @@ type SeriesOptions struct {
 	Smooth           bool              // Smooth lines (for line charts) (default: false)
 	ShowMarkers      bool              // Show markers (for line charts) (default: false)
 	DataLabels       *DataLabelOptions // Data labels for this series (nil for default)
+	FillPattern      *PatternFill      // Pattern fill; takes precedence over Color (nil for a solid fill)
 }
 
 // ChartProperties defines chart-level properties
@@ -149,6 +196,33 @@ type ChartProperties struct {
 	PlotVisibleOnly       bool   // Plot only visible cells (default: true)
 	DisplayBlanksAs       string // How to display blank cells: "gap", "zero", "span" (default: "gap")
 	ShowDataLabelsOverMax bool   // Show data labels even if over max (default: false)
+
+	// Background
+	ChartAreaBackground string // Hex fill color of the outer chart area (empty for default)
+}
+
+// PlotAreaOptions defines the fill and border of the plot area
+type PlotAreaOptions struct {
+	BackgroundColor string // Hex fill color (empty for no fill)
+	BorderColor     string // Hex border color (default: "000000" when another border field is set)
+	BorderWidth     int    // Border width in points (default: 1 when another border field is set)
+	BorderStyle     string // Border style: "solid", "dashed", "dotted", "longDash", "dashDot" (default: "solid")
+}
+
+// GridlineStyle defines the line used for axis gridlines
+type GridlineStyle struct {
+	Color    string // Hex line color (default: "000000")
+	Width    int    // Line width in points (default: 1)
+	DashType string // Dash type: "solid", "dashed", "dotted", "longDash", "dashDot" (default: "solid")
+}
+
+// chartLineDash maps chart line styles to DrawingML preset dashes.
+var chartLineDash = map[string]string{
+	"solid":    "solid",
+	"dashed":   "dash",
+	"dotted":   "sysDot",
+	"longDash": "lgDash",
+	"dashDot":  "dashDot",
 }
 
 // BarChartOptions defines options specific to bar/column charts
@@ -160,3 +234,10 @@ type BarChartOptions struct {
 	VaryColors bool         // Vary colors by point (default: false)
 }
 
+// AreaChartOptions defines options specific to area charts. With
+// AreaGroupingPercentStacked the values of a category need not add up to
+// 100: Word scales each category to the full height itself.
+type AreaChartOptions struct {
+	Grouping AreaGrouping // Grouping type (default: standard)
+}
+
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -147,6 +148,19 @@ func TestValidateExtendedChartOptions(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid area grouping",
+			opts: ChartOptions{
+				Categories: []string{"A", "B"},
+				Series: []SeriesOptions{
+					{Name: "S1", Values: []float64{1, 2}},
+				},
+				AreaChartOptions: &AreaChartOptions{
+					Grouping: "clustered", // bar-only grouping
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -275,7 +289,7 @@ func TestGenerateExtendedChartXML(t *testing.T) {
 		}
 		opts = applyChartDefaults(opts)
 
-		xml := generateChartXML(opts)
+		xml := generateChartXML(opts, newChartAxisIDs(1))
 		xmlStr := string(xml)
 
 		// Verify XML declaration
@@ -325,7 +339,7 @@ func TestGenerateExtendedChartXML(t *testing.T) {
 		}
 		opts = applyChartDefaults(opts)
 
-		xml := string(generateChartXML(opts))
+		xml := string(generateChartXML(opts, newChartAxisIDs(1)))
 
 		if !containsString(xml, "Custom Axis") {
 			t.Error("Missing custom axis title")
@@ -338,6 +352,123 @@ func TestGenerateExtendedChartXML(t *testing.T) {
 		}
 	})
 
+	t.Run("generates XML with axis number formats", func(t *testing.T) {
+		opts := ChartOptions{
+			Categories: []string{"A", "B"},
+			Series: []SeriesOptions{
+				{Name: "Series1", Values: []float64{0.25, 0.5}},
+			},
+			CategoryAxis: &AxisOptions{NumberFormat: NumberFormatDate},
+			ValueAxis: &AxisOptions{
+				NumberFormat:       NumberFormatPercent,
+				CustomNumberFormat: `"$"#,##0.00;[Red]("$"#,##0.00)`,
+			},
+		}
+		opts = applyChartDefaults(opts)
+
+		xml := string(generateChartXML(opts, newChartAxisIDs(1)))
+
+		if !containsString(xml, `<c:numFmt formatCode="m/d/yyyy" sourceLinked="0"/>`) {
+			t.Error("Missing date format on category axis")
+		}
+		if !containsString(xml, `<c:numFmt formatCode="&quot;$&quot;#,##0.00;[Red](&quot;$&quot;#,##0.00)" sourceLinked="0"/>`) {
+			t.Error("Missing escaped custom format on value axis")
+		}
+	})
+
+	t.Run("rejects invalid number format codes", func(t *testing.T) {
+		if err := validateAxisOptions("ValueAxis", &AxisOptions{CustomNumberFormat: `0.00 "<units>" & more`}); err != nil {
+			t.Errorf("unexpected error for markup characters: %v", err)
+		}
+		for _, axis := range []*AxisOptions{
+			{CustomNumberFormat: "0.00\x07"},
+			{NumberFormat: "0\n0"},
+			{CustomNumberFormat: strings.Repeat("0", maxNumberFormatLength+1)},
+		} {
+			if err := validateAxisOptions("ValueAxis", axis); err == nil {
+				t.Errorf("expected error for format %q / %q", axis.NumberFormat, axis.CustomNumberFormat)
+			}
+		}
+	})
+
+	t.Run("generates XML with plot and chart area styling", func(t *testing.T) {
+		opts := ChartOptions{
+			Categories: []string{"A", "B"},
+			Series: []SeriesOptions{
+				{Name: "Series1", Values: []float64{10, 20}},
+			},
+			PlotAreaOptions: &PlotAreaOptions{
+				BackgroundColor: "#F2F2F2",
+				BorderColor:     "1f3864",
+				BorderWidth:     2,
+				BorderStyle:     "dashed",
+			},
+			Properties: &ChartProperties{ChartAreaBackground: "FFFFFF"},
+		}
+		opts = applyChartDefaults(opts)
+
+		xml := string(generateChartXML(opts, newChartAxisIDs(1)))
+
+		wantPlot := `<c:spPr><a:solidFill><a:srgbClr val="F2F2F2"/></a:solidFill>` +
+			`<a:ln w="25400"><a:solidFill><a:srgbClr val="1F3864"/></a:solidFill><a:prstDash val="dash"/></a:ln></c:spPr></c:plotArea>`
+		if !containsString(xml, wantPlot) {
+			t.Errorf("Missing plot area shape properties, got: %s", xml)
+		}
+		if !containsString(xml, `</c:chart><c:spPr><a:solidFill><a:srgbClr val="FFFFFF"/></a:solidFill></c:spPr><c:externalData`) {
+			t.Error("Missing chart area background")
+		}
+
+		opts.PlotAreaOptions = &PlotAreaOptions{BackgroundColor: "EEEEEE"}
+		xml = string(generateChartXML(opts, newChartAxisIDs(1)))
+		if !containsString(xml, `<c:spPr><a:solidFill><a:srgbClr val="EEEEEE"/></a:solidFill></c:spPr></c:plotArea>`) {
+			t.Errorf("Expected fill-only plot area, got: %s", xml)
+		}
+	})
+
+	t.Run("generates XML with styled gridlines", func(t *testing.T) {
+		opts := ChartOptions{
+			Categories: []string{"A", "B"},
+			Series: []SeriesOptions{
+				{Name: "Series1", Values: []float64{10, 20}},
+			},
+			ValueAxis: &AxisOptions{
+				MajorGridlineStyle: &GridlineStyle{Color: "D9D9D9", DashType: "dashed"},
+				MinorGridlineStyle: &GridlineStyle{Color: "EEEEEE", Width: 2, DashType: "longDash"},
+			},
+		}
+		opts = applyChartDefaults(opts)
+
+		xml := string(generateChartXML(opts, newChartAxisIDs(1)))
+
+		want := `<c:majorGridlines><c:spPr><a:ln w="12700"><a:solidFill><a:srgbClr val="D9D9D9"/></a:solidFill><a:prstDash val="dash"/></a:ln></c:spPr></c:majorGridlines>` +
+			`<c:minorGridlines><c:spPr><a:ln w="25400"><a:solidFill><a:srgbClr val="EEEEEE"/></a:solidFill><a:prstDash val="lgDash"/></a:ln></c:spPr></c:minorGridlines>`
+		if !containsString(xml, want) {
+			t.Errorf("Missing styled gridlines, got: %s", xml)
+		}
+		if err := validateAxisOptions("ValueAxis", &AxisOptions{MajorGridlineStyle: &GridlineStyle{DashType: "zigzag"}}); err == nil {
+			t.Error("expected error for unsupported dash type")
+		}
+	})
+
+	t.Run("rejects invalid plot area options", func(t *testing.T) {
+		base := ChartOptions{
+			Categories: []string{"A"},
+			Series:     []SeriesOptions{{Name: "S", Values: []float64{1}}},
+		}
+		for _, p := range []*PlotAreaOptions{
+			{BackgroundColor: "grey"},
+			{BorderColor: "12345"},
+			{BorderWidth: -1},
+			{BorderStyle: "wavy"},
+		} {
+			opts := base
+			opts.PlotAreaOptions = p
+			if err := validateChartOptions(opts); err == nil {
+				t.Errorf("expected error for %+v", *p)
+			}
+		}
+	})
+
 	t.Run("generates XML with data labels", func(t *testing.T) {
 		opts := ChartOptions{
 			Categories: []string{"A", "B"},
@@ -352,7 +483,7 @@ func TestGenerateExtendedChartXML(t *testing.T) {
 		}
 		opts = applyChartDefaults(opts)
 
-		xml := string(generateChartXML(opts))
+		xml := string(generateChartXML(opts, newChartAxisIDs(1)))
 
 		if !containsString(xml, "<c:showVal val=\"1\"") {
 			t.Error("Missing showVal=1 for data labels")
@@ -375,7 +506,7 @@ func TestGenerateExtendedChartXML(t *testing.T) {
 		}
 		opts = applyChartDefaults(opts)
 
-		xml := string(generateChartXML(opts))
+		xml := string(generateChartXML(opts, newChartAxisIDs(1)))
 
 		if !containsString(xml, "FF0000") {
 			t.Error("Missing custom color")
@@ -409,7 +540,45 @@ func TestChartTypeXMLGeneration(t *testing.T) {
 			opts.ChartKind = tt.chartKind
 			opts = applyChartDefaults(opts)
 
-			xml := string(generateChartXML(opts))
+			xml := string(generateChartXML(opts, newChartAxisIDs(1)))
+
+			if !containsString(xml, tt.contains) {
+				t.Errorf("Expected XML to contain %s", tt.contains)
+			}
+		})
+	}
+}
+
+func TestAreaChartGrouping(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *AreaChartOptions
+		contains string
+	}{
+		{"default", nil, `<c:grouping val="standard"/>`},
+		{"standard", &AreaChartOptions{Grouping: AreaGroupingStandard}, `<c:grouping val="standard"/>`},
+		{"stacked", &AreaChartOptions{Grouping: AreaGroupingStacked}, `<c:grouping val="stacked"/>`},
+		// Percent-stacked values are normalized by Word and need not sum to 100.
+		{"percent stacked", &AreaChartOptions{Grouping: AreaGroupingPercentStacked}, `<c:grouping val="percentStacked"/>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := ChartOptions{
+				ChartKind:  ChartKindArea,
+				Categories: []string{"A", "B", "C"},
+				Series: []SeriesOptions{
+					{Name: "North", Values: []float64{10, 20, 15}},
+					{Name: "South", Values: []float64{5, 40, 1}},
+				},
+				AreaChartOptions: tt.options,
+			}
+			if err := validateChartOptions(opts); err != nil {
+				t.Fatalf("validateChartOptions: %v", err)
+			}
+			opts = applyChartDefaults(opts)
+
+			xml := string(generateChartXML(opts, newChartAxisIDs(1)))
 
 			if !containsString(xml, tt.contains) {
 				t.Errorf("Expected XML to contain %s", tt.contains)
@@ -418,6 +587,121 @@ func TestChartTypeXMLGeneration(t *testing.T) {
 	}
 }
 
+func TestLegendFormattingXML(t *testing.T) {
+	xml := generateLegendXML(&LegendOptions{
+		Show:       true,
+		Position:   "b",
+		FontSize:   9,
+		FontFamily: "Arial Narrow",
+		FontColor:  "#404040",
+		Bold:       true,
+		Italic:     true,
+		LegendBorderStyle: &LegendBorder{
+			Color: "A0A0A0",
+			Width: 2,
+		},
+	})
+
+	for _, want := range []string{
+		`<c:legendPos val="b"/>`,
+		`<c:spPr><a:ln w="25400"><a:solidFill><a:srgbClr val="A0A0A0"/></a:solidFill>`,
+		`<a:defRPr sz="900" b="1" i="1">`,
+		`<a:srgbClr val="404040"/>`,
+		`<a:latin typeface="Arial Narrow"/>`,
+	} {
+		if !containsString(xml, want) {
+			t.Errorf("legend XML missing %s:\n%s", want, xml)
+		}
+	}
+	// The schema requires spPr before txPr, both after overlay.
+	if strings.Index(xml, "<c:overlay") > strings.Index(xml, "<c:spPr>") || strings.Index(xml, "<c:spPr>") > strings.Index(xml, "<c:txPr>") {
+		t.Errorf("legend children out of order:\n%s", xml)
+	}
+
+	plain := generateLegendXML(&LegendOptions{Show: true, Position: "r"})
+	if containsString(plain, "<c:txPr>") || containsString(plain, "<c:spPr>") {
+		t.Errorf("legend without formatting should keep theme defaults:\n%s", plain)
+	}
+}
+
+func TestValidateLegendOptions(t *testing.T) {
+	base := ChartOptions{
+		Categories: []string{"A"},
+		Series:     []SeriesOptions{{Name: "S1", Values: []float64{1}}},
+	}
+	for name, legend := range map[string]*LegendOptions{
+		"font size":    {FontSize: 500},
+		"font color":   {FontColor: "not-a-color"},
+		"border color": {LegendBorderStyle: &LegendBorder{Color: "zzz"}},
+		"border width": {LegendBorderStyle: &LegendBorder{Width: -1}},
+	} {
+		opts := base
+		opts.Legend = legend
+		if err := validateChartOptions(opts); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+}
+
+func TestChartAnimationAndExtensionData(t *testing.T) {
+	opts := ChartOptions{
+		ChartKind:  ChartKindColumn,
+		Categories: []string{"A", "B"},
+		Series:     []SeriesOptions{{Name: "S1", Values: []float64{1, 2}}},
+		Animation:  &ChartAnimationOptions{Build: ChartBuildBySeries, Duration: 750},
+		ExtensionData: map[string]string{
+			"{B-URI}": `<x:note xmlns:x="urn:example">second</x:note>`,
+			"{A-URI}": `<x:note xmlns:x="urn:example">first</x:note>`,
+		},
+	}
+	if err := validateChartOptions(opts); err != nil {
+		t.Fatalf("validateChartOptions: %v", err)
+	}
+	opts = applyChartDefaults(opts)
+	xml := string(generateChartXML(opts, newChartAxisIDs(1)))
+
+	for _, want := range []string{
+		`<a:bldChart bld="series" animBg="1"`,
+		`gdx:dur="750"`,
+		`<c:ext uri="{A-URI}"><x:note xmlns:x="urn:example">first</x:note></c:ext><c:ext uri="{B-URI}">`,
+		`</c:externalData><c:extLst>`,
+		`</c:extLst></c:chartSpace>`,
+	} {
+		if !containsString(xml, want) {
+			t.Errorf("chart XML missing %s", want)
+		}
+	}
+
+	plain := ChartOptions{ChartKind: ChartKindColumn, Categories: []string{"A"}, Series: []SeriesOptions{{Name: "S1", Values: []float64{1}}}}
+	if containsString(string(generateChartXML(applyChartDefaults(plain), newChartAxisIDs(1))), "<c:extLst>") {
+		t.Error("chart without animation or extensions should have no extLst")
+	}
+}
+
+func TestValidateChartAnimationAndExtensionData(t *testing.T) {
+	base := ChartOptions{
+		Categories: []string{"A"},
+		Series:     []SeriesOptions{{Name: "S1", Values: []float64{1}}},
+	}
+	tests := map[string]func(*ChartOptions){
+		"unknown build": func(o *ChartOptions) { o.Animation = &ChartAnimationOptions{Build: "sideways"} },
+		"negative time": func(o *ChartOptions) { o.Animation = &ChartAnimationOptions{Duration: -1} },
+		"empty uri":     func(o *ChartOptions) { o.ExtensionData = map[string]string{" ": "<a/>"} },
+		"malformed xml": func(o *ChartOptions) { o.ExtensionData = map[string]string{"{X}": "<a><b></a>"} },
+		"reserved uri": func(o *ChartOptions) {
+			o.Animation = &ChartAnimationOptions{}
+			o.ExtensionData = map[string]string{chartAnimationExtURI: "<a/>"}
+		},
+	}
+	for name, mutate := range tests {
+		opts := base
+		mutate(&opts)
+		if err := validateChartOptions(opts); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+}
+
 func TestLineChartSpecificOptions(t *testing.T) {
 	opts := ChartOptions{
 		ChartKind:  ChartKindLine,
@@ -433,7 +717,7 @@ func TestLineChartSpecificOptions(t *testing.T) {
 	}
 	opts = applyChartDefaults(opts)
 
-	xml := string(generateChartXML(opts))
+	xml := string(generateChartXML(opts, newChartAxisIDs(1)))
 
 	if !containsString(xml, "<c:smooth val=\"1\"") {
 		t.Error("Missing smooth line option")
@@ -2,8 +2,11 @@ package godocx_test
 
 import (
 	"archive/zip"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -197,6 +200,183 @@ func TestInsertMultipleCharts(t *testing.T) {
 	}
 }
 
+func TestInsertMultipleChartsUseDistinctAxisIDs(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	titles := []string{"Revenue", "Costs", "Margin"}
+	for _, title := range titles {
+		err := u.InsertChart(godocx.ChartOptions{
+			Position:   godocx.PositionEnd,
+			ChartKind:  godocx.ChartKindColumn,
+			Title:      title,
+			Categories: []string{"Q1", "Q2"},
+			Series: []godocx.SeriesOptions{
+				{Name: title, Values: []float64{10, 20}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("InsertChart %q failed: %v", title, err)
+		}
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "output.docx")
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	axIDPattern := regexp.MustCompile(`<c:axId val="(\d+)"/>`)
+	crossAxPattern := regexp.MustCompile(`<c:crossAx val="(\d+)"/>`)
+	owner := map[string]string{}
+	for _, title := range titles {
+		chartXML, _ := findChartXMLContaining(t, outputPath, title)
+		ids := map[string]bool{}
+		for _, m := range axIDPattern.FindAllStringSubmatch(chartXML, -1) {
+			ids[m[1]] = true
+		}
+		if len(ids) != 2 {
+			t.Fatalf("chart %q: expected 2 axis IDs, got %v", title, ids)
+		}
+		for _, m := range crossAxPattern.FindAllStringSubmatch(chartXML, -1) {
+			if !ids[m[1]] {
+				t.Errorf("chart %q: crossAx %s does not reference one of its axes", title, m[1])
+			}
+		}
+		for id := range ids {
+			if other, ok := owner[id]; ok {
+				t.Errorf("axis ID %s used by both %q and %q", id, other, title)
+			}
+			owner[id] = title
+		}
+	}
+}
+
+func TestInsertChartDuplicateSeriesNames(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.InsertChart(godocx.ChartOptions{
+		Position:   godocx.PositionEnd,
+		Categories: []string{"Q1", "Q2"},
+		Series: []godocx.SeriesOptions{
+			{Name: "Revenue", Values: []float64{10, 20}},
+			{Name: "revenue", Values: []float64{30, 40}},
+		},
+	})
+	var docxErr *godocx.DocxError
+	if !errors.As(err, &docxErr) || docxErr.Code != godocx.ErrCodeValidation {
+		t.Fatalf("expected a validation error for duplicate series names, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "duplicates series[0]") {
+		t.Errorf("error should name the duplicated series: %v", err)
+	}
+}
+
+func TestInsertChartSuffixesDuplicateSeriesNames(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	series := []godocx.SeriesOptions{
+		{Name: "Revenue", Values: []float64{10, 20}},
+		{Name: "Costs", Values: []float64{5, 8}},
+		{Name: "Revenue", Values: []float64{30, 40}},
+	}
+	err = u.InsertChart(godocx.ChartOptions{
+		Position:                  godocx.PositionEnd,
+		Title:                     "Suffixed",
+		Categories:                []string{"Q1", "Q2"},
+		Series:                    series,
+		DuplicateSeriesResolution: godocx.DuplicateSeriesSuffix,
+	})
+	if err != nil {
+		t.Fatalf("InsertChart failed: %v", err)
+	}
+	if series[0].Name != "Revenue" || series[2].Name != "Revenue" {
+		t.Error("InsertChart must not rename the caller's series")
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "output.docx")
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	chartXML, _ := findChartXMLContaining(t, outputPath, "Suffixed")
+	for _, want := range []string{"<c:v>Revenue (1)</c:v>", "<c:v>Costs</c:v>", "<c:v>Revenue (2)</c:v>"} {
+		if !strings.Contains(chartXML, want) {
+			t.Errorf("chart XML missing %s", want)
+		}
+	}
+}
+
+func TestInsertChartProgress(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	const points = 1000
+	categories := make([]string, points)
+	values := make([]float64, points)
+	for i := range categories {
+		categories[i] = fmt.Sprintf("Day %d", i+1)
+		values[i] = float64(i % 37)
+	}
+
+	type progress struct{ done, total int }
+	last := map[string]progress{}
+	calls := 0
+	err = u.InsertChart(godocx.ChartOptions{
+		Position:   godocx.PositionEnd,
+		ChartKind:  godocx.ChartKindLine,
+		Categories: categories,
+		Series: []godocx.SeriesOptions{
+			{Name: "Open", Values: values},
+			{Name: "Close", Values: values},
+		},
+		Progress: func(step string, done, total int) {
+			calls++
+			if prev, ok := last[step]; ok {
+				if done <= prev.done {
+					t.Errorf("%s: done went from %d to %d", step, prev.done, done)
+				}
+				if total != prev.total {
+					t.Errorf("%s: total changed from %d to %d", step, prev.total, total)
+				}
+			}
+			if done > total {
+				t.Errorf("%s: done %d exceeds total %d", step, done, total)
+			}
+			last[step] = progress{done, total}
+		},
+	})
+	if err != nil {
+		t.Fatalf("InsertChart failed: %v", err)
+	}
+
+	if calls < 10 {
+		t.Errorf("expected at least 10 progress calls, got %d", calls)
+	}
+	want := map[string]progress{
+		godocx.ChartProgressWorkbook: {points + 1, points + 1},
+		godocx.ChartProgressSeries:   {2, 2},
+		godocx.ChartProgressWriting:  {1, 1},
+	}
+	for step, p := range want {
+		if last[step] != p {
+			t.Errorf("%s: last report %+v, want %+v", step, last[step], p)
+		}
+	}
+}
+
 func TestInsertChartInvalidData(t *testing.T) {
 	tempDir := t.TempDir()
 	inputPath := filepath.Join(tempDir, "input.docx")
@@ -416,3 +596,109 @@ func listZipEntries(t *testing.T, zipPath string) []string {
 	}
 	return entries
 }
+
+func TestInsertChartIntoBlankDocument(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	chartsDir := filepath.Join(u.TempDir(), "word", "charts")
+	if _, err := os.Stat(chartsDir); !os.IsNotExist(err) {
+		t.Fatalf("blank document should not have a charts directory (stat err: %v)", err)
+	}
+
+	if err := u.InsertChart(godocx.ChartOptions{
+		Title:      "First",
+		Categories: []string{"A", "B"},
+		Series:     []godocx.SeriesOptions{{Name: "S", Values: []float64{1, 2}}},
+		Position:   godocx.PositionEnd,
+	}); err != nil {
+		t.Fatalf("InsertChart: %v", err)
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "output.docx")
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	if chart := readZipEntry(t, outputPath, "word/charts/chart1.xml"); !strings.Contains(chart, "First") {
+		t.Errorf("chart1.xml missing the title:\n%s", chart)
+	}
+	rels := readZipEntry(t, outputPath, "word/charts/_rels/chart1.xml.rels")
+	if !strings.Contains(rels, `Target="../embeddings/Microsoft_Excel_Worksheet1.xlsx"`) {
+		t.Errorf("unexpected chart relationships:\n%s", rels)
+	}
+	if strings.Contains(rels, `\`) {
+		t.Errorf("relationship targets must use forward slashes:\n%s", rels)
+	}
+	if docRels := readZipEntry(t, outputPath, "word/_rels/document.xml.rels"); !strings.Contains(docRels, `Target="charts/chart1.xml"`) {
+		t.Errorf("document relationships missing the chart:\n%s", docRels)
+	}
+}
+
+func TestInsertFloatingChart(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	opts := godocx.ChartOptions{
+		Categories: []string{"A", "B"},
+		Series:     []godocx.SeriesOptions{{Name: "S", Values: []float64{1, 2}}},
+		Width:      3000000,
+		Height:     2000000,
+		Position:   godocx.PositionEnd,
+		FloatingAnchor: &godocx.FloatingOptions{
+			HorizontalPosition: 914400,
+			VerticalPosition:   457200,
+			HorizontalRelative: godocx.PosRelativeMargin,
+			VerticalRelative:   godocx.PosRelativeParagraph,
+			TextWrap:           godocx.TextWrapTight,
+			DistanceFromText:   91440,
+		},
+	}
+	if err := u.InsertChart(opts); err != nil {
+		t.Fatalf("InsertChart: %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatalf("read document.xml: %v", err)
+	}
+	doc := string(raw)
+	for _, want := range []string{
+		`<wp:anchor distT="91440" distB="91440" distL="91440" distR="91440" simplePos="0" `,
+		`allowOverlap="0">`,
+		`<wp:positionH relativeFrom="margin"><wp:posOffset>914400</wp:posOffset></wp:positionH>`,
+		`<wp:positionV relativeFrom="paragraph"><wp:posOffset>457200</wp:posOffset></wp:positionV>`,
+		`<wp:extent cx="3000000" cy="2000000"/>`,
+		`<wp:wrapTight wrapText="bothSides"><wp:wrapPolygon edited="0">`,
+		`<c:chart xmlns:c=`,
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("floating chart missing %s:\n%s", want, doc)
+		}
+	}
+	if strings.Contains(doc[strings.Index(doc, "<wp:anchor"):], "<wp:inline") {
+		t.Error("floating chart should not be emitted as an inline drawing")
+	}
+
+	if err := u.DeleteChart(1); err != nil {
+		t.Fatalf("DeleteChart: %v", err)
+	}
+	if raw, _ := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml")); strings.Contains(string(raw), "<wp:anchor") {
+		t.Error("expected the floating chart paragraph to be deleted")
+	}
+
+	opts.FloatingAnchor = &godocx.FloatingOptions{VerticalRelative: godocx.PosRelativeColumn}
+	if err := u.InsertChart(opts); err == nil {
+		t.Error("expected error for a horizontal-only reference on the vertical axis")
+	}
+	opts.FloatingAnchor = &godocx.FloatingOptions{TextWrap: "through"}
+	if err := u.InsertChart(opts); err == nil {
+		t.Error("expected error for unsupported text wrap")
+	}
+}
@@ -0,0 +1,95 @@
+package godocx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PalettePreset names a built-in chart color palette.
+type PalettePreset string
+
+const (
+	PaletteOffice        PalettePreset = "office"        // Office default accent colors
+	PaletteColorful      PalettePreset = "colorful"      // Saturated, high-contrast colors
+	PaletteMonochromatic PalettePreset = "monochromatic" // Shades of blue, dark to light
+	PalettePastel        PalettePreset = "pastel"        // Soft, light colors
+	PaletteCorporate     PalettePreset = "corporate"     // Muted navy, grey and signal colors
+)
+
+// chartPalettePresets holds the colors of each preset, in assignment order.
+var chartPalettePresets = map[PalettePreset][]string{
+	PaletteOffice:        {"4472C4", "ED7D31", "A5A5A5", "FFC000", "5B9BD5", "70AD47"},
+	PaletteColorful:      {"E74C3C", "3498DB", "2ECC71", "F39C12", "9B59B6", "1ABC9C"},
+	PaletteMonochromatic: {"1F3864", "2F5597", "4472C4", "8FAADC", "B4C7E7", "DAE3F3"},
+	PalettePastel:        {"AEC6CF", "FFB347", "B39EB5", "77DD77", "FDFD96", "FF6961"},
+	PaletteCorporate:     {"002060", "7F7F7F", "0070C0", "C00000", "00B050", "404040"},
+}
+
+// themeAccentCount is the number of accent colors in a document theme.
+const themeAccentCount = 6
+
+// ChartPalette assigns colors to series that have no explicit Color. Colors
+// are used in order and cycle when there are more series than colors. Pie
+// charts take one color per slice instead of per series.
+type ChartPalette struct {
+	Preset         PalettePreset // Built-in palette (default: PaletteOffice)
+	CustomColors   []string      // Hex colors; overrides Preset when set
+	UseThemeColors bool          // Use the document theme's accent1-accent6 instead of hex colors
+}
+
+func validateChartPalette(p *ChartPalette) error {
+	if p.Preset != "" {
+		if _, ok := chartPalettePresets[p.Preset]; !ok {
+			return NewValidationError("ColorPalette.Preset", fmt.Sprintf("unsupported preset %q", p.Preset))
+		}
+	}
+	for i, c := range p.CustomColors {
+		if normalizeHexColor(c) == "" {
+			return invalidColorError(fmt.Sprintf("ColorPalette.CustomColors[%d]", i), c)
+		}
+	}
+	return nil
+}
+
+// colorXML returns the DrawingML color element for palette slot i.
+func (p *ChartPalette) colorXML(i int) string {
+	if p.UseThemeColors {
+		return fmt.Sprintf(`<a:schemeClr val="accent%d"/>`, i%themeAccentCount+1)
+	}
+	colors := p.CustomColors
+	if len(colors) == 0 {
+		preset := p.Preset
+		if preset == "" {
+			preset = PaletteOffice
+		}
+		colors = chartPalettePresets[preset]
+	}
+	return fmt.Sprintf(`<a:srgbClr val="%s"/>`, normalizeHexColor(colors[i%len(colors)]))
+}
+
+// seriesColorXML returns the color element for a whole series: its explicit
+// Color, otherwise the palette color for its index. It returns "" when the
+// series should keep the chart's default coloring.
+func seriesColorXML(index int, series SeriesOptions, opts ChartOptions) string {
+	if series.Color != "" {
+		return fmt.Sprintf(`<a:srgbClr val="%s"/>`, normalizeHexColor(series.Color))
+	}
+	if opts.ColorPalette == nil || opts.ChartKind == ChartKindPie {
+		return ""
+	}
+	return opts.ColorPalette.colorXML(index)
+}
+
+// generatePalettePointsXML colors each data point of a pie series from the
+// palette, since pie slices vary by point rather than by series.
+func generatePalettePointsXML(series SeriesOptions, opts ChartOptions) string {
+	if opts.ColorPalette == nil || opts.ChartKind != ChartKindPie || series.Color != "" || series.FillPattern != nil {
+		return ""
+	}
+	var buf strings.Builder
+	for j := range series.Values {
+		fmt.Fprintf(&buf, `<c:dPt><c:idx val="%d"/><c:bubble3D val="0"/><c:spPr><a:solidFill>%s</a:solidFill></c:spPr></c:dPt>`,
+			j, opts.ColorPalette.colorXML(j))
+	}
+	return buf.String()
+}
@@ -0,0 +1,100 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSeriesColorXML_Palette(t *testing.T) {
+	series := make([]SeriesOptions, 8)
+	series[1].Color = "#112233"
+
+	tests := []struct {
+		name    string
+		palette *ChartPalette
+		want    map[int]string
+	}{
+		{
+			name:    "no palette",
+			palette: nil,
+			want:    map[int]string{0: "", 1: `<a:srgbClr val="112233"/>`, 2: ""},
+		},
+		{
+			name:    "default preset",
+			palette: &ChartPalette{},
+			want:    map[int]string{0: `<a:srgbClr val="4472C4"/>`, 1: `<a:srgbClr val="112233"/>`, 2: `<a:srgbClr val="A5A5A5"/>`},
+		},
+		{
+			name:    "pastel preset cycles",
+			palette: &ChartPalette{Preset: PalettePastel},
+			want:    map[int]string{0: `<a:srgbClr val="AEC6CF"/>`, 2: `<a:srgbClr val="B39EB5"/>`, 6: `<a:srgbClr val="AEC6CF"/>`, 7: `<a:srgbClr val="FFB347"/>`},
+		},
+		{
+			name:    "custom colors override preset",
+			palette: &ChartPalette{Preset: PaletteCorporate, CustomColors: []string{"ff0000", "#00ff00"}},
+			want:    map[int]string{0: `<a:srgbClr val="FF0000"/>`, 1: `<a:srgbClr val="112233"/>`, 2: `<a:srgbClr val="FF0000"/>`},
+		},
+		{
+			name:    "theme colors",
+			palette: &ChartPalette{UseThemeColors: true},
+			want:    map[int]string{0: `<a:schemeClr val="accent1"/>`, 1: `<a:srgbClr val="112233"/>`, 2: `<a:schemeClr val="accent3"/>`, 6: `<a:schemeClr val="accent1"/>`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := ChartOptions{ChartKind: ChartKindColumn, ColorPalette: tt.palette}
+			for i, want := range tt.want {
+				if got := seriesColorXML(i, series[i], opts); got != want {
+					t.Errorf("series %d: got %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateSeriesXML_Palette(t *testing.T) {
+	opts := ChartOptions{
+		ChartKind:    ChartKindColumn,
+		Categories:   []string{"A", "B"},
+		ColorPalette: &ChartPalette{Preset: PaletteColorful},
+	}
+	got := generateSeriesXML(1, SeriesOptions{Name: "S", Values: []float64{1, 2}}, opts)
+	if !strings.Contains(got, `<c:spPr><a:solidFill><a:srgbClr val="3498DB"/></a:solidFill></c:spPr>`) {
+		t.Errorf("expected palette fill on series, got: %s", got)
+	}
+
+	opts.ChartKind = ChartKindPie
+	got = generateSeriesXML(0, SeriesOptions{Name: "S", Values: []float64{1, 2}}, opts)
+	if strings.Contains(got, `</c:tx><c:spPr>`) {
+		t.Errorf("expected pie series to be colored per slice, got: %s", got)
+	}
+	for _, want := range []string{
+		`<c:dPt><c:idx val="0"/><c:bubble3D val="0"/><c:spPr><a:solidFill><a:srgbClr val="E74C3C"/></a:solidFill></c:spPr></c:dPt>`,
+		`<c:dPt><c:idx val="1"/><c:bubble3D val="0"/><c:spPr><a:solidFill><a:srgbClr val="3498DB"/></a:solidFill></c:spPr></c:dPt>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %s in pie series, got: %s", want, got)
+		}
+	}
+}
+
+func TestInsertChart_InvalidPalette(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Body</w:t></w:r></w:p>`))
+	base := ChartOptions{
+		Categories: []string{"A"},
+		Series:     []SeriesOptions{{Name: "S", Values: []float64{1}}},
+	}
+
+	opts := base
+	opts.ColorPalette = &ChartPalette{Preset: "neon"}
+	if err := u.InsertChart(opts); err == nil {
+		t.Error("expected error for unknown preset")
+	}
+
+	opts = base
+	opts.ColorPalette = &ChartPalette{CustomColors: []string{"red"}}
+	if err := u.InsertChart(opts); err == nil {
+		t.Error("expected error for invalid custom color")
+	}
+}
@@ -0,0 +1,74 @@
+package godocx
+
+import "fmt"
+
+// PatternKind names a DrawingML preset pattern for series fills. The values
+// are the prst values Word writes.
+type PatternKind string
+
+const (
+	PatternCross        PatternKind = "cross"     // Small grid of horizontal and vertical lines
+	PatternDiagonal     PatternKind = "diagCross" // Grid of crossing diagonal lines
+	PatternHorizontal   PatternKind = "horz"      // Horizontal lines
+	PatternVertical     PatternKind = "vert"      // Vertical lines
+	PatternDownDiagonal PatternKind = "dnDiag"    // Lines falling from left to right
+	PatternUpDiagonal   PatternKind = "upDiag"    // Lines rising from left to right
+	PatternLargeCross   PatternKind = "lgGrid"    // Large grid of horizontal and vertical lines
+)
+
+var validPatternKinds = map[PatternKind]bool{
+	PatternCross:        true,
+	PatternDiagonal:     true,
+	PatternHorizontal:   true,
+	PatternVertical:     true,
+	PatternDownDiagonal: true,
+	PatternUpDiagonal:   true,
+	PatternLargeCross:   true,
+}
+
+// PatternFill fills a series with a two-color pattern, which keeps series
+// distinguishable for color-blind readers and in black-and-white prints.
+type PatternFill struct {
+	Kind            PatternKind // Pattern preset (required)
+	ForegroundColor string      // Hex color of the lines (default: the series color, else "000000")
+	BackgroundColor string      // Hex color behind the lines (default: "FFFFFF")
+}
+
+func validatePatternFill(field string, p *PatternFill) error {
+	if !validPatternKinds[p.Kind] {
+		return NewValidationError(field+".Kind", fmt.Sprintf("unsupported pattern %q", p.Kind))
+	}
+	if err := validateColor(field+".ForegroundColor", p.ForegroundColor); err != nil {
+		return err
+	}
+	if err := validateColor(field+".BackgroundColor", p.BackgroundColor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// seriesFillXML returns the fill element for a whole series: its pattern
+// fill when set, otherwise a solid fill in the series color. It returns ""
+// when the series should keep the chart's default fill.
+func seriesFillXML(index int, series SeriesOptions, opts ChartOptions) string {
+	color := seriesColorXML(index, series, opts)
+	p := series.FillPattern
+	if p == nil {
+		if color == "" {
+			return ""
+		}
+		return fmt.Sprintf(`<a:solidFill>%s</a:solidFill>`, color)
+	}
+
+	fg := color
+	if p.ForegroundColor != "" {
+		fg = fmt.Sprintf(`<a:srgbClr val="%s"/>`, normalizeHexColor(p.ForegroundColor))
+	} else if fg == "" {
+		fg = `<a:srgbClr val="000000"/>`
+	}
+	bg := "FFFFFF"
+	if p.BackgroundColor != "" {
+		bg = normalizeHexColor(p.BackgroundColor)
+	}
+	return fmt.Sprintf(`<a:pattFill prst="%s"><a:fgClr>%s</a:fgClr><a:bgClr><a:srgbClr val="%s"/></a:bgClr></a:pattFill>`, p.Kind, fg, bg)
+}
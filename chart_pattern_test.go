@@ -0,0 +1,68 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateSeriesXML_PatternFill(t *testing.T) {
+	opts := ChartOptions{ChartKind: ChartKindColumn, Categories: []string{"A", "B"}}
+
+	for _, kind := range []PatternKind{
+		PatternCross, PatternDiagonal, PatternHorizontal, PatternVertical,
+		PatternDownDiagonal, PatternUpDiagonal, PatternLargeCross,
+	} {
+		t.Run(string(kind), func(t *testing.T) {
+			series := SeriesOptions{
+				Name:        "S",
+				Values:      []float64{1, 2},
+				FillPattern: &PatternFill{Kind: kind, ForegroundColor: "#1f3864", BackgroundColor: "dae3f3"},
+			}
+			got := generateSeriesXML(0, series, opts)
+			want := `<c:spPr><a:pattFill prst="` + string(kind) + `"><a:fgClr><a:srgbClr val="1F3864"/></a:fgClr>` +
+				`<a:bgClr><a:srgbClr val="DAE3F3"/></a:bgClr></a:pattFill></c:spPr>`
+			if !strings.Contains(got, want) {
+				t.Errorf("expected %s, got: %s", want, got)
+			}
+		})
+	}
+}
+
+func TestSeriesFillXML_PatternDefaults(t *testing.T) {
+	opts := ChartOptions{ChartKind: ChartKindColumn, ColorPalette: &ChartPalette{}}
+
+	// The pattern wins over Color, which becomes its foreground color.
+	got := seriesFillXML(0, SeriesOptions{Color: "C00000", FillPattern: &PatternFill{Kind: PatternVertical}}, opts)
+	want := `<a:pattFill prst="vert"><a:fgClr><a:srgbClr val="C00000"/></a:fgClr><a:bgClr><a:srgbClr val="FFFFFF"/></a:bgClr></a:pattFill>`
+	if got != want {
+		t.Errorf("series color foreground:\n got %s\nwant %s", got, want)
+	}
+
+	got = seriesFillXML(1, SeriesOptions{FillPattern: &PatternFill{Kind: PatternCross}}, opts)
+	if !strings.Contains(got, `<a:fgClr><a:srgbClr val="ED7D31"/></a:fgClr>`) {
+		t.Errorf("expected the palette color as foreground, got: %s", got)
+	}
+
+	got = seriesFillXML(0, SeriesOptions{FillPattern: &PatternFill{Kind: PatternCross}}, ChartOptions{})
+	if !strings.Contains(got, `<a:fgClr><a:srgbClr val="000000"/></a:fgClr>`) {
+		t.Errorf("expected a black foreground without any color, got: %s", got)
+	}
+}
+
+func TestInsertChart_InvalidPatternFill(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Body</w:t></w:r></w:p>`))
+
+	for _, p := range []*PatternFill{
+		{Kind: "zigzag"},
+		{Kind: PatternCross, ForegroundColor: "navy"},
+		{Kind: PatternCross, BackgroundColor: "12345"},
+	} {
+		err := u.InsertChart(ChartOptions{
+			Categories: []string{"A"},
+			Series:     []SeriesOptions{{Name: "S", Values: []float64{1}, FillPattern: p}},
+		})
+		if err == nil {
+			t.Errorf("expected error for pattern fill %+v", *p)
+		}
+	}
+}
@@ -12,15 +12,15 @@ import (
 // GetChartData reads the categories, series names, and values from chart N (1-based).
 func (u *Updater) GetChartData(chartIndex int) (ChartData, error) {
 	if u == nil {
-		return ChartData{}, fmt.Errorf("updater is nil")
+		return ChartData{}, NewValidationError("updater", "updater is nil")
 	}
 	if chartIndex < 1 {
-		return ChartData{}, fmt.Errorf("chart index must be >= 1")
+		return ChartData{}, NewValidationError("chartIndex", "chart index must be >= 1")
 	}
 	chartPath := filepath.Join(u.tempDir, "word", "charts", fmt.Sprintf("chart%d.xml", chartIndex))
 	raw, err := os.ReadFile(chartPath)
 	if err != nil {
-		return ChartData{}, fmt.Errorf("read chart%d.xml: %w", chartIndex, err)
+		return ChartData{}, NewFileReadError(fmt.Sprintf("chart%d.xml", chartIndex), err)
 	}
 	return parseChartDataFromXML(raw)
 }
@@ -29,7 +29,7 @@ func parseChartDataFromXML(raw []byte) (ChartData, error) {
 	content := string(raw)
 	// Basic sanity check — a chart XML file must contain a chartSpace element
 	if !strings.Contains(content, "chartSpace") {
-		return ChartData{}, fmt.Errorf("content does not appear to be chart XML (missing chartSpace element)")
+		return ChartData{}, NewMalformedXMLError("content does not appear to be chart XML (missing chartSpace element)")
 	}
 	// detectNamespacePrefix returns "c:" or "" (already includes the colon)
 	ns := detectNamespacePrefix(content)
@@ -52,13 +52,19 @@ func parseChartDataFromXML(raw []byte) (ChartData, error) {
 		}
 	}
 
-	// Series blocks
+	data.CategoryAxisTitle = axisTitleText(content, ns, axisRoleCategory)
+	data.ValueAxisTitle = axisTitleText(content, ns, axisRoleValue)
+
+	// Series blocks. Points are matched by their idx attribute so that caches
+	// with gaps (blank cells) keep each value at its category position.
+	ptRe := regexp.MustCompile(`<` + regexp.QuoteMeta(tag("pt")) + `\s+idx="(\d+)"[^>]*>\s*<` +
+		regexp.QuoteMeta(tag("v")) + `(?:\s[^>]*)?>([^<]*)<`)
 	serBlocks := extractBlocks(content, "<"+tag("ser")+">", "<"+tag("ser")+" ", "</"+tag("ser")+">")
 	data.Categories = extractCategoriesFromSer(serBlocks, tag, vRe)
 	for _, block := range serBlocks {
 		name := extractSeriesName(block, tag, vRe)
-		values := extractSeriesValues(block, tag, len(data.Categories), vRe)
-		data.Series = append(data.Series, SeriesData{Name: name, Values: values})
+		values, formatCode := extractSeriesValues(block, tag, len(data.Categories), vRe, ptRe)
+		data.Series = append(data.Series, SeriesData{Name: name, Values: values, FormatCode: formatCode})
 	}
 
 	return data, nil
@@ -119,18 +125,17 @@ func extractSeriesName(block string, tag func(string) string, vRe *regexp.Regexp
 	return ""
 }
 
+// extractCategoriesFromSer reads the categories of the first series. Scatter
+// charts have no <c:cat>; their numeric X values are returned as strings so
+// that UpdateChart can parse them back.
 func extractCategoriesFromSer(serBlocks []string, tag func(string) string, vRe *regexp.Regexp) []string {
 	if len(serBlocks) == 0 {
 		return nil
 	}
-	catOpen := "<" + tag("cat") + ">"
-	catClose := "</" + tag("cat") + ">"
-	catStart := strings.Index(serBlocks[0], catOpen)
-	catEnd := strings.LastIndex(serBlocks[0], catClose)
-	if catStart < 0 || catEnd < 0 {
+	catBlock := extractSeriesChild(serBlocks[0], tag, "cat", "xVal")
+	if catBlock == "" {
 		return nil
 	}
-	catBlock := serBlocks[0][catStart : catEnd+len(catClose)]
 	matches := vRe.FindAllStringSubmatch(catBlock, -1)
 	cats := make([]string, 0, len(matches))
 	for _, m := range matches {
@@ -139,20 +144,41 @@ func extractCategoriesFromSer(serBlocks []string, tag func(string) string, vRe *
 	return cats
 }
 
-func extractSeriesValues(block string, tag func(string) string, count int, vRe *regexp.Regexp) []float64 {
-	valOpen := "<" + tag("val") + ">"
-	valClose := "</" + tag("val") + ">"
-	valStart := strings.Index(block, valOpen)
-	valEnd := strings.LastIndex(block, valClose)
-	if valStart < 0 || valEnd < 0 {
-		return make([]float64, count)
-	}
-	valBlock := block[valStart : valEnd+len(valClose)]
-	matches := vRe.FindAllStringSubmatch(valBlock, -1)
-	values := make([]float64, 0, len(matches))
-	for _, m := range matches {
-		f, _ := strconv.ParseFloat(strings.TrimSpace(m[1]), 64)
-		values = append(values, f)
+// extractSeriesValues reads the cached values of a series (<c:val>, or
+// <c:yVal> for scatter charts) together with the cache's number format code.
+// Values are returned exactly as cached; stacked charts are not normalised.
+func extractSeriesValues(block string, tag func(string) string, count int, vRe, ptRe *regexp.Regexp) ([]float64, string) {
+	valBlock := extractSeriesChild(block, tag, "val", "yVal")
+	if valBlock == "" {
+		return make([]float64, count), ""
+	}
+
+	formatCode := ""
+	fcOpen := "<" + tag("formatCode") + ">"
+	if start := strings.Index(valBlock, fcOpen); start >= 0 {
+		start += len(fcOpen)
+		if end := strings.Index(valBlock[start:], "</"+tag("formatCode")+">"); end >= 0 {
+			formatCode = xmlUnescape(valBlock[start : start+end])
+		}
+	}
+
+	var values []float64
+	if points := ptRe.FindAllStringSubmatch(valBlock, -1); len(points) > 0 {
+		for _, m := range points {
+			idx, err := strconv.Atoi(m[1])
+			if err != nil {
+				continue
+			}
+			for len(values) <= idx {
+				values = append(values, 0)
+			}
+			values[idx], _ = strconv.ParseFloat(strings.TrimSpace(m[2]), 64)
+		}
+	} else {
+		for _, m := range vRe.FindAllStringSubmatch(valBlock, -1) {
+			f, _ := strconv.ParseFloat(strings.TrimSpace(m[1]), 64)
+			values = append(values, f)
+		}
 	}
 	for len(values) < count {
 		values = append(values, 0)
@@ -160,5 +186,20 @@ func extractSeriesValues(block string, tag func(string) string, count int, vRe *
 	if len(values) > count && count > 0 {
 		values = values[:count]
 	}
-	return values
+	return values, formatCode
+}
+
+// extractSeriesChild returns the first of the named child elements found in
+// a series block, e.g. "cat" or its scatter counterpart "xVal".
+func extractSeriesChild(block string, tag func(string) string, names ...string) string {
+	for _, name := range names {
+		open := "<" + tag(name) + ">"
+		closeTag := "</" + tag(name) + ">"
+		start := strings.Index(block, open)
+		end := strings.LastIndex(block, closeTag)
+		if start >= 0 && end > start {
+			return block[start : end+len(closeTag)]
+		}
+	}
+	return ""
 }
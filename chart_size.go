@@ -0,0 +1,111 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+// Chart size limits in EMUs. The maximum is Word's largest drawing size,
+// 22 inches.
+const (
+	minChartExtent = 100000
+	maxChartExtent = 20116800
+)
+
+var (
+	drawingFramePattern   = regexp.MustCompile(`(?s)<wp:(?:inline|anchor)[\s>].*?</wp:(?:inline|anchor)>`)
+	drawingExtentPattern  = regexp.MustCompile(`<wp:extent\b[^>]*/>`)
+	extentCxPattern       = regexp.MustCompile(`\scx="(\d+)"`)
+	extentCyPattern       = regexp.MustCompile(`\scy="(\d+)"`)
+	chartReferencePattern = regexp.MustCompile(`<c:chart\b[^>]*\br:id="[^"]*"`)
+)
+
+// GetChartSize returns the width and height, in EMUs, of the chart drawing
+// at chartIndex (1-based, in document order), whether inline or floating.
+func (u *Updater) GetChartSize(chartIndex int) (width, height int, err error) {
+	if u == nil {
+		return 0, 0, NewValidationError("updater", "updater is nil")
+	}
+	if chartIndex < 1 {
+		return 0, 0, NewValidationError("chartIndex", "chart index must be >= 1")
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "document.xml"))
+	if err != nil {
+		return 0, 0, NewFileReadError("document.xml", err)
+	}
+	start, end, err := findChartDrawingFrame(raw, chartIndex)
+	if err != nil {
+		return 0, 0, err
+	}
+	extent := drawingExtentPattern.Find(raw[start:end])
+	cx := extentCxPattern.FindSubmatch(extent)
+	cy := extentCyPattern.FindSubmatch(extent)
+	if cx == nil || cy == nil {
+		return 0, 0, NewMalformedXMLError(fmt.Sprintf("chart %d drawing has no extent", chartIndex))
+	}
+	width, _ = strconv.Atoi(string(cx[1]))
+	height, _ = strconv.Atoi(string(cy[1]))
+	return width, height, nil
+}
+
+// SetChartSize resizes the chart drawing at chartIndex (1-based, in document
+// order) to width x height EMUs (914400 per inch), whether inline or
+// floating. Both dimensions must be between 100000 EMU and 22 inches.
+func (u *Updater) SetChartSize(chartIndex, width, height int) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if chartIndex < 1 {
+		return NewValidationError("chartIndex", "chart index must be >= 1")
+	}
+	if width < minChartExtent || width > maxChartExtent {
+		return NewValidationError("width", fmt.Sprintf("width must be between %d and %d EMUs, got %d", minChartExtent, maxChartExtent, width))
+	}
+	if height < minChartExtent || height > maxChartExtent {
+		return NewValidationError("height", fmt.Sprintf("height must be between %d and %d EMUs, got %d", minChartExtent, maxChartExtent, height))
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+	start, end, err := findChartDrawingFrame(raw, chartIndex)
+	if err != nil {
+		return err
+	}
+	loc := drawingExtentPattern.FindIndex(raw[start:end])
+	if loc == nil {
+		return NewMalformedXMLError(fmt.Sprintf("chart %d drawing has no extent", chartIndex))
+	}
+
+	extent := string(raw[start+loc[0] : start+loc[1]])
+	extent = setOpenTagAttr(extent, "cx", strconv.Itoa(width))
+	extent = setOpenTagAttr(extent, "cy", strconv.Itoa(height))
+	updated := spliceBytes(raw, start+loc[0], start+loc[1], extent)
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// findChartDrawingFrame returns the bounds of the n-th (1-based) <wp:inline>
+// or <wp:anchor> element that holds a chart.
+func findChartDrawingFrame(raw []byte, n int) (int, int, error) {
+	count := 0
+	for _, loc := range drawingFramePattern.FindAllIndex(raw, -1) {
+		if !chartReferencePattern.Match(raw[loc[0]:loc[1]]) {
+			continue
+		}
+		count++
+		if count == n {
+			return loc[0], loc[1], nil
+		}
+	}
+	return 0, 0, NewChartNotFoundError(n)
+}
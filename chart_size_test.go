@@ -0,0 +1,61 @@
+package godocx_test
+
+import (
+	"errors"
+	"testing"
+
+	godocx "github.com/falcomza/go-docx"
+)
+
+func TestSetChartSize(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	base := godocx.ChartOptions{
+		Position:   godocx.PositionEnd,
+		Categories: []string{"A", "B"},
+		Series:     []godocx.SeriesOptions{{Name: "S", Values: []float64{1, 2}}},
+	}
+	if err := u.InsertChart(base); err != nil {
+		t.Fatalf("InsertChart failed: %v", err)
+	}
+	floating := base
+	floating.FloatingAnchor = &godocx.FloatingOptions{}
+	if err := u.InsertChart(floating); err != nil {
+		t.Fatalf("InsertChart (floating) failed: %v", err)
+	}
+
+	width, height, err := u.GetChartSize(1)
+	if err != nil {
+		t.Fatalf("GetChartSize failed: %v", err)
+	}
+	if width != 6099523 || height != 3340467 {
+		t.Errorf("default size = %dx%d, want 6099523x3340467", width, height)
+	}
+
+	for i := 1; i <= 2; i++ {
+		if err := u.SetChartSize(i, 4572000, 2743200); err != nil {
+			t.Fatalf("SetChartSize(%d) failed: %v", i, err)
+		}
+		width, height, err := u.GetChartSize(i)
+		if err != nil {
+			t.Fatalf("GetChartSize(%d) failed: %v", i, err)
+		}
+		if width != 4572000 || height != 2743200 {
+			t.Errorf("chart %d size = %dx%d, want 4572000x2743200", i, width, height)
+		}
+	}
+
+	if err := u.SetChartSize(1, 50000, 2743200); err == nil {
+		t.Error("expected error for a width below the minimum")
+	}
+	if err := u.SetChartSize(1, 4572000, 0); err == nil {
+		t.Error("expected error for a zero height")
+	}
+	if _, _, err := u.GetChartSize(3); !errors.Is(err, godocx.ErrCodeChartNotFound) {
+		t.Errorf("expected chart not found error, got %v", err)
+	}
+}
@@ -0,0 +1,408 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	drawingTextPattern      = regexp.MustCompile(`(<a:t(?:\s[^>]*)?>)([^<]*)(</a:t>)`)
+	autoTitleDeletedPattern = regexp.MustCompile(`<(c:)?autoTitleDeleted\b[^>]*/>`)
+)
+
+// GetChartTitle returns the title of chart N (1-based): the text of a rich
+// title, or the cached value of a title linked to a worksheet cell. It
+// returns an empty string when the chart has no explicit title.
+func (u *Updater) GetChartTitle(chartIndex int) (string, error) {
+	if u == nil {
+		return "", NewValidationError("updater", "updater is nil")
+	}
+	content, _, err := u.readChartPart(chartIndex)
+	if err != nil {
+		return "", err
+	}
+	ns := detectNamespacePrefix(content)
+	start, end := findChartTitle(content, ns)
+	if start < 0 {
+		return "", nil
+	}
+	return titleText(content[start:end], ns), nil
+}
+
+// titleText returns the text of a title element: its rich text runs, or the
+// cached value of a title linked to a worksheet cell.
+func titleText(title, ns string) string {
+	if runs := drawingTextPattern.FindAllStringSubmatch(title, -1); len(runs) > 0 {
+		var text strings.Builder
+		for _, m := range runs {
+			text.WriteString(xmlUnescape(m[2]))
+		}
+		return text.String()
+	}
+	vRe := regexp.MustCompile(`<` + regexp.QuoteMeta(ns+"v") + `(?:\s[^>]*)?>([^<]*)<`)
+	if m := vRe.FindStringSubmatch(title); m != nil {
+		return xmlUnescape(m[1])
+	}
+	return ""
+}
+
+// axisTitleText returns the title of the axis playing role, or "".
+func axisTitleText(content, ns string, role axisRole) string {
+	axis, ok := findAxis(content, ns, role)
+	if !ok {
+		return ""
+	}
+	start, end := findAxisTitle(content, axis, ns)
+	if start < 0 {
+		return ""
+	}
+	return titleText(content[start:end], ns)
+}
+
+// SetChartTitle replaces the title text of chart N (1-based). The formatting
+// of the first title run is kept and any further runs are emptied. A chart
+// without a title, or with a title linked to a worksheet cell, gets a new
+// rich text title.
+func (u *Updater) SetChartTitle(chartIndex int, title string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if strings.TrimSpace(title) == "" {
+		return NewValidationError("title", "title cannot be empty; use RemoveChartTitle to remove it")
+	}
+	content, chartPath, err := u.readChartPart(chartIndex)
+	if err != nil {
+		return err
+	}
+	ns := detectNamespacePrefix(content)
+
+	chartStart, _ := chartElementRange(content, ns)
+	if chartStart < 0 {
+		return NewMalformedXMLError(fmt.Sprintf("chart%d.xml has no chart element", chartIndex))
+	}
+	start, end := findChartTitle(content, ns)
+	content = replaceTitle(content, start, end, chartStart, chartTitleXML(title, ns), title)
+	content = setAutoTitleDeleted(content, ns, false)
+
+	if err := atomicWriteFile(chartPath, []byte(content), 0o644); err != nil {
+		return NewXMLWriteError(filepath.Base(chartPath), err)
+	}
+	return nil
+}
+
+// RemoveChartTitle removes the explicit title of chart N (1-based) and sets
+// autoTitleDeleted, so that Word does not show an automatic title instead.
+func (u *Updater) RemoveChartTitle(chartIndex int) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	content, chartPath, err := u.readChartPart(chartIndex)
+	if err != nil {
+		return err
+	}
+	ns := detectNamespacePrefix(content)
+
+	if start, end := findChartTitle(content, ns); start >= 0 {
+		content = content[:start] + content[end:]
+	}
+	if chartStart, _ := chartElementRange(content, ns); chartStart < 0 {
+		return NewMalformedXMLError(fmt.Sprintf("chart%d.xml has no chart element", chartIndex))
+	}
+	content = setAutoTitleDeleted(content, ns, true)
+
+	if err := atomicWriteFile(chartPath, []byte(content), 0o644); err != nil {
+		return NewXMLWriteError(filepath.Base(chartPath), err)
+	}
+	return nil
+}
+
+// replaceTitle sets the text of the title element at content[start:end],
+// keeping the formatting of its first run and emptying the others. A title
+// without rich text is replaced by newTitleXML, which is inserted at insertAt
+// when there is no title (start < 0).
+func replaceTitle(content string, start, end, insertAt int, newTitleXML, title string) string {
+	switch {
+	case start >= 0 && drawingTextPattern.MatchString(content[start:end]):
+		first := true
+		replaced := drawingTextPattern.ReplaceAllStringFunc(content[start:end], func(t string) string {
+			m := drawingTextPattern.FindStringSubmatch(t)
+			if !first {
+				return m[1] + m[3]
+			}
+			first = false
+			return m[1] + xmlEscapeContent(title) + m[3]
+		})
+		return content[:start] + replaced + content[end:]
+	case start >= 0:
+		return content[:start] + newTitleXML + content[end:]
+	default:
+		return content[:insertAt] + newTitleXML + content[insertAt:]
+	}
+}
+
+// readChartPart reads word/charts/chartN.xml and returns its content and path.
+func (u *Updater) readChartPart(chartIndex int) (string, string, error) {
+	if chartIndex < 1 {
+		return "", "", NewValidationError("chartIndex", "chart index must be >= 1")
+	}
+	name := fmt.Sprintf("chart%d.xml", chartIndex)
+	chartPath := filepath.Join(u.tempDir, "word", "charts", name)
+	raw, err := os.ReadFile(chartPath)
+	if err != nil {
+		return "", "", NewFileReadError(name, err)
+	}
+	return string(raw), chartPath, nil
+}
+
+// chartElementRange returns the offset just after the <c:chart> start tag
+// and the offset of its <c:plotArea> child, which bound the chart title,
+// or -1 when the chart element is missing.
+func chartElementRange(content, ns string) (int, int) {
+	open := "<" + ns + "chart>"
+	start := strings.Index(content, open)
+	if start < 0 {
+		return -1, -1
+	}
+	start += len(open)
+	plotArea := strings.Index(content[start:], "<"+ns+"plotArea")
+	if plotArea < 0 {
+		return start, len(content)
+	}
+	return start, start + plotArea
+}
+
+// findChartTitle returns the byte range of the chart's own <c:title> element,
+// or -1, -1 when there is none. Axis titles, which live inside the plot area,
+// are not considered.
+func findChartTitle(content, ns string) (int, int) {
+	chartStart, plotArea := chartElementRange(content, ns)
+	if chartStart < 0 {
+		return -1, -1
+	}
+	head := content[chartStart:plotArea]
+	rel := -1
+	for _, open := range []string{"<" + ns + "title>", "<" + ns + "title/", "<" + ns + "title "} {
+		if i := strings.Index(head, open); i >= 0 && (rel < 0 || i < rel) {
+			rel = i
+		}
+	}
+	if rel < 0 {
+		return -1, -1
+	}
+	start := chartStart + rel
+	if tagEnd := strings.IndexByte(content[start:], '>'); tagEnd > 0 && content[start+tagEnd-1] == '/' {
+		return start, start + tagEnd + 1 // empty <c:title/>
+	}
+	closeTag := "</" + ns + "title>"
+	closeIdx := strings.Index(content[start:], closeTag)
+	if closeIdx < 0 {
+		return -1, -1
+	}
+	return start, start + closeIdx + len(closeTag)
+}
+
+// setAutoTitleDeleted sets <c:autoTitleDeleted>, adding it after the chart
+// title (or as the first child of <c:chart>) when missing.
+func setAutoTitleDeleted(content, ns string, deleted bool) string {
+	elem := fmt.Sprintf(`<%sautoTitleDeleted val="%d"/>`, ns, boolToInt(deleted))
+	if autoTitleDeletedPattern.MatchString(content) {
+		return autoTitleDeletedPattern.ReplaceAllLiteralString(content, elem)
+	}
+	pos, _ := chartElementRange(content, ns)
+	if _, end := findChartTitle(content, ns); end >= 0 {
+		pos = end
+	}
+	return content[:pos] + elem + content[pos:]
+}
+
+// chartTitleXML generates title XML for a chart using namespace prefix ns.
+func chartTitleXML(title, ns string) string {
+	return withChartPrefix(generateTitleXML(title, false), ns)
+}
+
+// withChartPrefix rewrites generated "c:" elements to the chart's prefix.
+func withChartPrefix(generated, ns string) string {
+	if ns == "c:" {
+		return generated
+	}
+	generated = strings.ReplaceAll(generated, "<c:", "<"+ns)
+	return strings.ReplaceAll(generated, "</c:", "</"+ns)
+}
+
+// SetCategoryAxisTitle sets the title of the category (horizontal) axis of
+// chart N (1-based), creating the title if the axis has none. For scatter
+// charts, which have two value axes, this is the X axis.
+func (u *Updater) SetCategoryAxisTitle(chartIndex int, title string) error {
+	return u.setAxisTitle(chartIndex, title, axisRoleCategory)
+}
+
+// SetValueAxisTitle sets the title of the primary value (vertical) axis of
+// chart N (1-based), creating the title if the axis has none.
+func (u *Updater) SetValueAxisTitle(chartIndex int, title string) error {
+	return u.setAxisTitle(chartIndex, title, axisRoleValue)
+}
+
+// SetSecondaryValueAxisTitle sets the title of the secondary value axis of
+// a chart with dual value axes, such as a combo chart plotting a series on
+// a second scale.
+func (u *Updater) SetSecondaryValueAxisTitle(chartIndex int, title string) error {
+	return u.setAxisTitle(chartIndex, title, axisRoleSecondaryValue)
+}
+
+type axisRole int
+
+const (
+	axisRoleCategory axisRole = iota
+	axisRoleValue
+	axisRoleSecondaryValue
+)
+
+func (r axisRole) String() string {
+	switch r {
+	case axisRoleCategory:
+		return "category axis"
+	case axisRoleValue:
+		return "value axis"
+	default:
+		return "secondary value axis"
+	}
+}
+
+func (u *Updater) setAxisTitle(chartIndex int, title string, role axisRole) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if strings.TrimSpace(title) == "" {
+		return NewValidationError("title", "axis title cannot be empty")
+	}
+	content, chartPath, err := u.readChartPart(chartIndex)
+	if err != nil {
+		return err
+	}
+	ns := detectNamespacePrefix(content)
+
+	axis, ok := findAxis(content, ns, role)
+	if !ok {
+		return NewValidationError("chartIndex", fmt.Sprintf("chart %d has no %s", chartIndex, role))
+	}
+	start, end := findAxisTitle(content, axis, ns)
+	newTitle := withChartPrefix(generateAxisTitleXML(title, false), ns)
+	content = replaceTitle(content, start, end, axisTitleInsertPos(content, axis, ns), newTitle, title)
+
+	if err := atomicWriteFile(chartPath, []byte(content), 0o644); err != nil {
+		return NewXMLWriteError(filepath.Base(chartPath), err)
+	}
+	return nil
+}
+
+// axisRange is the byte range of an axis element in the chart XML.
+type axisRange struct {
+	kind       string // catAx, dateAx, serAx or valAx
+	start, end int
+}
+
+// chartAxes returns the axis elements of the plot area in document order.
+func chartAxes(content, ns string) []axisRange {
+	var axes []axisRange
+	for _, kind := range []string{"catAx", "dateAx", "serAx", "valAx"} {
+		closeTag := "</" + ns + kind + ">"
+		for offset := 0; ; {
+			i := strings.Index(content[offset:], "<"+ns+kind+">")
+			if i < 0 {
+				break
+			}
+			start := offset + i
+			j := strings.Index(content[start:], closeTag)
+			if j < 0 {
+				break
+			}
+			end := start + j + len(closeTag)
+			axes = append(axes, axisRange{kind: kind, start: start, end: end})
+			offset = end
+		}
+	}
+	slices.SortFunc(axes, func(a, b axisRange) int { return a.start - b.start })
+	return axes
+}
+
+// findAxis picks the axis playing role. The category axis is the first
+// catAx or dateAx; charts without one (scatter, bubble) use their first
+// valAx as the horizontal axis. The remaining value axes are the primary and
+// secondary value axes, in order.
+func findAxis(content, ns string, role axisRole) (axisRange, bool) {
+	var category *axisRange
+	var values []axisRange
+	axes := chartAxes(content, ns)
+	for i, axis := range axes {
+		if (axis.kind == "catAx" || axis.kind == "dateAx") && category == nil {
+			category = &axes[i]
+		}
+	}
+	for i, axis := range axes {
+		if axis.kind != "valAx" {
+			continue
+		}
+		if category == nil {
+			category = &axes[i]
+			continue
+		}
+		values = append(values, axis)
+	}
+
+	switch {
+	case role == axisRoleCategory && category != nil:
+		return *category, true
+	case role == axisRoleValue && len(values) > 0:
+		return values[0], true
+	case role == axisRoleSecondaryValue && len(values) > 1:
+		return values[1], true
+	}
+	return axisRange{}, false
+}
+
+// findAxisTitle returns the byte range of the <c:title> inside axis, or -1.
+func findAxisTitle(content string, axis axisRange, ns string) (int, int) {
+	block := content[axis.start:axis.end]
+	start := -1
+	for _, open := range []string{"<" + ns + "title>", "<" + ns + "title "} {
+		if i := strings.Index(block, open); i >= 0 && (start < 0 || i < start) {
+			start = i
+		}
+	}
+	if start < 0 {
+		return -1, -1
+	}
+	end := strings.Index(block[start:], "</"+ns+"title>")
+	if end < 0 {
+		return -1, -1
+	}
+	return axis.start + start, axis.start + start + end + len("</"+ns+"title>")
+}
+
+// axisChildOrder is the CT_Axis child sequence shared by catAx, dateAx,
+// serAx and valAx (the axis-specific elements all follow crossAx).
+var axisChildOrder = []string{
+	"axId", "scaling", "delete", "axPos", "majorGridlines", "minorGridlines", "title",
+	"numFmt", "majorTickMark", "minorTickMark", "tickLblPos", "spPr", "txPr", "crossAx",
+}
+
+// axisTitleInsertPos returns where a new title goes among the top-level
+// children of axis: after the axis position and gridlines, before the number
+// format and tick marks.
+func axisTitleInsertPos(content string, axis axisRange, ns string) int {
+	innerStart := axis.start + len("<"+ns+axis.kind+">")
+	innerEnd := axis.end - len("</"+ns+axis.kind+">")
+	children := splitXMLChildren([]byte(content[innerStart:innerEnd]))
+
+	const placeholder = "<title/>"
+	ordered := upsertOrderedChild(children, ns+"title", placeholder, axisChildOrder)
+	i := slices.IndexFunc(ordered, func(c xmlChild) bool { return string(c.xml) == placeholder })
+	if i+1 < len(ordered) {
+		return innerStart + ordered[i+1].offset
+	}
+	return innerEnd
+}
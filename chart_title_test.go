@@ -0,0 +1,254 @@
+package godocx_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	godocx "github.com/falcomza/go-docx"
+)
+
+func newChartTitleUpdater(t *testing.T, title string) *godocx.Updater {
+	t.Helper()
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	t.Cleanup(func() { u.Cleanup() })
+	err = u.InsertChart(godocx.ChartOptions{
+		Title:      title,
+		Categories: []string{"Q1", "Q2"},
+		Series:     []godocx.SeriesOptions{{Name: "Sales", Values: []float64{1, 2}}},
+		ValueAxis:  &godocx.AxisOptions{Title: "Revenue"},
+		Position:   godocx.PositionEnd,
+	})
+	if err != nil {
+		t.Fatalf("InsertChart: %v", err)
+	}
+	return u
+}
+
+func readChart1(t *testing.T, u *godocx.Updater) string {
+	t.Helper()
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "charts", "chart1.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(raw)
+}
+
+func TestSetChartTitleReplacesText(t *testing.T) {
+	u := newChartTitleUpdater(t, "Old Title")
+
+	if err := u.SetChartTitle(1, "Sales & Costs"); err != nil {
+		t.Fatalf("SetChartTitle: %v", err)
+	}
+	got, err := u.GetChartTitle(1)
+	if err != nil {
+		t.Fatalf("GetChartTitle: %v", err)
+	}
+	if got != "Sales & Costs" {
+		t.Errorf("GetChartTitle = %q, want %q", got, "Sales & Costs")
+	}
+	chart := readChart1(t, u)
+	if strings.Contains(chart, "Old Title") {
+		t.Error("old title text should be replaced")
+	}
+	if strings.Count(chart, "<c:title>") != 2 {
+		t.Errorf("expected chart and axis titles to remain, got:\n%s", chart)
+	}
+	if !strings.Contains(chart, "<a:t>Revenue</a:t>") {
+		t.Error("axis title must not be changed")
+	}
+}
+
+func TestSetChartTitleCreatesMissingTitle(t *testing.T) {
+	u := newChartTitleUpdater(t, "")
+
+	if got, err := u.GetChartTitle(1); err != nil || got != "" {
+		t.Fatalf("GetChartTitle on untitled chart = %q, %v", got, err)
+	}
+	if err := u.SetChartTitle(1, "New Title"); err != nil {
+		t.Fatalf("SetChartTitle: %v", err)
+	}
+	chart := readChart1(t, u)
+	if !strings.Contains(chart, `<c:chart><c:title>`) {
+		t.Errorf("title should be the first child of c:chart:\n%s", chart)
+	}
+	if !strings.Contains(chart, `<c:autoTitleDeleted val="0"/>`) {
+		t.Error("autoTitleDeleted should be 0 after setting a title")
+	}
+	if got, _ := u.GetChartTitle(1); got != "New Title" {
+		t.Errorf("GetChartTitle = %q, want %q", got, "New Title")
+	}
+}
+
+func TestRemoveChartTitle(t *testing.T) {
+	u := newChartTitleUpdater(t, "Doomed")
+
+	if err := u.RemoveChartTitle(1); err != nil {
+		t.Fatalf("RemoveChartTitle: %v", err)
+	}
+	chart := readChart1(t, u)
+	if strings.Contains(chart, "Doomed") {
+		t.Error("title should be removed")
+	}
+	if !strings.Contains(chart, `<c:chart><c:autoTitleDeleted val="1"/>`) {
+		t.Errorf("expected autoTitleDeleted=1 as first child of c:chart:\n%s", chart)
+	}
+	if !strings.Contains(chart, "<a:t>Revenue</a:t>") {
+		t.Error("axis title must be kept")
+	}
+	if got, _ := u.GetChartTitle(1); got != "" {
+		t.Errorf("GetChartTitle after removal = %q, want empty", got)
+	}
+}
+
+func TestChartTitleInvalidIndex(t *testing.T) {
+	u := newChartTitleUpdater(t, "Title")
+
+	if err := u.SetChartTitle(0, "x"); err == nil {
+		t.Error("expected error for chart index 0")
+	}
+	if err := u.SetChartTitle(1, " "); err == nil {
+		t.Error("expected error for empty title")
+	}
+	if err := u.RemoveChartTitle(5); err == nil {
+		t.Error("expected error for missing chart")
+	}
+	if _, err := u.GetChartTitle(5); err == nil {
+		t.Error("expected error for missing chart")
+	}
+}
+
+func TestSetAxisTitlesRoundTrip(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+	err = u.InsertChart(godocx.ChartOptions{
+		Categories:     []string{"Q1", "Q2"},
+		Series:         []godocx.SeriesOptions{{Name: "Sales", Values: []float64{1, 2}}},
+		ValueAxisTitle: "Old",
+		Position:       godocx.PositionEnd,
+	})
+	if err != nil {
+		t.Fatalf("InsertChart: %v", err)
+	}
+
+	if err := u.SetCategoryAxisTitle(1, "Quarter"); err != nil {
+		t.Fatalf("SetCategoryAxisTitle: %v", err)
+	}
+	if err := u.SetValueAxisTitle(1, "Revenue (€)"); err != nil {
+		t.Fatalf("SetValueAxisTitle: %v", err)
+	}
+	if err := u.SetSecondaryValueAxisTitle(1, "Margin"); err == nil {
+		t.Error("expected error for chart without a secondary value axis")
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "out.docx")
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	reloaded, err := godocx.New(outputPath)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer reloaded.Cleanup()
+
+	data, err := reloaded.GetChartData(1)
+	if err != nil {
+		t.Fatalf("GetChartData: %v", err)
+	}
+	if data.CategoryAxisTitle != "Quarter" {
+		t.Errorf("CategoryAxisTitle = %q, want %q", data.CategoryAxisTitle, "Quarter")
+	}
+	if data.ValueAxisTitle != "Revenue (€)" {
+		t.Errorf("ValueAxisTitle = %q, want %q", data.ValueAxisTitle, "Revenue (€)")
+	}
+
+	// The new category axis title must precede the axis number format.
+	chart := readChart1(t, reloaded)
+	catAx := chart[strings.Index(chart, "<c:catAx>"):strings.Index(chart, "</c:catAx>")]
+	if ti, ni := strings.Index(catAx, "<c:title>"), strings.Index(catAx, "<c:numFmt"); ti < 0 || (ni >= 0 && ti > ni) {
+		t.Errorf("category axis title misplaced:\n%s", catAx)
+	}
+}
+
+func TestSetValueAxisTitleWithStyledGridlines(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+	err = u.InsertChart(godocx.ChartOptions{
+		Categories: []string{"Q1", "Q2"},
+		Series:     []godocx.SeriesOptions{{Name: "Sales", Values: []float64{1, 2}}},
+		ValueAxis: &godocx.AxisOptions{
+			MajorGridlines:     true,
+			MajorGridlineStyle: &godocx.GridlineStyle{Color: "D9D9D9", DashType: "dashed"},
+		},
+		Position: godocx.PositionEnd,
+	})
+	if err != nil {
+		t.Fatalf("InsertChart: %v", err)
+	}
+	if err := u.SetValueAxisTitle(1, "Revenue"); err != nil {
+		t.Fatalf("SetValueAxisTitle: %v", err)
+	}
+
+	// The title must follow the gridlines, not land inside their <c:spPr>.
+	chart := readChart1(t, u)
+	valAx := chart[strings.Index(chart, "<c:valAx>"):strings.Index(chart, "</c:valAx>")]
+	gridlines := valAx[strings.Index(valAx, "<c:majorGridlines>"):strings.Index(valAx, "</c:majorGridlines>")]
+	if strings.Contains(gridlines, "<c:title>") {
+		t.Fatalf("axis title inserted inside the gridlines:\n%s", valAx)
+	}
+	ti := strings.Index(valAx, "<c:title>")
+	if ti < strings.Index(valAx, "</c:majorGridlines>") || ti > strings.Index(valAx, "<c:numFmt") {
+		t.Errorf("value axis title misplaced:\n%s", valAx)
+	}
+}
+
+func TestSetSecondaryValueAxisTitle(t *testing.T) {
+	u := newChartTitleUpdater(t, "Dual")
+
+	// Add a second value axis, as combo charts with two scales have.
+	chartPath := filepath.Join(u.TempDir(), "word", "charts", "chart1.xml")
+	chart := readChart1(t, u)
+	start, end := strings.Index(chart, "<c:valAx>"), strings.Index(chart, "</c:valAx>")+len("</c:valAx>")
+	secondary := strings.Replace(chart[start:end], `<c:axPos val="l"/>`, `<c:axPos val="r"/>`, 1)
+	secondary = secondary[:strings.Index(secondary, "<c:title>")] + secondary[strings.Index(secondary, "</c:title>")+len("</c:title>"):]
+	chart = chart[:end] + secondary + chart[end:]
+	if err := os.WriteFile(chartPath, []byte(chart), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := u.SetSecondaryValueAxisTitle(1, "Margin %"); err != nil {
+		t.Fatalf("SetSecondaryValueAxisTitle: %v", err)
+	}
+	chart = readChart1(t, u)
+	last := chart[strings.LastIndex(chart, "<c:valAx>"):]
+	if !strings.Contains(last, "<a:t>Margin %</a:t>") {
+		t.Errorf("secondary axis should have the new title:\n%s", last)
+	}
+	if data, _ := u.GetChartData(1); data.ValueAxisTitle != "Revenue" {
+		t.Errorf("primary value axis title = %q, want %q", data.ValueAxisTitle, "Revenue")
+	}
+}
+
+func TestSetAxisTitleValidation(t *testing.T) {
+	u := newChartTitleUpdater(t, "Title")
+
+	if err := u.SetValueAxisTitle(1, ""); err == nil {
+		t.Error("expected error for empty axis title")
+	}
+	if err := u.SetCategoryAxisTitle(0, "x"); err == nil {
+		t.Error("expected error for chart index 0")
+	}
+	if err := u.SetValueAxisTitle(3, "x"); err == nil {
+		t.Error("expected error for missing chart")
+	}
+}
@@ -1,12 +1,15 @@
 package godocx
 
 import (
+	"archive/zip"
+	"bytes"
 	"encoding/xml"
-	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"time"
 )
@@ -17,21 +20,33 @@ import (
 // operations read and write files in a shared temporary directory; callers
 // must serialise access externally if they need to issue operations from
 // multiple goroutines.
+//
+// Every Updater owns a temporary directory that Cleanup removes. Callers
+// should always defer Cleanup; an Updater that is garbage collected without
+// it logs a warning and removes its temporary files as a last resort.
 type Updater struct {
 	originalPath  string
 	tempDir       string
 	tempInputFile string
+	cleanupCalled bool
 
 	bulletListNumID   int
 	numberedListNumID int
+	numbering         *numberingManager
+
+	captions captionCounter
+
+	progress ProgressFunc
+	metrics  MetricsCollector
 }
 
 // NewBlank creates a new blank DOCX document from scratch without requiring a template.
 // The document contains a minimal valid OpenXML structure ready for content insertion.
-func NewBlank() (*Updater, error) {
+func NewBlank(opts ...Option) (*Updater, error) {
+	start := time.Now()
 	tempDir, err := os.MkdirTemp("", "docx-blank-*")
 	if err != nil {
-		return nil, fmt.Errorf("create temp dir: %w", err)
+		return nil, NewFileWriteError("temp dir", err)
 	}
 
 	if err := writeBlankDocxStructure(tempDir); err != nil {
@@ -39,41 +54,46 @@ func NewBlank() (*Updater, error) {
 		return nil, fmt.Errorf("write blank docx: %w", err)
 	}
 
-	u := &Updater{originalPath: "", tempDir: tempDir}
+	u := newUpdater("", tempDir)
+	u.applyOptions(opts)
 
 	if err := u.validateStructure(); err != nil {
 		u.Cleanup()
 		return nil, fmt.Errorf("invalid blank DOCX: %w", err)
 	}
 
+	u.recordOperation(MetricNewBlank, start, nil)
 	return u, nil
 }
 
 // NewFromBytes creates an Updater from raw DOCX bytes (e.g., uploaded template data).
 // This is useful when the template is received from a web upload, API payload, or
 // database rather than a file on disk.
-func NewFromBytes(data []byte) (*Updater, error) {
+func NewFromBytes(data []byte, opts ...Option) (*Updater, error) {
 	if len(data) == 0 {
-		return nil, errors.New("docx data is empty")
+		return nil, NewValidationError("data", "docx data is empty")
+	}
+	if _, err := zip.NewReader(bytes.NewReader(data), int64(len(data))); err != nil {
+		return nil, NewInvalidFileError("docx data is not a valid zip archive", err)
 	}
 
 	tmpFile, err := os.CreateTemp("", "docx-bytes-*.docx")
 	if err != nil {
-		return nil, fmt.Errorf("create temp file: %w", err)
+		return nil, NewFileWriteError("temp file", err)
 	}
 	tmpPath := tmpFile.Name()
 
 	if _, err := tmpFile.Write(data); err != nil {
 		tmpFile.Close()
 		os.Remove(tmpPath)
-		return nil, fmt.Errorf("write temp file: %w", err)
+		return nil, NewFileWriteError("temp file", err)
 	}
 	if err := tmpFile.Close(); err != nil {
 		os.Remove(tmpPath)
-		return nil, fmt.Errorf("close temp file: %w", err)
+		return nil, NewFileWriteError("temp file", err)
 	}
 
-	u, err := New(tmpPath)
+	u, err := New(tmpPath, opts...)
 	if err != nil {
 		os.Remove(tmpPath)
 		return nil, err
@@ -84,17 +104,18 @@ func NewFromBytes(data []byte) (*Updater, error) {
 }
 
 // New opens a DOCX file and prepares it for editing.
-func New(docxPath string) (*Updater, error) {
+func New(docxPath string, opts ...Option) (*Updater, error) {
 	if docxPath == "" {
-		return nil, errors.New("docx path is required")
+		return nil, NewValidationError("docxPath", "docx path is required")
 	}
+	start := time.Now()
 	if _, err := os.Stat(docxPath); err != nil {
-		return nil, fmt.Errorf("stat docx: %w", err)
+		return nil, NewFileReadError(docxPath, err)
 	}
 
 	tempDir, err := os.MkdirTemp("", "docx-update-*")
 	if err != nil {
-		return nil, fmt.Errorf("create temp dir: %w", err)
+		return nil, NewFileWriteError("temp dir", err)
 	}
 
 	if err := extractZip(docxPath, tempDir); err != nil {
@@ -120,7 +141,8 @@ func New(docxPath string) (*Updater, error) {
 		return nil, fmt.Errorf("normalize WML namespace: %w", err)
 	}
 
-	u := &Updater{originalPath: docxPath, tempDir: tempDir}
+	u := newUpdater(docxPath, tempDir)
+	u.applyOptions(opts)
 
 	// Validate DOCX structure
 	if err := u.validateStructure(); err != nil {
@@ -128,9 +150,30 @@ func New(docxPath string) (*Updater, error) {
 		return nil, fmt.Errorf("invalid DOCX: %w", err)
 	}
 
+	u.recordOperation(MetricNew, start, map[string]any{"path": docxPath})
 	return u, nil
 }
 
+// newUpdater creates an Updater for the extracted package in tempDir and
+// registers a finalizer that removes the temporary files if the Updater is
+// garbage collected without Cleanup having been called.
+func newUpdater(originalPath, tempDir string) *Updater {
+	u := &Updater{originalPath: originalPath, tempDir: tempDir}
+	runtime.SetFinalizer(u, finalizeUpdater)
+	return u
+}
+
+func finalizeUpdater(u *Updater) {
+	if u.cleanupCalled {
+		return
+	}
+	slog.Warn("godocx: Updater garbage collected without Cleanup; removing temporary files",
+		"tempDir", u.tempDir)
+	if err := u.Cleanup(); err != nil {
+		slog.Warn("godocx: cleanup of leaked Updater failed", "tempDir", u.tempDir, "error", err)
+	}
+}
+
 // TempDir returns the temporary directory where the DOCX was extracted.
 // It is intended for testing and advanced use only. Callers that read or write
 // files directly inside TempDir bypass all validation and relationship tracking
@@ -143,7 +186,7 @@ func (u *Updater) TempDir() string {
 // Returns 0 if the document contains no charts.
 func (u *Updater) GetChartCount() (int, error) {
 	if u == nil {
-		return 0, errors.New("updater is nil")
+		return 0, NewValidationError("updater", "updater is nil")
 	}
 	chartsDir := filepath.Join(u.tempDir, "word", "charts")
 	entries, err := os.ReadDir(chartsDir)
@@ -151,7 +194,7 @@ func (u *Updater) GetChartCount() (int, error) {
 		if os.IsNotExist(err) {
 			return 0, nil
 		}
-		return 0, fmt.Errorf("read charts dir: %w", err)
+		return 0, NewFileReadError("charts directory", err)
 	}
 	var count int
 	for _, e := range entries {
@@ -167,6 +210,10 @@ func (u *Updater) Cleanup() error {
 	if u == nil || u.tempDir == "" {
 		return nil
 	}
+	if !u.cleanupCalled {
+		u.cleanupCalled = true
+		runtime.SetFinalizer(u, nil)
+	}
 	err := os.RemoveAll(u.tempDir)
 	if u.tempInputFile != "" {
 		if rmErr := os.Remove(u.tempInputFile); rmErr != nil && !os.IsNotExist(rmErr) {
@@ -178,13 +225,19 @@ func (u *Updater) Cleanup() error {
 	return err
 }
 
+// CleanupCalled reports whether Cleanup has been called on the Updater.
+// It is intended for tests that check temporary files are released.
+func (u *Updater) CleanupCalled() bool {
+	return u != nil && u.cleanupCalled
+}
+
 // UpdateChart updates one chart by index (1-based).
 func (u *Updater) UpdateChart(chartIndex int, data ChartData) error {
 	if u == nil {
-		return errors.New("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if chartIndex < 1 {
-		return errors.New("chart index must be >= 1")
+		return NewValidationError("chartIndex", "chart index must be >= 1")
 	}
 	if err := validateChartData(data); err != nil {
 		return err
@@ -192,7 +245,7 @@ func (u *Updater) UpdateChart(chartIndex int, data ChartData) error {
 
 	chartPath := filepath.Join(u.tempDir, "word", "charts", fmt.Sprintf("chart%d.xml", chartIndex))
 	if _, err := os.Stat(chartPath); err != nil {
-		return fmt.Errorf("chart file does not exist: %w", err)
+		return NewFileReadError(fmt.Sprintf("chart%d.xml", chartIndex), err)
 	}
 
 	if err := updateChartXML(chartPath, data); err != nil {
@@ -203,6 +256,10 @@ func (u *Updater) UpdateChart(chartIndex int, data ChartData) error {
 	if err != nil {
 		return fmt.Errorf("resolve embedded workbook: %w", err)
 	}
+	xlsxPath, err = u.isolateChartWorkbook(chartIndex, xlsxPath)
+	if err != nil {
+		return fmt.Errorf("isolate embedded workbook: %w", err)
+	}
 	if err := updateEmbeddedWorkbook(xlsxPath, data); err != nil {
 		return fmt.Errorf("update embedded workbook: %w", err)
 	}
@@ -212,28 +269,28 @@ func (u *Updater) UpdateChart(chartIndex int, data ChartData) error {
 
 // NewFromReader opens a DOCX from an io.Reader and prepares it for editing.
 // The reader content is buffered to a temporary file which is cleaned up by Cleanup().
-func NewFromReader(r io.Reader) (*Updater, error) {
+func NewFromReader(r io.Reader, opts ...Option) (*Updater, error) {
 	if r == nil {
-		return nil, errors.New("reader is nil")
+		return nil, NewValidationError("r", "reader is nil")
 	}
 
 	tmpFile, err := os.CreateTemp("", "docx-input-*.docx")
 	if err != nil {
-		return nil, fmt.Errorf("create temp input file: %w", err)
+		return nil, NewFileWriteError("temp input file", err)
 	}
 	tmpPath := tmpFile.Name()
 
 	if _, err := io.Copy(tmpFile, r); err != nil {
 		tmpFile.Close()
 		os.Remove(tmpPath)
-		return nil, fmt.Errorf("buffer reader to temp file: %w", err)
+		return nil, NewFileWriteError("temp input file", err)
 	}
 	if err := tmpFile.Close(); err != nil {
 		os.Remove(tmpPath)
-		return nil, fmt.Errorf("close temp input file: %w", err)
+		return nil, NewFileWriteError("temp input file", err)
 	}
 
-	u, err := New(tmpPath)
+	u, err := New(tmpPath, opts...)
 	if err != nil {
 		os.Remove(tmpPath)
 		return nil, err
@@ -246,44 +303,90 @@ func NewFromReader(r io.Reader) (*Updater, error) {
 // SaveToWriter writes the updated DOCX to an io.Writer.
 func (u *Updater) SaveToWriter(w io.Writer) error {
 	if u == nil {
-		return errors.New("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if w == nil {
-		return errors.New("writer is nil")
+		return NewValidationError("w", "writer is nil")
 	}
-	return writeZipFromDir(u.tempDir, w)
+	start := time.Now()
+	if err := u.writePackage(w); err != nil {
+		return err
+	}
+	u.recordOperation(MetricSaveToWriter, start, nil)
+	u.reportProgress(ProgressWriting, 90)
+	u.reportProgress(ProgressDone, 100)
+	return nil
 }
 
-// Save writes the updated DOCX to outputPath.
+// Save writes the updated DOCX to outputPath. The document is written
+// atomically, as by SaveAtomically.
 func (u *Updater) Save(outputPath string) error {
+	return u.SaveAtomically(outputPath)
+}
+
+// SaveAtomically writes the updated DOCX to outputPath + ".tmp" and then
+// renames it to outputPath, so that outputPath always holds either the
+// previous file or the complete new document, never a partial write, even
+// if the process is killed while saving. Where the rename fails because the
+// destination is briefly locked (as on Windows while another process has it
+// open), it is retried with backoff. The temporary file is removed when the
+// save fails.
+func (u *Updater) SaveAtomically(outputPath string) error {
 	if u == nil {
-		return errors.New("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if outputPath == "" {
-		return errors.New("output path is required")
+		return NewValidationError("outputPath", "output path is required")
 	}
 	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
-		return fmt.Errorf("create output dir: %w", err)
+		return NewFileWriteError("output dir", err)
+	}
+
+	tmpPath := outputPath + ".tmp"
+	out, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("create output docx: %w", NewFileWriteError("output zip", err))
 	}
-	if err := createZipFromDir(u.tempDir, outputPath); err != nil {
+	start := time.Now()
+	if err := u.writePackage(out); err != nil {
+		out.Close()
+		os.Remove(tmpPath)
 		return fmt.Errorf("create output docx: %w", err)
 	}
+	u.recordOperation(MetricSave, start, map[string]any{"path": outputPath})
+	u.reportProgress(ProgressWriting, 90)
+	// Flush to disk before the rename, so a crash cannot leave the renamed
+	// file empty.
+	if err := out.Sync(); err != nil {
+		out.Close()
+		os.Remove(tmpPath)
+		return fmt.Errorf("create output docx: %w", NewFileWriteError("output zip", err))
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("create output docx: %w", NewFileWriteError("output zip", err))
+	}
+	if err := renameWithRetry(tmpPath, outputPath); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("create output docx: %w", NewFileWriteError("output zip", err))
+	}
+	u.reportProgress(ProgressDone, 100)
 	return nil
 }
 
 func validateChartData(data ChartData) error {
 	if len(data.Categories) == 0 {
-		return errors.New("categories cannot be empty")
+		return NewValidationError("Categories", "categories cannot be empty")
 	}
 	if len(data.Series) == 0 {
-		return errors.New("series cannot be empty")
+		return NewValidationError("series", "series cannot be empty")
 	}
 	for i, s := range data.Series {
 		if strings.TrimSpace(s.Name) == "" {
-			return fmt.Errorf("series[%d] name cannot be empty", i)
+			return NewValidationError("Series", fmt.Sprintf("series[%d] name cannot be empty", i))
 		}
 		if len(s.Values) != len(data.Categories) {
-			return fmt.Errorf("series[%d] values length (%d) must match categories length (%d)", i, len(s.Values), len(data.Categories))
+			return NewValidationError("Series", fmt.Sprintf("series[%d] values length (%d) must match categories length (%d)", i, len(s.Values), len(data.Categories)))
 		}
 	}
 	return nil
@@ -293,12 +396,12 @@ func (u *Updater) findWorkbookPathForChart(chartIndex int) (string, error) {
 	chartPath := filepath.Join(u.tempDir, "word", "charts", fmt.Sprintf("chart%d.xml", chartIndex))
 	rawChart, err := os.ReadFile(chartPath)
 	if err != nil {
-		return "", fmt.Errorf("read chart xml for chart%d: %w", chartIndex, err)
+		return "", NewFileReadError(fmt.Sprintf("chart xml for chart%d", chartIndex), err)
 	}
 
 	relID := externalDataRelID(rawChart)
 	if relID == "" {
-		return "", fmt.Errorf("chart%d.xml has no externalData relationship ID", chartIndex)
+		return "", NewMalformedXMLError(fmt.Sprintf("chart%d.xml has no externalData relationship ID", chartIndex))
 	}
 
 	relsPath := filepath.Join(u.tempDir, "word", "charts", "_rels", fmt.Sprintf("chart%d.xml.rels", chartIndex))
@@ -307,13 +410,13 @@ func (u *Updater) findWorkbookPathForChart(chartIndex int) (string, error) {
 		return "", fmt.Errorf("resolve relationship %s for chart%d: %w", relID, chartIndex, err)
 	}
 	if target == "" {
-		return "", fmt.Errorf("relationship %s for chart%d has empty target", relID, chartIndex)
+		return "", NewRelationshipError(fmt.Sprintf("relationship %s for chart%d has empty target", relID, chartIndex), nil)
 	}
 
 	// Relationship targets are relative to the source part (chart#.xml), not the .rels folder.
 	resolved := filepath.Clean(filepath.Join(filepath.Dir(chartPath), filepath.FromSlash(target)))
 	if _, statErr := os.Stat(resolved); statErr != nil {
-		return "", fmt.Errorf("workbook file %s for chart%d not found: %w", resolved, chartIndex, statErr)
+		return "", NewFileReadError(fmt.Sprintf("workbook %s for chart%d", resolved, chartIndex), statErr)
 	}
 
 	return resolved, nil
@@ -362,26 +465,27 @@ type relationships struct {
 }
 
 type relationship struct {
-	ID     string `xml:"Id,attr"`
-	Type   string `xml:"Type,attr"`
-	Target string `xml:"Target,attr"`
+	ID         string `xml:"Id,attr"`
+	Type       string `xml:"Type,attr"`
+	Target     string `xml:"Target,attr"`
+	TargetMode string `xml:"TargetMode,attr"`
 }
 
 func findRelationshipTarget(relsPath, relationshipID string) (string, error) {
 	raw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return "", fmt.Errorf("read relationships: %w", err)
+		return "", NewFileReadError("relationships", err)
 	}
 	var rels relationships
 	if err := xml.Unmarshal(raw, &rels); err != nil {
-		return "", fmt.Errorf("parse relationships: %w", err)
+		return "", NewXMLParseError(filepath.Base(relsPath), err)
 	}
 	for _, rel := range rels.Relationships {
 		if rel.ID == relationshipID {
 			return rel.Target, nil
 		}
 	}
-	return "", fmt.Errorf("relationship %s not found", relationshipID)
+	return "", NewRelationshipError(fmt.Sprintf("relationship %s not found", relationshipID), nil)
 }
 
 // normalizeTemplateToDocument promotes a DOTX template content type to a DOCX
@@ -390,7 +494,7 @@ func normalizeTemplateToDocument(tempDir string) error {
 	ctPath := filepath.Join(tempDir, "[Content_Types].xml")
 	data, err := os.ReadFile(ctPath)
 	if err != nil {
-		return fmt.Errorf("read [Content_Types].xml: %w", err)
+		return NewFileReadError("[Content_Types].xml", err)
 	}
 	content := string(data)
 	if !strings.Contains(content, DotxMainContentType) {
@@ -426,7 +530,7 @@ func normalizeWMLNamespacePrefix(tempDir string) error {
 	docPath := filepath.Join(tempDir, "word", "document.xml")
 	data, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	content := string(data)
@@ -461,7 +565,7 @@ func (u *Updater) validateStructure() error {
 	for _, path := range required {
 		fullPath := filepath.Join(u.tempDir, path)
 		if _, err := os.Stat(fullPath); err != nil {
-			return fmt.Errorf("missing required file %s", path)
+			return NewFileReadError(path, err)
 		}
 	}
 	return nil
@@ -484,10 +588,10 @@ func writeBlankDocxStructure(dir string) error {
 	for relPath, content := range files {
 		fullPath := filepath.Join(dir, relPath)
 		if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
-			return fmt.Errorf("create dir for %s: %w", relPath, err)
+			return NewFileWriteError(filepath.Dir(relPath), err)
 		}
 		if err := atomicWriteFile(fullPath, []byte(content), 0o644); err != nil {
-			return fmt.Errorf("write %s: %w", relPath, err)
+			return NewFileWriteError(relPath, err)
 		}
 	}
 
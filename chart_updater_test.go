@@ -4,8 +4,10 @@ import (
 	"archive/zip"
 	"bytes"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
@@ -665,3 +667,319 @@ func TestGetChartDataFromFixture(t *testing.T) {
 	}
 }
 
+
+func TestGetChartDataUpdateChartRoundTripAllKinds(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       godocx.ChartKind
+		categories []string
+		series     []godocx.SeriesOptions
+	}{
+		{"column", godocx.ChartKindColumn, []string{"Q1", "Q2", "Q3"}, []godocx.SeriesOptions{
+			{Name: "North", Values: []float64{1.5, 2.25, 0.1}},
+			{Name: "South", Values: []float64{-3, 1e6, 42}},
+		}},
+		{"bar", godocx.ChartKindBar, []string{"A", "B"}, []godocx.SeriesOptions{{Name: "Only", Values: []float64{0.3333333333333333, 7}}}},
+		{"line", godocx.ChartKindLine, []string{"Jan", "Feb", "Mar"}, []godocx.SeriesOptions{
+			{Name: "Temp", Values: []float64{-1.75, 0, 12.125}},
+		}},
+		{"pie single series", godocx.ChartKindPie, []string{"Red", "Green", "Blue"}, []godocx.SeriesOptions{
+			{Name: "Share", Values: []float64{0.5, 0.3, 0.2}},
+		}},
+		{"area", godocx.ChartKindArea, []string{"2022", "2023", "2024"}, []godocx.SeriesOptions{
+			{Name: "Base", Values: []float64{10, 20, 30}},
+			{Name: "Extra", Values: []float64{5, 5.5, 6}},
+		}},
+		{"scatter", godocx.ChartKindScatter, []string{"1", "2", "3"}, []godocx.SeriesOptions{
+			{Name: "Points", Values: []float64{2.5, 4.75, 9}, XValues: []float64{1, 2, 3}},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputPath := filepath.Join(t.TempDir(), "input.docx")
+			if err := os.WriteFile(inputPath, buildFixtureDocx(t), 0o644); err != nil {
+				t.Fatalf("write input fixture: %v", err)
+			}
+			u, err := godocx.New(inputPath)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			defer u.Cleanup()
+
+			if err := u.InsertChart(godocx.ChartOptions{
+				ChartKind:  tt.kind,
+				Categories: tt.categories,
+				Series:     tt.series,
+				Position:   godocx.PositionEnd,
+			}); err != nil {
+				t.Fatalf("InsertChart: %v", err)
+			}
+			index, _ := u.GetChartCount()
+
+			assertData := func(stage string, data godocx.ChartData) {
+				t.Helper()
+				if !slices.Equal(data.Categories, tt.categories) {
+					t.Errorf("%s: categories = %v, want %v", stage, data.Categories, tt.categories)
+				}
+				if len(data.Series) != len(tt.series) {
+					t.Fatalf("%s: got %d series, want %d", stage, len(data.Series), len(tt.series))
+				}
+				for i, s := range tt.series {
+					got := data.Series[i]
+					if got.Name != s.Name || got.FormatCode != "General" {
+						t.Errorf("%s: series[%d] name = %q, format = %q", stage, i, got.Name, got.FormatCode)
+					}
+					if len(got.Values) != len(s.Values) {
+						t.Errorf("%s: series[%d] values = %v, want %v", stage, i, got.Values, s.Values)
+						continue
+					}
+					for j, v := range s.Values {
+						if math.Abs(got.Values[j]-v) > 1e-9 {
+							t.Errorf("%s: series[%d].Values[%d] = %v, want %v", stage, i, j, got.Values[j], v)
+						}
+					}
+				}
+			}
+
+			data, err := u.GetChartData(index)
+			if err != nil {
+				t.Fatalf("GetChartData: %v", err)
+			}
+			assertData("after insert", data)
+
+			if err := u.UpdateChart(index, data); err != nil {
+				t.Fatalf("UpdateChart: %v", err)
+			}
+			data, err = u.GetChartData(index)
+			if err != nil {
+				t.Fatalf("GetChartData after update: %v", err)
+			}
+			assertData("after update", data)
+		})
+	}
+}
+
+func TestGetChartDataFormatCode(t *testing.T) {
+	inputPath := filepath.Join(t.TempDir(), "input.docx")
+	if err := os.WriteFile(inputPath, buildFixtureDocx(t), 0o644); err != nil {
+		t.Fatalf("write input fixture: %v", err)
+	}
+	u, err := godocx.New(inputPath)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer u.Cleanup()
+
+	data, err := u.GetChartData(1)
+	if err != nil {
+		t.Fatalf("GetChartData: %v", err)
+	}
+	data.Series[0].FormatCode = `0.0%;"<"0%`
+	if err := u.UpdateChart(1, data); err != nil {
+		t.Fatalf("UpdateChart: %v", err)
+	}
+
+	got, err := u.GetChartData(1)
+	if err != nil {
+		t.Fatalf("GetChartData after update: %v", err)
+	}
+	if got.Series[0].FormatCode != `0.0%;"<"0%` {
+		t.Errorf("FormatCode = %q, want the code written by UpdateChart", got.Series[0].FormatCode)
+	}
+}
+
+func TestUpdateChartIsolatesSharedWorkbook(t *testing.T) {
+	inputPath := filepath.Join(t.TempDir(), "input.docx")
+	if err := os.WriteFile(inputPath, buildFixtureDocxTwoCharts(t), 0o644); err != nil {
+		t.Fatalf("write input fixture: %v", err)
+	}
+	u, err := godocx.New(inputPath)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer u.Cleanup()
+
+	// Point chart2 at chart1's workbook, as some templates do.
+	chart2Rels := filepath.Join(u.TempDir(), "word", "charts", "_rels", "chart2.xml.rels")
+	if err := os.WriteFile(chart2Rels, []byte(chartRelsFixtureXML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	before, err := u.ExtractChartWorkbook(1)
+	if err != nil {
+		t.Fatalf("ExtractChartWorkbook(1): %v", err)
+	}
+
+	err = u.UpdateChart(2, godocx.ChartData{
+		Categories: []string{"Isolated"},
+		Series:     []godocx.SeriesData{{Name: "Only chart 2", Values: []float64{7}}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateChart: %v", err)
+	}
+
+	after, err := u.ExtractChartWorkbook(1)
+	if err != nil {
+		t.Fatalf("ExtractChartWorkbook(1): %v", err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Error("updating chart2 changed the workbook of chart1")
+	}
+	rels, err := os.ReadFile(chart2Rels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(rels), `Target="../embeddings/Microsoft_Excel_Worksheet3.xlsx"`) {
+		t.Errorf("chart2 should use its own workbook copy:\n%s", rels)
+	}
+
+	workbook, err := u.ExtractChartWorkbook(2)
+	if err != nil {
+		t.Fatalf("ExtractChartWorkbook(2): %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(workbook), int64(len(workbook)))
+	if err != nil {
+		t.Fatalf("chart2 workbook is not a zip: %v", err)
+	}
+	found := false
+	for _, f := range zr.File {
+		if f.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		rc, _ := f.Open()
+		sheet, _ := io.ReadAll(rc)
+		rc.Close()
+		found = strings.Contains(string(sheet), "Isolated")
+	}
+	if !found {
+		t.Error("chart2 workbook should contain the updated data")
+	}
+
+	if _, err := u.ExtractChartWorkbook(0); err == nil {
+		t.Error("expected error for chart index 0")
+	}
+	if _, err := u.ExtractChartWorkbook(9); err == nil {
+		t.Error("expected error for missing chart")
+	}
+}
+
+func TestReplaceChartWorkbookRoundTrip(t *testing.T) {
+	inputPath := filepath.Join(t.TempDir(), "input.docx")
+	if err := os.WriteFile(inputPath, buildFixtureDocxTwoCharts(t), 0o644); err != nil {
+		t.Fatalf("write input fixture: %v", err)
+	}
+	u, err := godocx.New(inputPath)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer u.Cleanup()
+
+	// Share chart1's workbook with chart2 so the replacement must not leak.
+	chart2Rels := filepath.Join(u.TempDir(), "word", "charts", "_rels", "chart2.xml.rels")
+	if err := os.WriteFile(chart2Rels, []byte(chartRelsFixtureXML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	original, err := u.ExtractChartWorkbook(1)
+	if err != nil {
+		t.Fatalf("ExtractChartWorkbook(1): %v", err)
+	}
+
+	// Rewrite sheet1 of the extracted workbook, as a spreadsheet library would.
+	zr, err := zip.NewReader(bytes.NewReader(original), int64(len(original)))
+	if err != nil {
+		t.Fatalf("workbook is not a zip: %v", err)
+	}
+	var out bytes.Buffer
+	zw := zip.NewWriter(&out)
+	foundSheet := false
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			foundSheet = true
+			data = append(data, []byte("<!-- processed -->")...)
+		}
+		w, err := zw.Create(f.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(data)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !foundSheet {
+		t.Fatal("extracted workbook has no xl/worksheets/sheet1.xml")
+	}
+
+	if err := u.ReplaceChartWorkbook(2, out.Bytes()); err != nil {
+		t.Fatalf("ReplaceChartWorkbook: %v", err)
+	}
+	got, err := u.ExtractChartWorkbook(2)
+	if err != nil {
+		t.Fatalf("ExtractChartWorkbook(2): %v", err)
+	}
+	if !bytes.Equal(got, out.Bytes()) {
+		t.Error("chart2 workbook should be the replacement bytes")
+	}
+	unchanged, err := u.ExtractChartWorkbook(1)
+	if err != nil {
+		t.Fatalf("ExtractChartWorkbook(1): %v", err)
+	}
+	if !bytes.Equal(unchanged, original) {
+		t.Error("replacing chart2's workbook changed the workbook of chart1")
+	}
+
+	if err := u.ReplaceChartWorkbook(1, []byte("not a zip")); err == nil {
+		t.Error("expected error for data that is not a zip archive")
+	}
+	var empty bytes.Buffer
+	zip.NewWriter(&empty).Close()
+	if err := u.ReplaceChartWorkbook(1, empty.Bytes()); err == nil {
+		t.Error("expected error for an archive without xl/workbook.xml")
+	}
+	if err := u.ReplaceChartWorkbook(9, out.Bytes()); err == nil {
+		t.Error("expected error for missing chart")
+	}
+}
+
+func TestInsertChartUsesUnusedWorkbookName(t *testing.T) {
+	inputPath := filepath.Join(t.TempDir(), "input.docx")
+	if err := os.WriteFile(inputPath, buildFixtureDocxTwoCharts(t), 0o644); err != nil {
+		t.Fatalf("write input fixture: %v", err)
+	}
+	u, err := godocx.New(inputPath)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer u.Cleanup()
+
+	// A workbook left over from a removed chart must not be overwritten.
+	stray := filepath.Join(u.TempDir(), "word", "embeddings", "Microsoft_Excel_Worksheet3.xlsx")
+	if err := os.WriteFile(stray, []byte("keep"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := u.InsertChart(godocx.ChartOptions{
+		Categories: []string{"A"},
+		Series:     []godocx.SeriesOptions{{Name: "S", Values: []float64{1}}},
+		Position:   godocx.PositionEnd,
+	}); err != nil {
+		t.Fatalf("InsertChart: %v", err)
+	}
+
+	if got, _ := os.ReadFile(stray); string(got) != "keep" {
+		t.Error("InsertChart overwrote an existing workbook")
+	}
+	rels, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "charts", "_rels", "chart3.xml.rels"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(rels), `Target="../embeddings/Microsoft_Excel_Worksheet4.xlsx"`) {
+		t.Errorf("expected chart3 to use a new workbook name:\n%s", rels)
+	}
+}
@@ -0,0 +1,177 @@
+package godocx
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// ValidationSeverity tells whether a ValidationError blocks an operation.
+type ValidationSeverity int
+
+const (
+	SeverityError   ValidationSeverity = iota // The options cannot be used
+	SeverityWarning                           // The options work but are probably a mistake
+)
+
+// Codes reported in ValidationError.Code by DefaultChartValidator.
+const (
+	ValidationCodeInvalidValue     = "INVALID_VALUE"         // A basic option check failed
+	ValidationCodeDuplicateSeries  = "DUPLICATE_SERIES_NAME" // Two series share a name, ignoring case
+	ValidationCodeAllZeroValues    = "ALL_ZERO_VALUES"       // A series plots nothing
+	ValidationCodeInvalidCharacter = "INVALID_XML_CHARACTER" // Text that cannot be stored in XML
+	ValidationCodeSizeTooSmall     = "SIZE_TOO_SMALL"        // Width or Height is unreasonably small
+)
+
+// minReasonableChartSizeEMU is the size below which a chart is too small to
+// read (100000 EMUs is about 0.1 inch).
+const minReasonableChartSizeEMU = 100000
+
+// ValidationError describes one problem found by a ChartValidator.
+type ValidationError struct {
+	Field    string // Option the problem relates to, e.g. "Series" or "Width"
+	Code     string // Machine-readable kind of problem
+	Message  string
+	Severity ValidationSeverity
+}
+
+// Error implements the error interface.
+func (e ValidationError) Error() string {
+	if e.Severity == SeverityWarning {
+		return fmt.Sprintf("%s (warning): %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Is makes validation problems match ErrCodeValidation with errors.Is.
+func (e ValidationError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && code == ErrCodeValidation
+}
+
+// ChartValidator checks chart options before InsertChart creates a chart.
+// InsertChart fails with every problem of SeverityError that Validate
+// returns; warnings do not stop the insertion.
+type ChartValidator interface {
+	Validate(opts ChartOptions) []ValidationError
+}
+
+// DefaultChartValidator runs the built-in chart option checks. It is used
+// when ChartOptions.Validator is nil, and can be embedded or called by a
+// custom validator that adds its own rules.
+type DefaultChartValidator struct{}
+
+// Validate reports all problems with opts, not just the first.
+func (DefaultChartValidator) Validate(opts ChartOptions) []ValidationError {
+	var problems []ValidationError
+	for _, err := range chartOptionErrors(opts) {
+		problem := ValidationError{Code: ValidationCodeInvalidValue, Message: err.Error(), Severity: SeverityError}
+		var docxErr *DocxError
+		if errors.As(err, &docxErr) {
+			problem.Message = docxErr.Message
+			if field, ok := docxErr.Context["field"].(string); ok {
+				problem.Field = field
+			}
+			if code, ok := docxErr.Context["code"].(string); ok {
+				problem.Code = code
+			}
+		}
+		problems = append(problems, problem)
+	}
+
+	for i, category := range opts.Categories {
+		if !isXMLText(category) {
+			problems = append(problems, ValidationError{
+				Field:    "Categories",
+				Code:     ValidationCodeInvalidCharacter,
+				Message:  fmt.Sprintf("category[%d] contains characters that cannot be stored in XML", i),
+				Severity: SeverityError,
+			})
+		}
+	}
+
+	for i, series := range opts.Series {
+		if !isXMLText(series.Name) {
+			problems = append(problems, ValidationError{
+				Field:    "Series",
+				Code:     ValidationCodeInvalidCharacter,
+				Message:  fmt.Sprintf("series[%d] name contains characters that cannot be stored in XML", i),
+				Severity: SeverityError,
+			})
+		}
+		if allZero(series.Values) {
+			problems = append(problems, ValidationError{
+				Field:    "Series",
+				Code:     ValidationCodeAllZeroValues,
+				Message:  fmt.Sprintf("series[%d] (%q) has only zero values", i, series.Name),
+				Severity: SeverityWarning,
+			})
+		}
+	}
+
+	for _, size := range [...]struct {
+		name string
+		emu  int
+	}{{"Width", opts.Width}, {"Height", opts.Height}} {
+		if size.emu > 0 && size.emu < minReasonableChartSizeEMU {
+			problems = append(problems, ValidationError{
+				Field:    size.name,
+				Code:     ValidationCodeSizeTooSmall,
+				Message:  fmt.Sprintf("%d EMUs is unreasonably small (less than %d)", size.emu, minReasonableChartSizeEMU),
+				Severity: SeverityWarning,
+			})
+		}
+	}
+	return problems
+}
+
+// validateChartWith runs v (or DefaultChartValidator when nil) and returns
+// a validation *DocxError wrapping every problem of SeverityError, which
+// callers can retrieve with errors.As on a ValidationError.
+func validateChartWith(v ChartValidator, opts ChartOptions) error {
+	if v == nil {
+		v = DefaultChartValidator{}
+	}
+	var errs []error
+	for _, problem := range v.Validate(opts) {
+		if problem.Severity == SeverityError {
+			errs = append(errs, problem)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &DocxError{
+		Code:    ErrCodeValidation,
+		Message: fmt.Sprintf("%d chart option problem(s)", len(errs)),
+		Err:     errors.Join(errs...),
+	}
+}
+
+// isXMLText reports whether s is valid UTF-8 made only of characters
+// allowed in XML 1.0 documents.
+func isXMLText(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case r == '\t' || r == '\n' || r == '\r':
+		case r < 0x20, r >= 0xD800 && r <= 0xDFFF, r == 0xFFFE, r == 0xFFFF:
+			return false
+		}
+	}
+	return true
+}
+
+func allZero(values []float64) bool {
+	if len(values) == 0 {
+		return false
+	}
+	for _, v := range values {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,165 @@
+package godocx_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	godocx "github.com/falcomza/go-docx"
+)
+
+func problemCodes(problems []godocx.ValidationError) map[string]godocx.ValidationSeverity {
+	codes := make(map[string]godocx.ValidationSeverity, len(problems))
+	for _, p := range problems {
+		codes[p.Code] = p.Severity
+	}
+	return codes
+}
+
+func TestDefaultChartValidatorReportsAllProblems(t *testing.T) {
+	problems := godocx.DefaultChartValidator{}.Validate(godocx.ChartOptions{
+		Categories: []string{"Q1", "bad\x01"},
+		Series: []godocx.SeriesOptions{
+			{Name: "Sales", Values: []float64{0, 0}},
+			{Name: "sales", Values: []float64{1}},
+		},
+		Width:  50000,
+		Height: 3000000,
+		BarChartOptions: &godocx.BarChartOptions{
+			GapWidth: 900,
+		},
+	})
+
+	codes := problemCodes(problems)
+	want := map[string]godocx.ValidationSeverity{
+		godocx.ValidationCodeInvalidValue:     godocx.SeverityError,
+		godocx.ValidationCodeInvalidCharacter: godocx.SeverityError,
+		godocx.ValidationCodeDuplicateSeries:  godocx.SeverityError,
+		godocx.ValidationCodeAllZeroValues:    godocx.SeverityWarning,
+		godocx.ValidationCodeSizeTooSmall:     godocx.SeverityWarning,
+	}
+	for code, severity := range want {
+		got, ok := codes[code]
+		if !ok {
+			t.Errorf("missing %s in %+v", code, problems)
+			continue
+		}
+		if got != severity {
+			t.Errorf("%s severity = %d, want %d", code, got, severity)
+		}
+	}
+
+	// The case-insensitive duplicate is reported once, as an error.
+	duplicates := 0
+	for _, p := range problems {
+		if p.Code == godocx.ValidationCodeDuplicateSeries {
+			duplicates++
+		}
+	}
+	if duplicates != 1 {
+		t.Errorf("duplicate series name reported %d times, want once: %+v", duplicates, problems)
+	}
+
+	// Both the length mismatch and the gap width are reported.
+	fields := map[string]bool{}
+	for _, p := range problems {
+		if p.Code == godocx.ValidationCodeInvalidValue {
+			fields[p.Field] = true
+		}
+	}
+	if !fields["Series"] || !fields["BarChartOptions.GapWidth"] {
+		t.Errorf("expected Series and BarChartOptions.GapWidth errors, got %+v", problems)
+	}
+}
+
+func TestInsertChartReturnsAllValidationErrors(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.InsertChart(godocx.ChartOptions{
+		Categories: []string{"A", "B"},
+		Series:     []godocx.SeriesOptions{{Name: "", Values: []float64{1, 2}}},
+		Legend:     &godocx.LegendOptions{FontColor: "nope"},
+	})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	if !errors.Is(err, godocx.ErrCodeValidation) {
+		t.Errorf("error should match ErrCodeValidation: %v", err)
+	}
+	var problem godocx.ValidationError
+	if !errors.As(err, &problem) || problem.Field != "Series" {
+		t.Errorf("expected the first ValidationError to concern Series, got %+v", problem)
+	}
+	for _, want := range []string{"name cannot be empty", "invalid hex color"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should mention %q", err, want)
+		}
+	}
+
+	// A legend font size of 0 means the default, so the message says so.
+	err = u.InsertChart(godocx.ChartOptions{
+		Categories: []string{"A"},
+		Series:     []godocx.SeriesOptions{{Name: "S", Values: []float64{1}}},
+		Legend:     &godocx.LegendOptions{FontSize: 401},
+	})
+	if err == nil || !strings.Contains(err.Error(), "must be 0 (use the default size) or between 1 and 400 points") {
+		t.Errorf("expected legend font size range error, got %v", err)
+	}
+
+	// Warnings alone do not block insertion.
+	err = u.InsertChart(godocx.ChartOptions{
+		Categories: []string{"A"},
+		Series:     []godocx.SeriesOptions{{Name: "Zero", Values: []float64{0}}},
+		Position:   godocx.PositionEnd,
+	})
+	if err != nil {
+		t.Errorf("InsertChart with warnings only: %v", err)
+	}
+}
+
+type rejectPieValidator struct{ godocx.DefaultChartValidator }
+
+func (v rejectPieValidator) Validate(opts godocx.ChartOptions) []godocx.ValidationError {
+	problems := v.DefaultChartValidator.Validate(opts)
+	if opts.ChartKind == godocx.ChartKindPie {
+		problems = append(problems, godocx.ValidationError{
+			Field:    "ChartKind",
+			Code:     "HOUSE_STYLE",
+			Message:  "pie charts are not allowed in reports",
+			Severity: godocx.SeverityError,
+		})
+	}
+	return problems
+}
+
+func TestInsertChartCustomValidator(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	opts := godocx.ChartOptions{
+		ChartKind:  godocx.ChartKindPie,
+		Categories: []string{"A", "B"},
+		Series:     []godocx.SeriesOptions{{Name: "Share", Values: []float64{1, 2}}},
+		Position:   godocx.PositionEnd,
+		Validator:  rejectPieValidator{},
+	}
+	err = u.InsertChart(opts)
+	if err == nil || !strings.Contains(err.Error(), "pie charts are not allowed") {
+		t.Fatalf("expected custom validator error, got %v", err)
+	}
+	if n, _ := u.GetChartCount(); n != 0 {
+		t.Errorf("rejected chart should not be inserted, found %d charts", n)
+	}
+
+	opts.ChartKind = godocx.ChartKindColumn
+	if err := u.InsertChart(opts); err != nil {
+		t.Errorf("InsertChart: %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package godocx
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var relationshipTargetAttrPattern = regexp.MustCompile(`\bTarget="[^"]*"`)
+
+// ExtractChartWorkbook returns the raw bytes of the embedded Excel workbook
+// that holds the data of chart N (1-based), for processing with a
+// spreadsheet library.
+func (u *Updater) ExtractChartWorkbook(chartIndex int) ([]byte, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+	if chartIndex < 1 {
+		return nil, NewValidationError("chartIndex", "chart index must be >= 1")
+	}
+
+	xlsxPath, err := u.findWorkbookPathForChart(chartIndex)
+	if err != nil {
+		return nil, fmt.Errorf("resolve embedded workbook: %w", err)
+	}
+	data, err := os.ReadFile(xlsxPath)
+	if err != nil {
+		return nil, NewFileReadError("embedded workbook", err)
+	}
+	return data, nil
+}
+
+// ReplaceChartWorkbook replaces the embedded Excel workbook behind chart N
+// (1-based) with xlsxData, completing a round trip through
+// ExtractChartWorkbook and a spreadsheet library. xlsxData must be a zip
+// archive containing xl/workbook.xml. The values cached in the chart itself
+// are not changed, so Word shows the new data once the chart is edited or
+// refreshed; use UpdateChart to change both.
+func (u *Updater) ReplaceChartWorkbook(chartIndex int, xlsxData []byte) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if chartIndex < 1 {
+		return NewValidationError("chartIndex", "chart index must be >= 1")
+	}
+	zr, err := zip.NewReader(bytes.NewReader(xlsxData), int64(len(xlsxData)))
+	if err != nil {
+		return NewValidationError("xlsxData", fmt.Sprintf("workbook is not a valid zip archive: %v", err))
+	}
+	hasWorkbook := false
+	for _, f := range zr.File {
+		if f.Name == "xl/workbook.xml" {
+			hasWorkbook = true
+			break
+		}
+	}
+	if !hasWorkbook {
+		return NewValidationError("xlsxData", "workbook archive has no xl/workbook.xml part")
+	}
+
+	xlsxPath, err := u.findWorkbookPathForChart(chartIndex)
+	if err != nil {
+		return fmt.Errorf("resolve embedded workbook: %w", err)
+	}
+	xlsxPath, err = u.isolateChartWorkbook(chartIndex, xlsxPath)
+	if err != nil {
+		return fmt.Errorf("isolate embedded workbook: %w", err)
+	}
+	if err := atomicWriteFile(xlsxPath, xlsxData, 0o644); err != nil {
+		return NewFileWriteError("embedded workbook", err)
+	}
+	return nil
+}
+
+// uniqueWorkbookPath returns the path of a new embedded workbook, starting
+// from Microsoft_Excel_Worksheet<start>.xlsx and skipping names already in
+// use, so that a new chart never overwrites another chart's data.
+func (u *Updater) uniqueWorkbookPath(start int) string {
+	embeddingsDir := filepath.Join(u.tempDir, "word", "embeddings")
+	for n := max(start, 1); ; n++ {
+		p := filepath.Join(embeddingsDir, fmt.Sprintf("Microsoft_Excel_Worksheet%d.xlsx", n))
+		if _, err := os.Stat(p); os.IsNotExist(err) {
+			return p
+		}
+	}
+}
+
+// isolateChartWorkbook gives chart N its own copy of xlsxPath when the
+// relationships of another chart also target it, so that updating one chart
+// cannot overwrite the data of another. It returns the workbook to update.
+func (u *Updater) isolateChartWorkbook(chartIndex int, xlsxPath string) (string, error) {
+	chartsDir := filepath.Join(u.tempDir, "word", "charts")
+	relsDir := filepath.Join(chartsDir, "_rels")
+	ownRels := fmt.Sprintf("chart%d.xml.rels", chartIndex)
+
+	entries, err := os.ReadDir(relsDir)
+	if err != nil {
+		return "", NewFileReadError("chart relationships", err)
+	}
+	shared := false
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == ownRels || !strings.HasSuffix(entry.Name(), ".rels") {
+			continue
+		}
+		raw, err := os.ReadFile(filepath.Join(relsDir, entry.Name()))
+		if err != nil {
+			return "", NewFileReadError(entry.Name(), err)
+		}
+		rels, err := parseRelationships(entry.Name(), raw)
+		if err != nil {
+			return "", err
+		}
+		for _, rel := range rels {
+			if rel.TargetMode != "External" && filepath.Join(chartsDir, filepath.FromSlash(rel.Target)) == xlsxPath {
+				shared = true
+			}
+		}
+	}
+	if !shared {
+		return xlsxPath, nil
+	}
+
+	rawChart, err := os.ReadFile(filepath.Join(chartsDir, fmt.Sprintf("chart%d.xml", chartIndex)))
+	if err != nil {
+		return "", NewFileReadError(fmt.Sprintf("chart%d.xml", chartIndex), err)
+	}
+	relID := externalDataRelID(rawChart)
+
+	data, err := os.ReadFile(xlsxPath)
+	if err != nil {
+		return "", NewFileReadError("embedded workbook", err)
+	}
+	copyPath := u.uniqueWorkbookPath(chartIndex)
+	if err := atomicWriteFile(copyPath, data, 0o644); err != nil {
+		return "", NewFileWriteError("embedded workbook", err)
+	}
+
+	relsPath := filepath.Join(relsDir, ownRels)
+	rawRels, err := os.ReadFile(relsPath)
+	if err != nil {
+		return "", NewFileReadError(ownRels, err)
+	}
+	target := relativePartTarget("word/charts", "word/embeddings/"+filepath.Base(copyPath))
+	updated := relationshipElemPattern.ReplaceAllFunc(rawRels, func(elem []byte) []byte {
+		if relationshipAttrs(elem)["Id"] != relID {
+			return elem
+		}
+		return relationshipTargetAttrPattern.ReplaceAll(elem, []byte(`Target="`+target+`"`))
+	})
+	if err := atomicWriteFile(relsPath, updated, 0o644); err != nil {
+		return "", NewFileWriteError(ownRels, err)
+	}
+	return copyPath, nil
+}
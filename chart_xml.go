@@ -23,11 +23,11 @@ func parseScatterXValues(categories []string) ([]float64, error) {
 	for i, cat := range categories {
 		clean := strings.TrimSpace(cat)
 		if clean == "" {
-			return nil, fmt.Errorf("scatter chart categories must be numeric")
+			return nil, NewValidationError("Categories", "scatter chart categories must be numeric")
 		}
 		val, err := strconv.ParseFloat(clean, 64)
 		if err != nil {
-			return nil, fmt.Errorf("scatter chart categories must be numeric: %w", err)
+			return nil, NewValidationError("Categories", fmt.Sprintf("scatter chart categories must be numeric: %v", err))
 		}
 		values[i] = val
 	}
@@ -140,7 +140,7 @@ type AxisTitle struct {
 func updateChartXML(chartPath string, data ChartData) error {
 	rawXML, err := os.ReadFile(chartPath)
 	if err != nil {
-		return fmt.Errorf("read chart xml: %w", err)
+		return NewFileReadError("chart xml", err)
 	}
 
 	// Use direct XML manipulation for better namespace support
@@ -154,7 +154,7 @@ func updateChartXML(chartPath string, data ChartData) error {
 	updated = ensureXMLDeclarationNewline(updated)
 
 	if err := atomicWriteFile(chartPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write chart xml: %w", err)
+		return NewXMLWriteError("chart xml", err)
 	}
 
 	return nil
@@ -321,7 +321,7 @@ func updateChartSeries(content string, data ChartData, nsPrefix string) (string,
 			chartType = ct
 			chartEnd = strings.Index(content[chartStart:], "</"+nsPrefix+ct+">")
 			if chartEnd == -1 {
-				return "", fmt.Errorf("malformed chart XML: no closing tag for %s", ct)
+				return "", NewMalformedXMLError(fmt.Sprintf("malformed chart XML: no closing tag for %s", ct))
 			}
 			chartEnd += chartStart
 			break
@@ -329,7 +329,7 @@ func updateChartSeries(content string, data ChartData, nsPrefix string) (string,
 	}
 
 	if chartType == "" {
-		return "", fmt.Errorf("unsupported or missing chart type")
+		return "", NewValidationError("ChartKind", "unsupported or missing chart type")
 	}
 
 	chartSection := content[chartStart:chartEnd]
@@ -350,7 +350,7 @@ func updateSeriesSection(chartSection string, data ChartData, nsPrefix, chartTyp
 	serTags := findAllSeriesTags(chartSection, nsPrefix)
 
 	if len(serTags) == 0 {
-		return "", fmt.Errorf("no series found in chart")
+		return "", NewMalformedXMLError("no series found in chart")
 	}
 	var scatterXValues []float64
 	if chartType == "scatterChart" {
@@ -408,7 +408,7 @@ func buildSeriesXML(series SeriesData, categories []string, scatterXValues []flo
 
 	// Series name
 	buf.WriteString("<" + nsPrefix + "tx>")
-	buf.WriteString("<" + nsPrefix + "v>" + xmlEscape(series.Name) + "</" + nsPrefix + "v>")
+	buf.WriteString("<" + nsPrefix + "v>" + xmlEscapeContent(series.Name) + "</" + nsPrefix + "v>")
 	buf.WriteString("</" + nsPrefix + "tx>")
 
 	// Categories (for most chart types except scatter)
@@ -419,7 +419,7 @@ func buildSeriesXML(series SeriesData, categories []string, scatterXValues []flo
 		buf.WriteString("<" + nsPrefix + "ptCount val=\"" + strconv.Itoa(len(categories)) + "\"/>")
 		for i, cat := range categories {
 			buf.WriteString("<" + nsPrefix + "pt idx=\"" + strconv.Itoa(i) + "\">")
-			buf.WriteString("<" + nsPrefix + "v>" + xmlEscape(cat) + "</" + nsPrefix + "v>")
+			buf.WriteString("<" + nsPrefix + "v>" + xmlEscapeContent(cat) + "</" + nsPrefix + "v>")
 			buf.WriteString("</" + nsPrefix + "pt>")
 		}
 		buf.WriteString("</" + nsPrefix + "strCache>")
@@ -427,7 +427,7 @@ func buildSeriesXML(series SeriesData, categories []string, scatterXValues []flo
 		buf.WriteString("</" + nsPrefix + "cat>")
 	} else {
 		if len(scatterXValues) == 0 {
-			return "", fmt.Errorf("scatter chart categories must be numeric")
+			return "", NewValidationError("Categories", "scatter chart categories must be numeric")
 		}
 		buf.WriteString("<" + nsPrefix + "xVal>")
 		buf.WriteString("<" + nsPrefix + "numRef>")
@@ -452,6 +452,9 @@ func buildSeriesXML(series SeriesData, categories []string, scatterXValues []flo
 	buf.WriteString("<" + nsPrefix + valTag + ">")
 	buf.WriteString("<" + nsPrefix + "numRef>")
 	buf.WriteString("<" + nsPrefix + "numCache>")
+	if series.FormatCode != "" {
+		buf.WriteString("<" + nsPrefix + "formatCode>" + xmlEscapeContent(series.FormatCode) + "</" + nsPrefix + "formatCode>")
+	}
 	buf.WriteString("<" + nsPrefix + "ptCount val=\"" + strconv.Itoa(len(series.Values)) + "\"/>")
 	for i, val := range series.Values {
 		buf.WriteString("<" + nsPrefix + "pt idx=\"" + strconv.Itoa(i) + "\">")
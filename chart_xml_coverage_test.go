@@ -1,6 +1,7 @@
 package godocx
 
 import (
+	"slices"
 	"strings"
 	"testing"
 )
@@ -244,3 +245,74 @@ func TestEnsureXMLDeclarationNewline(t *testing.T) {
 		})
 	}
 }
+
+func TestRelativePartTarget(t *testing.T) {
+	tests := []struct {
+		from, to, want string
+	}{
+		{"word/charts", "word/embeddings/Microsoft_Excel_Worksheet1.xlsx", "../embeddings/Microsoft_Excel_Worksheet1.xlsx"},
+		{"word/charts", "word/charts/colors1.xml", "colors1.xml"},
+		{"word", "word/charts/chart1.xml", "charts/chart1.xml"},
+		{"word/charts", "customXml/item1.xml", "../../customXml/item1.xml"},
+	}
+	for _, tt := range tests {
+		if got := relativePartTarget(tt.from, tt.to); got != tt.want {
+			t.Errorf("relativePartTarget(%q, %q) = %q, want %q", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestSeriesProgressReportedByChartGenerators(t *testing.T) {
+	var reports []int
+	opts := ChartOptions{
+		Categories:      []string{"1", "2"},
+		BarChartOptions: &BarChartOptions{Direction: BarDirectionColumn, Grouping: BarGroupingClustered},
+		Series: []SeriesOptions{
+			{Name: "A", Values: []float64{1, 2}},
+			{Name: "B", Values: []float64{3, 4}},
+			{Name: "C", Values: []float64{5, 6}},
+		},
+		Progress: func(step string, done, total int) {
+			if step == ChartProgressSeries {
+				reports = append(reports, done)
+			}
+		},
+	}
+
+	// The series XML helpers are plain generators and report nothing.
+	generateSeriesXML(0, opts.Series[0], opts)
+	generateScatterSeriesXML(0, opts.Series[0], opts)
+	if len(reports) != 0 {
+		t.Fatalf("series helpers reported progress %v, want none", reports)
+	}
+
+	generateBarChartXML(opts, newChartAxisIDs(1))
+	generateScatterChartXML(opts, newChartAxisIDs(1))
+	if want := []int{1, 2, 3, 1, 2, 3}; !slices.Equal(reports, want) {
+		t.Errorf("series progress = %v, want %v", reports, want)
+	}
+}
+
+func TestSuffixDuplicateSeriesNames(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  []string
+	}{
+		{[]string{"Revenue", "Costs", "revenue"}, []string{"Revenue (1)", "Costs", "revenue (2)"}},
+		{[]string{"Revenue", "Revenue", "Revenue (1)"}, []string{"Revenue (2)", "Revenue (3)", "Revenue (1)"}},
+		{[]string{"A", "A", "A (2)", "a (1)"}, []string{"A (3)", "A (4)", "A (2)", "a (1)"}},
+	}
+	for _, tt := range tests {
+		series := make([]SeriesOptions, len(tt.names))
+		for i, name := range tt.names {
+			series[i].Name = name
+		}
+		var got []string
+		for _, s := range suffixDuplicateSeriesNames(series) {
+			got = append(got, s.Name)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("suffixDuplicateSeriesNames(%q) = %q, want %q", tt.names, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,560 @@
+package godocx
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	bibliographyNamespace       = "http://schemas.openxmlformats.org/officeDocument/2006/bibliography"
+	customXMLRelationshipType   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXml"
+	customXMLPropsRelType       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXmlProps"
+	customXMLPropsContentType   = "application/vnd.openxmlformats-officedocument.customXmlProperties+xml"
+	customXMLDataContentType    = "application/xml"
+	customXMLDataStoreNamespace = "http://schemas.openxmlformats.org/officeDocument/2006/customXml"
+	defaultCitationLanguageID   = 1033
+)
+
+// CitationKind is the type of a bibliography source (the b:SourceType value).
+type CitationKind string
+
+const (
+	CitationBook        CitationKind = "Book"
+	CitationBookSection CitationKind = "BookSection"
+	CitationArticle     CitationKind = "JournalArticle"
+	CitationConference  CitationKind = "ConferenceProceedings"
+	CitationReport      CitationKind = "Report"
+	CitationWebsite     CitationKind = "InternetSite"
+	CitationMisc        CitationKind = "Misc"
+)
+
+// Citation is a bibliography source added with AddCitation.
+type Citation struct {
+	// Tag identifies the source in CITATION fields. When empty, a tag is
+	// generated from the first author and the year, as Word does (e.g. "Smi20").
+	Tag string
+
+	// Kind of source (default: CitationBook)
+	Kind CitationKind
+
+	// Author lists the authors separated by ";". Each author is written as
+	// "Last, First" or "First Last".
+	Author string
+
+	Title     string
+	Year      int
+	Publisher string
+	URL       string
+}
+
+// CitationFieldOptions defines options for InsertCitationField.
+type CitationFieldOptions struct {
+	// Pages cited, shown after the author and year (e.g. "12-15")
+	Pages string
+
+	// LanguageID used to format the citation (default: 1033, English (US))
+	LanguageID int
+
+	// Position where to insert the citation. With PositionAfterText or
+	// PositionBeforeText it is inserted into the paragraph containing the
+	// anchor text, next to the run holding it; otherwise in a new paragraph.
+	Position InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+}
+
+// BibStyle is the citation and bibliography style Word applies.
+type BibStyle string
+
+const (
+	BibStyleAPA     BibStyle = "APA"
+	BibStyleMLA     BibStyle = "MLA"
+	BibStyleChicago BibStyle = "Chicago"
+	BibStyleIEEE    BibStyle = "IEEE"
+	BibStyleHarvard BibStyle = "Harvard"
+)
+
+// bibStyleSheets maps each style to the style sheet, name and version
+// Word records on the b:Sources element.
+var bibStyleSheets = map[BibStyle][3]string{
+	BibStyleAPA:     {`\APASixthEditionOfficeOnline.xsl`, "APA", "6"},
+	BibStyleMLA:     {`\MLASeventhEditionOfficeOnline.xsl`, "MLA", "7"},
+	BibStyleChicago: {`\ChicagoSixteenthEditionOfficeOnline.xsl`, "Chicago", "16"},
+	BibStyleIEEE:    {`\IEEE2006OfficeOnline.xsl`, "IEEE", "2006"},
+	BibStyleHarvard: {`\HarvardAnglia2008OfficeOnline.xsl`, "Harvard - Anglia", "2008"},
+}
+
+var (
+	citationSourcePattern  = regexp.MustCompile(`(?s)<b:Source>.*?</b:Source>`)
+	citationTagPattern     = regexp.MustCompile(`<b:Tag>([^<]*)</b:Tag>`)
+	citationLastPattern    = regexp.MustCompile(`<b:(?:Last|Corporate)>([^<]*)</b:(?:Last|Corporate)>`)
+	citationYearPattern    = regexp.MustCompile(`<b:Year>([^<]*)</b:Year>`)
+	sourcesStylePattern    = regexp.MustCompile(`\s(?:SelectedStyle|StyleName|Version)="[^"]*"`)
+	customXMLItemPartRegex = regexp.MustCompile(`^item(\d+)\.xml$`)
+)
+
+// AddCitation adds a source to the document's bibliography sources and
+// returns its tag. Word keeps the sources in a custom XML part, which is
+// created together with its relationship and content types on first use.
+func (u *Updater) AddCitation(ref Citation) (string, error) {
+	if u == nil {
+		return "", NewValidationError("updater", "updater is nil")
+	}
+	if strings.TrimSpace(ref.Title) == "" {
+		return "", NewValidationError("Title", "citation title cannot be empty")
+	}
+	if ref.Year < 0 {
+		return "", NewValidationError("Year", "citation year cannot be negative")
+	}
+	if ref.Tag != "" {
+		if err := validateCitationTag(ref.Tag); err != nil {
+			return "", err
+		}
+	}
+	if ref.Kind == "" {
+		ref.Kind = CitationBook
+	}
+
+	partPath, sources, err := u.ensureBibliographySources()
+	if err != nil {
+		return "", err
+	}
+
+	tags := make(map[string]bool)
+	for _, m := range citationTagPattern.FindAllSubmatch(sources, -1) {
+		tags[xmlUnescape(string(m[1]))] = true
+	}
+	if ref.Tag == "" {
+		ref.Tag = uniqueCitationTag(generateCitationTag(ref), tags)
+	} else if tags[ref.Tag] {
+		return "", NewValidationError("Tag", fmt.Sprintf("a citation with tag %q already exists", ref.Tag))
+	}
+
+	closeIdx := bytes.LastIndex(sources, []byte("</b:Sources>"))
+	if closeIdx == -1 {
+		return "", NewMalformedXMLError("could not find </b:Sources> closing tag")
+	}
+	updated := spliceBytes(sources, closeIdx, closeIdx, generateCitationSourceXML(ref))
+	if err := atomicWriteFile(partPath, updated, 0o644); err != nil {
+		return "", NewXMLWriteError(filepath.Base(partPath), err)
+	}
+	return ref.Tag, nil
+}
+
+// validateCitationTag rejects tags that cannot be used as a field argument.
+func validateCitationTag(tag string) error {
+	if strings.ContainsFunc(tag, func(r rune) bool { return unicode.IsSpace(r) || r == '"' || r == '\\' }) {
+		return NewValidationError("Tag", fmt.Sprintf("citation tag %q cannot contain spaces, quotes or backslashes", tag))
+	}
+	return nil
+}
+
+// generateCitationTag builds a Word-style tag from the first three letters
+// of the first author's last name and the last two digits of the year.
+func generateCitationTag(ref Citation) string {
+	var tag []rune
+	if authors := parseCitationAuthors(ref.Author); len(authors) > 0 {
+		for _, r := range authors[0][0] {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				tag = append(tag, r)
+			}
+			if len(tag) == 3 {
+				break
+			}
+		}
+	}
+	if len(tag) == 0 {
+		tag = []rune("Src")
+	}
+	if ref.Year > 0 {
+		return fmt.Sprintf("%s%02d", string(tag), ref.Year%100)
+	}
+	return string(tag)
+}
+
+// uniqueCitationTag appends a number to tag until it is not in use.
+func uniqueCitationTag(tag string, used map[string]bool) string {
+	if !used[tag] {
+		return tag
+	}
+	for n := 1; ; n++ {
+		if candidate := tag + strconv.Itoa(n); !used[candidate] {
+			return candidate
+		}
+	}
+}
+
+// parseCitationAuthors splits a ";"-separated author list into
+// {last, first} name pairs.
+func parseCitationAuthors(author string) [][2]string {
+	var names [][2]string
+	for name := range strings.SplitSeq(author, ";") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if last, first, ok := strings.Cut(name, ","); ok {
+			names = append(names, [2]string{strings.TrimSpace(last), strings.TrimSpace(first)})
+		} else if i := strings.LastIndexByte(name, ' '); i != -1 {
+			names = append(names, [2]string{strings.TrimSpace(name[i+1:]), strings.TrimSpace(name[:i])})
+		} else {
+			names = append(names, [2]string{name, ""})
+		}
+	}
+	return names
+}
+
+// generateCitationSourceXML creates a <b:Source> element for ref.
+func generateCitationSourceXML(ref Citation) string {
+	var buf strings.Builder
+	buf.WriteString("<b:Source>")
+	fmt.Fprintf(&buf, "<b:Tag>%s</b:Tag>", xmlEscapeContent(ref.Tag))
+	fmt.Fprintf(&buf, "<b:SourceType>%s</b:SourceType>", xmlEscapeContent(string(ref.Kind)))
+	fmt.Fprintf(&buf, "<b:Guid>%s</b:Guid>", newGUID())
+	if authors := parseCitationAuthors(ref.Author); len(authors) > 0 {
+		buf.WriteString("<b:Author><b:Author><b:NameList>")
+		for _, name := range authors {
+			fmt.Fprintf(&buf, "<b:Person><b:Last>%s</b:Last>", xmlEscapeContent(name[0]))
+			if name[1] != "" {
+				fmt.Fprintf(&buf, "<b:First>%s</b:First>", xmlEscapeContent(name[1]))
+			}
+			buf.WriteString("</b:Person>")
+		}
+		buf.WriteString("</b:NameList></b:Author></b:Author>")
+	}
+	fmt.Fprintf(&buf, "<b:Title>%s</b:Title>", xmlEscapeContent(ref.Title))
+	if ref.Year > 0 {
+		fmt.Fprintf(&buf, "<b:Year>%d</b:Year>", ref.Year)
+	}
+	if ref.Publisher != "" {
+		fmt.Fprintf(&buf, "<b:Publisher>%s</b:Publisher>", xmlEscapeContent(ref.Publisher))
+	}
+	if ref.URL != "" {
+		fmt.Fprintf(&buf, "<b:URL>%s</b:URL>", xmlEscapeContent(ref.URL))
+	}
+	buf.WriteString("</b:Source>")
+	return buf.String()
+}
+
+// newGUID returns a random GUID in Word's "{XXXXXXXX-XXXX-...}" form.
+func newGUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("{%X-%X-%X-%X-%X}", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// InsertCitationField inserts a CITATION field for a source added with
+// AddCitation. Word formats the citation in the bibliography style when
+// fields are updated; until then it shows "(Author, Year)".
+func (u *Updater) InsertCitationField(tag string, opts CitationFieldOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if tag == "" {
+		return NewValidationError("tag", "citation tag cannot be empty")
+	}
+	if err := validateCitationTag(tag); err != nil {
+		return err
+	}
+	if opts.LanguageID == 0 {
+		opts.LanguageID = defaultCitationLanguageID
+	}
+	if opts.LanguageID < 0 {
+		return NewValidationError("LanguageID", "language ID cannot be negative")
+	}
+
+	partPath, err := u.findBibliographySourcesPart()
+	if err != nil {
+		return err
+	}
+	var source []byte
+	if partPath != "" {
+		sources, err := os.ReadFile(partPath)
+		if err != nil {
+			return NewFileReadError(filepath.Base(partPath), err)
+		}
+		source = findCitationSource(sources, tag)
+	}
+	if source == nil {
+		return NewValidationError("tag", fmt.Sprintf("no citation source with tag %q; add it with AddCitation", tag))
+	}
+
+	fieldXML := generateCitationFieldXML(tag, citationPlaceholder(source, opts.Pages), opts)
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	var updated []byte
+	if opts.Position == PositionAfterText || opts.Position == PositionBeforeText {
+		if opts.Anchor == "" {
+			return NewValidationError("anchor", "anchor text required for position-based insertion")
+		}
+		paraStart, paraEnd, err := findParagraphRangeByAnchor(raw, opts.Anchor)
+		if err != nil {
+			return err
+		}
+		para, err := insertRunInParagraph(raw[paraStart:paraEnd], fieldXML, opts.Anchor, opts.Position)
+		if err != nil {
+			return err
+		}
+		updated = spliceBytes(raw, paraStart, paraEnd, string(para))
+	} else {
+		updated, err = insertElementAtPosition(raw, []byte("<w:p>"+string(fieldXML)+"</w:p>"), opts.Position, opts.Anchor)
+		if err != nil {
+			return fmt.Errorf("insert citation: %w", err)
+		}
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// findCitationSource returns the <b:Source> element with the given tag.
+func findCitationSource(sources []byte, tag string) []byte {
+	for _, source := range citationSourcePattern.FindAll(sources, -1) {
+		if m := citationTagPattern.FindSubmatch(source); m != nil && xmlUnescape(string(m[1])) == tag {
+			return source
+		}
+	}
+	return nil
+}
+
+// citationPlaceholder builds the "(Author, Year, p. Pages)" result text shown
+// until Word updates the field.
+func citationPlaceholder(source []byte, pages string) string {
+	var parts []string
+	if m := citationLastPattern.FindSubmatch(source); m != nil {
+		parts = append(parts, xmlUnescape(string(m[1])))
+	}
+	if m := citationYearPattern.FindSubmatch(source); m != nil {
+		parts = append(parts, xmlUnescape(string(m[1])))
+	}
+	if pages != "" {
+		parts = append(parts, "p. "+pages)
+	}
+	if len(parts) == 0 {
+		return "(Citation)"
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// generateCitationFieldXML creates the runs of a CITATION field.
+//
+//	\l 1033 - language ID
+//	\p "12" - cited pages
+func generateCitationFieldXML(tag, placeholder string, opts CitationFieldOptions) []byte {
+	instr := fmt.Sprintf(` CITATION %s \l %d `, tag, opts.LanguageID)
+	if opts.Pages != "" {
+		instr += fmt.Sprintf(`\p "%s" `, strings.ReplaceAll(opts.Pages, `"`, `\"`))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`<w:r><w:fldChar w:fldCharType="begin"/></w:r>`)
+	fmt.Fprintf(&buf, `<w:r><w:instrText xml:space="preserve">%s</w:instrText></w:r>`, xmlEscapeContent(instr))
+	buf.WriteString(`<w:r><w:fldChar w:fldCharType="separate"/></w:r>`)
+	fmt.Fprintf(&buf, `<w:r><w:t>%s</w:t></w:r>`, xmlEscapeContent(placeholder))
+	buf.WriteString(`<w:r><w:fldChar w:fldCharType="end"/></w:r>`)
+	return buf.Bytes()
+}
+
+// InsertBibliography inserts a BIBLIOGRAPHY field listing the sources cited
+// in the document, and selects style as the document's citation style. Like
+// the Table of Contents, the list is built when the user updates fields.
+func (u *Updater) InsertBibliography(style BibStyle, position InsertPosition) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if style == "" {
+		style = BibStyleAPA
+	}
+	sheet, ok := bibStyleSheets[style]
+	if !ok {
+		return NewValidationError("style", fmt.Sprintf("unsupported bibliography style %q", style))
+	}
+	if position != PositionBeginning && position != PositionEnd {
+		return NewValidationError("position", "bibliography can only be inserted at the beginning or end of the document")
+	}
+
+	partPath, sources, err := u.ensureBibliographySources()
+	if err != nil {
+		return err
+	}
+	updated, err := setSourcesStyle(sources, sheet)
+	if err != nil {
+		return err
+	}
+	if err := atomicWriteFile(partPath, updated, 0o644); err != nil {
+		return NewXMLWriteError(filepath.Base(partPath), err)
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	instr := fmt.Sprintf(` BIBLIOGRAPHY \l %d `, defaultCitationLanguageID)
+	var para bytes.Buffer
+	para.WriteString("<w:p>")
+	para.WriteString(`<w:r><w:fldChar w:fldCharType="begin"/></w:r>`)
+	fmt.Fprintf(&para, `<w:r><w:instrText xml:space="preserve">%s</w:instrText></w:r>`, xmlEscapeContent(instr))
+	para.WriteString(`<w:r><w:fldChar w:fldCharType="separate"/></w:r>`)
+	para.WriteString(`<w:r><w:rPr><w:i/></w:rPr><w:t>Update this field to show the bibliography</w:t></w:r>`)
+	para.WriteString(`<w:r><w:fldChar w:fldCharType="end"/></w:r>`)
+	para.WriteString("</w:p>")
+
+	result, err := insertElementAtPosition(raw, para.Bytes(), position, "")
+	if err != nil {
+		return fmt.Errorf("insert bibliography: %w", err)
+	}
+	if err := atomicWriteFile(docPath, result, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// setSourcesStyle replaces the style attributes of the <b:Sources> root.
+func setSourcesStyle(sources []byte, sheet [3]string) ([]byte, error) {
+	start := bytes.Index(sources, []byte("<b:Sources"))
+	if start == -1 {
+		return nil, NewMalformedXMLError("could not find <b:Sources> root element")
+	}
+	end := bytes.IndexByte(sources[start:], '>')
+	if end == -1 {
+		return nil, NewMalformedXMLError("malformed <b:Sources> element")
+	}
+	end += start
+
+	if sources[end-1] == '/' {
+		return nil, NewMalformedXMLError("<b:Sources> element has no closing tag")
+	}
+
+	tag := sourcesStylePattern.ReplaceAll(sources[start:end], nil)
+	tag = append(tag, fmt.Sprintf(` SelectedStyle="%s" StyleName="%s" Version="%s"`,
+		xmlEscape(sheet[0]), xmlEscape(sheet[1]), sheet[2])...)
+	return spliceBytes(sources, start, end, string(tag)), nil
+}
+
+// findBibliographySourcesPart returns the path of the custom XML part holding
+// the bibliography sources, or "" when the document has none.
+func (u *Updater) findBibliographySourcesPart() (string, error) {
+	dir := filepath.Join(u.tempDir, "customXml")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", NewFileReadError("customXml", err)
+	}
+	for _, entry := range entries {
+		if !customXMLItemPartRegex.MatchString(entry.Name()) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return "", NewFileReadError(entry.Name(), err)
+		}
+		if bytes.Contains(raw, []byte("<b:Sources")) && bytes.Contains(raw, []byte(bibliographyNamespace)) {
+			return path, nil
+		}
+	}
+	return "", nil
+}
+
+// ensureBibliographySources returns the path and content of the bibliography
+// sources part, creating customXml/itemN.xml with its properties part,
+// relationships and content types when the document has none.
+func (u *Updater) ensureBibliographySources() (string, []byte, error) {
+	partPath, err := u.findBibliographySourcesPart()
+	if err != nil {
+		return "", nil, err
+	}
+	if partPath != "" {
+		raw, err := os.ReadFile(partPath)
+		if err != nil {
+			return "", nil, NewFileReadError(filepath.Base(partPath), err)
+		}
+		return partPath, raw, nil
+	}
+
+	sheet := bibStyleSheets[BibStyleAPA]
+	sources := []byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+		fmt.Sprintf(`<b:Sources SelectedStyle="%s" StyleName="%s" Version="%s" xmlns:b="%s" xmlns="%s">`,
+			sheet[0], sheet[1], sheet[2], bibliographyNamespace, bibliographyNamespace) +
+		`</b:Sources>`)
+	partPath, err = u.createCustomXMLPart(newGUID(), bibliographyNamespace, sources)
+	if err != nil {
+		return "", nil, err
+	}
+	return partPath, sources, nil
+}
+
+// addCustomXMLRelationship relates the custom XML part item to document.xml.
+func (u *Updater) addCustomXMLRelationship(item string) error {
+	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
+	raw, err := os.ReadFile(relsPath)
+	if err != nil {
+		return NewFileReadError("document.xml.rels", err)
+	}
+
+	target := "../customXml/" + item
+	content := string(raw)
+	if strings.Contains(content, `Target="`+target+`"`) {
+		return nil
+	}
+
+	relID, err := getNextRelIDFromFile(relsPath)
+	if err != nil {
+		return NewRelationshipError("find next relationship id", err)
+	}
+	rel := fmt.Sprintf(`<Relationship Id="%s" Type="%s" Target="%s"/>`, relID, customXMLRelationshipType, target)
+	content = strings.Replace(content, "</Relationships>", rel+"</Relationships>", 1)
+
+	if err := atomicWriteFile(relsPath, []byte(content), 0o644); err != nil {
+		return NewXMLWriteError("document.xml.rels", err)
+	}
+	return nil
+}
+
+// addCustomXMLContentTypes adds overrides for a custom XML part and its
+// properties part to [Content_Types].xml.
+func (u *Updater) addCustomXMLContentTypes(item, props string) error {
+	ctPath := filepath.Join(u.tempDir, "[Content_Types].xml")
+	raw, err := os.ReadFile(ctPath)
+	if err != nil {
+		return NewFileReadError("[Content_Types].xml", err)
+	}
+
+	content := string(raw)
+	for _, o := range []struct{ part, contentType string }{
+		{"/customXml/" + item, customXMLDataContentType},
+		{"/customXml/" + props, customXMLPropsContentType},
+	} {
+		if strings.Contains(content, `PartName="`+o.part+`"`) {
+			continue
+		}
+		override := fmt.Sprintf(`<Override PartName="%s" ContentType="%s"/>`, o.part, o.contentType)
+		content = strings.Replace(content, "</Types>", override+"</Types>", 1)
+	}
+
+	if err := atomicWriteFile(ctPath, []byte(content), 0o644); err != nil {
+		return NewFileWriteError("[Content_Types].xml", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddCitationCreatesSourcesPart(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	tag, err := u.AddCitation(Citation{
+		Kind:      CitationBook,
+		Author:    "Smith, Jane; Bob Jones",
+		Title:     "Go & XML",
+		Year:      2020,
+		Publisher: "Acme Press",
+	})
+	if err != nil {
+		t.Fatalf("AddCitation: %v", err)
+	}
+	if tag != "Smi20" {
+		t.Errorf("generated tag = %q, want Smi20", tag)
+	}
+
+	// A second source by the same author and year gets a distinct tag.
+	tag2, err := u.AddCitation(Citation{Author: "Smith, Jane", Title: "More Go", Year: 2020, Kind: CitationWebsite, URL: "https://example.com"})
+	if err != nil {
+		t.Fatalf("AddCitation: %v", err)
+	}
+	if tag2 != "Smi201" {
+		t.Errorf("second tag = %q, want Smi201", tag2)
+	}
+
+	sources := readTempFile(t, u, "customXml/item1.xml")
+	for _, want := range []string{
+		`<b:Sources SelectedStyle="\APASixthEditionOfficeOnline.xsl" StyleName="APA" Version="6"`,
+		`<b:Tag>Smi20</b:Tag><b:SourceType>Book</b:SourceType>`,
+		`<b:NameList><b:Person><b:Last>Smith</b:Last><b:First>Jane</b:First></b:Person><b:Person><b:Last>Jones</b:Last><b:First>Bob</b:First></b:Person></b:NameList>`,
+		`<b:Title>Go &amp; XML</b:Title><b:Year>2020</b:Year><b:Publisher>Acme Press</b:Publisher>`,
+		`<b:SourceType>InternetSite</b:SourceType>`,
+		`<b:URL>https://example.com</b:URL>`,
+	} {
+		if !strings.Contains(sources, want) {
+			t.Errorf("sources part missing %s:\n%s", want, sources)
+		}
+	}
+
+	if props := readTempFile(t, u, "customXml/itemProps1.xml"); !strings.Contains(props, `<ds:schemaRef ds:uri="`+bibliographyNamespace+`"/>`) {
+		t.Errorf("unexpected properties part:\n%s", props)
+	}
+	if rels := readTempFile(t, u, "customXml/_rels/item1.xml.rels"); !strings.Contains(rels, `Target="itemProps1.xml"`) {
+		t.Errorf("unexpected item relationships:\n%s", rels)
+	}
+	rels := readTempFile(t, u, "word/_rels/document.xml.rels")
+	if strings.Count(rels, `Target="../customXml/item1.xml"`) != 1 || !strings.Contains(rels, customXMLRelationshipType) {
+		t.Errorf("expected one custom XML relationship:\n%s", rels)
+	}
+	types := readTempFile(t, u, "[Content_Types].xml")
+	if !strings.Contains(types, `<Override PartName="/customXml/itemProps1.xml" ContentType="`+customXMLPropsContentType+`"/>`) {
+		t.Errorf("expected content type overrides:\n%s", types)
+	}
+
+	if _, err := u.AddCitation(Citation{Tag: "Smi20", Title: "Duplicate"}); err == nil {
+		t.Error("expected error for duplicate tag")
+	}
+	if _, err := u.AddCitation(Citation{Tag: "has space", Title: "Bad"}); err == nil {
+		t.Error("expected error for tag with spaces")
+	}
+	if _, err := u.AddCitation(Citation{Author: "Smith"}); err == nil {
+		t.Error("expected error for missing title")
+	}
+}
+
+func TestInsertCitationFieldAndBibliography(t *testing.T) {
+	body := `<w:p><w:r><w:t>As shown in the study</w:t></w:r><w:r><w:t>, results vary.</w:t></w:r></w:p><w:sectPr/>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.InsertCitationField("Doe19", CitationFieldOptions{Position: PositionEnd}); err == nil {
+		t.Error("expected error for unknown citation tag")
+	}
+
+	tag, err := u.AddCitation(Citation{Tag: "Doe19", Kind: CitationArticle, Author: "Jane Doe", Title: "Results", Year: 2019})
+	if err != nil {
+		t.Fatalf("AddCitation: %v", err)
+	}
+	if err := u.InsertCitationField(tag, CitationFieldOptions{Position: PositionAfterText, Anchor: "the study", Pages: "12"}); err != nil {
+		t.Fatalf("InsertCitationField: %v", err)
+	}
+
+	doc := readDocXML(t, u)
+	want := `<w:t>As shown in the study</w:t></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="begin"/></w:r>` +
+		`<w:r><w:instrText xml:space="preserve"> CITATION Doe19 \l 1033 \p "12" </w:instrText></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="separate"/></w:r>` +
+		`<w:r><w:t>(Doe, 2019, p. 12)</w:t></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="end"/></w:r>` +
+		`<w:r><w:t>, results vary.</w:t>`
+	if !strings.Contains(doc, want) {
+		t.Errorf("expected citation field after the anchor run:\n%s", doc)
+	}
+
+	if err := u.InsertBibliography(BibStyleMLA, PositionEnd); err != nil {
+		t.Fatalf("InsertBibliography: %v", err)
+	}
+	doc = readDocXML(t, u)
+	bib := strings.Index(doc, `<w:instrText xml:space="preserve"> BIBLIOGRAPHY \l 1033 </w:instrText>`)
+	if bib == -1 || !strings.HasSuffix(doc[:strings.Index(doc, "<w:sectPr/>")], `<w:fldChar w:fldCharType="end"/></w:r></w:p>`) {
+		t.Errorf("expected BIBLIOGRAPHY field paragraph before sectPr:\n%s", doc)
+	}
+	sources := readTempFile(t, u, "customXml/item1.xml")
+	if strings.Count(sources, "StyleName=") != 1 || !strings.Contains(sources, `SelectedStyle="\MLASeventhEditionOfficeOnline.xsl" StyleName="MLA" Version="7"`) {
+		t.Errorf("expected the style attributes replaced:\n%s", sources)
+	}
+
+	if err := u.InsertBibliography("Vancouver", PositionEnd); err == nil {
+		t.Error("expected error for unsupported style")
+	}
+	if err := u.InsertBibliography(BibStyleAPA, PositionAfterText); err == nil {
+		t.Error("expected error for anchored position")
+	}
+}
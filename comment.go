@@ -49,13 +49,13 @@ var (
 // The comment range spans the paragraph containing the anchor text.
 func (u *Updater) InsertComment(opts CommentOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if opts.Text == "" {
-		return fmt.Errorf("comment text cannot be empty")
+		return NewValidationError("text", "comment text cannot be empty")
 	}
 	if opts.Anchor == "" {
-		return fmt.Errorf("anchor text cannot be empty")
+		return NewValidationError("anchor", "anchor text cannot be empty")
 	}
 	if opts.Author == "" {
 		opts.Author = "Author"
@@ -84,7 +84,7 @@ func (u *Updater) InsertComment(opts CommentOptions) error {
 // Returns nil if the document has no comments.
 func (u *Updater) GetComments() ([]Comment, error) {
 	if u == nil {
-		return nil, fmt.Errorf("updater is nil")
+		return nil, NewValidationError("updater", "updater is nil")
 	}
 
 	commentsPath := filepath.Join(u.tempDir, "word", "comments.xml")
@@ -93,7 +93,7 @@ func (u *Updater) GetComments() ([]Comment, error) {
 		if os.IsNotExist(err) {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("read comments.xml: %w", err)
+		return nil, NewFileReadError("comments.xml", err)
 	}
 
 	return parseComments(raw), nil
@@ -106,7 +106,7 @@ func (u *Updater) ensureCommentsXML() (int, error) {
 	if _, err := os.Stat(commentsPath); os.IsNotExist(err) {
 		content := generateInitialCommentsXML()
 		if err := atomicWriteFile(commentsPath, content, 0o644); err != nil {
-			return 0, fmt.Errorf("write comments.xml: %w", err)
+			return 0, NewXMLWriteError("comments.xml", err)
 		}
 
 		if err := u.addNoteRelationship("comments.xml", "comments"); err != nil {
@@ -122,7 +122,7 @@ func (u *Updater) ensureCommentsXML() (int, error) {
 
 	raw, err := os.ReadFile(commentsPath)
 	if err != nil {
-		return 0, fmt.Errorf("read comments.xml: %w", err)
+		return 0, NewFileReadError("comments.xml", err)
 	}
 
 	return getNextCommentID(raw), nil
@@ -147,7 +147,7 @@ func (u *Updater) addCommentContent(id int, opts CommentOptions) error {
 	commentsPath := filepath.Join(u.tempDir, "word", "comments.xml")
 	raw, err := os.ReadFile(commentsPath)
 	if err != nil {
-		return fmt.Errorf("read comments.xml: %w", err)
+		return NewFileReadError("comments.xml", err)
 	}
 
 	commentXML := generateCommentEntry(id, opts)
@@ -155,7 +155,7 @@ func (u *Updater) addCommentContent(id int, opts CommentOptions) error {
 	closeTag := []byte("</w:comments>")
 	closeIdx := bytes.LastIndex(raw, closeTag)
 	if closeIdx == -1 {
-		return fmt.Errorf("could not find </w:comments> tag")
+		return NewMalformedXMLError("could not find </w:comments> tag")
 	}
 
 	result := make([]byte, 0, len(raw)+len(commentXML)+1)
@@ -185,7 +185,7 @@ func generateCommentEntry(id int, opts CommentOptions) []byte {
 	buf.WriteString("</w:r>")
 
 	buf.WriteString("<w:r>")
-	buf.WriteString(fmt.Sprintf(`<w:t xml:space="preserve"> %s</w:t>`, xmlEscape(opts.Text)))
+	buf.WriteString(fmt.Sprintf(`<w:t xml:space="preserve"> %s</w:t>`, xmlEscapeContent(opts.Text)))
 	buf.WriteString("</w:r>")
 
 	buf.WriteString("</w:p>")
@@ -200,7 +200,7 @@ func (u *Updater) insertCommentMarkers(anchor string, commentID int) error {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	paraStart, paraEnd, err := findParagraphRangeByAnchor(raw, anchor)
@@ -218,7 +218,7 @@ func (u *Updater) insertCommentMarkers(anchor string, commentID int) error {
 	} else {
 		pOpenEnd := strings.Index(pContent, ">")
 		if pOpenEnd < 0 {
-			return fmt.Errorf("invalid paragraph XML")
+			return NewMalformedXMLError("invalid paragraph XML")
 		}
 		insertStartOffset = pOpenEnd + 1
 	}
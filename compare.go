@@ -0,0 +1,199 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CompareDocuments compares the paragraph text of original and revised and
+// returns a new document showing the differences as tracked changes by
+// author, like Word's Compare Documents. Paragraphs found only in original
+// become tracked deletions, paragraphs found only in revised become tracked
+// insertions, and the others are kept as plain paragraphs; an edited
+// paragraph shows as its old text deleted followed by its new text inserted.
+//
+// The comparison is text-level: formatting, tables, images and other objects
+// are not compared or carried over, and the result holds Normal paragraphs.
+// The caller owns the returned Updater and must call Cleanup on it.
+func CompareDocuments(original, revised *Updater, author string) (*Updater, error) {
+	if original == nil {
+		return nil, NewValidationError("original", "updater is nil")
+	}
+	if revised == nil {
+		return nil, NewValidationError("revised", "updater is nil")
+	}
+	if author == "" {
+		author = "Author"
+	}
+
+	before, err := original.GetParagraphText()
+	if err != nil {
+		return nil, fmt.Errorf("read original: %w", err)
+	}
+	after, err := revised.GetParagraphText()
+	if err != nil {
+		return nil, fmt.Errorf("read revised: %w", err)
+	}
+
+	result, err := NewBlank()
+	if err != nil {
+		return nil, err
+	}
+
+	docPath := filepath.Join(result.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		result.Cleanup()
+		return nil, NewFileReadError("document.xml", err)
+	}
+
+	body := generateComparisonXML(diffStrings(before, after), author, time.Now())
+	updated, err := insertAtBodyEnd(raw, body)
+	if err != nil {
+		result.Cleanup()
+		return nil, fmt.Errorf("insert comparison: %w", err)
+	}
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		result.Cleanup()
+		return nil, NewXMLWriteError("document.xml", err)
+	}
+	return result, nil
+}
+
+// generateComparisonXML renders the edits as body paragraphs, numbering the
+// revision elements from 1.
+func generateComparisonXML(edits []diffEdit, author string, date time.Time) []byte {
+	var buf bytes.Buffer
+	dateStr := date.UTC().Format(time.RFC3339)
+	revID := 1
+	for _, edit := range edits {
+		switch edit.op {
+		case diffEqual:
+			buf.WriteString("<w:p><w:r>")
+			writeComparedText(&buf, edit.text)
+			buf.WriteString("</w:r></w:p>")
+		case diffInsert:
+			buf.Write(generateTrackedInsertXMLWithID(TrackedInsertOptions{
+				Text:   edit.text,
+				Author: author,
+				Date:   date,
+				Style:  StyleNormal,
+			}, revID))
+			revID += 2
+		case diffDelete:
+			var run bytes.Buffer
+			run.WriteString("<w:r>")
+			writeComparedText(&run, edit.text)
+			run.WriteString("</w:r>")
+
+			// Marking the paragraph mark deleted too lets accepting the
+			// change remove the paragraph rather than leave it empty.
+			fmt.Fprintf(&buf, `<w:p><w:pPr><w:rPr><w:del w:id="%d" w:author="%s" w:date="%s"/></w:rPr></w:pPr>`,
+				revID, xmlEscape(author), dateStr)
+			buf.WriteString(convertRunsToDeletedWithID(run.String(), author, dateStr, revID+1))
+			buf.WriteString("</w:p>")
+			revID += 2
+		}
+	}
+	return buf.Bytes()
+}
+
+// writeComparedText writes paragraph text as read by GetParagraphText back as
+// run content, turning the '\f' of page breaks into <w:br w:type="page"/>.
+func writeComparedText(buf *bytes.Buffer, text string) {
+	for i, part := range strings.Split(text, "\f") {
+		if i > 0 {
+			buf.WriteString(`<w:br w:type="page"/>`)
+		}
+		writeRunTextWithControls(buf, part)
+	}
+}
+
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+// diffEdit is one step of the edit script from a to b: a line kept,
+// deleted from a, or inserted from b.
+type diffEdit struct {
+	op   diffOp
+	text string
+}
+
+// diffStrings returns a shortest edit script turning a into b, using Myers'
+// O(ND) algorithm. Deletions are placed before insertions at each change.
+func diffStrings(a, b []string) []diffEdit {
+	n, m := len(a), len(b)
+	maxD := n + m
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+
+	// trace[d] holds the furthest reaching x for diagonals -(d+1)..d+1
+	// before step d; only that window is read when backtracking, so the
+	// trace grows with D² rather than D·(N+M).
+	var trace [][]int
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, slices.Clone(v[offset-d-1:offset+d+2]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackDiff(trace, a, b)
+			}
+		}
+	}
+	return nil
+}
+
+// backtrackDiff walks the Myers trace back from the end of both sequences.
+func backtrackDiff(trace [][]int, a, b []string) []diffEdit {
+	var edits []diffEdit
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v, offset := trace[d], d+1
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			edits = append(edits, diffEdit{op: diffEqual, text: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				edits = append(edits, diffEdit{op: diffInsert, text: b[y-1]})
+				y--
+			} else {
+				edits = append(edits, diffEdit{op: diffDelete, text: a[x-1]})
+				x--
+			}
+		}
+	}
+	slices.Reverse(edits)
+	return edits
+}
@@ -0,0 +1,136 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDiffStrings(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want []diffEdit
+	}{
+		{name: "both empty"},
+		{
+			name: "all inserted",
+			b:    []string{"x", "y"},
+			want: []diffEdit{{diffInsert, "x"}, {diffInsert, "y"}},
+		},
+		{
+			name: "all deleted",
+			a:    []string{"x"},
+			want: []diffEdit{{diffDelete, "x"}},
+		},
+		{
+			name: "replace in middle",
+			a:    []string{"a", "b", "c"},
+			b:    []string{"a", "B", "c"},
+			want: []diffEdit{{diffEqual, "a"}, {diffDelete, "b"}, {diffInsert, "B"}, {diffEqual, "c"}},
+		},
+		{
+			name: "insert and delete",
+			a:    []string{"a", "b", "c", "d"},
+			b:    []string{"b", "c", "e", "d"},
+			want: []diffEdit{{diffDelete, "a"}, {diffEqual, "b"}, {diffEqual, "c"}, {diffInsert, "e"}, {diffEqual, "d"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffStrings(tt.a, tt.b); !slices.Equal(got, tt.want) {
+				t.Errorf("diffStrings(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffStrings_LongInputsRoundTrip(t *testing.T) {
+	var a, b []string
+	for i := range 2000 {
+		a = append(a, strconv.Itoa(i))
+		if i%7 != 0 {
+			b = append(b, strconv.Itoa(i))
+		}
+		if i%11 == 0 {
+			b = append(b, "new"+strconv.Itoa(i))
+		}
+	}
+
+	var gotA, gotB []string
+	for _, e := range diffStrings(a, b) {
+		if e.op != diffInsert {
+			gotA = append(gotA, e.text)
+		}
+		if e.op != diffDelete {
+			gotB = append(gotB, e.text)
+		}
+	}
+	if !slices.Equal(gotA, a) || !slices.Equal(gotB, b) {
+		t.Fatal("edit script does not reproduce both inputs")
+	}
+}
+
+func TestCompareDocuments(t *testing.T) {
+	newDoc := func(paragraphs ...string) *Updater {
+		t.Helper()
+		u, err := NewBlank()
+		if err != nil {
+			t.Fatalf("NewBlank: %v", err)
+		}
+		t.Cleanup(func() { u.Cleanup() })
+		for _, p := range paragraphs {
+			if err := u.AddText(p, PositionEnd); err != nil {
+				t.Fatalf("AddText(%q): %v", p, err)
+			}
+		}
+		return u
+	}
+	original := newDoc("Introduction", "Old scope & goals", "Summary")
+	revised := newDoc("Introduction", "New scope", "Summary", "Appendix")
+
+	result, err := CompareDocuments(original, revised, "Reviewer")
+	if err != nil {
+		t.Fatalf("CompareDocuments: %v", err)
+	}
+	defer result.Cleanup()
+
+	raw, err := os.ReadFile(filepath.Join(result.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatalf("read document.xml: %v", err)
+	}
+	doc := string(raw)
+
+	for _, want := range []string{
+		`<w:delText xml:space="preserve">Old scope &amp; goals</w:delText>`,
+		`<w:del w:id="1" w:author="Reviewer"`,
+		`<w:ins w:id="4" w:author="Reviewer"`,
+		`<w:t>New scope</w:t>`,
+		`<w:t>Appendix</w:t>`,
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("expected %s in:\n%s", want, doc)
+		}
+	}
+	// Each changed paragraph marks both its paragraph mark and its run.
+	if strings.Count(doc, "<w:ins ") != 4 || strings.Count(doc, "<w:del ") != 2 {
+		t.Errorf("expected 2 inserted and 1 deleted paragraph in:\n%s", doc)
+	}
+
+	// The unchanged paragraphs and the insertions are the revised text.
+	paras, err := result.GetParagraphText()
+	if err != nil {
+		t.Fatalf("GetParagraphText: %v", err)
+	}
+	want := []string{"Introduction", "New scope", "Summary", "Appendix"}
+	if !slices.Equal(paras, want) {
+		t.Errorf("paragraphs = %q, want %q", paras, want)
+	}
+
+	if _, err := CompareDocuments(nil, revised, ""); err == nil {
+		t.Error("expected error for nil original")
+	}
+}
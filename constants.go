@@ -17,6 +17,12 @@ const (
 
 	// ChartIDIncrement is the increment per chart to ensure ID uniqueness
 	ChartIDIncrement = 0x1000
+
+	// ChartAxisIDBase is the base value for the axis IDs of generated charts
+	ChartAxisIDBase = 2071991000
+
+	// ChartAxisIDIncrement is the increment per chart to keep axis IDs unique
+	ChartAxisIDIncrement = 10
 )
 
 // OpenXML constants for image drawings
@@ -78,6 +84,9 @@ var (
 
 	// extractCellPattern matches full <w:tc> table-cell elements.
 	extractCellPattern = regexp.MustCompile(`(?s)<w:tc>.*?</w:tc>`)
+
+	// extractRunPattern matches full <w:r> run elements (but not <w:rPr>).
+	extractRunPattern = regexp.MustCompile(`(?s)<w:r(?:\s[^>]*)?>.*?</w:r>`)
 )
 
 // OpenXML namespace URIs
@@ -100,9 +109,19 @@ const (
 	// New() automatically promotes this to DocxMainContentType so templates can be
 	// used as input without any special handling by the caller.
 	DotxMainContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.template.main+xml"
-	ImageJPEGType    = "image/jpeg"
-	ImagePNGType     = "image/png"
-	ImageGIFType     = "image/gif"
-	ImageBMPType     = "image/bmp"
-	ImageTIFFType    = "image/tiff"
+
+	// DocmMainContentType is the document body content type for macro-enabled .docm files.
+	DocmMainContentType = "application/vnd.ms-word.document.macroEnabled.main+xml"
+
+	// DotmMainContentType is the document body content type for macro-enabled .dotm templates.
+	DotmMainContentType = "application/vnd.ms-word.template.macroEnabledTemplate.main+xml"
+
+	// VBAProjectContentType is the content type of the macro project part (vbaProject.bin).
+	VBAProjectContentType = "application/vnd.ms-office.vbaProject"
+
+	ImageJPEGType = "image/jpeg"
+	ImagePNGType  = "image/png"
+	ImageGIFType  = "image/gif"
+	ImageBMPType  = "image/bmp"
+	ImageTIFFType = "image/tiff"
 )
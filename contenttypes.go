@@ -0,0 +1,142 @@
+package godocx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const contentTypesPart = "[Content_Types].xml"
+
+type contentTypes struct {
+	XMLName   xml.Name              `xml:"Types"`
+	Defaults  []contentTypeDefault  `xml:"Default"`
+	Overrides []contentTypeOverride `xml:"Override"`
+}
+
+type contentTypeDefault struct {
+	Extension   string `xml:"Extension,attr"`
+	ContentType string `xml:"ContentType,attr"`
+}
+
+type contentTypeOverride struct {
+	PartName    string `xml:"PartName,attr"`
+	ContentType string `xml:"ContentType,attr"`
+}
+
+var (
+	contentTypeOverridePattern = regexp.MustCompile(`<Override\s[^>]*>`)
+	contentTypeDefaultPattern  = regexp.MustCompile(`<Default\s[^>]*>`)
+)
+
+// GetContentTypeMap returns the content type overrides of the package,
+// mapping each part name (e.g. "/word/document.xml") to its content type.
+// Parts without an override take the content type registered for their
+// extension by a <Default> entry, which is not part of the map.
+func (u *Updater) GetContentTypeMap() (map[string]string, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, contentTypesPart))
+	if err != nil {
+		return nil, NewFileReadError(contentTypesPart, err)
+	}
+	var parsed contentTypes
+	if err := xml.Unmarshal(raw, &parsed); err != nil {
+		return nil, NewXMLParseError(contentTypesPart, err)
+	}
+	m := make(map[string]string, len(parsed.Overrides))
+	for _, o := range parsed.Overrides {
+		m[o.PartName] = o.ContentType
+	}
+	return m, nil
+}
+
+// AddContentTypeOverride registers the content type of a single part, such as
+// a custom XML part added through AddRelationship. partName is the absolute
+// part name, e.g. "/customXml/item1.xml". An existing override for the part
+// is replaced.
+func (u *Updater) AddContentTypeOverride(partName, contentType string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if !strings.HasPrefix(partName, "/") {
+		return NewValidationError("partName", fmt.Sprintf("part name %q must start with /", partName))
+	}
+	if strings.TrimSpace(contentType) == "" {
+		return NewValidationError("contentType", "content type cannot be empty")
+	}
+	elem := fmt.Sprintf(`<Override PartName="%s" ContentType="%s"/>`, xmlEscape(partName), xmlEscape(contentType))
+	return u.setContentTypeEntry(contentTypeOverridePattern, "PartName", partName, elem)
+}
+
+// RemoveContentTypeOverride removes the override for partName, if any.
+func (u *Updater) RemoveContentTypeOverride(partName string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if !strings.HasPrefix(partName, "/") {
+		return NewValidationError("partName", fmt.Sprintf("part name %q must start with /", partName))
+	}
+	return u.setContentTypeEntry(contentTypeOverridePattern, "PartName", partName, "")
+}
+
+// AddContentTypeDefault registers the content type of every part with the
+// given file extension ("xml" or ".xml") that has no override. An existing
+// default for the extension is replaced.
+func (u *Updater) AddContentTypeDefault(extension, contentType string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	extension = strings.TrimPrefix(extension, ".")
+	if extension == "" || strings.ContainsAny(extension, "/.") {
+		return NewValidationError("extension", fmt.Sprintf("invalid extension %q", extension))
+	}
+	if strings.TrimSpace(contentType) == "" {
+		return NewValidationError("contentType", "content type cannot be empty")
+	}
+	elem := fmt.Sprintf(`<Default Extension="%s" ContentType="%s"/>`, xmlEscape(extension), xmlEscape(contentType))
+	return u.setContentTypeEntry(contentTypeDefaultPattern, "Extension", extension, elem)
+}
+
+// setContentTypeEntry replaces the entry matched by pattern whose attr equals
+// value (compared case-insensitively, as OPC names are) with elem, appending
+// it when there is none. An empty elem removes the entry.
+func (u *Updater) setContentTypeEntry(pattern *regexp.Regexp, attr, value, elem string) error {
+	ctPath := filepath.Join(u.tempDir, contentTypesPart)
+	raw, err := os.ReadFile(ctPath)
+	if err != nil {
+		return NewFileReadError(contentTypesPart, err)
+	}
+
+	start, end := -1, -1
+	for _, loc := range pattern.FindAllIndex(raw, -1) {
+		for _, m := range xmlAttrPattern.FindAllSubmatch(raw[loc[0]:loc[1]], -1) {
+			if string(m[1]) == attr && strings.EqualFold(xmlUnescape(string(m[2])), value) {
+				start, end = loc[0], loc[1]
+			}
+		}
+		if start != -1 {
+			break
+		}
+	}
+	if start == -1 {
+		if elem == "" {
+			return nil
+		}
+		closer := bytes.LastIndex(raw, []byte("</Types>"))
+		if closer == -1 {
+			return NewMalformedXMLError("invalid [Content_Types].xml: missing </Types>")
+		}
+		start, end = closer, closer
+	}
+
+	if err := atomicWriteFile(ctPath, spliceBytes(raw, start, end, elem), 0o644); err != nil {
+		return NewFileWriteError(contentTypesPart, err)
+	}
+	return nil
+}
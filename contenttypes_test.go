@@ -0,0 +1,77 @@
+package godocx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestContentTypeOverrides(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	const customType = "application/vnd.openxmlformats-officedocument.customXmlProperties+xml"
+	if err := u.AddContentTypeOverride("/customXml/itemProps1.xml", customType); err != nil {
+		t.Fatalf("AddContentTypeOverride: %v", err)
+	}
+	m, err := u.GetContentTypeMap()
+	if err != nil {
+		t.Fatalf("GetContentTypeMap: %v", err)
+	}
+	if m["/customXml/itemProps1.xml"] != customType {
+		t.Errorf("override not registered: %v", m)
+	}
+	if !strings.HasSuffix(m["/word/document.xml"], "document.main+xml") {
+		t.Errorf("expected the main document override, got %v", m)
+	}
+
+	// Adding again replaces the content type instead of duplicating the entry.
+	if err := u.AddContentTypeOverride("/customXml/ITEMPROPS1.xml", "application/xml"); err != nil {
+		t.Fatalf("AddContentTypeOverride: %v", err)
+	}
+	ct := readTempFile(t, u, "[Content_Types].xml")
+	if strings.Count(ct, "itemProps1.xml")+strings.Count(ct, "ITEMPROPS1.xml") != 1 {
+		t.Errorf("expected a single override for the part:\n%s", ct)
+	}
+
+	if err := u.RemoveContentTypeOverride("/customXml/itemProps1.xml"); err != nil {
+		t.Fatalf("RemoveContentTypeOverride: %v", err)
+	}
+	if m, _ := u.GetContentTypeMap(); len(m) == 0 || m["/customXml/ITEMPROPS1.xml"] != "" {
+		t.Errorf("expected the override to be removed: %v", m)
+	}
+	if err := u.RemoveContentTypeOverride("/customXml/missing.xml"); err != nil {
+		t.Errorf("removing a missing override should be a no-op, got %v", err)
+	}
+
+	err = u.AddContentTypeOverride("customXml/item1.xml", "application/xml")
+	var docErr *DocxError
+	if !errors.As(err, &docErr) || docErr.Code != ErrCodeValidation {
+		t.Errorf("expected validation error for relative part name, got %v", err)
+	}
+}
+
+func TestAddContentTypeDefault(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.AddContentTypeDefault(".svg", "image/svg+xml"); err != nil {
+		t.Fatalf("AddContentTypeDefault: %v", err)
+	}
+	if err := u.AddContentTypeDefault("svg", "image/svg+xml"); err != nil {
+		t.Fatalf("AddContentTypeDefault: %v", err)
+	}
+	ct := readTempFile(t, u, "[Content_Types].xml")
+	if strings.Count(ct, `<Default Extension="svg" ContentType="image/svg+xml"/>`) != 1 {
+		t.Errorf("expected one svg default:\n%s", ct)
+	}
+	if err := u.AddContentTypeDefault("", "image/png"); err == nil {
+		t.Error("expected error for empty extension")
+	}
+}
@@ -128,7 +128,7 @@ func TestGenerateBarChartXML(t *testing.T) {
 	}
 	opts = applyChartDefaults(opts)
 
-	result := generateBarChartXML(opts)
+	result := generateBarChartXML(opts, newChartAxisIDs(1))
 
 	if !strings.Contains(result, "<c:barChart>") {
 		t.Error("expected barChart element")
@@ -151,7 +151,7 @@ func TestGenerateLineChartXML(t *testing.T) {
 	}
 	opts = applyChartDefaults(opts)
 
-	result := generateLineChartXML(opts)
+	result := generateLineChartXML(opts, newChartAxisIDs(1))
 
 	if !strings.Contains(result, "<c:lineChart>") {
 		t.Error("expected lineChart element")
@@ -194,7 +194,7 @@ func TestGenerateAreaChartXML(t *testing.T) {
 	}
 	opts = applyChartDefaults(opts)
 
-	result := generateAreaChartXML(opts)
+	result := generateAreaChartXML(opts, newChartAxisIDs(1))
 
 	if !strings.Contains(result, "<c:areaChart>") {
 		t.Error("expected areaChart element")
@@ -282,7 +282,7 @@ func TestGenerateCategoryAxisXML_Extended(t *testing.T) {
 		TickLabelPos:   TickLabelNextTo,
 	}
 
-	result := generateCategoryAxisXML(axis)
+	result := generateCategoryAxisXML(axis, newChartAxisIDs(1))
 
 	if !strings.Contains(result, "X Axis") {
 		t.Error("expected axis title")
@@ -322,7 +322,7 @@ func TestGenerateValueAxisXML_Extended(t *testing.T) {
 		TickLabelPos:   TickLabelNextTo,
 	}
 
-	result := generateValueAxisXML(axis)
+	result := generateValueAxisXML(axis, newChartAxisIDs(1))
 
 	if !strings.Contains(result, "Y Axis") {
 		t.Error("expected axis title")
@@ -0,0 +1,171 @@
+package godocx
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CSVImportOptions defines options for building a table from CSV data.
+// The embedded TableOptions supply the position and all visual settings;
+// its Columns may be set to control column widths and alignment, in which
+// case their count must match the CSV.
+type CSVImportOptions struct {
+	TableOptions
+
+	// HasHeader treats the first CSV record as column titles. Without a header
+	// the columns keep the titles from TableOptions.Columns, or are titled
+	// "Column 1", "Column 2", ...
+	HasHeader bool
+
+	// Delimiter is the field separator (default: ',')
+	Delimiter rune
+}
+
+// TableFromCSV parses csvData and inserts it as a table.
+func (u *Updater) TableFromCSV(csvData []byte, opts CSVImportOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+
+	records, err := parseCSVRecords(csvData, opts.Delimiter)
+	if err != nil {
+		return err
+	}
+
+	var header []string
+	if opts.HasHeader {
+		header, records = records[0], records[1:]
+	}
+	numCols := len(header)
+	if header == nil {
+		numCols = len(records[0])
+	}
+
+	tableOpts := opts.TableOptions
+	if len(tableOpts.Columns) == 0 {
+		tableOpts.Columns = make([]ColumnDefinition, numCols)
+		for i := range tableOpts.Columns {
+			tableOpts.Columns[i].Title = fmt.Sprintf("Column %d", i+1)
+		}
+	} else {
+		if len(tableOpts.Columns) != numCols {
+			return NewValidationError("Columns", fmt.Sprintf("%d column definitions given for %d CSV columns", len(tableOpts.Columns), numCols))
+		}
+		tableOpts.Columns = append([]ColumnDefinition(nil), tableOpts.Columns...)
+	}
+	for i, title := range header {
+		tableOpts.Columns[i].Title = title
+	}
+	tableOpts.Rows = records
+
+	return u.InsertTable(tableOpts)
+}
+
+// ChartFromCSV parses csvData and inserts it as a chart of the given kind.
+//
+// The first record is a header. The first column holds the categories (the X
+// values for scatter charts) and every other column becomes a series named by
+// its header. Series already present in opts.Series, matched by index, keep
+// their styling (color, markers, data labels, ...).
+func (u *Updater) ChartFromCSV(csvData []byte, kind ChartKind, opts ChartOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+
+	records, err := parseCSVRecords(csvData, 0)
+	if err != nil {
+		return err
+	}
+	header := records[0]
+	if len(header) < 2 {
+		return NewValidationError("csvData", "chart CSV needs a category column and at least one series column")
+	}
+	if len(records) < 2 {
+		return NewValidationError("csvData", "chart CSV has no data rows")
+	}
+
+	series := make([]SeriesOptions, len(header)-1)
+	for i := range series {
+		if i < len(opts.Series) {
+			series[i] = opts.Series[i]
+		}
+		series[i].Name = header[i+1]
+		series[i].Values = make([]float64, 0, len(records)-1)
+	}
+
+	categories := make([]string, 0, len(records)-1)
+	var xValues []float64
+	for r, record := range records[1:] {
+		categories = append(categories, record[0])
+		if kind == ChartKindScatter {
+			x, err := parseCSVNumber(record[0])
+			if err != nil {
+				return csvNumberError(r+2, 1, err)
+			}
+			xValues = append(xValues, x)
+		}
+		for c := 1; c < len(record); c++ {
+			v, err := parseCSVNumber(record[c])
+			if err != nil {
+				return csvNumberError(r+2, c+1, err)
+			}
+			series[c-1].Values = append(series[c-1].Values, v)
+		}
+	}
+	if xValues != nil {
+		for i := range series {
+			series[i].XValues = xValues
+		}
+	}
+
+	opts.ChartKind = kind
+	opts.Categories = categories
+	opts.Series = series
+	if opts.CategoryAxisTitle == "" && (opts.CategoryAxis == nil || opts.CategoryAxis.Title == "") {
+		opts.CategoryAxisTitle = header[0]
+	}
+
+	return u.InsertChart(opts)
+}
+
+// parseCSVRecords reads all CSV records, ignoring a leading UTF-8 byte order
+// mark as written by spreadsheet exports.
+func parseCSVRecords(csvData []byte, delimiter rune) ([][]string, error) {
+	csvData = bytes.TrimPrefix(csvData, []byte("\xEF\xBB\xBF"))
+
+	reader := csv.NewReader(bytes.NewReader(csvData))
+	if delimiter != 0 {
+		reader.Comma = delimiter
+	}
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, NewValidationError("csvData", fmt.Sprintf("parse CSV: %v", err))
+	}
+	if len(records) == 0 {
+		return nil, NewValidationError("csvData", "CSV data is empty")
+	}
+	return records, nil
+}
+
+// parseCSVNumber parses a numeric CSV field. Empty fields are read as zero.
+func parseCSVNumber(field string) (float64, error) {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(field, 64)
+}
+
+// csvNumberError reports a field of csvData that is not a number, keeping
+// the parse error in the chain and the 1-based row and column in the context.
+func csvNumberError(row, column int, err error) error {
+	docxErr := &DocxError{
+		Code:    ErrCodeValidation,
+		Message: fmt.Sprintf("row %d, column %d: invalid number", row, column),
+		Err:     err,
+	}
+	return docxErr.WithContext("field", "csvData").WithContext("row", row).WithContext("column", column)
+}
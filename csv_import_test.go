@@ -0,0 +1,129 @@
+package godocx
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTableFromCSV(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	csvData := "\xEF\xBB\xBFRegion;Revenue;Notes\nNorth;1200;\"on track; growing\"\nSouth;950;behind\n"
+	err := u.TableFromCSV([]byte(csvData), CSVImportOptions{
+		TableOptions: TableOptions{
+			Position:         PositionEnd,
+			HeaderBold:       true,
+			HeaderBackground: "4472C4",
+		},
+		HasHeader: true,
+		Delimiter: ';',
+	})
+	if err != nil {
+		t.Fatalf("TableFromCSV: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	for _, want := range []string{">Region<", ">Revenue<", ">Notes<", ">North<", ">on track; growing<", ">950<", `w:fill="4472C4"`} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s in table, got: %s", want, docXML)
+		}
+	}
+	if n := strings.Count(docXML, "</w:tr>"); n != 3 {
+		t.Errorf("expected header and 2 data rows, got %d rows", n)
+	}
+	if strings.Contains(docXML, "\xEF\xBB\xBF") {
+		t.Error("expected byte order mark to be stripped")
+	}
+}
+
+func TestTableFromCSV_WithoutHeader(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.TableFromCSV([]byte("a,b\nc,d\n"), CSVImportOptions{
+		TableOptions: TableOptions{
+			Position: PositionEnd,
+			Columns:  []ColumnDefinition{{Title: "Left"}, {Title: "Right", Alignment: CellAlignRight}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("TableFromCSV: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	for _, want := range []string{">Left<", ">Right<", ">a<", ">d<"} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s in table, got: %s", want, docXML)
+		}
+	}
+
+	err = u.TableFromCSV([]byte("a,b,c\n"), CSVImportOptions{
+		TableOptions: TableOptions{Columns: []ColumnDefinition{{Title: "Only"}}},
+	})
+	if err == nil {
+		t.Error("expected error for column count mismatch")
+	}
+	if err := u.TableFromCSV([]byte("a,b\nc\n"), CSVImportOptions{}); err == nil {
+		t.Error("expected error for ragged CSV")
+	}
+	if err := u.TableFromCSV(nil, CSVImportOptions{}); err == nil {
+		t.Error("expected error for empty CSV")
+	}
+}
+
+func TestChartFromCSV(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	csvData := "Quarter,Revenue,Profit\nQ1,100,20\nQ2,150.5,\nQ3,120,25\n"
+	err := u.ChartFromCSV([]byte(csvData), ChartKindLine, ChartOptions{
+		Position: PositionEnd,
+		Title:    "Sales",
+		Series:   []SeriesOptions{{Color: "FF0000"}},
+	})
+	if err != nil {
+		t.Fatalf("ChartFromCSV: %v", err)
+	}
+
+	chartXML := readTempFile(t, u, "word/charts/chart1.xml")
+	for _, want := range []string{"<c:lineChart>", ">Revenue<", ">Profit<", ">Q2<", ">150.5<", `val="FF0000"`, ">Quarter<"} {
+		if !strings.Contains(chartXML, want) {
+			t.Errorf("expected %s in chart, got: %s", want, chartXML)
+		}
+	}
+}
+
+func TestChartFromCSV_Errors(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	cases := map[string]string{
+		"no series":   "Quarter\nQ1\n",
+		"no rows":     "Quarter,Revenue\n",
+		"bad number":  "Quarter,Revenue\nQ1,lots\n",
+		"bad scatter": "X,Y\nabc,1\n",
+	}
+	for name, csvData := range cases {
+		kind := ChartKindColumn
+		if name == "bad scatter" {
+			kind = ChartKindScatter
+		}
+		if err := u.ChartFromCSV([]byte(csvData), kind, ChartOptions{Position: PositionEnd}); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	err := u.ChartFromCSV([]byte("Quarter,Revenue\nQ1,10\nQ2,lots\n"), ChartKindColumn, ChartOptions{Position: PositionEnd})
+	var docxErr *DocxError
+	if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeValidation {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if docxErr.Context["row"] != 3 || docxErr.Context["column"] != 2 {
+		t.Errorf("context = %v, want row 3, column 2", docxErr.Context)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("error should wrap the parse error: %v", err)
+	}
+	if n := strings.Count(err.Error(), string(ErrCodeValidation)); n != 1 {
+		t.Errorf("error %q has the validation code %d times, want once", err, n)
+	}
+}
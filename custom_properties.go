@@ -0,0 +1,172 @@
+package godocx
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// StringProperty returns the value of the string custom property name.
+// It returns a PROPERTY_NOT_FOUND error when the property does not exist and
+// an INVALID_VALUE error when it holds another type.
+func (u *Updater) StringProperty(name string) (string, error) {
+	prop, err := u.customProperty(name, "lpwstr")
+	if err != nil {
+		return "", err
+	}
+	return prop.Value.(string), nil
+}
+
+// IntProperty returns the value of the integer custom property name.
+func (u *Updater) IntProperty(name string) (int, error) {
+	prop, err := u.customProperty(name, "i4")
+	if err != nil {
+		return 0, err
+	}
+	return prop.Value.(int), nil
+}
+
+// FloatProperty returns the value of the number custom property name.
+// Integer properties are converted to float64.
+func (u *Updater) FloatProperty(name string) (float64, error) {
+	prop, err := u.customProperty(name, "r8", "i4")
+	if err != nil {
+		return 0, err
+	}
+	if i, ok := prop.Value.(int); ok {
+		return float64(i), nil
+	}
+	return prop.Value.(float64), nil
+}
+
+// BoolProperty returns the value of the yes/no custom property name.
+func (u *Updater) BoolProperty(name string) (bool, error) {
+	prop, err := u.customProperty(name, "bool")
+	if err != nil {
+		return false, err
+	}
+	return prop.Value.(bool), nil
+}
+
+// DateProperty returns the value of the date custom property name.
+func (u *Updater) DateProperty(name string) (time.Time, error) {
+	prop, err := u.customProperty(name, "date")
+	if err != nil {
+		return time.Time{}, err
+	}
+	return prop.Value.(time.Time), nil
+}
+
+// SetStringProperty sets the custom property name to a string value,
+// replacing any existing property with that name or appending a new one.
+func (u *Updater) SetStringProperty(name, value string) error {
+	return u.setCustomProperty(CustomProperty{Name: name, Value: value, Type: "lpwstr"})
+}
+
+// SetIntProperty sets the custom property name to an integer value. Word
+// stores integer properties as 32-bit values.
+func (u *Updater) SetIntProperty(name string, value int) error {
+	if value < math.MinInt32 || value > math.MaxInt32 {
+		return NewValidationError("value", fmt.Sprintf("integer property value %d does not fit in 32 bits", value))
+	}
+	return u.setCustomProperty(CustomProperty{Name: name, Value: value, Type: "i4"})
+}
+
+// SetFloatProperty sets the custom property name to a number value.
+func (u *Updater) SetFloatProperty(name string, value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return NewValidationError("value", "number property value must be finite")
+	}
+	return u.setCustomProperty(CustomProperty{Name: name, Value: value, Type: "r8"})
+}
+
+// SetBoolProperty sets the custom property name to a yes/no value.
+func (u *Updater) SetBoolProperty(name string, value bool) error {
+	return u.setCustomProperty(CustomProperty{Name: name, Value: value, Type: "bool"})
+}
+
+// SetDateProperty sets the custom property name to a date value, stored in UTC.
+func (u *Updater) SetDateProperty(name string, value time.Time) error {
+	return u.setCustomProperty(CustomProperty{Name: name, Value: value, Type: "date"})
+}
+
+// customProperty looks up the custom property name and checks that it has
+// one of the given types and a value parsed as that type.
+func (u *Updater) customProperty(name string, types ...string) (CustomProperty, error) {
+	if u == nil {
+		return CustomProperty{}, NewValidationError("updater", "updater is nil")
+	}
+
+	props, err := u.GetCustomProperties()
+	if err != nil {
+		return CustomProperty{}, err
+	}
+	for _, prop := range props {
+		if prop.Name != name {
+			continue
+		}
+		for _, t := range types {
+			if prop.Type == t && customPropertyValueHasType(prop.Value, t) {
+				return prop, nil
+			}
+		}
+		return CustomProperty{}, &DocxError{
+			Code:    ErrCodeInvalidValue,
+			Message: fmt.Sprintf("custom property %q has type %q, not %q", name, prop.Type, types[0]),
+			Context: map[string]any{"name": name, "type": prop.Type},
+		}
+	}
+	return CustomProperty{}, NewPropertyNotFoundError(name)
+}
+
+// customPropertyValueHasType reports whether a parsed value has the Go type
+// that corresponds to the vt type, which is not the case when the stored
+// text could not be parsed.
+func customPropertyValueHasType(value any, vtType string) bool {
+	switch vtType {
+	case "lpwstr":
+		_, ok := value.(string)
+		return ok
+	case "i4":
+		_, ok := value.(int)
+		return ok
+	case "r8":
+		_, ok := value.(float64)
+		return ok
+	case "bool":
+		_, ok := value.(bool)
+		return ok
+	case "date":
+		_, ok := value.(time.Time)
+		return ok
+	}
+	return false
+}
+
+// setCustomProperty replaces the custom property with the same name as prop,
+// or appends prop when there is none, keeping all other properties.
+func (u *Updater) setCustomProperty(prop CustomProperty) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if prop.Name == "" {
+		return NewValidationError("name", "property name cannot be empty")
+	}
+
+	props, err := u.GetCustomProperties()
+	if err != nil {
+		return err
+	}
+	replaced := false
+	for i := range props {
+		if props[i].Name == prop.Name {
+			props[i] = prop
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		props = append(props, prop)
+	}
+	return u.SetCustomProperties(props)
+}
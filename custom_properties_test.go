@@ -0,0 +1,107 @@
+package godocx_test
+
+import (
+	"errors"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	godocx "github.com/falcomza/go-docx"
+)
+
+func TestTypedCustomProperties(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	approved := time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC)
+	steps := []func() error{
+		func() error { return u.SetStringProperty("Client", "Smith & Sons <Ltd>") },
+		func() error { return u.SetStringProperty("Empty", "") },
+		func() error { return u.SetIntProperty("Revision", 3) },
+		func() error { return u.SetFloatProperty("Budget", 1250.75) },
+		func() error { return u.SetBoolProperty("Approved", true) },
+		func() error { return u.SetDateProperty("ApprovedOn", approved) },
+		// Updating an existing property replaces it in place.
+		func() error { return u.SetIntProperty("Revision", 4) },
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("setter %d failed: %v", i, err)
+		}
+	}
+
+	// Values must survive a save and reopen.
+	outputPath := filepath.Join(t.TempDir(), "props.docx")
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	reopened, err := godocx.New(outputPath)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer reopened.Cleanup()
+
+	props, err := reopened.GetCustomProperties()
+	if err != nil {
+		t.Fatalf("GetCustomProperties failed: %v", err)
+	}
+	if len(props) != 6 {
+		t.Errorf("expected 6 properties, got %d: %+v", len(props), props)
+	}
+
+	if v, err := reopened.StringProperty("Client"); err != nil || v != "Smith & Sons <Ltd>" {
+		t.Errorf("StringProperty(Client) = %q, %v", v, err)
+	}
+	if v, err := reopened.StringProperty("Empty"); err != nil || v != "" {
+		t.Errorf("StringProperty(Empty) = %q, %v", v, err)
+	}
+	if v, err := reopened.IntProperty("Revision"); err != nil || v != 4 {
+		t.Errorf("IntProperty(Revision) = %d, %v", v, err)
+	}
+	if v, err := reopened.FloatProperty("Budget"); err != nil || v != 1250.75 {
+		t.Errorf("FloatProperty(Budget) = %v, %v", v, err)
+	}
+	if v, err := reopened.FloatProperty("Revision"); err != nil || v != 4 {
+		t.Errorf("FloatProperty(Revision) = %v, %v", v, err)
+	}
+	if v, err := reopened.BoolProperty("Approved"); err != nil || !v {
+		t.Errorf("BoolProperty(Approved) = %v, %v", v, err)
+	}
+	if v, err := reopened.DateProperty("ApprovedOn"); err != nil || !v.Equal(approved) {
+		t.Errorf("DateProperty(ApprovedOn) = %v, %v", v, err)
+	}
+}
+
+func TestTypedCustomPropertyErrors(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.SetStringProperty("Client", "Acme"); err != nil {
+		t.Fatalf("SetStringProperty failed: %v", err)
+	}
+
+	var docxErr *godocx.DocxError
+	_, err = u.StringProperty("Missing")
+	if !errors.As(err, &docxErr) || docxErr.Code != godocx.ErrCodePropertyNotFound {
+		t.Errorf("expected PROPERTY_NOT_FOUND, got %v", err)
+	}
+	_, err = u.IntProperty("Client")
+	if !errors.As(err, &docxErr) || docxErr.Code != godocx.ErrCodeInvalidValue {
+		t.Errorf("expected INVALID_VALUE for type mismatch, got %v", err)
+	}
+	if math.MaxInt > math.MaxInt32 {
+		if err := u.SetIntProperty("Big", math.MaxInt); err == nil {
+			t.Error("expected error for integer outside 32 bits")
+		}
+	}
+	if err := u.SetBoolProperty("", true); err == nil {
+		t.Error("expected error for empty name")
+	}
+}
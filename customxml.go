@@ -0,0 +1,266 @@
+package godocx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	customXMLItemIDPattern    = regexp.MustCompile(`^\{?([0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12})\}?$`)
+	customXMLItemIDAttrRegexp = regexp.MustCompile(`(?:\w+:)?itemID="([^"]*)"`)
+)
+
+// AddCustomXMLPart stores xmlContent as a custom XML part of the document
+// (customXml/itemN.xml), together with a properties part that records id as
+// the data store item ID and schemaURI as the schema of the data. id is a
+// GUID, with or without braces; schemaURI may be empty. Word exposes custom
+// XML parts to content control data binding and to SharePoint document
+// information panels.
+func (u *Updater) AddCustomXMLPart(id, schemaURI string, xmlContent []byte) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	itemID, err := normalizeCustomXMLItemID(id)
+	if err != nil {
+		return err
+	}
+	if err := checkWellFormedXMLDocument(xmlContent); err != nil {
+		return NewValidationError("xmlContent", fmt.Sprintf("custom XML is not well-formed: %v", err))
+	}
+
+	item, _, err := u.findCustomXMLPart(itemID)
+	if err != nil {
+		return err
+	}
+	if item != "" {
+		return NewValidationError("id", fmt.Sprintf("custom XML part %s already exists", itemID))
+	}
+
+	_, err = u.createCustomXMLPart(itemID, schemaURI, xmlContent)
+	return err
+}
+
+// GetCustomXMLPart returns the content of the custom XML part whose data
+// store item ID is id.
+func (u *Updater) GetCustomXMLPart(id string) ([]byte, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+	itemID, err := normalizeCustomXMLItemID(id)
+	if err != nil {
+		return nil, err
+	}
+	item, _, err := u.findCustomXMLPart(itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item == "" {
+		return nil, NewValidationError("id", fmt.Sprintf("custom XML part %s not found", itemID))
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "customXml", item))
+	if err != nil {
+		return nil, NewFileReadError(item, err)
+	}
+	return raw, nil
+}
+
+// DeleteCustomXMLPart removes the custom XML part whose data store item ID is
+// id, along with its properties part, relationships and content types.
+func (u *Updater) DeleteCustomXMLPart(id string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	itemID, err := normalizeCustomXMLItemID(id)
+	if err != nil {
+		return err
+	}
+	item, props, err := u.findCustomXMLPart(itemID)
+	if err != nil {
+		return err
+	}
+	if item == "" {
+		return NewValidationError("id", fmt.Sprintf("custom XML part %s not found", itemID))
+	}
+
+	for _, name := range []string{item, props, filepath.Join("_rels", item+".rels")} {
+		if err := os.Remove(filepath.Join(u.tempDir, "customXml", name)); err != nil && !os.IsNotExist(err) {
+			return NewFileWriteError(name, err)
+		}
+	}
+
+	// Word relates custom XML to the main document part; other tools use the
+	// package relationships.
+	for _, source := range []struct{ relsPart, dir string }{
+		{"word/_rels/document.xml.rels", "word"},
+		{"_rels/.rels", ""},
+	} {
+		if err := u.removeRelationshipsTo(source.relsPart, source.dir, "customXml/"+item); err != nil {
+			return err
+		}
+	}
+
+	if err := u.RemoveContentTypeOverride("/customXml/" + item); err != nil {
+		return err
+	}
+	return u.RemoveContentTypeOverride("/customXml/" + props)
+}
+
+// createCustomXMLPart writes data to the next free customXml/itemN.xml with
+// its properties part, relationships and content types, and returns the
+// path of the new part.
+func (u *Updater) createCustomXMLPart(itemID, schemaURI string, data []byte) (string, error) {
+	dir := filepath.Join(u.tempDir, "customXml")
+	n := 1
+	for {
+		if _, err := os.Stat(filepath.Join(dir, fmt.Sprintf("item%d.xml", n))); os.IsNotExist(err) {
+			break
+		}
+		n++
+	}
+	item := fmt.Sprintf("item%d.xml", n)
+	props := fmt.Sprintf("itemProps%d.xml", n)
+
+	schemaRefs := "<ds:schemaRefs/>"
+	if schemaURI != "" {
+		schemaRefs = fmt.Sprintf(`<ds:schemaRefs><ds:schemaRef ds:uri="%s"/></ds:schemaRefs>`, xmlEscape(schemaURI))
+	}
+	propsXML := `<?xml version="1.0" encoding="UTF-8" standalone="no"?>` + "\n" +
+		fmt.Sprintf(`<ds:datastoreItem ds:itemID="%s" xmlns:ds="%s">`, itemID, customXMLDataStoreNamespace) +
+		schemaRefs +
+		`</ds:datastoreItem>`
+	itemRels := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		fmt.Sprintf(`<Relationship Id="rId1" Type="%s" Target="%s"/>`, customXMLPropsRelType, props) +
+		`</Relationships>`
+
+	if err := os.MkdirAll(filepath.Join(dir, "_rels"), 0o755); err != nil {
+		return "", NewFileWriteError("customXml", err)
+	}
+	partPath := filepath.Join(dir, item)
+	for _, f := range []struct {
+		path string
+		data []byte
+	}{
+		{partPath, data},
+		{filepath.Join(dir, props), []byte(propsXML)},
+		{filepath.Join(dir, "_rels", item+".rels"), []byte(itemRels)},
+	} {
+		if err := atomicWriteFile(f.path, f.data, 0o644); err != nil {
+			return "", NewXMLWriteError(filepath.Base(f.path), err)
+		}
+	}
+
+	if err := u.addCustomXMLRelationship(item); err != nil {
+		return "", err
+	}
+	if err := u.addCustomXMLContentTypes(item, props); err != nil {
+		return "", err
+	}
+	return partPath, nil
+}
+
+// findCustomXMLPart returns the file names of the custom XML part whose
+// properties record itemID and of its properties part, or empty names when
+// there is none.
+func (u *Updater) findCustomXMLPart(itemID string) (item, props string, err error) {
+	entries, err := os.ReadDir(filepath.Join(u.tempDir, "customXml"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", "", nil
+		}
+		return "", "", NewFileReadError("customXml", err)
+	}
+	for _, entry := range entries {
+		if !customXMLItemPartRegex.MatchString(entry.Name()) {
+			continue
+		}
+		rels, err := u.GetRelationships("customXml/" + entry.Name())
+		if err != nil {
+			return "", "", err
+		}
+		for _, rel := range rels {
+			if rel.Type != customXMLPropsRelType {
+				continue
+			}
+			target := strings.TrimPrefix(resolveRelationshipTarget("customXml", rel.Target), "customXml/")
+			raw, err := os.ReadFile(filepath.Join(u.tempDir, "customXml", filepath.FromSlash(target)))
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return "", "", NewFileReadError(target, err)
+			}
+			if m := customXMLItemIDAttrRegexp.FindSubmatch(raw); m != nil && strings.EqualFold(string(m[1]), itemID) {
+				return entry.Name(), target, nil
+			}
+		}
+	}
+	return "", "", nil
+}
+
+// removeRelationshipsTo drops the relationships of relsPart whose target,
+// resolved against sourceDir, is the package path target.
+func (u *Updater) removeRelationshipsTo(relsPart, sourceDir, target string) error {
+	relsPath := filepath.Join(u.tempDir, filepath.FromSlash(relsPart))
+	raw, err := os.ReadFile(relsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return NewFileReadError(relsPart, err)
+	}
+	updated := relationshipElemPattern.ReplaceAllFunc(raw, func(elem []byte) []byte {
+		attrs := relationshipAttrs(elem)
+		if attrs["TargetMode"] != "External" && resolveRelationshipTarget(sourceDir, attrs["Target"]) == target {
+			return nil
+		}
+		return elem
+	})
+	if bytes.Equal(updated, raw) {
+		return nil
+	}
+	if err := atomicWriteFile(relsPath, updated, 0o644); err != nil {
+		return NewFileWriteError(relsPart, err)
+	}
+	return nil
+}
+
+// normalizeCustomXMLItemID validates a data store item ID and returns it in
+// Word's "{XXXXXXXX-XXXX-...}" form.
+func normalizeCustomXMLItemID(id string) (string, error) {
+	m := customXMLItemIDPattern.FindStringSubmatch(strings.TrimSpace(id))
+	if m == nil {
+		return "", NewValidationError("id", fmt.Sprintf("invalid custom XML item ID %q: expected a GUID", id))
+	}
+	return "{" + strings.ToUpper(m[1]) + "}", nil
+}
+
+// checkWellFormedXMLDocument reports whether data is a well-formed XML
+// document with a root element.
+func checkWellFormedXMLDocument(data []byte) error {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	hasRoot := false
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			if !hasRoot {
+				return errors.New("no root element")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := tok.(xml.StartElement); ok {
+			hasRoot = true
+		}
+	}
+}
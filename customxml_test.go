@@ -0,0 +1,73 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCustomXMLParts(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	const id = "{6C3C8BC8-F283-45AE-878A-BAB7291924A1}"
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?><workflow xmlns="urn:example:workflow"><status>Draft</status></workflow>`)
+	if err := u.AddCustomXMLPart(id, "urn:example:workflow", data); err != nil {
+		t.Fatalf("AddCustomXMLPart: %v", err)
+	}
+
+	got, err := u.GetCustomXMLPart("6c3c8bc8-f283-45ae-878a-bab7291924a1")
+	if err != nil {
+		t.Fatalf("GetCustomXMLPart: %v", err)
+	}
+	if string(got) != string(data) {
+		t.Errorf("GetCustomXMLPart = %s, want %s", got, data)
+	}
+
+	props := readTempFile(t, u, "customXml/itemProps1.xml")
+	if !strings.Contains(props, `ds:itemID="`+id+`"`) || !strings.Contains(props, `ds:uri="urn:example:workflow"`) {
+		t.Errorf("unexpected properties part: %s", props)
+	}
+	if rels := readTempFile(t, u, "word/_rels/document.xml.rels"); !strings.Contains(rels, `Target="../customXml/item1.xml"`) {
+		t.Errorf("expected customXml relationship, got: %s", rels)
+	}
+	types, err := u.GetContentTypeMap()
+	if err != nil {
+		t.Fatalf("GetContentTypeMap: %v", err)
+	}
+	if types["/customXml/itemProps1.xml"] != customXMLPropsContentType {
+		t.Errorf("expected properties content type, got %v", types)
+	}
+
+	if err := u.AddCustomXMLPart(id, "", data); err == nil {
+		t.Error("expected error for duplicate item ID")
+	}
+	if err := u.AddCustomXMLPart("not-a-guid", "", data); err == nil {
+		t.Error("expected error for invalid item ID")
+	}
+	if err := u.AddCustomXMLPart(newGUID(), "", []byte("<open>")); err == nil {
+		t.Error("expected error for malformed XML")
+	}
+
+	if err := u.DeleteCustomXMLPart(id); err != nil {
+		t.Fatalf("DeleteCustomXMLPart: %v", err)
+	}
+	for _, name := range []string{"item1.xml", "itemProps1.xml", "_rels/item1.xml.rels"} {
+		if _, err := os.Stat(filepath.Join(u.TempDir(), "customXml", filepath.FromSlash(name))); !os.IsNotExist(err) {
+			t.Errorf("expected customXml/%s to be removed", name)
+		}
+	}
+	if rels := readTempFile(t, u, "word/_rels/document.xml.rels"); strings.Contains(rels, "customXml") {
+		t.Errorf("expected relationship to be removed, got: %s", rels)
+	}
+	if ct := readTempFile(t, u, "[Content_Types].xml"); strings.Contains(ct, "/customXml/") {
+		t.Errorf("expected overrides to be removed, got: %s", ct)
+	}
+	if _, err := u.GetCustomXMLPart(id); err == nil {
+		t.Error("expected error for deleted part")
+	}
+}
@@ -29,16 +29,16 @@ func DefaultDeleteOptions() DeleteOptions {
 // Returns the number of paragraphs deleted.
 func (u *Updater) DeleteParagraphs(text string, opts DeleteOptions) (int, error) {
 	if u == nil {
-		return 0, fmt.Errorf("updater is nil")
+		return 0, NewValidationError("updater", "updater is nil")
 	}
 	if text == "" {
-		return 0, fmt.Errorf("text cannot be empty")
+		return 0, NewValidationError("text", "text cannot be empty")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return 0, fmt.Errorf("read document.xml: %w", err)
+		return 0, NewFileReadError("document.xml", err)
 	}
 
 	updated, count, err := deleteParagraphsContaining(raw, text, opts)
@@ -47,25 +47,72 @@ func (u *Updater) DeleteParagraphs(text string, opts DeleteOptions) (int, error)
 	}
 
 	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
-		return count, fmt.Errorf("write document.xml: %w", err)
+		return count, NewXMLWriteError("document.xml", err)
 	}
 
 	return count, nil
 }
 
-// DeleteTable removes a table by index (1-based).
+// DeleteParagraphByIndex removes the paragraph at the given 1-based position
+// among the top-level paragraphs of the document body (paragraphs inside tables
+// are not counted), as reported by ParagraphInfo.Index. Returns a validation
+// error when index is out of range.
+func (u *Updater) DeleteParagraphByIndex(index int) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	_, err := u.DeleteParagraphsByRange(index, index)
+	return err
+}
+
+// DeleteParagraphsByRange removes the top-level body paragraphs from position
+// from to position to (1-based, inclusive, as in ParagraphInfo.Index). The
+// body's <w:sectPr> is always kept, and a paragraph carrying a section break is
+// reduced to an empty paragraph holding its <w:sectPr> so the section layout
+// survives. Returns the number of paragraphs deleted.
+func (u *Updater) DeleteParagraphsByRange(from, to int) (int, error) {
+	if u == nil {
+		return 0, NewValidationError("updater", "updater is nil")
+	}
+	if from < 1 {
+		return 0, NewValidationError("from", "paragraph index must be >= 1")
+	}
+	if to < from {
+		return 0, NewValidationError("to", fmt.Sprintf("end of range %d is before start %d", to, from))
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return 0, NewFileReadError("document.xml", err)
+	}
+
+	updated, count, err := deleteBodyParagraphRange(raw, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return count, NewXMLWriteError("document.xml", err)
+	}
+
+	return count, nil
+}
+
+// DeleteTable removes a top-level table by index (1-based), including any
+// tables nested inside it.
 func (u *Updater) DeleteTable(tableIndex int) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if tableIndex < 1 {
-		return fmt.Errorf("table index must be >= 1")
+		return NewValidationError("tableIndex", "table index must be >= 1")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	updated, err := deleteNthTable(raw, tableIndex)
@@ -74,26 +121,26 @@ func (u *Updater) DeleteTable(tableIndex int) error {
 	}
 
 	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
 }
 
-// DeleteImage removes an image by index (1-based).
-// Note: This removes the image from the document but does not delete the media file.
+// DeleteImage removes an image by index (1-based). The image relationship and
+// its media file are removed too, unless another drawing still uses them.
 func (u *Updater) DeleteImage(imageIndex int) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if imageIndex < 1 {
-		return fmt.Errorf("image index must be >= 1")
+		return NewValidationError("imageIndex", "image index must be >= 1")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	updated, err := deleteNthImage(raw, imageIndex)
@@ -102,7 +149,11 @@ func (u *Updater) DeleteImage(imageIndex int) error {
 	}
 
 	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
+	}
+
+	if err := u.removeUnreferencedImages(raw, updated); err != nil {
+		return fmt.Errorf("remove image media: %w", err)
 	}
 
 	return nil
@@ -111,16 +162,16 @@ func (u *Updater) DeleteImage(imageIndex int) error {
 // DeleteChart removes a chart by index (1-based).
 func (u *Updater) DeleteChart(chartIndex int) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if chartIndex < 1 {
-		return fmt.Errorf("chart index must be >= 1")
+		return NewValidationError("chartIndex", "chart index must be >= 1")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	updated, err := deleteNthChart(raw, chartIndex)
@@ -129,7 +180,7 @@ func (u *Updater) DeleteChart(chartIndex int) error {
 	}
 
 	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
@@ -184,13 +235,89 @@ func deleteParagraphsContaining(raw []byte, text string, opts DeleteOptions) ([]
 	return result.Bytes(), count, nil
 }
 
-// deleteNthTable removes the Nth table from the document
+// deleteBodyParagraphRange removes the top-level body paragraphs from..to
+// (1-based, inclusive).
+func deleteBodyParagraphRange(raw []byte, from, to int) ([]byte, int, error) {
+	bodyStart, bodyEnd, children, err := splitBodyChildren(raw)
+	if err != nil {
+		return nil, 0, err
+	}
+	total := 0
+	for _, child := range children {
+		if child.name == "w:p" {
+			total++
+		}
+	}
+	if to > total {
+		return nil, 0, NewValidationError("index",
+			fmt.Sprintf("paragraph %d out of range (document has %d paragraphs)", to, total))
+	}
+
+	var body bytes.Buffer
+	pos := 0
+	for _, child := range children {
+		if child.name == "w:p" {
+			pos++
+			if pos >= from && pos <= to {
+				if sectPr := paragraphSectPr(child.xml); sectPr != nil {
+					body.WriteString("<w:p><w:pPr>")
+					body.Write(sectPr)
+					body.WriteString("</w:pPr></w:p>")
+				}
+				continue
+			}
+		}
+		body.Write(child.xml)
+	}
+
+	return replaceBodyContent(raw, bodyStart, bodyEnd, body.Bytes()), to - from + 1, nil
+}
+
+// splitBodyChildren returns the offsets of the <w:body> content and its
+// top-level elements.
+func splitBodyChildren(raw []byte) (bodyStart, bodyEnd int, children []xmlChild, err error) {
+	bodyStart, err = findBodyContentStart(raw)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	bodyEnd = bytes.LastIndex(raw, []byte("</w:body>"))
+	if bodyEnd == -1 || bodyEnd < bodyStart {
+		return 0, 0, nil, NewMalformedXMLError("could not find </w:body> tag")
+	}
+	return bodyStart, bodyEnd, splitXMLChildren(raw[bodyStart:bodyEnd]), nil
+}
+
+// replaceBodyContent returns raw with the content between bodyStart and
+// bodyEnd replaced by body.
+func replaceBodyContent(raw []byte, bodyStart, bodyEnd int, body []byte) []byte {
+	var result bytes.Buffer
+	result.Grow(len(raw) - (bodyEnd - bodyStart) + len(body))
+	result.Write(raw[:bodyStart])
+	result.Write(body)
+	result.Write(raw[bodyEnd:])
+	return result.Bytes()
+}
+
+// paragraphSectPr returns the <w:sectPr> of a section-break paragraph, or nil.
+func paragraphSectPr(para []byte) []byte {
+	start := bytes.Index(para, []byte("<w:sectPr"))
+	if start == -1 {
+		return nil
+	}
+	end := bytes.Index(para[start:], []byte("</w:sectPr>"))
+	if end == -1 {
+		return nil
+	}
+	return para[start : start+end+len("</w:sectPr>")]
+}
+
+// deleteNthTable removes the Nth top-level table from the document. Tables
+// nested in cells are removed together with the table that contains them.
 func deleteNthTable(raw []byte, n int) ([]byte, error) {
-	// Find all tables
-	tables := extractTablePattern.FindAllIndex(raw, -1)
+	tables := findTopLevelTables(raw)
 
 	if n > len(tables) {
-		return nil, fmt.Errorf("table %d not found (document has %d tables)", n, len(tables))
+		return nil, NewValidationError("tableIndex", fmt.Sprintf("table %d not found (document has %d tables)", n, len(tables)))
 	}
 
 	tableIdx := tables[n-1]
@@ -203,35 +330,132 @@ func deleteNthTable(raw []byte, n int) ([]byte, error) {
 	return result.Bytes(), nil
 }
 
-// deleteNthImage removes the Nth image (drawing with blip) from the document
+// findTopLevelTables returns the start and end offsets of every <w:tbl> that
+// is not nested inside another table, in document order. Nested tables are
+// skipped by matching each table's closing tag with depth tracking.
+func findTopLevelTables(raw []byte) [][2]int {
+	var tables [][2]int
+	pos := 0
+	for {
+		start := findNextTagStart(raw, pos, "w:tbl")
+		if start == -1 {
+			return tables
+		}
+		closeRel := findMatchingClose(raw[start:], "w:tbl")
+		if closeRel == -1 {
+			return tables
+		}
+		end := start + closeRel + len("</w:tbl>")
+		tables = append(tables, [2]int{start, end})
+		pos = end
+	}
+}
+
+// countTableStarts counts the <w:tbl> start tags in raw at any depth.
+func countTableStarts(raw []byte) int {
+	count := 0
+	pos := 0
+	for {
+		idx := findNextTagStart(raw, pos, "w:tbl")
+		if idx == -1 {
+			return count
+		}
+		count++
+		pos = idx + len("<w:tbl")
+	}
+}
+
+// imageBlipPattern matches the picture reference of an image drawing.
+var imageBlipPattern = regexp.MustCompile(`<a:blip\b[^>]*\br:(?:embed|link)="[^"]*"`)
+
+// findImageDrawings returns the offsets of every <w:drawing> that holds a
+// picture, whether inline (<wp:inline>) or floating (<wp:anchor>), in
+// document order. Only the innermost drawing that directly holds the
+// <pic:pic> counts: a text box or group drawing is never taken for an image
+// inside it, though an image drawing inside a text box is found on its own.
+func findImageDrawings(raw []byte) [][2]int {
+	var drawings [][2]int
+	pos := 0
+	for {
+		start := findNextTagStart(raw, pos, "w:drawing")
+		if start == -1 {
+			return drawings
+		}
+		pos = start + len("<w:drawing")
+		closeRel := findMatchingClose(raw[start:], "w:drawing")
+		if closeRel == -1 {
+			return drawings
+		}
+		end := start + closeRel + len("</w:drawing>")
+		drawing := raw[start:end]
+		if bytes.Contains(drawing, []byte("<pic:pic")) && imageBlipPattern.Match(drawing) &&
+			!imageContainerPattern.Match(drawing) && findNextTagStart(drawing, len("<w:drawing"), "w:drawing") == -1 {
+			drawings = append(drawings, [2]int{start, end})
+		}
+	}
+}
+
+// imageContainerPattern matches the text box and group content of a drawing
+// that may hold pictures without being one.
+var imageContainerPattern = regexp.MustCompile(`<(?:wps:txbx|wpg:grpSp|wpg:wgp)[ >]`)
+
+// findEnclosingElement returns the offsets of the innermost qname element
+// that contains raw[start:end], or false when there is none.
+func findEnclosingElement(raw []byte, start, end int, qname string) (int, int, bool) {
+	for pos := start; pos > 0; {
+		open := max(bytes.LastIndex(raw[:pos], []byte("<"+qname+">")), bytes.LastIndex(raw[:pos], []byte("<"+qname+" ")))
+		if open == -1 {
+			return 0, 0, false
+		}
+		if closeRel := findMatchingClose(raw[open:], qname); closeRel != -1 && open+closeRel >= end {
+			return open, open + closeRel + len("</"+qname+">"), true
+		}
+		pos = open
+	}
+	return 0, 0, false
+}
+
+// paragraphContentPattern matches content that keeps a paragraph alive once
+// an image run has been removed from it.
+var paragraphContentPattern = regexp.MustCompile(`<w:(?:t|drawing|pict|object|sectPr|fldSimple|instrText)[ >]`)
+
+// deleteNthImage removes the Nth image (inline or floating drawing with a
+// blip) from the document. The run holding the image is removed, together
+// with its paragraph when nothing else is left in it.
 func deleteNthImage(raw []byte, n int) ([]byte, error) {
-	// Find all image drawings (wp:inline with a:blip)
-	// Pattern matches the paragraph containing an image
-	imagePattern := regexp.MustCompile(`(?s)<w:p[^>]*>.*?<wp:inline.*?<a:blip[^>]*r:embed="[^"]*"[^>]*>.*?</wp:inline>.*?</w:p>`)
-	images := imagePattern.FindAllIndex(raw, -1)
+	images := findImageDrawings(raw)
 
 	if n > len(images) {
-		return nil, fmt.Errorf("image %d not found (document has %d images)", n, len(images))
+		return nil, NewValidationError("imageIndex", fmt.Sprintf("image %d not found (document has %d images)", n, len(images)))
 	}
 
-	imgIdx := images[n-1]
+	start, end := images[n-1][0], images[n-1][1]
+	if runStart, runEnd, ok := findEnclosingElement(raw, start, end, "w:r"); ok {
+		start, end = runStart, runEnd
+	}
+	if paraStart, paraEnd, ok := findEnclosingElement(raw, start, end, "w:p"); ok {
+		rest := append(append([]byte{}, raw[paraStart:start]...), raw[end:paraEnd]...)
+		if !paragraphContentPattern.Match(rest) {
+			start, end = paraStart, paraEnd
+		}
+	}
 
 	// Build result without this image
 	var result bytes.Buffer
-	result.Write(raw[:imgIdx[0]])
-	result.Write(raw[imgIdx[1]:])
+	result.Write(raw[:start])
+	result.Write(raw[end:])
 
 	return result.Bytes(), nil
 }
 
 // deleteNthChart removes the Nth chart from the document
 func deleteNthChart(raw []byte, n int) ([]byte, error) {
-	// Find all chart drawings (wp:inline with c:chart)
-	chartPattern := regexp.MustCompile(`(?s)<w:p[^>]*>.*?<wp:inline.*?<c:chart[^>]*r:id="[^"]*"[^>]*>.*?</wp:inline>.*?</w:p>`)
+	// Find all chart drawings (inline or floating, with c:chart)
+	chartPattern := regexp.MustCompile(`(?s)<w:p[^>]*>.*?<wp:(?:inline|anchor).*?<c:chart[^>]*r:id="[^"]*"[^>]*>.*?</wp:(?:inline|anchor)>.*?</w:p>`)
 	charts := chartPattern.FindAllIndex(raw, -1)
 
 	if n > len(charts) {
-		return nil, fmt.Errorf("chart %d not found (document has %d charts)", n, len(charts))
+		return nil, NewChartNotFoundError(n)
 	}
 
 	chartIdx := charts[n-1]
@@ -244,34 +468,58 @@ func deleteNthChart(raw []byte, n int) ([]byte, error) {
 	return result.Bytes(), nil
 }
 
-// GetTableCount returns the number of tables in the document
+// GetTableCount returns the number of top-level tables in the document.
+// Tables nested inside table cells are not counted; see GetNestedTableCount.
 func (u *Updater) GetTableCount() (int, error) {
 	if u == nil {
-		return 0, fmt.Errorf("updater is nil")
+		return 0, NewValidationError("updater", "updater is nil")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return 0, fmt.Errorf("read document.xml: %w", err)
+		return 0, NewFileReadError("document.xml", err)
 	}
 
-	tablePattern := regexp.MustCompile(`(?s)<w:tbl>`)
-	tables := tablePattern.FindAllIndex(raw, -1)
+	return len(findTopLevelTables(raw)), nil
+}
 
-	return len(tables), nil
+// GetNestedTableCount returns the number of tables nested, at any depth,
+// inside the top-level table at tableIndex (1-based).
+func (u *Updater) GetNestedTableCount(tableIndex int) (int, error) {
+	if u == nil {
+		return 0, NewValidationError("updater", "updater is nil")
+	}
+	if tableIndex < 1 {
+		return 0, NewValidationError("tableIndex", "table index must be >= 1")
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return 0, NewFileReadError("document.xml", err)
+	}
+
+	tables := findTopLevelTables(raw)
+	if tableIndex > len(tables) {
+		return 0, NewValidationError("tableIndex", fmt.Sprintf("table %d not found (document has %d tables)", tableIndex, len(tables)))
+	}
+	table := tables[tableIndex-1]
+
+	// The first start tag is the table itself.
+	return countTableStarts(raw[table[0]:table[1]]) - 1, nil
 }
 
 // GetParagraphCount returns the number of paragraphs in the document
 func (u *Updater) GetParagraphCount() (int, error) {
 	if u == nil {
-		return 0, fmt.Errorf("updater is nil")
+		return 0, NewValidationError("updater", "updater is nil")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return 0, fmt.Errorf("read document.xml: %w", err)
+		return 0, NewFileReadError("document.xml", err)
 	}
 
 	paraPattern := regexp.MustCompile(`(?s)<w:p[^>]*>`)
@@ -280,22 +528,19 @@ func (u *Updater) GetParagraphCount() (int, error) {
 	return len(paras), nil
 }
 
-// GetImageCount returns the number of images in the document
+// GetImageCount returns the number of images in the document, counting
+// both inline and floating pictures.
 func (u *Updater) GetImageCount() (int, error) {
 	if u == nil {
-		return 0, fmt.Errorf("updater is nil")
+		return 0, NewValidationError("updater", "updater is nil")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return 0, fmt.Errorf("read document.xml: %w", err)
+		return 0, NewFileReadError("document.xml", err)
 	}
 
-	// Count images by counting blip elements
-	blipPattern := regexp.MustCompile(`<a:blip[^>]*r:embed="[^"]*"[^>]*>`)
-	blips := blipPattern.FindAllIndex(raw, -1)
-
-	return len(blips), nil
+	return len(findImageDrawings(raw)), nil
 }
 
@@ -1,6 +1,7 @@
 package godocx
 
 import (
+	"errors"
 	"strings"
 	"testing"
 )
@@ -106,6 +107,78 @@ func TestDeleteParagraphsContaining(t *testing.T) {
 	}
 }
 
+func TestDeleteParagraphByIndex(t *testing.T) {
+	body := `<w:p><w:r><w:t>First</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Second</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Third</w:t></w:r></w:p>` +
+		`<w:sectPr><w:pgSz w:w="12240" w:h="15840"/></w:sectPr>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.DeleteParagraphByIndex(2); err != nil {
+		t.Fatalf("DeleteParagraphByIndex: %v", err)
+	}
+
+	paragraphs, err := u.GetParagraphText()
+	if err != nil {
+		t.Fatalf("GetParagraphText: %v", err)
+	}
+	if len(paragraphs) != 2 || paragraphs[0] != "First" || paragraphs[1] != "Third" {
+		t.Errorf("paragraphs = %q, want [First Third]", paragraphs)
+	}
+	if !strings.Contains(readDocXML(t, u), `<w:sectPr><w:pgSz w:w="12240" w:h="15840"/></w:sectPr></w:body>`) {
+		t.Error("body sectPr was not preserved")
+	}
+}
+
+func TestDeleteParagraphsByRange(t *testing.T) {
+	body := `<w:p><w:r><w:t>One</w:t></w:r></w:p>` +
+		`<w:tbl><w:tr><w:tc><w:p><w:r><w:t>Cell</w:t></w:r></w:p></w:tc></w:tr></w:tbl>` +
+		`<w:p><w:pPr><w:sectPr><w:pgSz w:w="15840" w:h="12240" w:orient="landscape"/></w:sectPr></w:pPr><w:r><w:t>Two</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Three</w:t></w:r></w:p>` +
+		`<w:sectPr><w:pgSz w:w="12240" w:h="15840"/></w:sectPr>`
+
+	t.Run("deletes range", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		count, err := u.DeleteParagraphsByRange(1, 3)
+		if err != nil {
+			t.Fatalf("DeleteParagraphsByRange: %v", err)
+		}
+		if count != 3 {
+			t.Errorf("count = %d, want 3", count)
+		}
+
+		docXML := readDocXML(t, u)
+		for _, gone := range []string{"One", "Two", "Three"} {
+			if strings.Contains(docXML, "<w:t>"+gone+"</w:t>") {
+				t.Errorf("paragraph %q was not deleted", gone)
+			}
+		}
+		for _, keep := range []string{
+			"<w:t>Cell</w:t>",
+			`<w:p><w:pPr><w:sectPr><w:pgSz w:w="15840" w:h="12240" w:orient="landscape"/></w:sectPr></w:pPr></w:p>`,
+			`<w:sectPr><w:pgSz w:w="12240" w:h="15840"/></w:sectPr></w:body>`,
+		} {
+			if !strings.Contains(docXML, keep) {
+				t.Errorf("document missing %q", keep)
+			}
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		for _, r := range [][2]int{{0, 1}, {2, 1}, {3, 4}} {
+			_, err := u.DeleteParagraphsByRange(r[0], r[1])
+			var docxErr *DocxError
+			if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeValidation {
+				t.Errorf("DeleteParagraphsByRange(%d, %d) error = %v, want validation error", r[0], r[1], err)
+			}
+		}
+		if err := u.DeleteParagraphByIndex(4); err == nil {
+			t.Error("expected error for index past the last paragraph")
+		}
+	})
+}
+
 func TestDeleteNthTable(t *testing.T) {
 	docXML := `<w:body>` +
 		`<w:tbl><w:tr><w:tc><w:p><w:r><w:t>Table1</w:t></w:r></w:p></w:tc></w:tr></w:tbl>` +
@@ -152,11 +225,60 @@ func TestDeleteNthTable(t *testing.T) {
 	})
 }
 
+func TestTopLevelTables(t *testing.T) {
+	nested := `<w:tbl><w:tblPr/><w:tr><w:tc><w:p><w:r><w:t>Outer</w:t></w:r></w:p>` +
+		`<w:tbl><w:tr><w:tc><w:tbl><w:tr><w:tc><w:p><w:r><w:t>Deep</w:t></w:r></w:p></w:tc></w:tr></w:tbl><w:p/></w:tc></w:tr></w:tbl>` +
+		`<w:p/></w:tc><w:tc><w:tbl><w:tr><w:tc><w:p><w:r><w:t>Inner2</w:t></w:r></w:p></w:tc></w:tr></w:tbl><w:p/></w:tc></w:tr></w:tbl>`
+	body := nested +
+		`<w:p><w:r><w:t>Paragraph</w:t></w:r></w:p>` +
+		`<w:tbl><w:tr><w:tc><w:p><w:r><w:t>Second</w:t></w:r></w:p></w:tc></w:tr></w:tbl>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	count, err := u.GetTableCount()
+	if err != nil || count != 2 {
+		t.Errorf("GetTableCount() = %d, %v; want 2", count, err)
+	}
+
+	nestedCount, err := u.GetNestedTableCount(1)
+	if err != nil || nestedCount != 3 {
+		t.Errorf("GetNestedTableCount(1) = %d, %v; want 3", nestedCount, err)
+	}
+	nestedCount, err = u.GetNestedTableCount(2)
+	if err != nil || nestedCount != 0 {
+		t.Errorf("GetNestedTableCount(2) = %d, %v; want 0", nestedCount, err)
+	}
+	if _, err := u.GetNestedTableCount(3); err == nil {
+		t.Error("expected error for nonexistent table")
+	}
+
+	if err := u.DeleteTable(2); err != nil {
+		t.Fatalf("DeleteTable(2): %v", err)
+	}
+	docXML := readDocXML(t, u)
+	if strings.Contains(docXML, "Second") {
+		t.Error("expected second top-level table to be removed")
+	}
+	if !strings.Contains(docXML, nested) {
+		t.Error("expected the nested table structure to be untouched")
+	}
+
+	if err := u.DeleteTable(1); err != nil {
+		t.Fatalf("DeleteTable(1): %v", err)
+	}
+	docXML = readDocXML(t, u)
+	if strings.Contains(docXML, "<w:tbl") {
+		t.Errorf("expected all tables to be removed, got %s", docXML)
+	}
+	if !strings.Contains(docXML, "Paragraph") {
+		t.Error("expected paragraph to be kept")
+	}
+}
+
 func TestDeleteNthImage(t *testing.T) {
 	docXML := `<w:body>` +
-		`<w:p><w:r><w:drawing><wp:inline><a:blip r:embed="rId1"/></wp:inline></w:drawing></w:r></w:p>` +
+		`<w:p><w:r><w:drawing><wp:inline><pic:pic><a:blip r:embed="rId1"/></pic:pic></wp:inline></w:drawing></w:r></w:p>` +
 		`<w:p><w:r><w:t>text between</w:t></w:r></w:p>` +
-		`<w:p><w:r><w:drawing><wp:inline><a:blip r:embed="rId2"/></wp:inline></w:drawing></w:r></w:p>` +
+		`<w:p><w:r><w:drawing><wp:inline><pic:pic><a:blip r:embed="rId2"/></pic:pic></wp:inline></w:drawing></w:r></w:p>` +
 		`</w:body>`
 
 	t.Run("delete first image", func(t *testing.T) {
@@ -179,6 +301,32 @@ func TestDeleteNthImage(t *testing.T) {
 			t.Error("expected error for nonexistent image")
 		}
 	})
+
+	t.Run("image inside text box", func(t *testing.T) {
+		textBox := `<w:body>` +
+			`<w:p><w:r><w:drawing><wp:anchor><wps:wsp><wps:txbx><w:txbxContent>` +
+			`<w:p><w:r><w:t>Caption</w:t></w:r><w:r><w:drawing><wp:inline><pic:pic><a:blip r:embed="rId7"/></pic:pic></wp:inline></w:drawing></w:r></w:p>` +
+			`</w:txbxContent></wps:txbx></wps:wsp></wp:anchor></w:drawing></w:r></w:p>` +
+			`<w:p><w:r><w:drawing><wp:anchor><wpg:wgp><wpg:grpSp/><pic:pic><a:blip r:embed="rId8"/></pic:pic></wpg:wgp></wp:anchor></w:drawing></w:r></w:p>` +
+			`</w:body>`
+		if got := len(findImageDrawings([]byte(textBox))); got != 1 {
+			t.Fatalf("found %d images, want only the picture inside the text box", got)
+		}
+		result, err := deleteNthImage([]byte(textBox), 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		rs := string(result)
+		if strings.Contains(rs, "rId7") {
+			t.Error("expected the picture to be removed")
+		}
+		if !strings.Contains(rs, "<wps:txbx><w:txbxContent><w:p><w:r><w:t>Caption</w:t></w:r></w:p>") {
+			t.Errorf("expected the text box and its text to be kept, got %s", rs)
+		}
+		if !strings.Contains(rs, "rId8") {
+			t.Error("expected the group drawing to be kept")
+		}
+	})
 }
 
 func TestDeleteNthChart(t *testing.T) {
@@ -42,6 +42,11 @@
 //   - [PositionBeginning] — prepends to the document body
 //   - [PositionAfterText] — inserts after the paragraph containing Anchor text
 //   - [PositionBeforeText] — inserts before the paragraph containing Anchor text
+//   - [IndexedPosition](n) — inserts after top-level paragraph n (1-based)
+//
+// Paragraph indexes are the same everywhere: the 1-based position among the
+// top-level body paragraphs, as reported by [ParagraphInfo].Index. Paragraphs
+// inside tables are not counted.
 //
 // # Document Properties
 //
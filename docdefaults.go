@@ -0,0 +1,345 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultParaStyle holds the document defaults that paragraphs inherit when
+// neither they nor their style set a value. Zero fields leave the current
+// default unchanged.
+type DefaultParaStyle struct {
+	// FontFamily and FontSize (in half-points, e.g. 22 = 11pt) are stored with
+	// the run defaults, as OOXML keeps fonts on runs; they are the same
+	// settings as in DefaultCharStyle.
+	FontFamily string
+	FontSize   int
+
+	LineSpacing int // Line spacing in 240ths of a line (240 = single, 480 = double)
+	SpaceAfter  int // Space below each paragraph in twips
+	Alignment   ParagraphAlignment
+}
+
+// DefaultCharStyle holds the document defaults that runs inherit when
+// neither they nor their styles set a value. Zero fields leave the current
+// default unchanged.
+type DefaultCharStyle struct {
+	FontFamily string // e.g. "Calibri"
+	FontSize   int    // Font size in half-points (e.g. 22 = 11pt)
+	Color      string // Hex color code, e.g. "1F3864"
+	Language   string // Proofing language, e.g. "en-US"
+}
+
+// docDefaultThemeFontAttrPattern matches the theme font attributes of
+// <w:rFonts> that take precedence over the explicit ascii, hAnsi and cs fonts.
+var docDefaultThemeFontAttrPattern = regexp.MustCompile(`\s+w:(?:asciiTheme|hAnsiTheme|cstheme)="[^"]*"`)
+
+// SetDefaultParagraphStyle sets the paragraph defaults in <w:docDefaults> of
+// word/styles.xml, which apply to every paragraph that does not override
+// them, including those of the Normal style.
+func (u *Updater) SetDefaultParagraphStyle(opts DefaultParaStyle) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	for _, f := range []struct {
+		name  string
+		value int
+	}{
+		{"FontSize", opts.FontSize}, {"LineSpacing", opts.LineSpacing}, {"SpaceAfter", opts.SpaceAfter},
+	} {
+		if f.value < 0 {
+			return NewValidationError(f.name, "must not be negative")
+		}
+	}
+	switch opts.Alignment {
+	case "", ParagraphAlignLeft, ParagraphAlignCenter, ParagraphAlignRight, ParagraphAlignJustify:
+	default:
+		return NewValidationError("Alignment", fmt.Sprintf("unsupported alignment %q", opts.Alignment))
+	}
+
+	return u.updateDocDefaults(func(rPr, pPr []xmlChild) ([]xmlChild, []xmlChild) {
+		rPr = setDefaultRunFont(rPr, opts.FontFamily, opts.FontSize)
+		if opts.LineSpacing > 0 || opts.SpaceAfter > 0 {
+			var spacing strings.Builder
+			spacing.WriteString("<w:spacing")
+			if opts.SpaceAfter > 0 {
+				fmt.Fprintf(&spacing, ` w:after="%d"`, opts.SpaceAfter)
+			}
+			if opts.LineSpacing > 0 {
+				fmt.Fprintf(&spacing, ` w:line="%d" w:lineRule="auto"`, opts.LineSpacing)
+			}
+			spacing.WriteString("/>")
+			pPr = mergeDefaultProperty(pPr, "w:spacing", spacing.String(), pPrChildOrder)
+		}
+		if opts.Alignment != "" {
+			pPr = upsertOrderedChild(pPr, "w:jc", fmt.Sprintf(`<w:jc w:val="%s"/>`, opts.Alignment), pPrChildOrder)
+		}
+		return rPr, pPr
+	})
+}
+
+// SetDefaultCharacterStyle sets the run defaults in <w:docDefaults> of
+// word/styles.xml, which apply to all text that does not override them.
+func (u *Updater) SetDefaultCharacterStyle(opts DefaultCharStyle) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if opts.FontSize < 0 {
+		return NewValidationError("FontSize", "must not be negative")
+	}
+	color := ""
+	if opts.Color != "" {
+		if color = normalizeHexColor(opts.Color); color == "" {
+			return invalidColorError("Color", opts.Color)
+		}
+	}
+
+	return u.updateDocDefaults(func(rPr, pPr []xmlChild) ([]xmlChild, []xmlChild) {
+		rPr = setDefaultRunFont(rPr, opts.FontFamily, opts.FontSize)
+		if color != "" {
+			rPr = upsertOrderedChild(rPr, "w:color", fmt.Sprintf(`<w:color w:val="%s"/>`, color), rPrChildOrder)
+		}
+		if opts.Language != "" {
+			// Keep the East Asian and complex script languages.
+			rPr = mergeDefaultProperty(rPr, "w:lang", fmt.Sprintf(`<w:lang w:val="%s"/>`, xmlEscape(opts.Language)), rPrChildOrder)
+		}
+		return rPr, pPr
+	})
+}
+
+// GetDefaultParagraphStyle returns the paragraph defaults of the document.
+// FontFamily is empty when the defaults use the theme fonts.
+func (u *Updater) GetDefaultParagraphStyle() (DefaultParaStyle, error) {
+	if u == nil {
+		return DefaultParaStyle{}, NewValidationError("updater", "updater is nil")
+	}
+	rPr, pPr, err := u.readDocDefaults()
+	if err != nil {
+		return DefaultParaStyle{}, err
+	}
+
+	char := parseDefaultCharStyle(rPr)
+	style := DefaultParaStyle{FontFamily: char.FontFamily, FontSize: char.FontSize}
+	for _, child := range pPr {
+		attrs := defaultPropertyAttrs(child)
+		switch child.name {
+		case "w:spacing":
+			style.SpaceAfter, _ = strconv.Atoi(attrs["w:after"])
+			if rule := attrs["w:lineRule"]; rule == "" || rule == "auto" {
+				style.LineSpacing, _ = strconv.Atoi(attrs["w:line"])
+			}
+		case "w:jc":
+			style.Alignment = ParagraphAlignment(attrs["w:val"])
+		}
+	}
+	return style, nil
+}
+
+// GetDefaultCharacterStyle returns the run defaults of the document.
+// FontFamily is empty when the defaults use the theme fonts.
+func (u *Updater) GetDefaultCharacterStyle() (DefaultCharStyle, error) {
+	if u == nil {
+		return DefaultCharStyle{}, NewValidationError("updater", "updater is nil")
+	}
+	rPr, _, err := u.readDocDefaults()
+	if err != nil {
+		return DefaultCharStyle{}, err
+	}
+	return parseDefaultCharStyle(rPr), nil
+}
+
+// setDefaultRunFont sets the default font family and size on the run
+// defaults rPr, dropping theme fonts that would take precedence.
+func setDefaultRunFont(rPr []xmlChild, family string, size int) []xmlChild {
+	if family != "" {
+		escaped := xmlEscape(family)
+		fonts := fmt.Sprintf(`<w:rFonts w:ascii="%s" w:hAnsi="%s" w:cs="%s"/>`, escaped, escaped, escaped)
+		for _, child := range rPr {
+			if child.name == "w:rFonts" {
+				base := docDefaultThemeFontAttrPattern.ReplaceAllString(string(child.xml), "")
+				fonts = mergeEmptyElementAttrs(base, fonts)
+			}
+		}
+		rPr = upsertOrderedChild(rPr, "w:rFonts", fonts, rPrChildOrder)
+	}
+	if size > 0 {
+		rPr = upsertOrderedChild(rPr, "w:sz", fmt.Sprintf(`<w:sz w:val="%d"/>`, size), rPrChildOrder)
+		rPr = upsertOrderedChild(rPr, "w:szCs", fmt.Sprintf(`<w:szCs w:val="%d"/>`, size), rPrChildOrder)
+	}
+	return rPr
+}
+
+// mergeDefaultProperty sets elemXML on children, keeping the attributes of
+// the element it replaces that elemXML does not set.
+func mergeDefaultProperty(children []xmlChild, qname, elemXML string, order []string) []xmlChild {
+	for _, child := range children {
+		if child.name == qname {
+			elemXML = mergeEmptyElementAttrs(string(child.xml), elemXML)
+		}
+	}
+	return upsertOrderedChild(children, qname, elemXML, order)
+}
+
+// parseDefaultCharStyle reads the settings of DefaultCharStyle from the run
+// defaults rPr.
+func parseDefaultCharStyle(rPr []xmlChild) DefaultCharStyle {
+	var style DefaultCharStyle
+	for _, child := range rPr {
+		attrs := defaultPropertyAttrs(child)
+		switch child.name {
+		case "w:rFonts":
+			if attrs["w:asciiTheme"] == "" {
+				style.FontFamily = attrs["w:ascii"]
+			}
+		case "w:sz":
+			style.FontSize, _ = strconv.Atoi(attrs["w:val"])
+		case "w:color":
+			if val := attrs["w:val"]; val != "auto" {
+				style.Color = val
+			}
+		case "w:lang":
+			style.Language = attrs["w:val"]
+		}
+	}
+	return style
+}
+
+// defaultPropertyAttrs returns the attributes of a property element.
+func defaultPropertyAttrs(child xmlChild) map[string]string {
+	openTag := child.xml
+	if end := bytes.IndexByte(openTag, '>'); end != -1 {
+		openTag = openTag[:end]
+	}
+	attrs := make(map[string]string)
+	for _, m := range xmlAttrPattern.FindAllSubmatch(openTag, -1) {
+		attrs[string(m[1])] = xmlUnescape(string(m[2]))
+	}
+	return attrs
+}
+
+// readDocDefaults returns the children of the run and paragraph properties
+// in <w:docDefaults>, or none when styles.xml does not define them.
+func (u *Updater) readDocDefaults() (rPr, pPr []xmlChild, err error) {
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "styles.xml"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, NewFileReadError("styles.xml", err)
+	}
+	start, end, err := findDocDefaults(raw)
+	if err != nil || start == -1 {
+		return nil, nil, err
+	}
+	rPr, pPr = splitDocDefaults(raw[start:end])
+	return rPr, pPr, nil
+}
+
+// updateDocDefaults rewrites <w:docDefaults> in styles.xml with the run and
+// paragraph properties returned by fn, creating styles.xml or the element
+// when missing.
+func (u *Updater) updateDocDefaults(fn func(rPr, pPr []xmlChild) ([]xmlChild, []xmlChild)) error {
+	stylesPath := filepath.Join(u.tempDir, "word", "styles.xml")
+	raw, err := os.ReadFile(stylesPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return NewFileReadError("styles.xml", err)
+		}
+		rPr, pPr := fn(nil, nil)
+		if err := atomicWriteFile(stylesPath, generateStylesDocument([]byte(generateDocDefaultsXML(rPr, pPr))), 0o644); err != nil {
+			return NewXMLWriteError("styles.xml", err)
+		}
+		if err := u.ensureStylesRelationship(); err != nil {
+			return fmt.Errorf("ensure styles relationship: %w", err)
+		}
+		return nil
+	}
+
+	start, end, err := findDocDefaults(raw)
+	if err != nil {
+		return err
+	}
+	var rPr, pPr []xmlChild
+	if start == -1 {
+		// CT_Styles lists docDefaults first.
+		rootStart := findNextTagStart(raw, 0, "w:styles")
+		if rootStart == -1 {
+			return NewMalformedXMLError("could not find <w:styles> root element")
+		}
+		rootEnd := bytes.IndexByte(raw[rootStart:], '>')
+		if rootEnd == -1 {
+			return NewMalformedXMLError("malformed <w:styles> element")
+		}
+		start = rootStart + rootEnd + 1
+		end = start
+	} else {
+		rPr, pPr = splitDocDefaults(raw[start:end])
+	}
+
+	rPr, pPr = fn(rPr, pPr)
+	updated := spliceBytes(raw, start, end, generateDocDefaultsXML(rPr, pPr))
+	if bytes.Equal(updated, raw) {
+		return nil
+	}
+	if err := atomicWriteFile(stylesPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("styles.xml", err)
+	}
+	return nil
+}
+
+// findDocDefaults returns the bounds of the <w:docDefaults> element of
+// stylesXML, or -1 when there is none.
+func findDocDefaults(stylesXML []byte) (start, end int, err error) {
+	start = findNextTagStart(stylesXML, 0, "w:docDefaults")
+	if start == -1 {
+		return -1, -1, nil
+	}
+	tagEnd := bytes.IndexByte(stylesXML[start:], '>')
+	if tagEnd == -1 {
+		return 0, 0, NewMalformedXMLError("malformed docDefaults element")
+	}
+	if stylesXML[start+tagEnd-1] == '/' {
+		return start, start + tagEnd + 1, nil
+	}
+	closeIdx := findMatchingClose(stylesXML[start:], "w:docDefaults")
+	if closeIdx == -1 {
+		return 0, 0, NewMalformedXMLError("malformed docDefaults: closing tag not found")
+	}
+	return start, start + closeIdx + len("</w:docDefaults>"), nil
+}
+
+// splitDocDefaults returns the children of the <w:rPr> in <w:rPrDefault> and
+// of the <w:pPr> in <w:pPrDefault> of a <w:docDefaults> element.
+func splitDocDefaults(docDefaults []byte) (rPr, pPr []xmlChild) {
+	for _, def := range elementChildren(xmlChild{name: "w:docDefaults", xml: docDefaults}) {
+		for _, props := range elementChildren(def) {
+			switch {
+			case def.name == "w:rPrDefault" && props.name == "w:rPr":
+				rPr = elementChildren(props)
+			case def.name == "w:pPrDefault" && props.name == "w:pPr":
+				pPr = elementChildren(props)
+			}
+		}
+	}
+	return rPr, pPr
+}
+
+// generateDocDefaultsXML creates a <w:docDefaults> element holding the run
+// and paragraph default properties, in the CT_DocDefaults order.
+func generateDocDefaultsXML(rPr, pPr []xmlChild) string {
+	var buf strings.Builder
+	buf.WriteString("<w:docDefaults>")
+	if len(rPr) > 0 {
+		buf.WriteString("<w:rPrDefault>" + wrapProperties("w:rPr", rPr) + "</w:rPrDefault>")
+	}
+	if len(pPr) > 0 {
+		buf.WriteString("<w:pPrDefault>" + wrapProperties("w:pPr", pPr) + "</w:pPrDefault>")
+	}
+	buf.WriteString("</w:docDefaults>")
+	return buf.String()
+}
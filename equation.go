@@ -0,0 +1,418 @@
+package godocx
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// mathNamespaces are the root declarations required by Office Math (OMML).
+var mathNamespaces = []xmlNamespace{
+	{"m", "http://schemas.openxmlformats.org/officeDocument/2006/math"},
+}
+
+// EquationOptions defines options for equation insertion.
+type EquationOptions struct {
+	// Inline places the equation within the text flow (<m:oMath>). When false
+	// the equation is a centered display equation (<m:oMathPara>) in its own
+	// paragraph.
+	//
+	// With PositionAfterText/PositionBeforeText an inline equation is inserted
+	// into the paragraph containing the anchor text, next to the run holding it.
+	Inline bool
+
+	// Position where to insert the equation
+	Position InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+}
+
+// InsertEquation converts a LaTeX math expression to Office Math and inserts it.
+//
+// The supported LaTeX subset covers \frac{a}{b}, superscripts (^), subscripts
+// (_), \sqrt{x} and \sqrt[n]{x}, braces for grouping, Greek letters (\alpha,
+// \Omega, ...) and common operators and symbols (\times, \pm, \leq, \infty,
+// \sum, \int, ...). Unsupported commands return an error.
+func (u *Updater) InsertEquation(latex string, opts EquationOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if strings.TrimSpace(latex) == "" {
+		return NewValidationError("latex", "equation cannot be empty")
+	}
+
+	omml, err := latexToOMML(latex)
+	if err != nil {
+		return err
+	}
+	return u.insertMath("<m:oMath>"+omml+"</m:oMath>", opts)
+}
+
+// InsertOMML inserts a caller-supplied Office Math expression. omml may be a
+// complete <m:oMathPara> or <m:oMath> element, or the content of an <m:oMath>
+// element, using the "m:" prefix.
+func (u *Updater) InsertOMML(omml string, opts EquationOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	omml = strings.TrimSpace(omml)
+	if omml == "" {
+		return NewValidationError("omml", "equation cannot be empty")
+	}
+	if err := checkWellFormedXML(omml); err != nil {
+		return NewValidationError("omml", fmt.Sprintf("malformed OMML: %v", err))
+	}
+
+	switch {
+	case strings.HasPrefix(omml, "<m:oMathPara"):
+		if opts.Inline {
+			return NewValidationError("omml", "an <m:oMathPara> display equation cannot be inserted inline")
+		}
+		return u.insertMathXML(omml, opts)
+	case strings.HasPrefix(omml, "<m:oMath>") || strings.HasPrefix(omml, "<m:oMath "):
+		return u.insertMath(omml, opts)
+	default:
+		return u.insertMath("<m:oMath>"+omml+"</m:oMath>", opts)
+	}
+}
+
+// insertMath inserts an <m:oMath> element, wrapping it in <m:oMathPara> for
+// display equations.
+func (u *Updater) insertMath(oMath string, opts EquationOptions) error {
+	if !opts.Inline {
+		oMath = "<m:oMathPara>" + oMath + "</m:oMathPara>"
+	}
+	return u.insertMathXML(oMath, opts)
+}
+
+// insertMathXML writes mathXML into document.xml, either as a new paragraph or,
+// for inline equations with a text anchor, into the anchor paragraph.
+func (u *Updater) insertMathXML(mathXML string, opts EquationOptions) error {
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	raw, err = ensureDocumentNamespaces(raw, mathNamespaces)
+	if err != nil {
+		return err
+	}
+
+	var updated []byte
+	anchored := opts.Position == PositionAfterText || opts.Position == PositionBeforeText
+	if opts.Inline && anchored {
+		if opts.Anchor == "" {
+			return NewValidationError("anchor", "anchor text required for position-based insertion")
+		}
+		paraStart, paraEnd, err := findParagraphRangeByAnchor(raw, opts.Anchor)
+		if err != nil {
+			return err
+		}
+		para, err := insertRunInParagraph(raw[paraStart:paraEnd], []byte(mathXML), opts.Anchor, opts.Position)
+		if err != nil {
+			return err
+		}
+		updated = make([]byte, 0, len(raw)+len(mathXML))
+		updated = append(updated, raw[:paraStart]...)
+		updated = append(updated, para...)
+		updated = append(updated, raw[paraEnd:]...)
+	} else {
+		updated, err = insertElementAtPosition(raw, []byte("<w:p>"+mathXML+"</w:p>"), opts.Position, opts.Anchor)
+		if err != nil {
+			return fmt.Errorf("insert equation: %w", err)
+		}
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// checkWellFormedXML reports whether fragment is a well-formed XML fragment.
+func checkWellFormedXML(fragment string) error {
+	d := xml.NewDecoder(strings.NewReader("<root>" + fragment + "</root>"))
+	for {
+		_, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// latexSymbols maps supported LaTeX commands to the characters they produce.
+var latexSymbols = map[string]string{
+	// Lowercase Greek
+	"alpha": "α", "beta": "β", "gamma": "γ", "delta": "δ", "epsilon": "ϵ", "varepsilon": "ε",
+	"zeta": "ζ", "eta": "η", "theta": "θ", "vartheta": "ϑ", "iota": "ι", "kappa": "κ",
+	"lambda": "λ", "mu": "μ", "nu": "ν", "xi": "ξ", "pi": "π", "varpi": "ϖ", "rho": "ρ",
+	"varrho": "ϱ", "sigma": "σ", "varsigma": "ς", "tau": "τ", "upsilon": "υ", "phi": "ϕ",
+	"varphi": "φ", "chi": "χ", "psi": "ψ", "omega": "ω",
+	// Uppercase Greek
+	"Gamma": "Γ", "Delta": "Δ", "Theta": "Θ", "Lambda": "Λ", "Xi": "Ξ", "Pi": "Π",
+	"Sigma": "Σ", "Upsilon": "Υ", "Phi": "Φ", "Psi": "Ψ", "Omega": "Ω",
+	// Operators and relations
+	"times": "×", "cdot": "⋅", "div": "÷", "pm": "±", "mp": "∓", "ast": "∗",
+	"leq": "≤", "le": "≤", "geq": "≥", "ge": "≥", "neq": "≠", "ne": "≠",
+	"approx": "≈", "equiv": "≡", "sim": "∼", "propto": "∝",
+	"in": "∈", "notin": "∉", "subset": "⊂", "subseteq": "⊆", "cup": "∪", "cap": "∩",
+	"to": "→", "rightarrow": "→", "leftarrow": "←", "Rightarrow": "⇒", "Leftrightarrow": "⇔",
+	// Large operators and miscellaneous symbols
+	"sum": "∑", "prod": "∏", "int": "∫", "oint": "∮", "partial": "∂", "nabla": "∇",
+	"infty": "∞", "forall": "∀", "exists": "∃", "emptyset": "∅", "degree": "°",
+	"ldots": "…", "cdots": "⋯",
+	// Spacing and delimiters
+	",": " ", ";": " ", "quad": " ", "qquad": "  ", " ": " ",
+	"{": "{", "}": "}", "%": "%", "&": "&", "_": "_", "#": "#",
+	"left": "", "right": "",
+}
+
+// latexFunctions are upright function names such as \sin.
+var latexFunctions = map[string]bool{
+	"sin": true, "cos": true, "tan": true, "cot": true, "sec": true, "csc": true,
+	"log": true, "ln": true, "exp": true, "lim": true, "max": true, "min": true,
+}
+
+// latexParser converts a subset of LaTeX math to OMML.
+type latexParser struct {
+	src []rune
+	pos int
+}
+
+// latexToOMML converts a LaTeX math expression to the content of an <m:oMath> element.
+func latexToOMML(latex string) (string, error) {
+	p := &latexParser{src: []rune(latex)}
+	out, err := p.parseSequence(false)
+	if err != nil {
+		return "", err
+	}
+	if p.pos < len(p.src) {
+		return "", NewValidationError("latex", fmt.Sprintf("unexpected %q at position %d", p.src[p.pos], p.pos))
+	}
+	return out, nil
+}
+
+// parseSequence parses atoms until the end of input or, inside a group, the
+// closing brace.
+func (p *latexParser) parseSequence(inGroup bool) (string, error) {
+	var buf strings.Builder
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.src) {
+			if inGroup {
+				return "", NewValidationError("latex", "missing closing brace")
+			}
+			return buf.String(), nil
+		}
+		switch p.src[p.pos] {
+		case '}':
+			if !inGroup {
+				return "", NewValidationError("latex", fmt.Sprintf("unexpected '}' at position %d", p.pos))
+			}
+			p.pos++
+			return buf.String(), nil
+		case '^', '_':
+			// Script without a base, e.g. "^2x".
+			scripted, err := p.parseScripts("")
+			if err != nil {
+				return "", err
+			}
+			buf.WriteString(scripted)
+			continue
+		}
+
+		base, err := p.parseAtom()
+		if err != nil {
+			return "", err
+		}
+		scripted, err := p.parseScripts(base)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(scripted)
+	}
+}
+
+// parseScripts wraps base in a superscript and/or subscript structure when the
+// next tokens are ^ or _.
+func (p *latexParser) parseScripts(base string) (string, error) {
+	var sup, sub string
+	var hasSup, hasSub bool
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.src) || (p.src[p.pos] != '^' && p.src[p.pos] != '_') {
+			break
+		}
+		op := p.src[p.pos]
+		p.pos++
+		arg, err := p.parseArgument()
+		if err != nil {
+			return "", err
+		}
+		if op == '^' {
+			if hasSup {
+				return "", NewValidationError("latex", "double superscript")
+			}
+			sup, hasSup = arg, true
+		} else {
+			if hasSub {
+				return "", NewValidationError("latex", "double subscript")
+			}
+			sub, hasSub = arg, true
+		}
+	}
+
+	switch {
+	case hasSup && hasSub:
+		return "<m:sSubSup>" + ommlElement("e", base) + ommlElement("sub", sub) + ommlElement("sup", sup) + "</m:sSubSup>", nil
+	case hasSup:
+		return "<m:sSup>" + ommlElement("e", base) + ommlElement("sup", sup) + "</m:sSup>", nil
+	case hasSub:
+		return "<m:sSub>" + ommlElement("e", base) + ommlElement("sub", sub) + "</m:sSub>", nil
+	default:
+		return base, nil
+	}
+}
+
+// parseArgument parses a braced group or a single atom, as used by \frac,
+// \sqrt and scripts.
+func (p *latexParser) parseArgument() (string, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.src) {
+		return "", NewValidationError("latex", "missing argument at end of expression")
+	}
+	if p.src[p.pos] == '{' {
+		p.pos++
+		return p.parseSequence(true)
+	}
+	return p.parseAtom()
+}
+
+// parseAtom parses a single character, group or command.
+func (p *latexParser) parseAtom() (string, error) {
+	ch := p.src[p.pos]
+	switch ch {
+	case '{':
+		p.pos++
+		return p.parseSequence(true)
+	case '\\':
+		return p.parseCommand()
+	}
+
+	p.pos++
+	// Keep multi-digit numbers together unless a script follows the last digit.
+	if isDigit(ch) {
+		start := p.pos - 1
+		for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+			if p.pos+1 < len(p.src) && (p.src[p.pos+1] == '^' || p.src[p.pos+1] == '_') {
+				break
+			}
+			p.pos++
+		}
+		return ommlRun(string(p.src[start:p.pos]), false), nil
+	}
+	return ommlRun(string(ch), false), nil
+}
+
+// parseCommand parses a backslash command.
+func (p *latexParser) parseCommand() (string, error) {
+	p.pos++ // skip '\'
+	if p.pos >= len(p.src) {
+		return "", NewValidationError("latex", "incomplete command at end of expression")
+	}
+
+	start := p.pos
+	if isLetter(p.src[p.pos]) {
+		for p.pos < len(p.src) && isLetter(p.src[p.pos]) {
+			p.pos++
+		}
+	} else {
+		p.pos++
+	}
+	name := string(p.src[start:p.pos])
+
+	switch name {
+	case "frac":
+		num, err := p.parseArgument()
+		if err != nil {
+			return "", err
+		}
+		den, err := p.parseArgument()
+		if err != nil {
+			return "", err
+		}
+		return "<m:f>" + ommlElement("num", num) + ommlElement("den", den) + "</m:f>", nil
+
+	case "sqrt":
+		var degree string
+		p.skipSpaces()
+		if p.pos < len(p.src) && p.src[p.pos] == '[' {
+			end := p.pos + 1
+			for end < len(p.src) && p.src[end] != ']' {
+				end++
+			}
+			if end >= len(p.src) {
+				return "", NewValidationError("latex", "missing ']' in \\sqrt")
+			}
+			inner, err := latexToOMML(string(p.src[p.pos+1 : end]))
+			if err != nil {
+				return "", err
+			}
+			degree = inner
+			p.pos = end + 1
+		}
+		radicand, err := p.parseArgument()
+		if err != nil {
+			return "", err
+		}
+		if degree == "" {
+			return `<m:rad><m:radPr><m:degHide m:val="1"/></m:radPr><m:deg/>` + ommlElement("e", radicand) + "</m:rad>", nil
+		}
+		return "<m:rad>" + ommlElement("deg", degree) + ommlElement("e", radicand) + "</m:rad>", nil
+	}
+
+	if latexFunctions[name] {
+		return ommlRun(name, true), nil
+	}
+	if sym, ok := latexSymbols[name]; ok {
+		if sym == "" {
+			return "", nil
+		}
+		return ommlRun(sym, false), nil
+	}
+	return "", NewValidationError("latex", fmt.Sprintf("unsupported LaTeX command \\%s", name))
+}
+
+func (p *latexParser) skipSpaces() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t' || p.src[p.pos] == '\n') {
+		p.pos++
+	}
+}
+
+// ommlRun creates a math run; upright runs are used for function names.
+func ommlRun(text string, upright bool) string {
+	if upright {
+		return `<m:r><m:rPr><m:sty m:val="p"/></m:rPr><m:t>` + xmlEscapeContent(text) + `</m:t></m:r>`
+	}
+	return `<m:r><m:t>` + xmlEscapeContent(text) + `</m:t></m:r>`
+}
+
+// ommlElement wraps content in <m:name>, using the empty-element form when
+// content is empty.
+func ommlElement(name, content string) string {
+	if content == "" {
+		return "<m:" + name + "/>"
+	}
+	return "<m:" + name + ">" + content + "</m:" + name + ">"
+}
+
+func isDigit(r rune) bool { return r >= '0' && r <= '9' }
@@ -0,0 +1,133 @@
+package godocx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLatexToOMML(t *testing.T) {
+	tests := []struct {
+		name  string
+		latex string
+		want  string
+	}{
+		{"plain", "x+1", `<m:r><m:t>x</m:t></m:r><m:r><m:t>+</m:t></m:r><m:r><m:t>1</m:t></m:r>`},
+		{"superscript", "x^2", `<m:sSup><m:e><m:r><m:t>x</m:t></m:r></m:e><m:sup><m:r><m:t>2</m:t></m:r></m:sup></m:sSup>`},
+		{"subscript group", "a_{ij}", `<m:sSub><m:e><m:r><m:t>a</m:t></m:r></m:e><m:sub><m:r><m:t>i</m:t></m:r><m:r><m:t>j</m:t></m:r></m:sub></m:sSub>`},
+		{"sub and sup", "x_1^2", `<m:sSubSup><m:e><m:r><m:t>x</m:t></m:r></m:e><m:sub><m:r><m:t>1</m:t></m:r></m:sub><m:sup><m:r><m:t>2</m:t></m:r></m:sup></m:sSubSup>`},
+		{"fraction", `\frac{1}{2}`, `<m:f><m:num><m:r><m:t>1</m:t></m:r></m:num><m:den><m:r><m:t>2</m:t></m:r></m:den></m:f>`},
+		{"square root", `\sqrt{x}`, `<m:rad><m:radPr><m:degHide m:val="1"/></m:radPr><m:deg/><m:e><m:r><m:t>x</m:t></m:r></m:e></m:rad>`},
+		{"nth root", `\sqrt[3]{8}`, `<m:rad><m:deg><m:r><m:t>3</m:t></m:r></m:deg><m:e><m:r><m:t>8</m:t></m:r></m:e></m:rad>`},
+		{"greek", `\alpha\Omega`, `<m:r><m:t>α</m:t></m:r><m:r><m:t>Ω</m:t></m:r>`},
+		{"number", "3.14", `<m:r><m:t>3.14</m:t></m:r>`},
+		{"number with script", "10^3", `<m:r><m:t>1</m:t></m:r><m:sSup><m:e><m:r><m:t>0</m:t></m:r></m:e><m:sup><m:r><m:t>3</m:t></m:r></m:sup></m:sSup>`},
+		{"function", `\sin x`, `<m:r><m:rPr><m:sty m:val="p"/></m:rPr><m:t>sin</m:t></m:r><m:r><m:t>x</m:t></m:r>`},
+		{"escaped", "a<b", `<m:r><m:t>a</m:t></m:r><m:r><m:t>&lt;</m:t></m:r><m:r><m:t>b</m:t></m:r>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := latexToOMML(tt.latex)
+			if err != nil {
+				t.Fatalf("latexToOMML(%q): %v", tt.latex, err)
+			}
+			if got != tt.want {
+				t.Errorf("latexToOMML(%q) =\n%s\nwant\n%s", tt.latex, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLatexToOMML_Errors(t *testing.T) {
+	for _, latex := range []string{`\frac{1}`, `{x`, `x}`, `\unknown`, `x^`, `x^1^2`, `\sqrt[3{x}`} {
+		if _, err := latexToOMML(latex); err == nil {
+			t.Errorf("expected error for %q", latex)
+		}
+	}
+}
+
+func TestInsertEquation_Display(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Quadratic formula:</w:t></w:r></w:p>`))
+
+	err := u.InsertEquation(`x=\frac{-b\pm\sqrt{b^2-4ac}}{2a}`, EquationOptions{
+		Position: PositionAfterText,
+		Anchor:   "Quadratic formula:",
+	})
+	if err != nil {
+		t.Fatalf("InsertEquation: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `xmlns:m="http://schemas.openxmlformats.org/officeDocument/2006/math"`) {
+		t.Error("expected math namespace on document root")
+	}
+	if !strings.Contains(docXML, `</w:p><w:p><m:oMathPara><m:oMath><m:r><m:t>x</m:t></m:r>`) {
+		t.Errorf("expected display equation paragraph after anchor, got: %s", docXML)
+	}
+	if !strings.Contains(docXML, `<m:r><m:t>±</m:t></m:r><m:rad>`) {
+		t.Errorf("expected plus-minus followed by radical, got: %s", docXML)
+	}
+}
+
+func TestInsertEquation_InlineInAnchorParagraph(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t xml:space="preserve">The area is </w:t></w:r></w:p>`))
+
+	if err := u.InsertEquation(`\pi r^2`, EquationOptions{Inline: true, Position: PositionAfterText, Anchor: "The area is"}); err != nil {
+		t.Fatalf("InsertEquation: %v", err)
+	}
+	// A second equation must not declare the namespace again.
+	if err := u.InsertEquation(`E=mc^2`, EquationOptions{Position: PositionEnd}); err != nil {
+		t.Fatalf("InsertEquation: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `The area is </w:t></w:r><m:oMath><m:r><m:t>π</m:t></m:r>`) {
+		t.Errorf("expected inline equation inside the anchor paragraph, got: %s", docXML)
+	}
+	if n := strings.Count(docXML, `xmlns:m=`); n != 1 {
+		t.Errorf("expected one math namespace declaration, got %d", n)
+	}
+}
+
+func TestInsertOMML(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	if err := u.InsertOMML(`<m:r><m:t>y</m:t></m:r>`, EquationOptions{Position: PositionEnd}); err != nil {
+		t.Fatalf("InsertOMML: %v", err)
+	}
+	if err := u.InsertOMML(`<m:oMath><m:r><m:t>z</m:t></m:r></m:oMath>`, EquationOptions{Inline: true, Position: PositionEnd}); err != nil {
+		t.Fatalf("InsertOMML: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:p><m:oMathPara><m:oMath><m:r><m:t>y</m:t></m:r></m:oMath></m:oMathPara></w:p>`) {
+		t.Errorf("expected wrapped display equation, got: %s", docXML)
+	}
+	if !strings.Contains(docXML, `<w:p><m:oMath><m:r><m:t>z</m:t></m:r></m:oMath></w:p>`) {
+		t.Errorf("expected inline equation, got: %s", docXML)
+	}
+
+	if err := u.InsertOMML(`<m:oMath><m:r>`, EquationOptions{}); err == nil {
+		t.Error("expected error for malformed OMML")
+	}
+	if err := u.InsertOMML(`<m:oMathPara><m:oMath/></m:oMathPara>`, EquationOptions{Inline: true}); err == nil {
+		t.Error("expected error for inline oMathPara")
+	}
+}
+
+func TestInsertEquation_ParseErrorNotWrappedTwice(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertEquation(`\unknown`, EquationOptions{Position: PositionEnd})
+	var docxErr *DocxError
+	if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeValidation {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if docxErr.Message != `unsupported LaTeX command \unknown` {
+		t.Errorf("unexpected message %q", docxErr.Message)
+	}
+	if n := strings.Count(err.Error(), string(ErrCodeValidation)); n != 1 {
+		t.Errorf("error %q has the validation code %d times, want once", err, n)
+	}
+}
@@ -1,6 +1,10 @@
 package godocx
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+)
 
 // ErrorCode represents specific error conditions
 type ErrorCode string
@@ -12,6 +16,7 @@ const (
 	ErrCodeFileCorrupted    ErrorCode = "FILE_CORRUPTED"
 	ErrCodeFileTooLarge     ErrorCode = "FILE_TOO_LARGE"
 	ErrCodePermissionDenied ErrorCode = "PERMISSION_DENIED"
+	ErrCodeFileWrite        ErrorCode = "FILE_WRITE"
 
 	// Chart-related errors
 	ErrCodeChartNotFound    ErrorCode = "CHART_NOT_FOUND"
@@ -60,8 +65,17 @@ const (
 
 	// Header/Footer errors
 	ErrCodeHeaderFooter ErrorCode = "HEADER_FOOTER"
+
+	// Property errors
+	ErrCodePropertyNotFound ErrorCode = "PROPERTY_NOT_FOUND"
 )
 
+// Error implements the error interface so that codes can be used as targets
+// for errors.Is, e.g. errors.Is(err, ErrCodeValidation).
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
 // DocxError provides structured error information
 type DocxError struct {
 	Code    ErrorCode
@@ -83,6 +97,17 @@ func (e *DocxError) Unwrap() error {
 	return e.Err
 }
 
+// Is reports whether target is an ErrorCode or *DocxError with the same Code.
+func (e *DocxError) Is(target error) bool {
+	switch t := target.(type) {
+	case ErrorCode:
+		return e.Code == t
+	case *DocxError:
+		return t != nil && e.Code == t.Code
+	}
+	return false
+}
+
 // WithContext adds context to the error
 func (e *DocxError) WithContext(key string, value any) *DocxError {
 	if e.Context == nil {
@@ -133,7 +158,7 @@ func NewImageFormatError(format string) error {
 func NewTextNotFoundError(text string) error {
 	return &DocxError{
 		Code:    ErrCodeTextNotFound,
-		Message: "text not found in document",
+		Message: fmt.Sprintf("text %q not found in document", text),
 		Context: map[string]any{"text": text},
 	}
 }
@@ -158,6 +183,15 @@ func NewXMLParseError(file string, err error) error {
 	}
 }
 
+// NewMalformedXMLError creates an error for a package part whose XML is
+// missing an element or closing tag that the editing code relies on.
+func NewMalformedXMLError(reason string) error {
+	return &DocxError{
+		Code:    ErrCodeXMLParse,
+		Message: reason,
+	}
+}
+
 // NewXMLWriteError creates an error for XML writing failures
 func NewXMLWriteError(file string, err error) error {
 	return &DocxError{
@@ -186,6 +220,15 @@ func NewValidationError(field, reason string) error {
 	}
 }
 
+// NewPropertyNotFoundError creates an error for a missing custom property
+func NewPropertyNotFoundError(name string) error {
+	return &DocxError{
+		Code:    ErrCodePropertyNotFound,
+		Message: fmt.Sprintf("custom property %q not found", name),
+		Context: map[string]any{"name": name},
+	}
+}
+
 // NewFileNotFoundError creates an error for missing files
 func NewFileNotFoundError(path string) error {
 	return &DocxError{
@@ -195,6 +238,39 @@ func NewFileNotFoundError(path string) error {
 	}
 }
 
+// NewFileReadError creates an error for a file that could not be read.
+// Missing files are reported as ErrCodeFileNotFound.
+func NewFileReadError(file string, err error) error {
+	code := ErrCodeInvalidFile
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		code = ErrCodeFileNotFound
+	case errors.Is(err, fs.ErrPermission):
+		code = ErrCodePermissionDenied
+	}
+	return &DocxError{
+		Code:    code,
+		Message: "failed to read " + file,
+		Err:     err,
+		Context: map[string]any{"file": file},
+	}
+}
+
+// NewFileWriteError creates an error for a file or directory that could not
+// be created or written.
+func NewFileWriteError(file string, err error) error {
+	code := ErrCodeFileWrite
+	if errors.Is(err, fs.ErrPermission) {
+		code = ErrCodePermissionDenied
+	}
+	return &DocxError{
+		Code:    code,
+		Message: "failed to write " + file,
+		Err:     err,
+		Context: map[string]any{"file": file},
+	}
+}
+
 // NewInvalidFileError creates an error for invalid DOCX files
 func NewInvalidFileError(reason string, err error) error {
 	return &DocxError{
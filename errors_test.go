@@ -3,6 +3,9 @@ package godocx
 import (
 	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -295,3 +298,154 @@ func TestNewHeaderFooterError(t *testing.T) {
 		t.Errorf("expected HEADER_FOOTER, got %s", docxErr.Code)
 	}
 }
+
+func TestDocxError_Is(t *testing.T) {
+	err := fmt.Errorf("insert paragraph: %w", NewValidationError("text", "text cannot be empty"))
+
+	if !errors.Is(err, ErrCodeValidation) {
+		t.Error("errors.Is should match the error code through wrapping")
+	}
+	if errors.Is(err, ErrCodeXMLParse) {
+		t.Error("errors.Is should not match a different error code")
+	}
+	if !errors.Is(err, &DocxError{Code: ErrCodeValidation}) {
+		t.Error("errors.Is should match a *DocxError target with the same code")
+	}
+	if errors.Is(err, (*DocxError)(nil)) {
+		t.Error("errors.Is should not match a nil *DocxError target")
+	}
+}
+
+func TestNewFileReadError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want ErrorCode
+	}{
+		{fs.ErrNotExist, ErrCodeFileNotFound},
+		{&fs.PathError{Op: "open", Path: "x", Err: fs.ErrPermission}, ErrCodePermissionDenied},
+		{fmt.Errorf("disk failure"), ErrCodeInvalidFile},
+	}
+	for _, tt := range tests {
+		err := NewFileReadError("document.xml", tt.err)
+		var docxErr *DocxError
+		if !errors.As(err, &docxErr) || docxErr.Code != tt.want {
+			t.Errorf("NewFileReadError(%v) = %v, want code %s", tt.err, err, tt.want)
+		}
+		if !errors.Is(err, tt.err) {
+			t.Errorf("NewFileReadError(%v) should wrap the cause", tt.err)
+		}
+	}
+}
+
+// TestPublicMethodErrorCodes checks that failures surfaced by public methods
+// carry a *DocxError with the code for their category.
+func TestPublicMethodErrorCodes(t *testing.T) {
+	chartData := ChartData{
+		Categories: []string{"A"},
+		Series:     []SeriesData{{Name: "S", Values: []float64{1}}},
+	}
+	tests := []struct {
+		name string
+		run  func(t *testing.T, u *Updater) error
+		want ErrorCode
+	}{
+		{
+			name: "nil updater",
+			run: func(t *testing.T, u *Updater) error {
+				var nilU *Updater
+				return nilU.InsertParagraph(ParagraphOptions{Text: "x"})
+			},
+			want: ErrCodeValidation,
+		},
+		{
+			name: "invalid options",
+			run: func(t *testing.T, u *Updater) error {
+				return u.InsertTable(TableOptions{})
+			},
+			want: ErrCodeValidation,
+		},
+		{
+			name: "missing document part",
+			run: func(t *testing.T, u *Updater) error {
+				if err := os.Remove(filepath.Join(u.tempDir, "word", "document.xml")); err != nil {
+					t.Fatal(err)
+				}
+				return u.InsertParagraph(ParagraphOptions{Text: "x", Position: PositionEnd})
+			},
+			want: ErrCodeFileNotFound,
+		},
+		{
+			name: "missing chart part",
+			run: func(t *testing.T, u *Updater) error {
+				return u.UpdateChart(3, chartData)
+			},
+			want: ErrCodeFileNotFound,
+		},
+		{
+			name: "malformed document",
+			run: func(t *testing.T, u *Updater) error {
+				docPath := filepath.Join(u.tempDir, "word", "document.xml")
+				if err := os.WriteFile(docPath, []byte(`<w:document><w:body>`), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return u.InsertParagraph(ParagraphOptions{Text: "x", Position: PositionEnd})
+			},
+			want: ErrCodeXMLParse,
+		},
+		{
+			name: "malformed relationships",
+			run: func(t *testing.T, u *Updater) error {
+				relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
+				if err := os.WriteFile(relsPath, []byte(`<Relationships>`), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return u.InsertHyperlink("link", "https://example.com", HyperlinkOptions{Position: PositionEnd})
+			},
+			want: ErrCodeXMLParse,
+		},
+		{
+			name: "missing chart relationship",
+			run: func(t *testing.T, u *Updater) error {
+				if err := u.InsertChart(ChartOptions{
+					Position:   PositionEnd,
+					Categories: []string{"A"},
+					Series:     []SeriesOptions{{Name: "S", Values: []float64{1}}},
+				}); err != nil {
+					t.Fatalf("InsertChart: %v", err)
+				}
+				relsPath := filepath.Join(u.tempDir, "word", "charts", "_rels", "chart1.xml.rels")
+				if err := os.WriteFile(relsPath, []byte(`<Relationships/>`), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return u.UpdateChart(1, chartData)
+			},
+			want: ErrCodeRelationship,
+		},
+		{
+			name: "missing anchor",
+			run: func(t *testing.T, u *Updater) error {
+				return u.InsertParagraph(ParagraphOptions{Text: "x", Position: PositionAfterText, Anchor: "nowhere"})
+			},
+			want: ErrCodeTextNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := NewBlank()
+			if err != nil {
+				t.Fatalf("NewBlank: %v", err)
+			}
+			defer u.Cleanup()
+
+			err = tt.run(t, u)
+			var docxErr *DocxError
+			if !errors.As(err, &docxErr) {
+				t.Fatalf("expected *DocxError, got %T: %v", err, err)
+			}
+			if !errors.Is(err, tt.want) {
+				t.Errorf("error %v does not match code %s", err, tt.want)
+			}
+		})
+	}
+}
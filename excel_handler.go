@@ -17,12 +17,12 @@ import (
 func updateEmbeddedWorkbook(xlsxPath string, data ChartData) error {
 	xlsxRaw, err := os.ReadFile(xlsxPath)
 	if err != nil {
-		return fmt.Errorf("read embedded workbook: %w", err)
+		return NewFileReadError("embedded workbook", err)
 	}
 
 	zr, err := zip.NewReader(bytes.NewReader(xlsxRaw), int64(len(xlsxRaw)))
 	if err != nil {
-		return fmt.Errorf("open workbook zip: %w", err)
+		return NewInvalidFileError("embedded workbook is not a valid zip archive", err)
 	}
 
 	entries := make(map[string][]byte, len(zr.File))
@@ -31,17 +31,17 @@ func updateEmbeddedWorkbook(xlsxPath string, data ChartData) error {
 	for _, f := range zr.File {
 		rc, err := f.Open()
 		if err != nil {
-			return fmt.Errorf("open workbook entry %s: %w", f.Name, err)
+			return NewInvalidFileError(fmt.Sprintf("open workbook entry %s", f.Name), err)
 		}
 
 		content, err := io.ReadAll(rc)
 		if err != nil {
 			rc.Close()
-			return fmt.Errorf("read workbook entry %s: %w", f.Name, err)
+			return NewInvalidFileError(fmt.Sprintf("read workbook entry %s", f.Name), err)
 		}
 
 		if err := rc.Close(); err != nil {
-			return fmt.Errorf("close workbook entry %s: %w", f.Name, err)
+			return NewInvalidFileError(fmt.Sprintf("close workbook entry %s", f.Name), err)
 		}
 
 		entries[f.Name] = content
@@ -54,7 +54,7 @@ func updateEmbeddedWorkbook(xlsxPath string, data ChartData) error {
 		return err
 	}
 	if worksheetPath == "" {
-		return fmt.Errorf("no worksheet found in embedded workbook")
+		return NewMalformedXMLError("no worksheet found in embedded workbook")
 	}
 
 	useSharedStrings := false
@@ -80,21 +80,21 @@ func updateEmbeddedWorkbook(xlsxPath string, data ChartData) error {
 	for _, name := range names {
 		w, err := zw.Create(name)
 		if err != nil {
-			return fmt.Errorf("create workbook entry %s: %w", name, err)
+			return NewFileWriteError(name, err)
 		}
 		if _, err := w.Write(entries[name]); err != nil {
-			return fmt.Errorf("write workbook entry %s: %w", name, err)
+			return NewFileWriteError(name, err)
 		}
 	}
 	if err := zw.Close(); err != nil {
-		return fmt.Errorf("close workbook writer: %w", err)
+		return NewFileWriteError("embedded workbook", err)
 	}
 
 	if err := os.MkdirAll(filepath.Dir(xlsxPath), 0o755); err != nil {
-		return fmt.Errorf("create workbook parent dir: %w", err)
+		return NewFileWriteError("workbook parent dir", err)
 	}
 	if err := atomicWriteFile(xlsxPath, buf.Bytes(), 0o644); err != nil {
-		return fmt.Errorf("write embedded workbook: %w", err)
+		return NewFileWriteError("embedded workbook", err)
 	}
 
 	return nil
@@ -120,7 +120,7 @@ func resolveWorksheetPath(entries map[string][]byte, names []string) (string, er
 
 	var wb workbookXML
 	if err := xml.Unmarshal(workbookRaw, &wb); err != nil {
-		return "", fmt.Errorf("parse workbook.xml: %w", err)
+		return "", NewXMLParseError("workbook.xml", err)
 	}
 	if len(wb.Sheets) == 0 {
 		return firstWorksheetPath(names), nil
@@ -128,7 +128,7 @@ func resolveWorksheetPath(entries map[string][]byte, names []string) (string, er
 
 	var rels relationships
 	if err := xml.Unmarshal(relsRaw, &rels); err != nil {
-		return "", fmt.Errorf("parse workbook.xml.rels: %w", err)
+		return "", NewXMLParseError("workbook.xml.rels", err)
 	}
 
 	target := ""
@@ -163,7 +163,7 @@ func updateWorksheetXML(existing []byte, data ChartData, useSharedStrings bool,
 
 	reSheetData := regexp.MustCompile(`(?s)<sheetData\b[^>]*>.*?</sheetData>`)
 	if !reSheetData.MatchString(updated) {
-		return nil, fmt.Errorf("worksheet has no sheetData element")
+		return nil, NewMalformedXMLError("worksheet has no sheetData element")
 	}
 	updated = reSheetData.ReplaceAllString(updated, newSheetData)
 
@@ -219,7 +219,7 @@ func stringCell(ref, value string, useSharedStrings bool, stringIndexes map[stri
 }
 
 func inlineStringCell(ref, value string) string {
-	return `<c r="` + ref + `" t="inlineStr"><is><t>` + xmlEscape(value) + `</t></is></c>`
+	return `<c r="` + ref + `" t="inlineStr"><is><t>` + xmlEscapeContent(value) + `</t></is></c>`
 }
 
 func sharedStringCell(ref string, stringIndex int) string {
@@ -247,7 +247,7 @@ type sharedStringItem struct {
 func updateSharedStringsXML(existing []byte, data ChartData) ([]byte, map[string]int, error) {
 	var parsed sharedStringTable
 	if err := xml.Unmarshal(existing, &parsed); err != nil {
-		return nil, nil, fmt.Errorf("parse sharedStrings.xml: %w", err)
+		return nil, nil, NewXMLParseError("sharedStrings.xml", err)
 	}
 	if parsed.XMLNS == "" {
 		parsed.XMLNS = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
@@ -281,7 +281,7 @@ func updateSharedStringsXML(existing []byte, data ChartData) ([]byte, map[string
 
 	encoded, err := xml.Marshal(parsed)
 	if err != nil {
-		return nil, nil, fmt.Errorf("marshal sharedStrings.xml: %w", err)
+		return nil, nil, NewXMLWriteError("sharedStrings.xml", err)
 	}
 
 	out := append([]byte(xml.Header), encoded...)
@@ -1,6 +1,7 @@
 package godocx
 
 import (
+	"errors"
 	"strings"
 	"testing"
 )
@@ -285,7 +286,8 @@ func TestMergeTableCellsVertical_Validation(t *testing.T) {
 func TestInsertComment_EmptyText(t *testing.T) {
 	u := &Updater{}
 	err := u.InsertComment(CommentOptions{Anchor: "text"})
-	if err == nil || !strings.Contains(err.Error(), "comment text cannot be empty") {
+	var docxErr *DocxError
+	if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeValidation || !strings.Contains(err.Error(), "comment text cannot be empty") {
 		t.Error("expected empty text error")
 	}
 }
@@ -293,7 +295,8 @@ func TestInsertComment_EmptyText(t *testing.T) {
 func TestInsertComment_EmptyAnchor(t *testing.T) {
 	u := &Updater{}
 	err := u.InsertComment(CommentOptions{Text: "comment"})
-	if err == nil || !strings.Contains(err.Error(), "anchor text cannot be empty") {
+	var docxErr *DocxError
+	if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeValidation || !strings.Contains(err.Error(), "anchor text cannot be empty") {
 		t.Error("expected empty anchor error")
 	}
 }
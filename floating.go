@@ -0,0 +1,93 @@
+package godocx
+
+import "fmt"
+
+// PosRelative selects the reference frame of a floating drawing's offset.
+type PosRelative string
+
+const (
+	// PosRelativePage measures from the page edge
+	PosRelativePage PosRelative = "page"
+	// PosRelativeMargin measures from the page margin
+	PosRelativeMargin PosRelative = "margin"
+	// PosRelativeParagraph measures from the top of the anchor paragraph (vertical only)
+	PosRelativeParagraph PosRelative = "paragraph"
+	// PosRelativeLine measures from the line holding the anchor (vertical only)
+	PosRelativeLine PosRelative = "line"
+	// PosRelativeColumn measures from the edge of the text column (horizontal only)
+	PosRelativeColumn PosRelative = "column"
+)
+
+// TextWrapKind selects how body text flows around a floating drawing.
+type TextWrapKind string
+
+const (
+	// TextWrapSquare wraps text around the drawing's bounding box
+	TextWrapSquare TextWrapKind = "square"
+	// TextWrapTight wraps text closely around the drawing's outline
+	TextWrapTight TextWrapKind = "tight"
+	// TextWrapNone places the drawing over the text without wrapping
+	TextWrapNone TextWrapKind = "none"
+)
+
+// FloatingOptions positions a drawing as a floating <wp:anchor> object that
+// text wraps around, instead of an inline object in its own paragraph.
+type FloatingOptions struct {
+	// Offset of the drawing in EMUs from the reference frames below
+	HorizontalPosition int
+	VerticalPosition   int
+
+	// Reference frames (default: PosRelativeColumn / PosRelativeParagraph)
+	HorizontalRelative PosRelative
+	VerticalRelative   PosRelative
+
+	// TextWrap selects the wrapping style (default: TextWrapSquare)
+	TextWrap TextWrapKind
+
+	// DistanceFromText is the gap between the drawing and the surrounding
+	// text in EMUs on all sides (0 for Word's default of 0.125" left and right)
+	DistanceFromText int
+
+	// AllowOverlap lets other floating objects overlap this one
+	AllowOverlap bool
+}
+
+func validateFloatingOptions(field string, opts *FloatingOptions) error {
+	switch opts.HorizontalRelative {
+	case "", PosRelativePage, PosRelativeMargin, PosRelativeColumn:
+	default:
+		return NewValidationError(field+".HorizontalRelative", fmt.Sprintf("unsupported horizontal reference %q", opts.HorizontalRelative))
+	}
+	switch opts.VerticalRelative {
+	case "", PosRelativePage, PosRelativeMargin, PosRelativeParagraph, PosRelativeLine:
+	default:
+		return NewValidationError(field+".VerticalRelative", fmt.Sprintf("unsupported vertical reference %q", opts.VerticalRelative))
+	}
+	switch opts.TextWrap {
+	case "", TextWrapSquare, TextWrapTight, TextWrapNone:
+	default:
+		return NewValidationError(field+".TextWrap", fmt.Sprintf("unsupported text wrap %q", opts.TextWrap))
+	}
+	if opts.DistanceFromText < 0 {
+		return NewValidationError(field+".DistanceFromText", "distance from text cannot be negative")
+	}
+	return nil
+}
+
+// floatingOptionsPosition converts FloatingOptions into the anchor layout
+// used by generateAnchorDrawingXML.
+func floatingOptionsPosition(opts *FloatingOptions, width, height int) floatingPosition {
+	pos := floatingPosition{
+		relativeFromH: string(PosRelativeColumn), relativeFromV: string(PosRelativeParagraph),
+		x: opts.HorizontalPosition, y: opts.VerticalPosition,
+		width: width, height: height,
+		wrap: opts.TextWrap, distText: opts.DistanceFromText, noOverlap: !opts.AllowOverlap,
+	}
+	if opts.HorizontalRelative != "" {
+		pos.relativeFromH = string(opts.HorizontalRelative)
+	}
+	if opts.VerticalRelative != "" {
+		pos.relativeFromV = string(opts.VerticalRelative)
+	}
+	return pos
+}
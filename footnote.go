@@ -33,13 +33,13 @@ type EndnoteOptions struct {
 // containing the anchor text.
 func (u *Updater) InsertFootnote(opts FootnoteOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if opts.Text == "" {
-		return fmt.Errorf("footnote text cannot be empty")
+		return NewValidationError("text", "footnote text cannot be empty")
 	}
 	if opts.Anchor == "" {
-		return fmt.Errorf("anchor text cannot be empty")
+		return NewValidationError("anchor", "anchor text cannot be empty")
 	}
 
 	// Ensure footnotes.xml exists and get next footnote ID
@@ -66,13 +66,13 @@ func (u *Updater) InsertFootnote(opts FootnoteOptions) error {
 // containing the anchor text.
 func (u *Updater) InsertEndnote(opts EndnoteOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if opts.Text == "" {
-		return fmt.Errorf("endnote text cannot be empty")
+		return NewValidationError("text", "endnote text cannot be empty")
 	}
 	if opts.Anchor == "" {
-		return fmt.Errorf("anchor text cannot be empty")
+		return NewValidationError("anchor", "anchor text cannot be empty")
 	}
 
 	// Ensure endnotes.xml exists and get next endnote ID
@@ -102,7 +102,7 @@ func (u *Updater) ensureFootnotesXML() (int, error) {
 		// Create initial footnotes.xml with separator footnotes
 		content := generateInitialFootnotesXML()
 		if err := os.WriteFile(fnPath, content, 0o644); err != nil {
-			return 0, fmt.Errorf("write footnotes.xml: %w", err)
+			return 0, NewXMLWriteError("footnotes.xml", err)
 		}
 
 		// Add relationship
@@ -121,7 +121,7 @@ func (u *Updater) ensureFootnotesXML() (int, error) {
 	// Read existing file and find the next available ID
 	raw, err := os.ReadFile(fnPath)
 	if err != nil {
-		return 0, fmt.Errorf("read footnotes.xml: %w", err)
+		return 0, NewFileReadError("footnotes.xml", err)
 	}
 
 	return getNextNoteID(raw, "footnote"), nil
@@ -134,7 +134,7 @@ func (u *Updater) ensureEndnotesXML() (int, error) {
 	if _, err := os.Stat(enPath); os.IsNotExist(err) {
 		content := generateInitialEndnotesXML()
 		if err := os.WriteFile(enPath, content, 0o644); err != nil {
-			return 0, fmt.Errorf("write endnotes.xml: %w", err)
+			return 0, NewXMLWriteError("endnotes.xml", err)
 		}
 
 		if err := u.addNoteRelationship("endnotes.xml", "endnotes"); err != nil {
@@ -150,7 +150,7 @@ func (u *Updater) ensureEndnotesXML() (int, error) {
 
 	raw, err := os.ReadFile(enPath)
 	if err != nil {
-		return 0, fmt.Errorf("read endnotes.xml: %w", err)
+		return 0, NewFileReadError("endnotes.xml", err)
 	}
 
 	return getNextNoteID(raw, "endnote"), nil
@@ -217,7 +217,7 @@ func (u *Updater) addFootnoteContent(id int, text string) error {
 	fnPath := filepath.Join(u.tempDir, "word", "footnotes.xml")
 	raw, err := os.ReadFile(fnPath)
 	if err != nil {
-		return fmt.Errorf("read footnotes.xml: %w", err)
+		return NewFileReadError("footnotes.xml", err)
 	}
 
 	footnoteXML := generateFootnoteEntry(id, text)
@@ -226,7 +226,7 @@ func (u *Updater) addFootnoteContent(id int, text string) error {
 	closeTag := []byte("</w:footnotes>")
 	closeIdx := bytes.LastIndex(raw, closeTag)
 	if closeIdx == -1 {
-		return fmt.Errorf("could not find </w:footnotes> tag")
+		return NewMalformedXMLError("could not find </w:footnotes> tag")
 	}
 
 	result := make([]byte, 0, len(raw)+len(footnoteXML)+1)
@@ -236,7 +236,7 @@ func (u *Updater) addFootnoteContent(id int, text string) error {
 	result = append(result, raw[closeIdx:]...)
 
 	if err := os.WriteFile(fnPath, result, 0o644); err != nil {
-		return fmt.Errorf("write footnotes.xml: %w", err)
+		return NewXMLWriteError("footnotes.xml", err)
 	}
 
 	return nil
@@ -247,7 +247,7 @@ func (u *Updater) addEndnoteContent(id int, text string) error {
 	enPath := filepath.Join(u.tempDir, "word", "endnotes.xml")
 	raw, err := os.ReadFile(enPath)
 	if err != nil {
-		return fmt.Errorf("read endnotes.xml: %w", err)
+		return NewFileReadError("endnotes.xml", err)
 	}
 
 	endnoteXML := generateEndnoteEntry(id, text)
@@ -255,7 +255,7 @@ func (u *Updater) addEndnoteContent(id int, text string) error {
 	closeTag := []byte("</w:endnotes>")
 	closeIdx := bytes.LastIndex(raw, closeTag)
 	if closeIdx == -1 {
-		return fmt.Errorf("could not find </w:endnotes> tag")
+		return NewMalformedXMLError("could not find </w:endnotes> tag")
 	}
 
 	result := make([]byte, 0, len(raw)+len(endnoteXML)+1)
@@ -265,7 +265,7 @@ func (u *Updater) addEndnoteContent(id int, text string) error {
 	result = append(result, raw[closeIdx:]...)
 
 	if err := os.WriteFile(enPath, result, 0o644); err != nil {
-		return fmt.Errorf("write endnotes.xml: %w", err)
+		return NewXMLWriteError("endnotes.xml", err)
 	}
 
 	return nil
@@ -287,7 +287,7 @@ func generateFootnoteEntry(id int, text string) []byte {
 
 	// Space + text
 	buf.WriteString("<w:r>")
-	buf.WriteString(fmt.Sprintf(`<w:t xml:space="preserve"> %s</w:t>`, xmlEscape(text)))
+	buf.WriteString(fmt.Sprintf(`<w:t xml:space="preserve"> %s</w:t>`, xmlEscapeContent(text)))
 	buf.WriteString("</w:r>")
 
 	buf.WriteString("</w:p>")
@@ -310,7 +310,7 @@ func generateEndnoteEntry(id int, text string) []byte {
 	buf.WriteString("</w:r>")
 
 	buf.WriteString("<w:r>")
-	buf.WriteString(fmt.Sprintf(`<w:t xml:space="preserve"> %s</w:t>`, xmlEscape(text)))
+	buf.WriteString(fmt.Sprintf(`<w:t xml:space="preserve"> %s</w:t>`, xmlEscapeContent(text)))
 	buf.WriteString("</w:r>")
 
 	buf.WriteString("</w:p>")
@@ -325,7 +325,7 @@ func (u *Updater) insertNoteReference(anchor string, noteID int, noteType string
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	// Find the paragraph containing the anchor text
@@ -356,7 +356,7 @@ func (u *Updater) insertNoteReference(anchor string, noteID int, noteType string
 	result = append(result, raw[insertPos:]...)
 
 	if err := os.WriteFile(docPath, result, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
@@ -388,7 +388,7 @@ func (u *Updater) addNoteRelationship(filename, relType string) error {
 	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
 	raw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return fmt.Errorf("read rels: %w", err)
+		return NewFileReadError("rels", err)
 	}
 
 	content := string(raw)
@@ -411,7 +411,7 @@ func (u *Updater) addNoteRelationship(filename, relType string) error {
 	content = strings.Replace(content, "</Relationships>", newRel+"</Relationships>", 1)
 
 	if err := os.WriteFile(relsPath, []byte(content), 0o644); err != nil {
-		return fmt.Errorf("write rels: %w", err)
+		return NewXMLWriteError("rels", err)
 	}
 
 	return nil
@@ -422,7 +422,7 @@ func (u *Updater) addNoteContentType(filename, noteType string) error {
 	ctPath := filepath.Join(u.tempDir, "[Content_Types].xml")
 	raw, err := os.ReadFile(ctPath)
 	if err != nil {
-		return fmt.Errorf("read content types: %w", err)
+		return NewFileReadError("content types", err)
 	}
 
 	content := string(raw)
@@ -442,7 +442,7 @@ func (u *Updater) addNoteContentType(filename, noteType string) error {
 	content = strings.Replace(content, "</Types>", override+"</Types>", 1)
 
 	if err := os.WriteFile(ctPath, []byte(content), 0o644); err != nil {
-		return fmt.Errorf("write content types: %w", err)
+		return NewFileWriteError("content types", err)
 	}
 
 	return nil
@@ -131,7 +131,7 @@ func TestFootnoteEntryXMLEscaping(t *testing.T) {
 	if !strings.Contains(xml, "&lt;special&gt;") {
 		t.Error("expected escaped angle brackets")
 	}
-	if !strings.Contains(xml, "&quot;quotes&quot;") {
-		t.Error("expected escaped quotes")
+	if !strings.Contains(xml, `"quotes"`) {
+		t.Error("expected quotes to be kept in element content")
 	}
 }
@@ -125,8 +125,8 @@ func TestGolden_GenerateParagraphXML_XMLEscaping(t *testing.T) {
 	if !strings.Contains(result, "&amp;") {
 		t.Errorf("expected escaped ampersand in: %s", result)
 	}
-	if !strings.Contains(result, "&quot;chars&quot;") {
-		t.Errorf("expected escaped quotes in: %s", result)
+	if !strings.Contains(result, `"chars"`) {
+		t.Errorf("expected quotes kept in element content in: %s", result)
 	}
 }
 
@@ -149,7 +149,7 @@ func TestGolden_GenerateTOCXML(t *testing.T) {
 	}
 
 	// Verify TOC field instruction
-	if !strings.Contains(result, `TOC \o &quot;1-3&quot;`) {
+	if !strings.Contains(result, `TOC \o "1-3"`) {
 		t.Errorf("expected TOC field instruction in: %s", result)
 	}
 
@@ -421,8 +421,20 @@ func TestGolden_NormalizeHexColor(t *testing.T) {
 		{"ff0000", "FF0000"},
 		{"#FF0000", "FF0000"},
 		{"#ff0000", "FF0000"},
+		{" 00ff7f ", "00FF7F"},
+		{"F00", "FF0000"},
+		{"#abc", "AABBCC"},
+		{"rgb(255,0,0)", "FF0000"},
+		{"RGB( 18, 52 , 86 )", "123456"},
 		{"invalid", ""},
+		{"gg0000", ""},
+		{"#gg0", ""},
 		{"FFFF", ""},
+		{"##FF0000", ""},
+		{"rgb(256,0,0)", ""},
+		{"rgb(-1,0,0)", ""},
+		{"rgb(255,0)", ""},
+		{"rgba(255,0,0,1)", ""},
 		{"", ""},
 	}
 
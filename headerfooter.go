@@ -1,11 +1,13 @@
 package godocx
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"unicode"
 )
 
 // HeaderType defines the type of header
@@ -101,7 +103,7 @@ func DefaultFooterOptions() FooterOptions {
 // SetHeader sets or creates a header for the document
 func (u *Updater) SetHeader(content HeaderFooterContent, opts HeaderOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	// Determine header filename based on type
@@ -149,7 +151,7 @@ func (u *Updater) SetHeader(content HeaderFooterContent, opts HeaderOptions) err
 // SetFooter sets or creates a footer for the document
 func (u *Updater) SetFooter(content HeaderFooterContent, opts FooterOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	// Determine footer filename based on type
@@ -252,7 +254,7 @@ func (u *Updater) generateThreeColumnTable(content HeaderFooterContent) string {
 	buf.WriteString("<w:tcPr><w:tcW w:w=\"3000\" w:type=\"dxa\"/></w:tcPr>")
 	if content.LeftText != "" {
 		buf.WriteString("<w:p><w:pPr><w:jc w:val=\"left\"/></w:pPr>")
-		buf.WriteString(fmt.Sprintf("<w:r><w:t>%s</w:t></w:r>", xmlEscape(content.LeftText)))
+		buf.WriteString(fmt.Sprintf("<w:r><w:t>%s</w:t></w:r>", xmlEscapeContent(content.LeftText)))
 		buf.WriteString("</w:p>")
 	} else {
 		buf.WriteString("<w:p/>")
@@ -264,7 +266,7 @@ func (u *Updater) generateThreeColumnTable(content HeaderFooterContent) string {
 	buf.WriteString("<w:tcPr><w:tcW w:w=\"3000\" w:type=\"dxa\"/></w:tcPr>")
 	if content.CenterText != "" {
 		buf.WriteString("<w:p><w:pPr><w:jc w:val=\"center\"/></w:pPr>")
-		buf.WriteString(fmt.Sprintf("<w:r><w:t>%s</w:t></w:r>", xmlEscape(content.CenterText)))
+		buf.WriteString(fmt.Sprintf("<w:r><w:t>%s</w:t></w:r>", xmlEscapeContent(content.CenterText)))
 		buf.WriteString("</w:p>")
 	} else {
 		buf.WriteString("<w:p/>")
@@ -276,7 +278,7 @@ func (u *Updater) generateThreeColumnTable(content HeaderFooterContent) string {
 	buf.WriteString("<w:tcPr><w:tcW w:w=\"3000\" w:type=\"dxa\"/></w:tcPr>")
 	if content.RightText != "" {
 		buf.WriteString("<w:p><w:pPr><w:jc w:val=\"right\"/></w:pPr>")
-		buf.WriteString(fmt.Sprintf("<w:r><w:t>%s</w:t></w:r>", xmlEscape(content.RightText)))
+		buf.WriteString(fmt.Sprintf("<w:r><w:t>%s</w:t></w:r>", xmlEscapeContent(content.RightText)))
 		buf.WriteString("</w:p>")
 	} else {
 		buf.WriteString("<w:p/>")
@@ -289,47 +291,63 @@ func (u *Updater) generateThreeColumnTable(content HeaderFooterContent) string {
 	return buf.String()
 }
 
-// generatePageNumberParagraph creates a paragraph with page number field
+// generatePageNumberParagraph creates a centered paragraph with page number
+// fields. In format a standalone X becomes a { PAGE } field and a standalone
+// Y a { NUMPAGES } field; everything else is literal text, so "Page X of Y",
+// "X / Y" and "X" all work. An empty format shows the page number only.
 func (u *Updater) generatePageNumberParagraph(format string) string {
-	var buf strings.Builder
+	if format == "" {
+		format = "X"
+	}
 
+	var buf bytes.Buffer
 	buf.WriteString("<w:p>")
 	buf.WriteString("<w:pPr><w:jc w:val=\"center\"/></w:pPr>")
-	buf.WriteString("<w:r>")
 
-	if format != "" {
-		// Custom format (e.g., "Page X of Y")
-		parts := strings.Split(format, "X")
-		if len(parts) > 0 && parts[0] != "" {
-			buf.WriteString(fmt.Sprintf("<w:t>%s</w:t></w:r><w:r>", xmlEscape(parts[0])))
+	writeText := func(text string) {
+		if text != "" {
+			buf.WriteString("<w:r>")
+			writeRunTextWithControls(&buf, text)
+			buf.WriteString("</w:r>")
 		}
 	}
+	isLetter := func(runes []rune, i int) bool {
+		return i >= 0 && i < len(runes) && unicode.IsLetter(runes[i])
+	}
 
-	// Page number field
-	buf.WriteString("<w:fldChar w:fldCharType=\"begin\"/>")
-	buf.WriteString("</w:r><w:r>")
-	buf.WriteString("<w:instrText>PAGE</w:instrText>")
-	buf.WriteString("</w:r><w:r>")
-	buf.WriteString("<w:fldChar w:fldCharType=\"end\"/>")
-
-	if format != "" && strings.Contains(format, "Y") {
-		// Add " of Y" (total pages)
-		buf.WriteString("</w:r><w:r>")
-		buf.WriteString("<w:t> of </w:t>")
-		buf.WriteString("</w:r><w:r>")
-		buf.WriteString("<w:fldChar w:fldCharType=\"begin\"/>")
-		buf.WriteString("</w:r><w:r>")
-		buf.WriteString("<w:instrText>NUMPAGES</w:instrText>")
-		buf.WriteString("</w:r><w:r>")
-		buf.WriteString("<w:fldChar w:fldCharType=\"end\"/>")
+	runes := []rune(format)
+	start := 0
+	for i, r := range runes {
+		instr := ""
+		switch r {
+		case 'X':
+			instr = "PAGE"
+		case 'Y':
+			instr = "NUMPAGES"
+		}
+		if instr == "" || isLetter(runes, i-1) || isLetter(runes, i+1) {
+			continue
+		}
+		writeText(string(runes[start:i]))
+		buf.WriteString(generatePageFieldXML(instr))
+		start = i + 1
 	}
+	writeText(string(runes[start:]))
 
-	buf.WriteString("</w:r>")
 	buf.WriteString("</w:p>")
-
 	return buf.String()
 }
 
+// generatePageFieldXML creates the runs of a PAGE or NUMPAGES field, with
+// "1" as the cached result until Word updates the field.
+func generatePageFieldXML(instr string) string {
+	return `<w:r><w:fldChar w:fldCharType="begin"/></w:r>` +
+		`<w:r><w:instrText xml:space="preserve"> ` + instr + ` </w:instrText></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="separate"/></w:r>` +
+		`<w:r><w:t>1</w:t></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="end"/></w:r>`
+}
+
 // generateDateParagraph creates a paragraph with date field
 func (u *Updater) generateDateParagraph(format string) string {
 	var buf strings.Builder
@@ -360,7 +378,7 @@ func (u *Updater) addHeaderFooterRelationship(filename, hdrFtrType string) (stri
 
 	raw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return "", fmt.Errorf("read relationships: %w", err)
+		return "", NewFileReadError("relationships", err)
 	}
 
 	content := string(raw)
@@ -395,7 +413,7 @@ func (u *Updater) addHeaderFooterRelationship(filename, hdrFtrType string) (stri
 
 	// Write updated relationships
 	if err := atomicWriteFile(relsPath, []byte(content), 0o644); err != nil {
-		return "", fmt.Errorf("write relationships: %w", err)
+		return "", NewFileWriteError("relationships", err)
 	}
 
 	return relID, nil
@@ -407,7 +425,7 @@ func (u *Updater) updateDocumentForHeaderFooter(hdrFtrType string, hdrFtr string
 
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document: %w", err)
+		return NewFileReadError("document", err)
 	}
 
 	content := string(raw)
@@ -429,7 +447,7 @@ func (u *Updater) updateDocumentForHeaderFooter(hdrFtrType string, hdrFtr string
 
 	// Write updated document
 	if err := atomicWriteFile(docPath, []byte(content), 0o644); err != nil {
-		return fmt.Errorf("write document: %w", err)
+		return NewFileWriteError("document", err)
 	}
 
 	return nil
@@ -511,7 +529,7 @@ func (u *Updater) addHeaderFooterContentType(filename, hdrFtrType string) error
 
 	raw, err := os.ReadFile(contentTypesPath)
 	if err != nil {
-		return fmt.Errorf("read content types: %w", err)
+		return NewFileReadError("content types", err)
 	}
 
 	content := string(raw)
@@ -537,7 +555,7 @@ func (u *Updater) addHeaderFooterContentType(filename, hdrFtrType string) error
 
 	// Write updated content types
 	if err := atomicWriteFile(contentTypesPath, []byte(content), 0o644); err != nil {
-		return fmt.Errorf("write content types: %w", err)
+		return NewFileWriteError("content types", err)
 	}
 
 	return nil
@@ -0,0 +1,49 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetFooterPageNumberFields(t *testing.T) {
+	field := func(instr string) string {
+		return `<w:r><w:fldChar w:fldCharType="begin"/></w:r>` +
+			`<w:r><w:instrText xml:space="preserve"> ` + instr + ` </w:instrText></w:r>` +
+			`<w:r><w:fldChar w:fldCharType="separate"/></w:r><w:r><w:t>1</w:t></w:r>` +
+			`<w:r><w:fldChar w:fldCharType="end"/></w:r>`
+	}
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"Page X of Y", `<w:r><w:t xml:space="preserve">Page </w:t></w:r>` + field("PAGE") +
+			`<w:r><w:t xml:space="preserve"> of </w:t></w:r>` + field("NUMPAGES") + `</w:p>`},
+		{"X / Y", field("PAGE") + `<w:r><w:t xml:space="preserve"> / </w:t></w:r>` + field("NUMPAGES") + `</w:p>`},
+		{"X", `</w:pPr>` + field("PAGE") + `</w:p>`},
+		{"", `</w:pPr>` + field("PAGE") + `</w:p>`},
+		// X and Y inside words are literal text.
+		{"Xmas page X", `<w:r><w:t xml:space="preserve">Xmas page </w:t></w:r>` + field("PAGE") + `</w:p>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			u, err := NewBlank()
+			if err != nil {
+				t.Fatalf("NewBlank: %v", err)
+			}
+			defer u.Cleanup()
+
+			err = u.SetFooter(HeaderFooterContent{PageNumber: true, PageNumberFormat: tt.format}, DefaultFooterOptions())
+			if err != nil {
+				t.Fatalf("SetFooter: %v", err)
+			}
+			footer := readTempFile(t, u, "word/footer3.xml")
+			if !strings.Contains(footer, tt.want) {
+				t.Errorf("footer for %q missing\n%s\ngot:\n%s", tt.format, tt.want, footer)
+			}
+			if strings.Contains(tt.format, "Y") != strings.Contains(footer, "NUMPAGES") {
+				t.Errorf("NUMPAGES field presence does not match format %q:\n%s", tt.format, footer)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package godocx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HeadingOptions controls a heading inserted by AddHeadingWithOptions.
+type HeadingOptions struct {
+	// Position where to insert the heading
+	Position InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+
+	// StyleOverride is a paragraph style ID used instead of HeadingN, for
+	// templates whose heading styles have other names (e.g. "CorpHeading1").
+	StyleOverride string
+
+	// NumberingEnabled numbers the heading as part of an outline ("1",
+	// "1.1", "1.1.1", ...), with the heading level selecting the outline level.
+	NumberingEnabled bool
+
+	// AdditionalRuns follow the heading text, for mixed formatting such as
+	// "Chapter 1: " followed by a bold "Introduction".
+	AdditionalRuns []RunOptions
+}
+
+// AddHeadingWithOptions adds a heading paragraph at the specified level
+// (1–9) with control over its position, style and numbering.
+func (u *Updater) AddHeadingWithOptions(level int, text string, opts HeadingOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if level < 1 || level > 9 {
+		return NewValidationError("level", fmt.Sprintf("heading level must be between 1 and 9, got %d", level))
+	}
+	if text == "" && len(opts.AdditionalRuns) == 0 {
+		return NewValidationError("text", "heading text cannot be empty")
+	}
+	if (opts.Position == PositionAfterText || opts.Position == PositionBeforeText) && opts.Anchor == "" {
+		return NewValidationError("anchor", "anchor text required for position-based insertion")
+	}
+
+	style := headingStyles[level]
+	if override := strings.TrimSpace(opts.StyleOverride); override != "" {
+		style = ParagraphStyle(override)
+	}
+	para := ParagraphOptions{
+		Text:     text,
+		Style:    style,
+		Position: opts.Position,
+		Anchor:   opts.Anchor,
+		KeepNext: true, // Prevent headings from being orphaned at the bottom of a page
+	}
+	if len(opts.AdditionalRuns) > 0 {
+		para.Runs = make([]RunOptions, 0, len(opts.AdditionalRuns)+1)
+		if text != "" {
+			para.Runs = append(para.Runs, RunOptions{Text: text})
+		}
+		para.Runs = append(para.Runs, opts.AdditionalRuns...)
+	}
+
+	if !opts.NumberingEnabled {
+		return u.insertParagraph(para, 0)
+	}
+	numID, err := u.ensureHeadingNumbering()
+	if err != nil {
+		return fmt.Errorf("ensure heading numbering: %w", err)
+	}
+	para.ListType = ListTypeNumbered
+	para.ListLevel = level - 1
+	return u.insertParagraph(para, numID)
+}
+
+// ensureHeadingNumbering returns the numId of the outline numbering used by
+// numbered headings, adding its definition to numbering.xml on first use.
+func (u *Updater) ensureHeadingNumbering() (int, error) {
+	return u.getOrCreateNumbering(headingNumberingOptions())
+}
@@ -1,12 +1,16 @@
 package godocx
 
 import (
+	"bytes"
 	"encoding/xml"
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // xmlEscapeReplacer normalises the numeric entity refs that xml.EscapeText emits
@@ -14,10 +18,17 @@ import (
 // serialiser and the existing test suite.
 var xmlEscapeReplacer = strings.NewReplacer("&#34;", "&quot;", "&#39;", "&apos;")
 
+// xmlContentReplacer restores the quote characters that xml.EscapeText
+// escapes, which are plain characters in element content.
+var xmlContentReplacer = strings.NewReplacer("&#34;", `"`, "&#39;", "'")
+
 // xmlEscape escapes XML special characters using the stdlib XML encoder.
 // This handles all edge cases including carriage-returns (&#xD;) and invalid
 // UTF-8 sequences, which a naive strings.ReplaceAll approach would miss.
 // Named entities (&quot;, &apos;) are used instead of numeric ones.
+//
+// Quotes are always escaped, so the result is safe in attribute values
+// delimited by either quote character. Use xmlEscapeContent for element text.
 func xmlEscape(s string) string {
 	var b strings.Builder
 	if err := xml.EscapeText(&b, []byte(s)); err != nil {
@@ -29,6 +40,17 @@ func xmlEscape(s string) string {
 	return xmlEscapeReplacer.Replace(b.String())
 }
 
+// xmlEscapeContent escapes s for use as element content such as <w:t> text.
+// Quotes need no escaping there and are written as-is, as Word does; the
+// result must not be used in attribute values.
+func xmlEscapeContent(s string) string {
+	var b strings.Builder
+	if err := xml.EscapeText(&b, []byte(s)); err != nil {
+		return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+	}
+	return xmlContentReplacer.Replace(b.String())
+}
+
 // formatFloat formats a float64 for XML output.
 // Removes trailing zeros and unnecessary decimal points.
 func formatFloat(f float64) string {
@@ -60,16 +82,30 @@ func cellRef(col, row int) string {
 	return columnLetters(col) + strconv.Itoa(row)
 }
 
-// normalizeHexColor normalizes a hex color code for use in Office Open XML.
-// Accepts colors with or without '#' prefix. Returns empty string if invalid.
-// Examples: "#FF0000" -> "FF0000", "ff0000" -> "FF0000"
+// normalizeHexColor normalizes a color for use in Office Open XML, which
+// expects six uppercase hex digits. It accepts hex colors with or without a
+// '#' prefix, the 3-digit shorthand and CSS rgb(r, g, b) notation. Returns
+// empty string if invalid.
+// Examples: "#FF0000" -> "FF0000", "ff0000" -> "FF0000", "F00" -> "FF0000",
+// "rgb(255, 0, 0)" -> "FF0000"
 func normalizeHexColor(color string) string {
 	c := strings.TrimSpace(color)
 	if c == "" {
 		return ""
 	}
+	if m := rgbColorPattern.FindStringSubmatch(c); m != nil {
+		var hex strings.Builder
+		for _, component := range m[1:] {
+			v, err := strconv.Atoi(component)
+			if err != nil || v > 255 {
+				return ""
+			}
+			fmt.Fprintf(&hex, "%02X", v)
+		}
+		return hex.String()
+	}
 	c = strings.TrimPrefix(c, "#")
-	if len(c) != 6 {
+	if len(c) != 3 && len(c) != 6 {
 		return ""
 	}
 	for _, ch := range c {
@@ -77,15 +113,36 @@ func normalizeHexColor(color string) string {
 			return ""
 		}
 	}
+	if len(c) == 3 {
+		c = string([]byte{c[0], c[0], c[1], c[1], c[2], c[2]})
+	}
 	return strings.ToUpper(c)
 }
 
+// validateColor returns a validation error for field when value is set but is
+// not a color normalizeHexColor accepts.
+func validateColor(field, value string) error {
+	if value == "" || normalizeHexColor(value) != "" {
+		return nil
+	}
+	return invalidColorError(field, value)
+}
+
+// invalidColorError is the validation error for a color value that
+// normalizeHexColor rejects.
+func invalidColorError(field, value string) error {
+	return NewValidationError(field, fmt.Sprintf("invalid hex color %q: expected RRGGBB, RGB or rgb(r,g,b)", value))
+}
+
+// rgbColorPattern matches CSS rgb(r, g, b) notation with decimal components.
+var rgbColorPattern = regexp.MustCompile(`(?i)^rgb\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*\)$`)
+
 // getNextDocPrId finds the next available docPr ID in the document.
 func (u *Updater) getNextDocPrId() (int, error) {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return 0, fmt.Errorf("read document: %w", err)
+		return 0, NewFileReadError("document", err)
 	}
 
 	matches := docPrIDPattern.FindAllStringSubmatch(string(raw), -1)
@@ -119,21 +176,21 @@ func atomicWriteFile(path string, data []byte, perm os.FileMode) error {
 	dir := filepath.Dir(path)
 	tmp, err := os.CreateTemp(dir, ".docx-write-*")
 	if err != nil {
-		return fmt.Errorf("create temp file: %w", err)
+		return NewFileWriteError(path, err)
 	}
 	tmpName := tmp.Name()
 	if _, err := tmp.Write(data); err != nil {
 		tmp.Close()
 		os.Remove(tmpName)
-		return fmt.Errorf("write temp file: %w", err)
+		return NewFileWriteError(path, err)
 	}
 	if err := tmp.Close(); err != nil {
 		os.Remove(tmpName)
-		return fmt.Errorf("close temp file: %w", err)
+		return NewFileWriteError(path, err)
 	}
 	if err := os.Chmod(tmpName, perm); err != nil {
 		os.Remove(tmpName)
-		return fmt.Errorf("chmod temp file: %w", err)
+		return NewFileWriteError(path, err)
 	}
 	// On Windows, os.Rename fails with "Access is denied" when the destination
 	// already exists.  Remove it first; this sacrifices strict atomicity on
@@ -141,21 +198,50 @@ func atomicWriteFile(path string, data []byte, perm os.FileMode) error {
 	_ = os.Remove(path)
 	if err := os.Rename(tmpName, path); err != nil {
 		os.Remove(tmpName)
-		return fmt.Errorf("rename temp file: %w", err)
+		return NewFileWriteError(path, err)
 	}
 	return nil
 }
 
+// renameFile is os.Rename, replaceable in tests.
+var renameFile = os.Rename
+
+// renameAttempts and renameBackoff bound how long renameWithRetry keeps
+// retrying: the delay doubles after each failed attempt.
+const (
+	renameAttempts = 5
+	renameBackoff  = 20 * time.Millisecond
+)
+
+// renameWithRetry renames from to to, replacing an existing file. On Windows
+// the rename fails while another process (a virus scanner, a search indexer
+// or a preview pane) has the destination open, so failed renames are
+// retried with backoff.
+func renameWithRetry(from, to string) error {
+	delay := renameBackoff
+	var err error
+	for attempt := 1; attempt <= renameAttempts; attempt++ {
+		if err = renameFile(from, to); err == nil {
+			return nil
+		}
+		if attempt < renameAttempts {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+	return err
+}
+
 // getNextRelIDFromFile finds the next available relationship ID in a .rels file.
 func getNextRelIDFromFile(relsPath string) (string, error) {
 	raw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return "", fmt.Errorf("read rels file %s: %w", relsPath, err)
+		return "", NewFileReadError(filepath.Base(relsPath), err)
 	}
 
 	var rels relationships
 	if err := xml.Unmarshal(raw, &rels); err != nil {
-		return "", fmt.Errorf("parse rels file %s: %w", relsPath, err)
+		return "", NewXMLParseError(filepath.Base(relsPath), err)
 	}
 
 	maxId := 0
@@ -173,3 +259,171 @@ func getNextRelIDFromFile(relsPath string) (string, error) {
 
 	return fmt.Sprintf("rId%d", maxId+1), nil
 }
+
+// xmlChild is one top-level child element of an XML fragment.
+type xmlChild struct {
+	name string // qualified name, e.g. "w:jc"
+	xml  []byte
+	// offset is where xml starts within the fragment given to splitXMLChildren.
+	offset int
+}
+
+// splitXMLChildren splits an XML fragment into its top-level elements.
+// Whitespace, comments and processing instructions between elements are dropped.
+func splitXMLChildren(fragment []byte) []xmlChild {
+	var children []xmlChild
+	pos := 0
+	for {
+		rel := bytes.IndexByte(fragment[pos:], '<')
+		if rel == -1 {
+			return children
+		}
+		start := pos + rel
+
+		if bytes.HasPrefix(fragment[start:], []byte("<!--")) {
+			end := bytes.Index(fragment[start:], []byte("-->"))
+			if end == -1 {
+				return children
+			}
+			pos = start + end + len("-->")
+			continue
+		}
+		if bytes.HasPrefix(fragment[start:], []byte("<?")) || bytes.HasPrefix(fragment[start:], []byte("</")) {
+			end := bytes.IndexByte(fragment[start:], '>')
+			if end == -1 {
+				return children
+			}
+			pos = start + end + 1
+			continue
+		}
+
+		nameEnd := start + 1
+		for nameEnd < len(fragment) && !strings.ContainsRune(" \t\r\n/>", rune(fragment[nameEnd])) {
+			nameEnd++
+		}
+		name := string(fragment[start+1 : nameEnd])
+
+		tagEnd := bytes.IndexByte(fragment[start:], '>')
+		if tagEnd == -1 {
+			return children
+		}
+		tagEnd += start
+
+		end := tagEnd + 1
+		if fragment[tagEnd-1] != '/' {
+			closeRel := findMatchingClose(fragment[start:], name)
+			if closeRel == -1 {
+				return children
+			}
+			end = start + closeRel + len("</"+name+">")
+		}
+
+		children = append(children, xmlChild{name: name, xml: fragment[start:end], offset: start})
+		pos = end
+	}
+}
+
+// findMatchingClose returns the offset of the closing tag for the qname element
+// that starts at the beginning of data, accounting for nested elements with the
+// same name (e.g. <w:pPr> inside <w:pPrChange>). It returns -1 when no matching
+// tag exists.
+func findMatchingClose(data []byte, qname string) int {
+	closeTag := []byte("</" + qname + ">")
+	depth := 0
+	pos := 0
+	for pos < len(data) {
+		nextOpen := findNextTagStart(data, pos, qname)
+		nextClose := bytes.Index(data[pos:], closeTag)
+		if nextClose == -1 {
+			return -1
+		}
+		nextClose += pos
+		if nextOpen != -1 && nextOpen < nextClose {
+			tagEnd := bytes.IndexByte(data[nextOpen:], '>')
+			if tagEnd == -1 {
+				return -1
+			}
+			if data[nextOpen+tagEnd-1] != '/' {
+				depth++
+			}
+			pos = nextOpen + tagEnd + 1
+			continue
+		}
+		depth--
+		if depth == 0 {
+			return nextClose
+		}
+		pos = nextClose + len(closeTag)
+	}
+	return -1
+}
+
+// upsertOrderedChild replaces the child named qname with elemXML, or inserts it
+// at the position required by order (a list of local names in schema
+// sequence, in the namespace prefix of qname). Children not listed in order
+// keep their relative position. An empty elemXML removes the child.
+func upsertOrderedChild(children []xmlChild, qname, elemXML string, order []string) []xmlChild {
+	children = slices.DeleteFunc(children, func(c xmlChild) bool { return c.name == qname })
+	if elemXML == "" {
+		return children
+	}
+	prefix := qname[:strings.IndexByte(qname, ':')+1]
+	rank := slices.Index(order, strings.TrimPrefix(qname, prefix))
+	insertAt := len(children)
+	for i, c := range children {
+		if !strings.HasPrefix(c.name, prefix) || (prefix == "" && strings.Contains(c.name, ":")) {
+			continue
+		}
+		if r := slices.Index(order, strings.TrimPrefix(c.name, prefix)); r > rank {
+			insertAt = i
+			break
+		}
+	}
+	return slices.Insert(children, insertAt, xmlChild{name: qname, xml: []byte(elemXML)})
+}
+
+// xmlNamespace is a namespace prefix and URI pair.
+type xmlNamespace struct {
+	prefix string
+	uri    string
+}
+
+// drawingShapeNamespaces are the root declarations required by floating
+// DrawingML shapes and text boxes wrapped in mc:AlternateContent.
+var drawingShapeNamespaces = []xmlNamespace{
+	{"wp", "http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing"},
+	{"mc", "http://schemas.openxmlformats.org/markup-compatibility/2006"},
+	{"wps", "http://schemas.microsoft.com/office/word/2010/wordprocessingShape"},
+	{"wpg", "http://schemas.microsoft.com/office/word/2010/wordprocessingGroup"},
+}
+
+// ensureDocumentNamespaces adds any missing namespace declarations to the
+// <w:document> root element of document.xml.
+func ensureDocumentNamespaces(docXML []byte, namespaces []xmlNamespace) ([]byte, error) {
+	rootStart := findNextTagStart(docXML, 0, "w:document")
+	if rootStart == -1 {
+		return nil, NewMalformedXMLError("could not find <w:document> root element")
+	}
+	rootEnd := bytes.IndexByte(docXML[rootStart:], '>')
+	if rootEnd == -1 {
+		return nil, NewMalformedXMLError("malformed <w:document> root element")
+	}
+	rootTag := docXML[rootStart : rootStart+rootEnd]
+
+	var decls strings.Builder
+	for _, ns := range namespaces {
+		if !bytes.Contains(rootTag, []byte("xmlns:"+ns.prefix+"=")) {
+			fmt.Fprintf(&decls, ` xmlns:%s="%s"`, ns.prefix, ns.uri)
+		}
+	}
+	if decls.Len() == 0 {
+		return docXML, nil
+	}
+
+	insertAt := rootStart + len("<w:document")
+	result := make([]byte, 0, len(docXML)+decls.Len())
+	result = append(result, docXML[:insertAt]...)
+	result = append(result, decls.String()...)
+	result = append(result, docXML[insertAt:]...)
+	return result, nil
+}
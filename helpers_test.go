@@ -0,0 +1,152 @@
+package godocx
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+var xmlEscapeSeeds = []string{
+	"",
+	"plain text",
+	`He said "hello"`,
+	"it's",
+	`a < b & c > d`,
+	`"'<>&`,
+	"]]>",
+	"tab\there\nline\r\nend",
+	"\x00\x1f control",
+	"invalid \xff utf-8",
+	"emoji 😀 and CJK 漢字",
+}
+
+func FuzzXMLEscapeAttribute(f *testing.F) {
+	for _, s := range xmlEscapeSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		escaped := xmlEscape(s)
+		doc := `<x a="` + escaped + `" b='` + escaped + `'/>`
+
+		d := xml.NewDecoder(strings.NewReader(doc))
+		tok, err := d.Token()
+		if err != nil {
+			t.Fatalf("xmlEscape(%q) produced unparsable attribute %s: %v", s, doc, err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || len(start.Attr) != 2 {
+			t.Fatalf("unexpected token %#v for %s", tok, doc)
+		}
+		if isXMLText(s) {
+			for _, attr := range start.Attr {
+				if attr.Value != s {
+					t.Errorf("attribute %s = %q, want %q", attr.Name.Local, attr.Value, s)
+				}
+			}
+		}
+	})
+}
+
+func FuzzXMLEscapeContent(f *testing.F) {
+	for _, s := range xmlEscapeSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		escaped := xmlEscapeContent(s)
+		if strings.Contains(escaped, "&quot;") || strings.Contains(escaped, "&apos;") {
+			t.Errorf("xmlEscapeContent(%q) = %q escapes quotes", s, escaped)
+		}
+
+		var got strings.Builder
+		d := xml.NewDecoder(strings.NewReader("<x>" + escaped + "</x>"))
+		for {
+			tok, err := d.Token()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				t.Fatalf("xmlEscapeContent(%q) produced unparsable content %q: %v", s, escaped, err)
+			}
+			if cd, ok := tok.(xml.CharData); ok {
+				got.Write(cd)
+			}
+		}
+		if isXMLText(s) && got.String() != s {
+			t.Errorf("content round trip = %q, want %q", got.String(), s)
+		}
+	})
+}
+
+// TestGeneratedAttributesEscaped checks that free-form option strings written
+// into attribute values cannot break the generated XML.
+func TestGeneratedAttributesEscaped(t *testing.T) {
+	var u *Updater
+	hyperlink := u.generateHyperlinkXML(`Say "hi"`, "rId1", HyperlinkOptions{
+		Style: `My "Link" Style`,
+		Color: `"0000FF`,
+	})
+	doc := `<root xmlns:w="w" xmlns:r="r">` + string(hyperlink) + `</root>`
+	if err := xml.Unmarshal([]byte(doc), new(struct{})); err != nil {
+		t.Errorf("generated XML does not parse: %v\n%s", err, hyperlink)
+	}
+	if !strings.Contains(string(hyperlink), `w:val="My &quot;Link&quot; Style"`) {
+		t.Errorf("style attribute not escaped:\n%s", hyperlink)
+	}
+	if !strings.Contains(string(hyperlink), `<w:t>Say "hi"</w:t>`) {
+		t.Errorf("link text should keep quotes as-is:\n%s", hyperlink)
+	}
+}
+
+func TestInvalidColorsRejected(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	isValidation := func(err error) bool {
+		var docxErr *DocxError
+		return errors.As(err, &docxErr) && docxErr.Code == ErrCodeValidation
+	}
+	if err := u.InsertParagraph(ParagraphOptions{Runs: []RunOptions{{Text: "Red", Color: "gg0000"}}}); !isValidation(err) {
+		t.Errorf("InsertParagraph with invalid run color: got %v, want validation error", err)
+	}
+	if err := u.AddStyle(StyleDefinition{ID: "Bad", Color: "gg0000"}); !isValidation(err) {
+		t.Errorf("AddStyle with invalid color: got %v, want validation error", err)
+	}
+	if err := u.SetTextWatermark(WatermarkOptions{Text: "DRAFT", Color: "gg0000"}); !isValidation(err) {
+		t.Errorf("SetTextWatermark with invalid color: got %v, want validation error", err)
+	}
+	if err := u.InsertHyperlink("Link", "https://example.com", HyperlinkOptions{Color: "blue"}); !isValidation(err) {
+		t.Errorf("InsertHyperlink with invalid color: got %v, want validation error", err)
+	}
+	if err := u.InsertInternalLink("Link", "Top", HyperlinkOptions{Color: "blue"}); !isValidation(err) {
+		t.Errorf("InsertInternalLink with invalid color: got %v, want validation error", err)
+	}
+	err = u.InsertChart(ChartOptions{
+		Categories: []string{"A"},
+		Series:     []SeriesOptions{{Name: "S", Values: []float64{1}, Color: "navy"}},
+	})
+	if !isValidation(err) || !strings.Contains(err.Error(), "Series[0].Color") {
+		t.Errorf("InsertChart with invalid series color: got %v, want validation error", err)
+	}
+
+	if err := u.InsertParagraph(ParagraphOptions{Runs: []RunOptions{{Text: "Red", Color: "rgb(255,0,0)"}}}); err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+	if err := u.AddStyle(StyleDefinition{ID: "Accent", Color: "#0f0"}); err != nil {
+		t.Fatalf("AddStyle: %v", err)
+	}
+	if err := u.InsertHyperlink("Link", "https://example.com", HyperlinkOptions{Position: PositionEnd, Color: "#06c"}); err != nil {
+		t.Fatalf("InsertHyperlink: %v", err)
+	}
+	doc := readDocXML(t, u)
+	if !strings.Contains(doc, `<w:color w:val="FF0000"/>`) {
+		t.Errorf("run color should be normalized to FF0000:\n%s", doc)
+	}
+	if !strings.Contains(doc, `<w:color w:val="0066CC"/>`) {
+		t.Errorf("hyperlink color should be normalized to 0066CC:\n%s", doc)
+	}
+}
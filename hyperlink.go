@@ -45,7 +45,7 @@ func DefaultHyperlinkOptions() HyperlinkOptions {
 // InsertHyperlink inserts a hyperlink into the document
 func (u *Updater) InsertHyperlink(text, urlStr string, opts HyperlinkOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if text == "" {
 		return NewValidationError("text", "hyperlink text cannot be empty")
@@ -59,6 +59,10 @@ func (u *Updater) InsertHyperlink(text, urlStr string, opts HyperlinkOptions) er
 		return err
 	}
 
+	if err := validateColor("Color", opts.Color); err != nil {
+		return err
+	}
+
 	// Apply defaults
 	if opts.Color == "" {
 		opts.Color = "0563C1"
@@ -100,7 +104,7 @@ func (u *Updater) InsertHyperlink(text, urlStr string, opts HyperlinkOptions) er
 // InsertInternalLink inserts a link to a bookmark within the document
 func (u *Updater) InsertInternalLink(text, bookmarkName string, opts HyperlinkOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if text == "" {
 		return NewValidationError("text", "link text cannot be empty")
@@ -109,6 +113,10 @@ func (u *Updater) InsertInternalLink(text, bookmarkName string, opts HyperlinkOp
 		return NewValidationError("bookmarkName", "bookmark name cannot be empty")
 	}
 
+	if err := validateColor("Color", opts.Color); err != nil {
+		return err
+	}
+
 	// Apply defaults
 	if opts.Color == "" {
 		opts.Color = "0563C1"
@@ -147,7 +155,7 @@ func (u *Updater) addHyperlinkRelationship(urlStr string) (string, error) {
 
 	raw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return "", fmt.Errorf("read relationships: %w", err)
+		return "", NewFileReadError("relationships", err)
 	}
 
 	content := string(raw)
@@ -170,7 +178,7 @@ func (u *Updater) addHyperlinkRelationship(urlStr string) (string, error) {
 
 	// Write updated relationships
 	if err := atomicWriteFile(relsPath, []byte(content), 0o644); err != nil {
-		return "", fmt.Errorf("write relationships: %w", err)
+		return "", NewFileWriteError("relationships", err)
 	}
 
 	return relID, nil
@@ -185,7 +193,7 @@ func (u *Updater) generateHyperlinkXML(text, relID string, opts HyperlinkOptions
 	// Add paragraph properties
 	if opts.Style != "" {
 		buf.WriteString("<w:pPr>")
-		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, opts.Style))
+		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, xmlEscape(string(opts.Style))))
 		buf.WriteString("</w:pPr>")
 	}
 
@@ -209,7 +217,7 @@ func (u *Updater) generateHyperlinkXML(text, relID string, opts HyperlinkOptions
 
 	// Add color
 	if opts.Color != "" {
-		buf.WriteString(fmt.Sprintf(`<w:color w:val="%s"/>`, opts.Color))
+		buf.WriteString(fmt.Sprintf(`<w:color w:val="%s"/>`, normalizeHexColor(opts.Color)))
 	}
 
 	// Add underline
@@ -218,7 +226,7 @@ func (u *Updater) generateHyperlinkXML(text, relID string, opts HyperlinkOptions
 	}
 
 	buf.WriteString("</w:rPr>")
-	buf.WriteString(fmt.Sprintf("<w:t>%s</w:t>", xmlEscape(text)))
+	buf.WriteString(fmt.Sprintf("<w:t>%s</w:t>", xmlEscapeContent(text)))
 	buf.WriteString("</w:r>")
 
 	buf.WriteString("</w:hyperlink>")
@@ -236,7 +244,7 @@ func (u *Updater) generateInternalHyperlinkXML(text, bookmarkName string, opts H
 	// Add paragraph properties
 	if opts.Style != "" {
 		buf.WriteString("<w:pPr>")
-		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, opts.Style))
+		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, xmlEscape(string(opts.Style))))
 		buf.WriteString("</w:pPr>")
 	}
 
@@ -260,7 +268,7 @@ func (u *Updater) generateInternalHyperlinkXML(text, bookmarkName string, opts H
 
 	// Add color
 	if opts.Color != "" {
-		buf.WriteString(fmt.Sprintf(`<w:color w:val="%s"/>`, opts.Color))
+		buf.WriteString(fmt.Sprintf(`<w:color w:val="%s"/>`, normalizeHexColor(opts.Color)))
 	}
 
 	// Add underline
@@ -269,7 +277,7 @@ func (u *Updater) generateInternalHyperlinkXML(text, bookmarkName string, opts H
 	}
 
 	buf.WriteString("</w:rPr>")
-	buf.WriteString(fmt.Sprintf("<w:t>%s</w:t>", xmlEscape(text)))
+	buf.WriteString(fmt.Sprintf("<w:t>%s</w:t>", xmlEscapeContent(text)))
 	buf.WriteString("</w:r>")
 
 	buf.WriteString("</w:hyperlink>")
@@ -296,6 +304,9 @@ func (u *Updater) insertHyperlinkAtPosition(docXML, hyperlinkXML []byte, opts Hy
 		}
 		return insertBeforeText(docXML, hyperlinkXML, opts.Anchor)
 	default:
+		if n, ok := opts.Position.paragraphIndex(); ok {
+			return insertAtParagraphIndex(docXML, hyperlinkXML, n)
+		}
 		return insertAtBodyEnd(docXML, hyperlinkXML)
 	}
 }
@@ -17,15 +17,15 @@ import (
 // InsertImage inserts an image into the document with optional proportional sizing
 func (u *Updater) InsertImage(opts ImageOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if opts.Path == "" {
-		return fmt.Errorf("image path cannot be empty")
+		return NewValidationError("imagePath", "image path cannot be empty")
 	}
 
 	// Check if the image file exists
 	if _, err := os.Stat(opts.Path); os.IsNotExist(err) {
-		return fmt.Errorf("image file not found: %s", opts.Path)
+		return NewImageNotFoundError(opts.Path)
 	}
 
 	// Get actual image dimensions from file
@@ -74,11 +74,12 @@ func (u *Updater) InsertImage(opts ImageOptions) error {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	// Handle caption if provided
 	var contentToInsert []byte
+	opts.Caption = u.applyCaptionCounter(opts.Caption)
 	if opts.Caption != nil {
 		// Validate caption options
 		if err := ValidateCaptionOptions(opts.Caption); err != nil {
@@ -104,11 +105,15 @@ func (u *Updater) InsertImage(opts ImageOptions) error {
 	if err != nil {
 		return fmt.Errorf("insert image: %w", err)
 	}
+	if opts.Caption != nil {
+		updated = numberCaptionField(updated)
+	}
 
 	// Write updated document
 	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
+	u.consumeCaptionReset(opts.Caption)
 
 	return nil
 }
@@ -117,13 +122,18 @@ func (u *Updater) InsertImage(opts ImageOptions) error {
 func getImageDimensions(path string) (ImageDimensions, error) {
 	file, err := os.Open(path)
 	if err != nil {
-		return ImageDimensions{}, fmt.Errorf("open image: %w", err)
+		return ImageDimensions{}, NewFileReadError("image", err)
 	}
 	defer file.Close()
 
 	config, _, err := image.DecodeConfig(file)
 	if err != nil {
-		return ImageDimensions{}, fmt.Errorf("decode image config: %w", err)
+		return ImageDimensions{}, &DocxError{
+			Code:    ErrCodeImageFormat,
+			Message: "failed to decode image",
+			Err:     err,
+			Context: map[string]any{"path": path},
+		}
 	}
 
 	return ImageDimensions{
@@ -201,12 +211,12 @@ func (u *Updater) getNextImageIndex() (int, error) {
 
 	// Create media folder if it doesn't exist
 	if err := os.MkdirAll(mediaPath, 0o755); err != nil {
-		return 0, fmt.Errorf("create media folder: %w", err)
+		return 0, NewFileWriteError("media folder", err)
 	}
 
 	entries, err := os.ReadDir(mediaPath)
 	if err != nil {
-		return 0, fmt.Errorf("read media folder: %w", err)
+		return 0, NewFileReadError("media folder", err)
 	}
 
 	maxIndex := 0
@@ -262,7 +272,7 @@ func (u *Updater) addImageRelationship(imageFileName string) (string, error) {
 	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
 	raw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return "", fmt.Errorf("read document relationships: %w", err)
+		return "", NewFileReadError("document relationships", err)
 	}
 
 	// Get next relationship ID
@@ -278,7 +288,7 @@ func (u *Updater) addImageRelationship(imageFileName string) (string, error) {
 	closer := []byte("</Relationships>")
 	pos := bytes.LastIndex(raw, closer)
 	if pos == -1 {
-		return "", fmt.Errorf("invalid document.xml.rels: missing </Relationships>")
+		return "", NewMalformedXMLError("invalid document.xml.rels: missing </Relationships>")
 	}
 
 	result := make([]byte, len(raw)+len(insert))
@@ -287,7 +297,7 @@ func (u *Updater) addImageRelationship(imageFileName string) (string, error) {
 	copy(result[n:], raw[pos:])
 
 	if err := atomicWriteFile(relsPath, result, 0o644); err != nil {
-		return "", fmt.Errorf("write relationships: %w", err)
+		return "", NewFileWriteError("relationships", err)
 	}
 
 	return nextRelId, nil
@@ -298,7 +308,7 @@ func (u *Updater) addImageContentType(ext, contentType string) error {
 	contentTypesPath := filepath.Join(u.tempDir, "[Content_Types].xml")
 	raw, err := os.ReadFile(contentTypesPath)
 	if err != nil {
-		return fmt.Errorf("read content types: %w", err)
+		return NewFileReadError("content types", err)
 	}
 
 	// Remove leading dot from extension
@@ -317,7 +327,7 @@ func (u *Updater) addImageContentType(ext, contentType string) error {
 	closer := []byte("</Types>")
 	pos := bytes.LastIndex(raw, closer)
 	if pos == -1 {
-		return fmt.Errorf("invalid [Content_Types].xml: missing </Types>")
+		return NewMalformedXMLError("invalid [Content_Types].xml: missing </Types>")
 	}
 
 	result := make([]byte, len(raw)+len(insert))
@@ -334,13 +344,13 @@ func (u *Updater) copyImageToMedia(srcPath, destFileName string) error {
 
 	// Ensure media directory exists
 	if err := os.MkdirAll(mediaPath, 0o755); err != nil {
-		return fmt.Errorf("create media directory: %w", err)
+		return NewFileWriteError("media directory", err)
 	}
 
 	// Open source file
 	srcFile, err := os.Open(srcPath)
 	if err != nil {
-		return fmt.Errorf("open source file: %w", err)
+		return NewFileReadError("source file", err)
 	}
 	defer srcFile.Close()
 
@@ -348,13 +358,13 @@ func (u *Updater) copyImageToMedia(srcPath, destFileName string) error {
 	destPath := filepath.Join(mediaPath, destFileName)
 	destFile, err := os.Create(destPath)
 	if err != nil {
-		return fmt.Errorf("create destination file: %w", err)
+		return NewFileWriteError("destination file", err)
 	}
 	defer destFile.Close()
 
 	// Copy file content
 	if _, err := io.Copy(destFile, srcFile); err != nil {
-		return fmt.Errorf("copy file: %w", err)
+		return NewFileWriteError(destPath, err)
 	}
 
 	return nil
@@ -362,29 +372,7 @@ func (u *Updater) copyImageToMedia(srcPath, destFileName string) error {
 
 // insertImageAtPosition inserts the image XML at the specified position in document.xml
 func insertImageAtPosition(raw []byte, imageXML []byte, opts ImageOptions) ([]byte, error) {
-
-	switch opts.Position {
-	case PositionBeginning:
-		return insertAtBodyStart(raw, imageXML)
-
-	case PositionEnd:
-		return insertAtBodyEnd(raw, imageXML)
-
-	case PositionAfterText:
-		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionAfterText")
-		}
-		return insertAfterText(raw, imageXML, opts.Anchor)
-
-	case PositionBeforeText:
-		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionBeforeText")
-		}
-		return insertBeforeText(raw, imageXML, opts.Anchor)
-
-	default:
-		return nil, fmt.Errorf("invalid position: %d", opts.Position)
-	}
+	return insertElementAtPosition(raw, imageXML, opts.Position, opts.Anchor)
 }
 
 // insertAfterAnchor and insertBeforeAnchor removed in favor of paragraph-aware helpers.
@@ -0,0 +1,264 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// IndexEntryOptions defines options for an index entry marked with MarkIndexEntry.
+type IndexEntryOptions struct {
+	// SubEntry is a second-level entry listed under the main entry
+	SubEntry string
+
+	// Bold makes the entry's page number bold in the index
+	Bold bool
+
+	// Italic makes the entry's page number italic in the index
+	Italic bool
+}
+
+// IndexOptions defines options for the index inserted by InsertIndex.
+type IndexOptions struct {
+	// Columns is the number of columns of the index (default: 2)
+	Columns int
+
+	// LanguageID is the language used to sort entries (default: 1033, English (US))
+	LanguageID int
+
+	// Position where to insert the index
+	Position InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+}
+
+// IndexEntry is an index entry (XE field) found in the document.
+type IndexEntry struct {
+	Entry    string // Main entry text
+	SubEntry string // Second-level entry text, if any
+	Bold     bool   // Page number is bold (\b)
+	Italic   bool   // Page number is italic (\i)
+}
+
+// MarkIndexEntry marks the paragraph containing anchor as an index entry by
+// inserting an XE field right after the run holding the anchor text. Word
+// collects XE fields into the index built by InsertIndex.
+func (u *Updater) MarkIndexEntry(anchor string, entry string, opts IndexEntryOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if anchor == "" {
+		return NewValidationError("anchor", "anchor text cannot be empty")
+	}
+	if strings.TrimSpace(entry) == "" {
+		return NewValidationError("entry", "index entry text cannot be empty")
+	}
+
+	fieldXML := generateIndexEntryFieldXML(entry, opts)
+	return u.updateParagraphByAnchor(anchor, func(para []byte) ([]byte, error) {
+		return insertRunInParagraph(para, fieldXML, anchor, PositionAfterText)
+	})
+}
+
+// generateIndexEntryFieldXML creates the runs of an XE field. XE fields have
+// no result, so the field has no separate marker.
+func generateIndexEntryFieldXML(entry string, opts IndexEntryOptions) []byte {
+	text := escapeIndexEntryText(entry)
+	if opts.SubEntry != "" {
+		text += ":" + escapeIndexEntryText(opts.SubEntry)
+	}
+	instr := fmt.Sprintf(` XE "%s"`, text)
+	if opts.Bold {
+		instr += ` \b`
+	}
+	if opts.Italic {
+		instr += ` \i`
+	}
+	instr += " "
+
+	var buf bytes.Buffer
+	buf.WriteString(`<w:r><w:fldChar w:fldCharType="begin"/></w:r>`)
+	fmt.Fprintf(&buf, `<w:r><w:instrText xml:space="preserve">%s</w:instrText></w:r>`, xmlEscapeContent(instr))
+	buf.WriteString(`<w:r><w:fldChar w:fldCharType="end"/></w:r>`)
+	return buf.Bytes()
+}
+
+// escapeIndexEntryText escapes characters that have a meaning inside an XE
+// field argument: backslashes, quotes, and colons (the sub-entry separator).
+func escapeIndexEntryText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r == '\\' || r == '"' || r == ':' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// InsertIndex inserts an INDEX field that lists all entries marked with
+// MarkIndexEntry. Like the Table of Contents, the index is populated when the
+// user updates fields in Word (Ctrl+A, F9).
+func (u *Updater) InsertIndex(opts IndexOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if opts.Columns == 0 {
+		opts.Columns = 2
+	}
+	if opts.Columns < 1 || opts.Columns > 4 {
+		return NewValidationError("Columns", "index must have 1 to 4 columns")
+	}
+	if opts.LanguageID == 0 {
+		opts.LanguageID = 1033
+	}
+	if opts.LanguageID < 0 {
+		return NewValidationError("LanguageID", "language ID cannot be negative")
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	updated, err := insertElementAtPosition(raw, generateIndexXML(opts), opts.Position, opts.Anchor)
+	if err != nil {
+		return fmt.Errorf("insert index: %w", err)
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// generateIndexXML creates the paragraph holding the INDEX field.
+//
+//	\c "2"    - number of columns
+//	\z "1033" - language ID used for sorting
+func generateIndexXML(opts IndexOptions) []byte {
+	instr := fmt.Sprintf(` INDEX \c "%d" \z "%d" `, opts.Columns, opts.LanguageID)
+
+	var buf bytes.Buffer
+	buf.WriteString("<w:p>")
+	buf.WriteString(`<w:r><w:fldChar w:fldCharType="begin"/></w:r>`)
+	fmt.Fprintf(&buf, `<w:r><w:instrText xml:space="preserve">%s</w:instrText></w:r>`, xmlEscapeContent(instr))
+	buf.WriteString(`<w:r><w:fldChar w:fldCharType="separate"/></w:r>`)
+	buf.WriteString(`<w:r><w:rPr><w:i/></w:rPr><w:t>Update this field to show the index</w:t></w:r>`)
+	buf.WriteString(`<w:r><w:fldChar w:fldCharType="end"/></w:r>`)
+	buf.WriteString("</w:p>")
+	return buf.Bytes()
+}
+
+// fieldTokenPattern matches the parts of complex and simple fields, in order.
+var fieldTokenPattern = regexp.MustCompile(`<w:fldChar\s[^>]*w:fldCharType="(begin|separate|end)"|<w:instrText(?:\s[^>]*)?>([^<]*)</w:instrText>|<w:fldSimple\s[^>]*w:instr="([^"]*)"`)
+
+// GetIndexEntries returns the index entries (XE fields) of the document body
+// in document order.
+func (u *Updater) GetIndexEntries() ([]IndexEntry, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "document.xml"))
+	if err != nil {
+		return nil, NewFileReadError("document.xml", err)
+	}
+
+	var entries []IndexEntry
+	for _, instr := range fieldInstructions(raw) {
+		if entry, ok := parseIndexEntryField(instr); ok {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// fieldInstructions returns the instruction text of every field in docXML,
+// joining complex-field instructions split over several runs.
+func fieldInstructions(docXML []byte) []string {
+	var instrs []string
+	var open []*strings.Builder // instruction of each field being read, innermost last
+	var collecting []bool       // whether that field is still before its separate marker
+	for _, m := range fieldTokenPattern.FindAllSubmatch(docXML, -1) {
+		switch {
+		case string(m[1]) == "begin":
+			open = append(open, &strings.Builder{})
+			collecting = append(collecting, true)
+		case string(m[1]) == "separate":
+			if n := len(collecting); n > 0 {
+				collecting[n-1] = false
+			}
+		case string(m[1]) == "end":
+			if n := len(open); n > 0 {
+				instrs = append(instrs, open[n-1].String())
+				open, collecting = open[:n-1], collecting[:n-1]
+			}
+		case m[3] != nil:
+			instrs = append(instrs, xmlUnescape(string(m[3])))
+		default:
+			if n := len(open); n > 0 && collecting[n-1] {
+				open[n-1].WriteString(xmlUnescape(string(m[2])))
+			}
+		}
+	}
+	return instrs
+}
+
+// parseIndexEntryField parses an XE field instruction such as
+// ` XE "Entry:Sub" \b `.
+func parseIndexEntryField(instr string) (IndexEntry, bool) {
+	instr = strings.TrimSpace(instr)
+	if len(instr) < 2 || !strings.EqualFold(instr[:2], "XE") || (len(instr) > 2 && instr[2] != ' ') {
+		return IndexEntry{}, false
+	}
+	rest := strings.TrimSpace(instr[2:])
+
+	// Read the entry argument, quoted or a single word, splitting levels on
+	// unescaped colons.
+	var levels []string
+	var cur strings.Builder
+	quoted := strings.HasPrefix(rest, `"`)
+	i := 0
+	if quoted {
+		i = 1
+	}
+	for ; i < len(rest); i++ {
+		c := rest[i]
+		if c == '\\' && i+1 < len(rest) {
+			i++
+			cur.WriteByte(rest[i])
+			continue
+		}
+		if (quoted && c == '"') || (!quoted && c == ' ') {
+			i++
+			break
+		}
+		if c == ':' {
+			levels = append(levels, cur.String())
+			cur.Reset()
+			continue
+		}
+		cur.WriteByte(c)
+	}
+	levels = append(levels, cur.String())
+
+	entry := IndexEntry{Entry: levels[0]}
+	if len(levels) > 1 {
+		entry.SubEntry = strings.Join(levels[1:], ":")
+	}
+	for _, sw := range strings.Fields(rest[min(i, len(rest)):]) {
+		switch sw {
+		case `\b`:
+			entry.Bold = true
+		case `\i`:
+			entry.Italic = true
+		}
+	}
+	return entry, true
+}
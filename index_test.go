@@ -0,0 +1,105 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkIndexEntry(t *testing.T) {
+	body := `<w:p><w:r><w:t>Photosynthesis converts light.</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Respiration releases energy.</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.MarkIndexEntry("Photosynthesis", "Photosynthesis", IndexEntryOptions{Bold: true}); err != nil {
+		t.Fatalf("MarkIndexEntry: %v", err)
+	}
+	if err := u.MarkIndexEntry("Respiration", `Energy "ATP"`, IndexEntryOptions{SubEntry: "cells: aerobic", Italic: true}); err != nil {
+		t.Fatalf("MarkIndexEntry: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	want := `<w:t>Photosynthesis converts light.</w:t></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="begin"/></w:r>` +
+		`<w:r><w:instrText xml:space="preserve"> XE "Photosynthesis" \b </w:instrText></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>`
+	if !strings.Contains(docXML, want) {
+		t.Errorf("XE field not found after anchor run:\n%s", docXML)
+	}
+
+	entries, err := u.GetIndexEntries()
+	if err != nil {
+		t.Fatalf("GetIndexEntries: %v", err)
+	}
+	wantEntries := []IndexEntry{
+		{Entry: "Photosynthesis", Bold: true},
+		{Entry: `Energy "ATP"`, SubEntry: "cells: aerobic", Italic: true},
+	}
+	if len(entries) != len(wantEntries) {
+		t.Fatalf("got %d entries, want %d: %+v", len(entries), len(wantEntries), entries)
+	}
+	for i := range wantEntries {
+		if entries[i] != wantEntries[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, entries[i], wantEntries[i])
+		}
+	}
+
+	if err := u.MarkIndexEntry("Photosynthesis", " ", IndexEntryOptions{}); err == nil {
+		t.Error("expected error for empty entry")
+	}
+	if err := u.MarkIndexEntry("missing", "Entry", IndexEntryOptions{}); err == nil {
+		t.Error("expected error for missing anchor")
+	}
+}
+
+func TestGetIndexEntriesFieldForms(t *testing.T) {
+	body := `<w:p><w:r><w:t>Text</w:t></w:r>` +
+		// Instruction split over runs, a simple field, and a non-XE field.
+		`<w:r><w:fldChar w:fldCharType="begin"/></w:r><w:r><w:instrText> XE "Split</w:instrText></w:r>` +
+		`<w:r><w:instrText xml:space="preserve"> entry" </w:instrText></w:r><w:r><w:fldChar w:fldCharType="end"/></w:r>` +
+		`<w:fldSimple w:instr=" XE &quot;Simple:Level two&quot; "><w:r><w:t></w:t></w:r></w:fldSimple>` +
+		`<w:r><w:fldChar w:fldCharType="begin"/></w:r><w:r><w:instrText> PAGE </w:instrText></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="separate"/></w:r><w:r><w:t>1</w:t></w:r><w:r><w:fldChar w:fldCharType="end"/></w:r>` +
+		`</w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	entries, err := u.GetIndexEntries()
+	if err != nil {
+		t.Fatalf("GetIndexEntries: %v", err)
+	}
+	want := []IndexEntry{{Entry: "Split entry"}, {Entry: "Simple", SubEntry: "Level two"}}
+	if len(entries) != len(want) {
+		t.Fatalf("got %+v, want %+v", entries, want)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+}
+
+func TestInsertIndex(t *testing.T) {
+	body := `<w:p><w:r><w:t>Content</w:t></w:r></w:p><w:sectPr/>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.InsertIndex(IndexOptions{Position: PositionEnd}); err != nil {
+		t.Fatalf("InsertIndex: %v", err)
+	}
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:instrText xml:space="preserve"> INDEX \c "2" \z "1033" </w:instrText>`) {
+		t.Errorf("INDEX field not found:\n%s", docXML)
+	}
+	if strings.Index(docXML, "INDEX") > strings.Index(docXML, "<w:sectPr/>") {
+		t.Error("index should be inserted before the body sectPr")
+	}
+
+	if err := u.InsertIndex(IndexOptions{Columns: 1, LanguageID: 1031, Position: PositionBeginning}); err != nil {
+		t.Fatalf("InsertIndex: %v", err)
+	}
+	if !strings.Contains(readDocXML(t, u), `INDEX \c "1" \z "1031"`) {
+		t.Error("custom columns and language not applied")
+	}
+
+	if err := u.InsertIndex(IndexOptions{Columns: 5}); err == nil {
+		t.Error("expected error for too many columns")
+	}
+}
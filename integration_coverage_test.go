@@ -214,6 +214,138 @@ func TestDeleteImage_Integration(t *testing.T) {
 	}
 }
 
+func TestDeleteImage_RemovesOrphanedMedia(t *testing.T) {
+	image := func(relID string) string {
+		return `<w:p><w:r><w:drawing><wp:inline><wp:extent cx="100" cy="100"/><wp:docPr id="1" name="Pic"/>` +
+			`<a:graphic><a:graphicData><pic:pic><pic:blipFill><a:blip r:embed="` + relID + `"/></pic:blipFill></pic:pic>` +
+			`</a:graphicData></a:graphic></wp:inline></w:drawing></w:r></w:p>`
+	}
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, image("rId5")+image("rId6")+image("rId5")))
+
+	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
+	rels := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId5" Type="` + OfficeDocumentNS + `/image" Target="media/image1.png"/>` +
+		`<Relationship Id="rId6" Type="` + OfficeDocumentNS + `/image" Target="media/image2.png"/>` +
+		`</Relationships>`
+	if err := os.WriteFile(relsPath, []byte(rels), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mediaDir := filepath.Join(u.tempDir, "word", "media")
+	if err := os.MkdirAll(mediaDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"image1.png", "image2.png"} {
+		if err := os.WriteFile(filepath.Join(mediaDir, name), []byte("png"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The second image has its own relationship, which goes with it.
+	if err := u.DeleteImage(2); err != nil {
+		t.Fatalf("DeleteImage(2): %v", err)
+	}
+	if got := readTempFile(t, u, "word/_rels/document.xml.rels"); strings.Contains(got, "rId6") || !strings.Contains(got, "rId5") {
+		t.Errorf("expected only the rId6 relationship removed:\n%s", got)
+	}
+	if _, err := os.Stat(filepath.Join(mediaDir, "image2.png")); !os.IsNotExist(err) {
+		t.Error("expected image2.png to be deleted")
+	}
+
+	// The first image shares rId5 with the remaining one, so both stay.
+	if err := u.DeleteImage(1); err != nil {
+		t.Fatalf("DeleteImage(1): %v", err)
+	}
+	if got := readTempFile(t, u, "word/_rels/document.xml.rels"); !strings.Contains(got, `Id="rId5"`) {
+		t.Errorf("shared relationship should be kept:\n%s", got)
+	}
+	if _, err := os.Stat(filepath.Join(mediaDir, "image1.png")); err != nil {
+		t.Errorf("shared media should be kept: %v", err)
+	}
+}
+
+func TestDeleteImage_InlineAndFloating(t *testing.T) {
+	blip := func(relID string) string {
+		return `<a:graphic><a:graphicData><pic:pic><pic:blipFill><a:blip r:embed="` + relID + `"/></pic:blipFill></pic:pic></a:graphicData></a:graphic>`
+	}
+	inline := `<w:p><w:r><w:drawing><wp:inline><wp:extent cx="100" cy="100"/><wp:docPr id="1" name="Inline"/>` +
+		blip("rId5") + `</wp:inline></w:drawing></w:r></w:p>`
+	floating := `<w:p><w:r><w:t>Caption text</w:t></w:r><w:r><w:drawing><wp:anchor behindDoc="0" locked="0">` +
+		`<wp:extent cx="100" cy="100"/><wp:docPr id="2" name="Floating"/>` + blip("rId6") + `</wp:anchor></w:drawing></w:r></w:p>`
+	body := `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>` + inline + `<w:p><w:r><w:t>Between</w:t></w:r></w:p>` + floating
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	count, err := u.GetImageCount()
+	if err != nil {
+		t.Fatalf("GetImageCount: %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("GetImageCount = %d, want 2 (inline and floating)", count)
+	}
+
+	// The floating image shares its paragraph with text, so only its run goes.
+	if err := u.DeleteImage(2); err != nil {
+		t.Fatalf("DeleteImage(2): %v", err)
+	}
+	doc := readDocXML(t, u)
+	if strings.Contains(doc, "wp:anchor") || !strings.Contains(doc, "Caption text") {
+		t.Errorf("expected the floating image removed and its text kept:\n%s", doc)
+	}
+	if !strings.Contains(doc, "Intro") || !strings.Contains(doc, "Between") || !strings.Contains(doc, "wp:inline") {
+		t.Errorf("other content must be kept:\n%s", doc)
+	}
+
+	if err := u.DeleteImage(1); err != nil {
+		t.Fatalf("DeleteImage(1): %v", err)
+	}
+	doc = readDocXML(t, u)
+	if strings.Contains(doc, "wp:inline") || !strings.Contains(doc, "Intro") || !strings.Contains(doc, "Between") {
+		t.Errorf("expected only the inline image paragraph removed:\n%s", doc)
+	}
+	if count, _ := u.GetImageCount(); count != 0 {
+		t.Errorf("GetImageCount after deletes = %d, want 0", count)
+	}
+}
+
+func TestCleanOrphanedMedia(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Text</w:t></w:r></w:p>`))
+
+	mediaDir := filepath.Join(u.tempDir, "word", "media")
+	if err := os.MkdirAll(mediaDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"logo.png", "stray.png", "unused.jpeg"} {
+		if err := os.WriteFile(filepath.Join(mediaDir, name), []byte("img"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// logo.png is only referenced from a header part.
+	headerRels := `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="` + OfficeDocumentNS + `/image" Target="media/logo.png"/>` +
+		`<Relationship Id="rId2" Type="` + OfficeDocumentNS + `/hyperlink" Target="media/stray.png" TargetMode="External"/>` +
+		`</Relationships>`
+	if err := os.WriteFile(filepath.Join(u.tempDir, "word", "_rels", "header1.xml.rels"), []byte(headerRels), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := u.CleanOrphanedMedia()
+	if err != nil {
+		t.Fatalf("CleanOrphanedMedia: %v", err)
+	}
+	if removed != 2 {
+		t.Errorf("removed = %d, want 2", removed)
+	}
+	entries, _ := os.ReadDir(mediaDir)
+	if len(entries) != 1 || entries[0].Name() != "logo.png" {
+		t.Errorf("expected only logo.png to remain, got %v", entries)
+	}
+
+	var nilU *Updater
+	if _, err := nilU.CleanOrphanedMedia(); err == nil {
+		t.Error("expected error for nil updater")
+	}
+}
+
 func TestDeleteImage_NotFound(t *testing.T) {
 	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>No image</w:t></w:r></w:p>`))
 	err := u.DeleteImage(1)
@@ -3,9 +3,15 @@ package godocx
 import (
 	"archive/zip"
 	"bytes"
+	"errors"
+	"io"
+	"log/slog"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewFromReader_NilReader(t *testing.T) {
@@ -272,3 +278,146 @@ func TestWriteZipFromDir(t *testing.T) {
 		t.Error("expected non-empty zip output")
 	}
 }
+
+func TestSaveAtomically(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "report.docx")
+	tmpPath := outputPath + ".tmp"
+
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	original, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Make the next save large, so it takes a while to write.
+	payload := bytes.Repeat([]byte("0123456789abcdef"), 1<<19) // 8 MiB
+	if err := u.SetFileContent("word/media/large.bin", payload); err != nil {
+		t.Fatalf("SetFileContent: %v", err)
+	}
+	// A partial file left behind by an earlier interrupted save.
+	if err := os.WriteFile(tmpPath, []byte("PK\x03\x04 truncated"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The process stops before the rename: the previous document survives.
+	defer func(orig func(string, string) error) { renameFile = orig }(renameFile)
+	renameFile = func(string, string) error { return errors.New("interrupted") }
+	if err := u.SaveAtomically(outputPath); err == nil {
+		t.Fatal("expected the failed rename to be reported")
+	}
+	if got, _ := os.ReadFile(outputPath); !bytes.Equal(got, original) {
+		t.Error("a failed save must leave the previous document intact")
+	}
+	if _, err := os.Stat(tmpPath); !os.IsNotExist(err) {
+		t.Error("a failed save must remove its temporary file")
+	}
+
+	// A destination locked for a moment, as on Windows, is retried.
+	failures := 2
+	renameFile = func(from, to string) error {
+		if failures > 0 {
+			failures--
+			return errors.New("file in use")
+		}
+		return os.Rename(from, to)
+	}
+	if err := u.SaveAtomically(outputPath); err != nil {
+		t.Fatalf("SaveAtomically: %v", err)
+	}
+	if _, err := os.Stat(tmpPath); !os.IsNotExist(err) {
+		t.Error("temporary file should have been renamed")
+	}
+
+	zr, err := zip.OpenReader(outputPath)
+	if err != nil {
+		t.Fatalf("saved document is not a valid zip: %v", err)
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		if f.Name != "word/media/large.bin" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil || !bytes.Equal(got, payload) {
+			t.Errorf("large part corrupted: %d bytes, err %v", len(got), err)
+		}
+		return
+	}
+	t.Error("large part missing from the saved document")
+}
+
+func TestCleanupCalled(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	if u.CleanupCalled() {
+		t.Error("CleanupCalled should be false before Cleanup")
+	}
+	if err := u.Cleanup(); err != nil {
+		t.Fatalf("Cleanup: %v", err)
+	}
+	if !u.CleanupCalled() {
+		t.Error("CleanupCalled should be true after Cleanup")
+	}
+	if _, err := os.Stat(u.TempDir()); !os.IsNotExist(err) {
+		t.Errorf("temp dir should be removed, stat err = %v", err)
+	}
+
+	var nilU *Updater
+	if nilU.CleanupCalled() {
+		t.Error("CleanupCalled should be false for a nil updater")
+	}
+}
+
+func TestUpdaterFinalizerRemovesTempFiles(t *testing.T) {
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.DiscardHandler))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	// Create the updater in a separate function so no reference to it
+	// survives on this stack frame.
+	tempDir, inputFile := func() (string, string) {
+		var buf bytes.Buffer
+		blank, err := NewBlank()
+		if err != nil {
+			t.Fatalf("NewBlank: %v", err)
+		}
+		defer blank.Cleanup()
+		if err := blank.SaveToWriter(&buf); err != nil {
+			t.Fatalf("SaveToWriter: %v", err)
+		}
+
+		u, err := NewFromReader(&buf)
+		if err != nil {
+			t.Fatalf("NewFromReader: %v", err)
+		}
+		return u.tempDir, u.tempInputFile
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		runtime.GC()
+		_, dirErr := os.Stat(tempDir)
+		_, fileErr := os.Stat(inputFile)
+		if os.IsNotExist(dirErr) && os.IsNotExist(fileErr) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("finalizer did not remove temp files (dir err: %v, input file err: %v)", dirErr, fileErr)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,194 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// LineNumberRestart defines when line numbering restarts
+type LineNumberRestart string
+
+const (
+	// LineNumberContinuous numbers lines continuously through the document
+	LineNumberContinuous LineNumberRestart = "continuous"
+	// LineNumberEachPage restarts numbering on each page (default)
+	LineNumberEachPage LineNumberRestart = "newPage"
+	// LineNumberEachSection restarts numbering at each section
+	LineNumberEachSection LineNumberRestart = "newSection"
+)
+
+// LineNumberingOptions defines margin line numbering for a section
+type LineNumberingOptions struct {
+	// CountBy shows a number on every Nth line (default: 1)
+	CountBy int
+
+	// Start is the first line number (default: 1)
+	Start int
+
+	// Distance between the numbers and the text in twips (0 for automatic)
+	Distance int
+
+	// Restart controls when numbering restarts (default: LineNumberEachPage)
+	Restart LineNumberRestart
+}
+
+// sectPrChildOrder is the CT_SectPr child sequence.
+var sectPrChildOrder = []string{
+	"headerReference", "footerReference", "footnotePr", "endnotePr", "type", "pgSz", "pgMar",
+	"paperSrc", "pgBorders", "lnNumType", "pgNumType", "cols", "formProt", "vAlign", "noEndnote",
+	"titlePg", "textDirection", "bidi", "rtlGutter", "docGrid", "printerSettings", "sectPrChange",
+}
+
+var lnNumTypePattern = regexp.MustCompile(`<w:lnNumType(?:\s[^>]*)?/>`)
+
+// SetLineNumbering turns on line numbers in the margin for the document's
+// final (body-level) section, replacing any existing line numbering settings.
+// Individual paragraphs can opt out with ParagraphOptions.SuppressLineNumbers.
+func (u *Updater) SetLineNumbering(opts LineNumberingOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if opts.CountBy < 0 {
+		return NewValidationError("CountBy", "must be >= 0")
+	}
+	if opts.Start < 0 {
+		return NewValidationError("Start", "must be >= 0")
+	}
+	if opts.Distance < 0 {
+		return NewValidationError("Distance", "must be >= 0")
+	}
+	switch opts.Restart {
+	case "", LineNumberContinuous, LineNumberEachPage, LineNumberEachSection:
+	default:
+		return NewValidationError("Restart", fmt.Sprintf("invalid line number restart: %s", opts.Restart))
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	updated, err := setBodySectPrChild(raw, "w:lnNumType", generateLineNumberingXML(opts))
+	if err != nil {
+		return fmt.Errorf("set line numbering: %w", err)
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// RemoveLineNumbering turns off line numbering in every section of the document.
+func (u *Updater) RemoveLineNumbering() error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	updated := lnNumTypePattern.ReplaceAll(raw, nil)
+	if len(updated) == len(raw) {
+		return nil
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// generateLineNumberingXML creates the <w:lnNumType> element. Word stores the
+// starting value zero-based, so Start 1 is the attribute default of 0.
+func generateLineNumberingXML(opts LineNumberingOptions) string {
+	countBy := opts.CountBy
+	if countBy == 0 {
+		countBy = 1
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, `<w:lnNumType w:countBy="%d"`, countBy)
+	if opts.Start > 1 {
+		fmt.Fprintf(&buf, ` w:start="%d"`, opts.Start-1)
+	}
+	if opts.Distance > 0 {
+		fmt.Fprintf(&buf, ` w:distance="%d"`, opts.Distance)
+	}
+	if opts.Restart != "" {
+		fmt.Fprintf(&buf, ` w:restart="%s"`, opts.Restart)
+	}
+	buf.WriteString(`/>`)
+	return buf.String()
+}
+
+// setBodySectPrChild replaces (or removes, when elemXML is empty) the qname
+// child of the body-level <w:sectPr>, keeping the schema order. A sectPr is
+// created when the body has none.
+func setBodySectPrChild(docXML []byte, qname, elemXML string) ([]byte, error) {
+	bodyEnd := bytes.LastIndex(docXML, []byte("</w:body>"))
+	if bodyEnd == -1 {
+		return nil, NewMalformedXMLError("could not find </w:body> tag")
+	}
+
+	// The body-level sectPr is the last child of the body; a sectPr inside a
+	// paragraph's pPr belongs to an earlier section.
+	sectPrStart := bytes.LastIndex(docXML[:bodyEnd], []byte("<w:sectPr"))
+	if sectPrStart != -1 && bytes.Contains(docXML[sectPrStart:bodyEnd], []byte("</w:p>")) {
+		sectPrStart = -1
+	}
+	if sectPrStart == -1 {
+		if elemXML == "" {
+			return docXML, nil
+		}
+		newSectPr := "<w:sectPr>" + elemXML + "</w:sectPr>"
+		result := make([]byte, 0, len(docXML)+len(newSectPr))
+		result = append(result, docXML[:bodyEnd]...)
+		result = append(result, newSectPr...)
+		result = append(result, docXML[bodyEnd:]...)
+		return result, nil
+	}
+
+	openEnd := bytes.IndexByte(docXML[sectPrStart:], '>')
+	if openEnd == -1 {
+		return nil, NewMalformedXMLError("malformed sectPr element")
+	}
+	openEnd += sectPrStart + 1
+
+	var openTag []byte
+	var inner []byte
+	sectPrEnd := openEnd
+	if docXML[openEnd-2] == '/' {
+		openTag = append([]byte(nil), docXML[sectPrStart:openEnd-2]...)
+		openTag = append(openTag, '>')
+	} else {
+		closeIdx := bytes.Index(docXML[openEnd:bodyEnd], []byte("</w:sectPr>"))
+		if closeIdx == -1 {
+			return nil, NewMalformedXMLError("malformed sectPr element")
+		}
+		openTag = docXML[sectPrStart:openEnd]
+		inner = docXML[openEnd : openEnd+closeIdx]
+		sectPrEnd = openEnd + closeIdx + len("</w:sectPr>")
+	}
+
+	children := upsertOrderedChild(splitXMLChildren(inner), qname, elemXML, sectPrChildOrder)
+
+	var buf bytes.Buffer
+	buf.Grow(len(docXML) + len(elemXML))
+	buf.Write(docXML[:sectPrStart])
+	buf.Write(openTag)
+	for _, c := range children {
+		buf.Write(c.xml)
+	}
+	buf.WriteString("</w:sectPr>")
+	buf.Write(docXML[sectPrEnd:])
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,93 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetLineNumbering(t *testing.T) {
+	body := `<w:p><w:r><w:t>Clause 1</w:t></w:r></w:p>` +
+		`<w:sectPr><w:pgSz w:w="12240" w:h="15840"/><w:pgMar w:top="1440" w:right="1440" w:bottom="1440" w:left="1440" w:header="720" w:footer="720" w:gutter="0"/><w:cols w:space="720"/></w:sectPr>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	err := u.SetLineNumbering(LineNumberingOptions{CountBy: 5, Start: 1, Distance: 360, Restart: LineNumberEachSection})
+	if err != nil {
+		t.Fatalf("SetLineNumbering: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	want := `<w:pgMar w:top="1440" w:right="1440" w:bottom="1440" w:left="1440" w:header="720" w:footer="720" w:gutter="0"/>` +
+		`<w:lnNumType w:countBy="5" w:distance="360" w:restart="newSection"/><w:cols w:space="720"/>`
+	if !strings.Contains(docXML, want) {
+		t.Errorf("expected lnNumType between pgMar and cols, got: %s", docXML)
+	}
+
+	// Setting again replaces the element.
+	if err := u.SetLineNumbering(LineNumberingOptions{Start: 10}); err != nil {
+		t.Fatalf("SetLineNumbering: %v", err)
+	}
+	docXML = readDocXML(t, u)
+	if n := strings.Count(docXML, "<w:lnNumType"); n != 1 {
+		t.Errorf("expected one lnNumType, found %d", n)
+	}
+	if !strings.Contains(docXML, `<w:lnNumType w:countBy="1" w:start="9"/>`) {
+		t.Errorf("expected replaced lnNumType, got: %s", docXML)
+	}
+
+	if err := u.RemoveLineNumbering(); err != nil {
+		t.Fatalf("RemoveLineNumbering: %v", err)
+	}
+	docXML = readDocXML(t, u)
+	if strings.Contains(docXML, "lnNumType") {
+		t.Errorf("expected lnNumType to be removed, got: %s", docXML)
+	}
+	if !strings.Contains(docXML, `<w:cols w:space="720"/></w:sectPr>`) {
+		t.Errorf("expected the rest of sectPr to be kept, got: %s", docXML)
+	}
+}
+
+func TestSetLineNumbering_CreatesSectPr(t *testing.T) {
+	body := `<w:p><w:pPr><w:sectPr><w:type w:val="nextPage"/></w:sectPr></w:pPr></w:p><w:p><w:r><w:t>Second section</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.SetLineNumbering(LineNumberingOptions{}); err != nil {
+		t.Fatalf("SetLineNumbering: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:sectPr><w:type w:val="nextPage"/></w:sectPr>`) {
+		t.Errorf("expected paragraph-level sectPr to be untouched, got: %s", docXML)
+	}
+	if !strings.Contains(docXML, `</w:p><w:sectPr><w:lnNumType w:countBy="1"/></w:sectPr></w:body>`) {
+		t.Errorf("expected new body-level sectPr, got: %s", docXML)
+	}
+}
+
+func TestSetLineNumbering_Errors(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Text</w:t></w:r></w:p>`))
+
+	for _, opts := range []LineNumberingOptions{
+		{CountBy: -1},
+		{Start: -1},
+		{Distance: -5},
+		{Restart: "eachLine"},
+	} {
+		if err := u.SetLineNumbering(opts); err == nil {
+			t.Errorf("expected error for %+v", opts)
+		}
+	}
+}
+
+func TestParagraphSuppressLineNumbers(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Text</w:t></w:r></w:p>`))
+
+	err := u.InsertParagraph(ParagraphOptions{Text: "Heading", KeepNext: true, SuppressLineNumbers: true, BackgroundColor: "EEEEEE", Position: PositionEnd})
+	if err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:keepNext/><w:suppressLineNumbers/><w:shd`) {
+		t.Errorf("expected suppressLineNumbers in pPr order, got: %s", docXML)
+	}
+}
@@ -37,21 +37,23 @@ func (u *Updater) ensureNumberingXML() error {
 		} else if hasLegacyManagedNumbering(content) {
 			u.setListNumberingIDs(BulletListNumID, NumberedListNumID)
 		} else {
-			updated, bulletID, numberedID, appendErr := appendDocxUpdateNumberingDefinitions(content)
-			if appendErr != nil {
-				return fmt.Errorf("append numbering definitions: %w", appendErr)
+			// Reuse matching list definitions of the document, if any.
+			bulletID, err := u.getOrCreateNumbering(bulletNumberingOptions())
+			if err != nil {
+				return fmt.Errorf("add bullet numbering: %w", err)
 			}
-			if err := atomicWriteFile(numberingPath, []byte(updated), 0o644); err != nil {
-				return fmt.Errorf("write numbering.xml: %w", err)
+			numberedID, err := u.getOrCreateNumbering(numberedNumberingOptions())
+			if err != nil {
+				return fmt.Errorf("add numbered list numbering: %w", err)
 			}
 			u.setListNumberingIDs(bulletID, numberedID)
 		}
 	} else if !os.IsNotExist(err) {
-		return fmt.Errorf("read numbering.xml: %w", err)
+		return NewFileReadError("numbering.xml", err)
 	} else {
 		numberingXML := generateNumberingXML()
 		if err := atomicWriteFile(numberingPath, []byte(numberingXML), 0o644); err != nil {
-			return fmt.Errorf("write numbering.xml: %w", err)
+			return NewXMLWriteError("numbering.xml", err)
 		}
 		u.setListNumberingIDs(BulletListNumID, NumberedListNumID)
 	}
@@ -71,6 +73,11 @@ func (u *Updater) ensureNumberingXML() error {
 
 // generateNumberingXML creates a complete numbering.xml with bullet and numbered list definitions
 func generateNumberingXML() string {
+	return generateNumberingXMLWith(generateDocxUpdateNumberingDefinitions(0, 1, BulletListNumID, NumberedListNumID))
+}
+
+// generateNumberingXMLWith creates a numbering.xml holding definitions.
+func generateNumberingXMLWith(definitions string) string {
 	var buf bytes.Buffer
 
 	buf.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
@@ -78,72 +85,20 @@ func generateNumberingXML() string {
 	buf.WriteString(`             xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006" ` + "\n")
 	buf.WriteString(`             xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml"` + "\n")
 	buf.WriteString(`             mc:Ignorable="w14">` + "\n")
-	buf.WriteString(generateDocxUpdateNumberingDefinitions(0, 1, BulletListNumID, NumberedListNumID))
+	buf.WriteString(definitions)
 	buf.WriteString("\n</w:numbering>")
 
 	return buf.String()
 }
 
 func generateDocxUpdateNumberingDefinitions(bulletAbstractID, numberedAbstractID, bulletNumID, numberedNumID int) string {
-	var buf bytes.Buffer
-
-	bulletSymbols := []string{"●", "○", "■", "●", "○", "■", "●", "○", "■"}
-	bulletFonts := []string{"Symbol", "Courier New", "Wingdings", "", "", "", "", "", ""}
-
-	buf.WriteString("\n  <!-- Abstract Numbering Definition for Bullets -->\n")
-	buf.WriteString(fmt.Sprintf("  <w:abstractNum w:abstractNumId=\"%d\">\n", bulletAbstractID))
-	buf.WriteString("    <w:multiLevelType w:val=\"hybridMultilevel\"/>\n")
-	for level := 0; level <= 8; level++ {
-		left := 720 * (level + 1)
-		buf.WriteString(fmt.Sprintf("    <w:lvl w:ilvl=\"%d\">\n", level))
-		buf.WriteString("      <w:start w:val=\"1\"/>\n")
-		buf.WriteString("      <w:numFmt w:val=\"bullet\"/>\n")
-		buf.WriteString(fmt.Sprintf("      <w:lvlText w:val=\"%s\"/>\n", bulletSymbols[level]))
-		buf.WriteString("      <w:lvlJc w:val=\"left\"/>\n")
-		buf.WriteString("      <w:pPr>\n")
-		buf.WriteString(fmt.Sprintf("        <w:ind w:left=\"%d\" w:hanging=\"360\"/>\n", left))
-		buf.WriteString("      </w:pPr>\n")
-		if font := bulletFonts[level]; font != "" {
-			buf.WriteString("      <w:rPr>\n")
-			buf.WriteString(fmt.Sprintf("        <w:rFonts w:ascii=\"%s\" w:hAnsi=\"%s\" w:hint=\"default\"/>\n", font, font))
-			buf.WriteString("      </w:rPr>\n")
-		}
-		buf.WriteString("    </w:lvl>\n")
-	}
-	buf.WriteString("  </w:abstractNum>\n")
-
-	numberedFormats := []string{"decimal", "lowerLetter", "lowerRoman", "decimal", "lowerLetter", "lowerRoman", "decimal", "lowerLetter", "lowerRoman"}
-	numberedTexts := []string{"%1.", "%2.", "%3.", "%4)", "(%5)", "(%6)", "%7.", "%8.", "%9."}
-
-	buf.WriteString("\n  <!-- Abstract Numbering Definition for Numbered Lists -->\n")
-	buf.WriteString(fmt.Sprintf("  <w:abstractNum w:abstractNumId=\"%d\">\n", numberedAbstractID))
-	buf.WriteString("    <w:multiLevelType w:val=\"hybridMultilevel\"/>\n")
-	for level := 0; level <= 8; level++ {
-		left := 720 * (level + 1)
-		buf.WriteString(fmt.Sprintf("    <w:lvl w:ilvl=\"%d\">\n", level))
-		buf.WriteString("      <w:start w:val=\"1\"/>\n")
-		buf.WriteString(fmt.Sprintf("      <w:numFmt w:val=\"%s\"/>\n", numberedFormats[level]))
-		buf.WriteString(fmt.Sprintf("      <w:lvlText w:val=\"%s\"/>\n", numberedTexts[level]))
-		buf.WriteString("      <w:lvlJc w:val=\"left\"/>\n")
-		buf.WriteString("      <w:pPr>\n")
-		buf.WriteString(fmt.Sprintf("        <w:ind w:left=\"%d\" w:hanging=\"360\"/>\n", left))
-		buf.WriteString("      </w:pPr>\n")
-		buf.WriteString("    </w:lvl>\n")
-	}
-	buf.WriteString("  </w:abstractNum>\n")
-
-	buf.WriteString("\n")
-	buf.WriteString(fmt.Sprintf("  <!-- DOCXUPDATE_BULLET_NUMID:%d -->\n", bulletNumID))
-	buf.WriteString(fmt.Sprintf("  <w:num w:numId=\"%d\">\n", bulletNumID))
-	buf.WriteString(fmt.Sprintf("    <w:abstractNumId w:val=\"%d\"/>\n", bulletAbstractID))
-	buf.WriteString("  </w:num>\n")
-
-	buf.WriteString(fmt.Sprintf("  <!-- DOCXUPDATE_NUMBERED_NUMID:%d -->\n", numberedNumID))
-	buf.WriteString(fmt.Sprintf("  <w:num w:numId=\"%d\">\n", numberedNumID))
-	buf.WriteString(fmt.Sprintf("    <w:abstractNumId w:val=\"%d\"/>\n", numberedAbstractID))
-	buf.WriteString("  </w:num>\n")
-
-	return buf.String()
+	bullet := bulletNumberingOptions()
+	numbered := numberedNumberingOptions()
+	return generateAbstractNumXML(bulletAbstractID, bullet) +
+		generateAbstractNumXML(numberedAbstractID, numbered) +
+		"\n" +
+		generateNumXML(bulletNumID, bulletAbstractID, bullet) +
+		generateNumXML(numberedNumID, numberedAbstractID, numbered)
 }
 
 func extractDocxUpdateNumberingIDs(content string) (int, int, bool) {
@@ -177,27 +132,6 @@ func hasLegacyManagedNumbering(content string) bool {
 		strings.Contains(content, `w:numFmt w:val="decimal"`)
 }
 
-func appendDocxUpdateNumberingDefinitions(content string) (string, int, int, error) {
-	closingTag := "</w:numbering>"
-	insertPos := strings.LastIndex(content, closingTag)
-	if insertPos == -1 {
-		return "", 0, 0, fmt.Errorf("invalid numbering.xml: missing </w:numbering>")
-	}
-
-	maxNumID := findMaxXMLAttributeInt(content, numIDPattern)
-	maxAbstractNumID := findMaxXMLAttributeInt(content, abstractNumIDPattern)
-
-	bulletAbstractID := maxAbstractNumID + 1
-	numberedAbstractID := maxAbstractNumID + 2
-	bulletNumID := maxNumID + 1
-	numberedNumID := maxNumID + 2
-
-	definitions := generateDocxUpdateNumberingDefinitions(bulletAbstractID, numberedAbstractID, bulletNumID, numberedNumID)
-	updated := content[:insertPos] + definitions + "\n" + content[insertPos:]
-
-	return updated, bulletNumID, numberedNumID, nil
-}
-
 func findMaxXMLAttributeInt(content string, pattern *regexp.Regexp) int {
 	maxValue := 0
 	matches := pattern.FindAllStringSubmatch(content, -1)
@@ -227,7 +161,7 @@ func (u *Updater) setListNumberingIDs(bulletID, numberedID int) {
 func allocateRestartNumIDInContent(content string, numberedNumID, level int) (int, string, error) {
 	abstractID := findAbstractNumIDForNum(content, numberedNumID)
 	if abstractID < 0 {
-		return 0, "", fmt.Errorf("could not find abstractNumId for numbered list numId=%d", numberedNumID)
+		return 0, "", NewMalformedXMLError(fmt.Sprintf("could not find abstractNumId for numbered list numId=%d", numberedNumID))
 	}
 
 	maxNumID := findMaxXMLAttributeInt(content, numIDPattern)
@@ -243,7 +177,7 @@ func allocateRestartNumIDInContent(content string, numberedNumID, level int) (in
 	closingTag := "</w:numbering>"
 	insertPos := strings.LastIndex(content, closingTag)
 	if insertPos == -1 {
-		return 0, "", fmt.Errorf("invalid numbering.xml: missing </w:numbering>")
+		return 0, "", NewMalformedXMLError("invalid numbering.xml: missing </w:numbering>")
 	}
 
 	updated := content[:insertPos] + newNum + "\n" + content[insertPos:]
@@ -258,7 +192,7 @@ func (u *Updater) allocateRestartNumID(level int) (int, error) {
 	numberingPath := filepath.Join(u.tempDir, "word", "numbering.xml")
 	data, err := os.ReadFile(numberingPath)
 	if err != nil {
-		return 0, fmt.Errorf("read numbering.xml: %w", err)
+		return 0, NewFileReadError("numbering.xml", err)
 	}
 
 	ids := u.getListNumberingIDs()
@@ -268,7 +202,7 @@ func (u *Updater) allocateRestartNumID(level int) (int, error) {
 	}
 
 	if err := atomicWriteFile(numberingPath, []byte(updated), 0o644); err != nil {
-		return 0, fmt.Errorf("write numbering.xml: %w", err)
+		return 0, NewXMLWriteError("numbering.xml", err)
 	}
 
 	return newNumID, nil
@@ -316,7 +250,7 @@ func (u *Updater) ensureNumberingContentType() error {
 	contentTypesPath := filepath.Join(u.tempDir, "[Content_Types].xml")
 	data, err := os.ReadFile(contentTypesPath)
 	if err != nil {
-		return fmt.Errorf("read [Content_Types].xml: %w", err)
+		return NewFileReadError("[Content_Types].xml", err)
 	}
 
 	content := string(data)
@@ -338,7 +272,7 @@ func (u *Updater) ensureNumberingRelationship() error {
 	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
 	data, err := os.ReadFile(relsPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml.rels: %w", err)
+		return NewFileReadError("document.xml.rels", err)
 	}
 
 	content := string(data)
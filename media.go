@@ -0,0 +1,177 @@
+package godocx
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// relationshipElemPattern matches one <Relationship .../> element together
+	// with the whitespace before it, so that removing it leaves tidy XML.
+	relationshipElemPattern = regexp.MustCompile(`\s*<Relationship\b[^>]*?/>`)
+	relationshipAttrPattern = regexp.MustCompile(`\b(Id|Type|Target|TargetMode)="([^"]*)"`)
+	// relIDReferencePattern matches relationship references in document XML
+	// (r:embed on blips, r:link on linked pictures, r:id on VML and charts).
+	relIDReferencePattern = regexp.MustCompile(`\br:(?:embed|link|id)="([^"]+)"`)
+)
+
+// CleanOrphanedMedia removes files under word/media that are no longer the
+// target of any relationship in the package, for example images left behind
+// by editing the document XML directly. It returns the number of files removed.
+func (u *Updater) CleanOrphanedMedia() (int, error) {
+	if u == nil {
+		return 0, NewValidationError("updater", "updater is nil")
+	}
+
+	referenced, err := u.relationshipTargets()
+	if err != nil {
+		return 0, err
+	}
+
+	mediaDir := filepath.Join(u.tempDir, "word", "media")
+	entries, err := os.ReadDir(mediaDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, NewFileReadError("word/media", err)
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if referenced["word/media/"+entry.Name()] {
+			continue
+		}
+		if err := os.Remove(filepath.Join(mediaDir, entry.Name())); err != nil {
+			return removed, NewFileWriteError("word/media/"+entry.Name(), err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// removeUnreferencedImages drops the image relationships whose IDs were
+// referenced in before but no longer in after (the document XML around a
+// deletion), and deletes their media files once nothing else targets them.
+// Images shared with other drawings keep their relationship.
+func (u *Updater) removeUnreferencedImages(before, after []byte) error {
+	stillUsed := make(map[string]bool)
+	for _, m := range relIDReferencePattern.FindAllSubmatch(after, -1) {
+		stillUsed[string(m[1])] = true
+	}
+	var dropped []string
+	for _, m := range relIDReferencePattern.FindAllSubmatch(before, -1) {
+		if id := string(m[1]); !stillUsed[id] {
+			dropped = append(dropped, id)
+			stillUsed[id] = true // record each ID once
+		}
+	}
+	if len(dropped) == 0 {
+		return nil
+	}
+
+	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
+	raw, err := os.ReadFile(relsPath)
+	if err != nil {
+		return NewFileReadError("document relationships", err)
+	}
+
+	var candidates []string
+	updated := relationshipElemPattern.ReplaceAllFunc(raw, func(elem []byte) []byte {
+		attrs := relationshipAttrs(elem)
+		if !strings.HasSuffix(attrs["Type"], "/image") || !slices.Contains(dropped, attrs["Id"]) {
+			return elem
+		}
+		if attrs["TargetMode"] != "External" {
+			candidates = append(candidates, resolveRelationshipTarget("word", attrs["Target"]))
+		}
+		return nil
+	})
+	if bytes.Equal(updated, raw) {
+		return nil
+	}
+	if err := atomicWriteFile(relsPath, updated, 0o644); err != nil {
+		return NewFileWriteError("relationships", err)
+	}
+
+	// Headers, footers and other parts may point at the same media file
+	// through their own relationships.
+	referenced, err := u.relationshipTargets()
+	if err != nil {
+		return err
+	}
+	for _, part := range candidates {
+		if referenced[part] {
+			continue
+		}
+		if err := os.Remove(filepath.Join(u.tempDir, filepath.FromSlash(part))); err != nil && !os.IsNotExist(err) {
+			return NewFileWriteError(part, err)
+		}
+	}
+	return nil
+}
+
+// relationshipTargets returns the package paths (e.g. "word/media/image1.png")
+// of all internal relationship targets in every .rels part of the package.
+func (u *Updater) relationshipTargets() (map[string]bool, error) {
+	targets := make(map[string]bool)
+	err := filepath.WalkDir(u.tempDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".rels") {
+			return nil
+		}
+		rel, err := filepath.Rel(u.tempDir, p)
+		if err != nil {
+			return err
+		}
+		// A part's relationships live in <dir>/_rels/<part>.rels and resolve
+		// against <dir>; the package relationships resolve against the root.
+		sourceDir := path.Dir(path.Dir(filepath.ToSlash(rel)))
+		raw, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		for _, elem := range relationshipElemPattern.FindAll(raw, -1) {
+			attrs := relationshipAttrs(elem)
+			if attrs["TargetMode"] == "External" || attrs["Target"] == "" {
+				continue
+			}
+			targets[resolveRelationshipTarget(sourceDir, attrs["Target"])] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, NewFileReadError("relationships", err)
+	}
+	return targets, nil
+}
+
+// relationshipAttrs returns the Id, Type, Target and TargetMode attributes of
+// a <Relationship> element.
+func relationshipAttrs(elem []byte) map[string]string {
+	attrs := make(map[string]string, 4)
+	for _, m := range relationshipAttrPattern.FindAllSubmatch(elem, -1) {
+		attrs[string(m[1])] = xmlUnescape(string(m[2]))
+	}
+	return attrs
+}
+
+// resolveRelationshipTarget resolves a relationship target against the
+// directory of its source part, returning a package path without a leading slash.
+func resolveRelationshipTarget(sourceDir, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(path.Clean(target), "/")
+	}
+	return strings.TrimPrefix(path.Join(sourceDir, target), "./")
+}
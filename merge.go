@@ -14,25 +14,25 @@ import (
 // Note: nested tables (a table inside a table cell) are not supported.
 func (u *Updater) MergeTableCellsHorizontal(tableIndex, row, startCol, endCol int) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if tableIndex < 1 {
-		return fmt.Errorf("tableIndex must be >= 1")
+		return NewValidationError("tableIndex", "tableIndex must be >= 1")
 	}
 	if row < 1 {
-		return fmt.Errorf("row must be >= 1")
+		return NewValidationError("row", "row must be >= 1")
 	}
 	if startCol < 1 {
-		return fmt.Errorf("startCol must be >= 1")
+		return NewValidationError("startCol", "startCol must be >= 1")
 	}
 	if endCol <= startCol {
-		return fmt.Errorf("endCol must be greater than startCol")
+		return NewValidationError("endCol", "endCol must be greater than startCol")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	updated, err := mergeTableCellsHorizontal(raw, tableIndex, row, startCol, endCol)
@@ -51,25 +51,25 @@ func (u *Updater) MergeTableCellsHorizontal(tableIndex, row, startCol, endCol in
 // Note: nested tables (a table inside a table cell) are not supported.
 func (u *Updater) MergeTableCellsVertical(tableIndex, startRow, endRow, col int) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if tableIndex < 1 {
-		return fmt.Errorf("tableIndex must be >= 1")
+		return NewValidationError("tableIndex", "tableIndex must be >= 1")
 	}
 	if col < 1 {
-		return fmt.Errorf("col must be >= 1")
+		return NewValidationError("col", "col must be >= 1")
 	}
 	if startRow < 1 {
-		return fmt.Errorf("startRow must be >= 1")
+		return NewValidationError("startRow", "startRow must be >= 1")
 	}
 	if endRow <= startRow {
-		return fmt.Errorf("endRow must be greater than startRow")
+		return NewValidationError("endRow", "endRow must be greater than startRow")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	updated, err := mergeTableCellsVertical(raw, tableIndex, startRow, endRow, col)
@@ -83,7 +83,7 @@ func (u *Updater) MergeTableCellsVertical(tableIndex, startRow, endRow, col int)
 // mergeTableCellsHorizontal performs horizontal cell merge on raw document XML.
 func mergeTableCellsHorizontal(raw []byte, tableIndex, row, startCol, endCol int) ([]byte, error) {
 	if endCol <= startCol {
-		return nil, fmt.Errorf("endCol (%d) must be greater than startCol (%d)", endCol, startCol)
+		return nil, NewValidationError("endCol", fmt.Sprintf("endCol (%d) must be greater than startCol (%d)", endCol, startCol))
 	}
 
 	content := string(raw)
@@ -124,7 +124,7 @@ func mergeTableCellsHorizontal(raw []byte, tableIndex, row, startCol, endCol int
 // mergeTableCellsVertical performs vertical cell merge on raw document XML.
 func mergeTableCellsVertical(raw []byte, tableIndex, startRow, endRow, col int) ([]byte, error) {
 	if endRow <= startRow {
-		return nil, fmt.Errorf("endRow (%d) must be greater than startRow (%d)", endRow, startRow)
+		return nil, NewValidationError("endRow", fmt.Sprintf("endRow (%d) must be greater than startRow (%d)", endRow, startRow))
 	}
 
 	result := string(raw)
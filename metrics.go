@@ -0,0 +1,76 @@
+package godocx
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// MetricsCollector receives the duration of each instrumented operation,
+// for example to feed OpenTelemetry spans or Prometheus histograms. The
+// metadata describes the operation (such as the part or chart it worked on)
+// and must not be modified.
+type MetricsCollector interface {
+	RecordOperation(name string, duration time.Duration, metadata map[string]any)
+}
+
+// Operation names passed to a MetricsCollector. Names with a dot are steps
+// of the operation before the dot, recorded in addition to its total.
+const (
+	MetricNew                      = "New"                       // opening and extracting a document, also via NewFromBytes and NewFromReader
+	MetricNewBlank                 = "NewBlank"                  // creating a blank document
+	MetricInsertChart              = "InsertChart"               // the whole InsertChart call
+	MetricInsertChartXML           = "InsertChart.chartXML"      // generating and writing the chart part
+	MetricInsertChartWorkbook      = "InsertChart.workbook"      // writing the embedded workbook
+	MetricInsertChartRelationships = "InsertChart.relationships" // writing the chart and document relationships
+	MetricInsertChartDrawing       = "InsertChart.drawing"       // inserting the drawing into document.xml
+	MetricInsertChartContentTypes  = "InsertChart.contentTypes"  // registering the chart content type
+	MetricSave                     = "Save"                      // zipping the package to a file
+	MetricSaveToWriter             = "SaveToWriter"              // zipping the package to a writer
+	MetricReplaceText              = "ReplaceText"               // the whole ReplaceText call
+	MetricReplaceTextParse         = "ReplaceText.parse"         // replacing text in one part's XML
+	MetricReplaceTextWrite         = "ReplaceText.write"         // writing one changed part
+)
+
+// WithMetrics reports the duration of New, NewBlank, InsertChart and its
+// steps, Save, SaveToWriter and ReplaceText to collector.
+func WithMetrics(collector MetricsCollector) Option {
+	return func(u *Updater) {
+		u.metrics = collector
+	}
+}
+
+// recordOperation reports the time since start to the metrics collector, if
+// any.
+func (u *Updater) recordOperation(name string, start time.Time, metadata map[string]any) {
+	if u.metrics != nil {
+		u.metrics.RecordOperation(name, time.Since(start), metadata)
+	}
+}
+
+// DefaultMetricsCollector adds up the durations recorded for each operation.
+// The zero value is ready to use, and it is safe for concurrent use, so one
+// collector can be shared by several Updaters.
+type DefaultMetricsCollector struct {
+	totals sync.Map // operation name -> *atomic.Int64 of nanoseconds
+}
+
+// RecordOperation adds duration to the total of the operation name. The
+// metadata is ignored.
+func (c *DefaultMetricsCollector) RecordOperation(name string, duration time.Duration, _ map[string]any) {
+	total, ok := c.totals.Load(name)
+	if !ok {
+		total, _ = c.totals.LoadOrStore(name, new(atomic.Int64))
+	}
+	total.(*atomic.Int64).Add(int64(duration))
+}
+
+// GetMetrics returns the total duration recorded for each operation.
+func (c *DefaultMetricsCollector) GetMetrics() map[string]time.Duration {
+	metrics := make(map[string]time.Duration)
+	c.totals.Range(func(name, total any) bool {
+		metrics[name.(string)] = time.Duration(total.(*atomic.Int64).Load())
+		return true
+	})
+	return metrics
+}
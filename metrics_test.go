@@ -0,0 +1,101 @@
+package godocx_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	godocx "github.com/falcomza/go-docx"
+)
+
+type recordedOperation struct {
+	name     string
+	metadata map[string]any
+}
+
+type recordingCollector struct {
+	ops []recordedOperation
+}
+
+func (c *recordingCollector) RecordOperation(name string, _ time.Duration, metadata map[string]any) {
+	c.ops = append(c.ops, recordedOperation{name, metadata})
+}
+
+func TestWithMetricsRecordsOperations(t *testing.T) {
+	var totals godocx.DefaultMetricsCollector
+	u, err := godocx.NewBlank(godocx.WithMetrics(&totals))
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.AddText("Revenue for FY2025", godocx.PositionEnd); err != nil {
+		t.Fatalf("AddText: %v", err)
+	}
+	if err := u.InsertChart(godocx.ChartOptions{
+		Position:   godocx.PositionEnd,
+		Categories: []string{"Q1", "Q2"},
+		Series:     []godocx.SeriesOptions{{Name: "Revenue", Values: []float64{1, 2}}},
+	}); err != nil {
+		t.Fatalf("InsertChart: %v", err)
+	}
+	if _, err := u.ReplaceText("FY2025", "FY2026", godocx.DefaultReplaceOptions()); err != nil {
+		t.Fatalf("ReplaceText: %v", err)
+	}
+	outputPath := filepath.Join(t.TempDir(), "out.docx")
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := u.SaveToWriter(&bytes.Buffer{}); err != nil {
+		t.Fatalf("SaveToWriter: %v", err)
+	}
+
+	metrics := totals.GetMetrics()
+	for _, name := range []string{
+		godocx.MetricNewBlank,
+		godocx.MetricInsertChart, godocx.MetricInsertChartXML, godocx.MetricInsertChartWorkbook,
+		godocx.MetricInsertChartRelationships, godocx.MetricInsertChartDrawing, godocx.MetricInsertChartContentTypes,
+		godocx.MetricReplaceText, godocx.MetricReplaceTextParse, godocx.MetricReplaceTextWrite,
+		godocx.MetricSave, godocx.MetricSaveToWriter,
+	} {
+		if _, ok := metrics[name]; !ok {
+			t.Errorf("no duration recorded for %s (got %v)", name, metrics)
+		}
+	}
+	if metrics[godocx.MetricInsertChart] < metrics[godocx.MetricInsertChartWorkbook] {
+		t.Errorf("InsertChart total %v is shorter than its workbook step %v",
+			metrics[godocx.MetricInsertChart], metrics[godocx.MetricInsertChartWorkbook])
+	}
+
+	var ops recordingCollector
+	reopened, err := godocx.New(outputPath, godocx.WithMetrics(&ops))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer reopened.Cleanup()
+	if len(ops.ops) != 1 || ops.ops[0].name != godocx.MetricNew || ops.ops[0].metadata["path"] != outputPath {
+		t.Errorf("expected one New operation for %s, got %+v", outputPath, ops.ops)
+	}
+}
+
+func TestDefaultMetricsCollectorAccumulates(t *testing.T) {
+	var c godocx.DefaultMetricsCollector
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.RecordOperation("Save", time.Millisecond, nil)
+		}()
+	}
+	wg.Wait()
+	c.RecordOperation("New", 3*time.Millisecond, map[string]any{"path": "a.docx"})
+
+	metrics := c.GetMetrics()
+	if metrics["Save"] != 50*time.Millisecond || metrics["New"] != 3*time.Millisecond || len(metrics) != 2 {
+		t.Errorf("unexpected totals: %v", metrics)
+	}
+}
@@ -0,0 +1,220 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// cloneBookmarkPattern matches bookmark markers, which must not be duplicated
+// because bookmark names and IDs have to be unique in a document.
+var cloneBookmarkPattern = regexp.MustCompile(`<w:bookmark(?:Start|End)\s[^>]*/>`)
+
+// MoveParagraph moves the top-level body paragraph at fromIndex so that it
+// becomes the paragraph at toIndex (both 1-based as in ParagraphInfo.Index,
+// counted before the move; paragraphs inside tables are not counted). The
+// paragraph is moved intact, so list items keep their numbering properties and
+// runs keep their formatting.
+func (u *Updater) MoveParagraph(fromIndex, toIndex int) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	return u.moveBodyElement("w:p", "paragraph", fromIndex, toIndex)
+}
+
+// MoveTable moves the top-level table at fromTableIndex so that it becomes the
+// table at toTableIndex (both 1-based). Tables nested inside cells move with
+// the table that contains them.
+func (u *Updater) MoveTable(fromTableIndex, toTableIndex int) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	return u.moveBodyElement("w:tbl", "table", fromTableIndex, toTableIndex)
+}
+
+func (u *Updater) moveBodyElement(qname, kind string, from, to int) error {
+	if from < 1 {
+		return NewValidationError("fromIndex", kind+" index must be >= 1")
+	}
+	if to < 1 {
+		return NewValidationError("toIndex", kind+" index must be >= 1")
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	updated, err := moveBodyChild(raw, qname, kind, from, to)
+	if err != nil {
+		return err
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// moveBodyChild moves the from-th top-level qname element of the body so it
+// ends up as the to-th element of that name.
+func moveBodyChild(raw []byte, qname, kind string, from, to int) ([]byte, error) {
+	bodyStart, bodyEnd, children, err := splitBodyChildren(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var positions []int // indexes into children of each qname element
+	for i, child := range children {
+		if child.name == qname {
+			positions = append(positions, i)
+		}
+	}
+	for _, idx := range []int{from, to} {
+		if idx > len(positions) {
+			return nil, NewValidationError("index",
+				fmt.Sprintf("%s %d out of range (document has %d %ss)", kind, idx, len(positions), kind))
+		}
+	}
+	if from == to {
+		return raw, nil
+	}
+
+	moved := children[positions[from-1]]
+	rest := make([]xmlChild, 0, len(children))
+	rest = append(rest, children[:positions[from-1]]...)
+	rest = append(rest, children[positions[from-1]+1:]...)
+
+	// Find where the element goes among the remaining ones: before the element
+	// now at position to, or after the last one when moving to the end.
+	insertAt := len(rest)
+	seen := 0
+	for i, child := range rest {
+		if child.name != qname {
+			continue
+		}
+		seen++
+		if seen == to {
+			insertAt = i
+			break
+		}
+		if seen == len(positions)-1 {
+			insertAt = i + 1
+		}
+	}
+
+	var body bytes.Buffer
+	for i, child := range rest {
+		if i == insertAt {
+			body.Write(moved.xml)
+		}
+		body.Write(child.xml)
+	}
+	if insertAt == len(rest) {
+		body.Write(moved.xml)
+	}
+
+	return replaceBodyContent(raw, bodyStart, bodyEnd, body.Bytes()), nil
+}
+
+// DuplicateParagraph copies the top-level body paragraph at index (1-based, as
+// in ParagraphInfo.Index) and inserts the copy directly after the original when
+// after is true, or before it otherwise. Each key of substitutions is replaced
+// by its value in the copy's text, even when the key is split across runs. The
+// copy keeps the original's style and run formatting; bookmarks are not copied.
+// Returns the 1-based index of the new paragraph.
+func (u *Updater) DuplicateParagraph(index int, after bool, substitutions map[string]string) (int, error) {
+	if u == nil {
+		return 0, NewValidationError("updater", "updater is nil")
+	}
+	for old := range substitutions {
+		if old == "" {
+			return 0, NewValidationError("substitutions", "pattern cannot be empty")
+		}
+	}
+
+	return u.duplicateBodyElement("w:p", "paragraph", index, after, func(para []byte) []byte {
+		if len(substitutions) == 0 {
+			return para
+		}
+		patterns := make([]string, 0, len(substitutions))
+		for old := range substitutions {
+			patterns = append(patterns, old)
+		}
+		r := newBulkReplacer(patterns, substitutions, ReplaceOptions{MatchCase: true})
+		total := 0
+		updated, _ := r.replaceInParagraph(para, make(map[string]int, len(patterns)), &total)
+		return updated
+	})
+}
+
+// DuplicateTable copies the top-level table at tableIndex (1-based) and
+// inserts the copy directly after the original when after is true, or before
+// it otherwise. Bookmarks inside the table are not copied.
+// Returns the 1-based index of the new table.
+func (u *Updater) DuplicateTable(tableIndex int, after bool) (int, error) {
+	if u == nil {
+		return 0, NewValidationError("updater", "updater is nil")
+	}
+	return u.duplicateBodyElement("w:tbl", "table", tableIndex, after, nil)
+}
+
+func (u *Updater) duplicateBodyElement(qname, kind string, index int, after bool, transform func([]byte) []byte) (int, error) {
+	if index < 1 {
+		return 0, NewValidationError("index", kind+" index must be >= 1")
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return 0, NewFileReadError("document.xml", err)
+	}
+
+	bodyStart, bodyEnd, children, err := splitBodyChildren(raw)
+	if err != nil {
+		return 0, err
+	}
+
+	target, count := -1, 0
+	for i, child := range children {
+		if child.name != qname {
+			continue
+		}
+		count++
+		if count == index {
+			target = i
+		}
+	}
+	if target == -1 {
+		return 0, NewValidationError("index",
+			fmt.Sprintf("%s %d out of range (document has %d %ss)", kind, index, count, kind))
+	}
+
+	clone := cloneBookmarkPattern.ReplaceAll(children[target].xml, nil)
+	if transform != nil {
+		clone = transform(clone)
+	}
+
+	var body bytes.Buffer
+	for i, child := range children {
+		if i == target && !after {
+			body.Write(clone)
+		}
+		body.Write(child.xml)
+		if i == target && after {
+			body.Write(clone)
+		}
+	}
+
+	if err := atomicWriteFile(docPath, replaceBodyContent(raw, bodyStart, bodyEnd, body.Bytes()), 0o644); err != nil {
+		return 0, NewXMLWriteError("document.xml", err)
+	}
+
+	if after {
+		return index + 1, nil
+	}
+	return index, nil
+}
@@ -0,0 +1,171 @@
+package godocx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMoveParagraph(t *testing.T) {
+	body := `<w:p><w:pPr><w:numPr><w:ilvl w:val="0"/><w:numId w:val="2"/></w:numPr></w:pPr><w:r><w:t>First</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Second</w:t></w:r></w:p>` +
+		`<w:tbl><w:tr><w:tc><w:p><w:r><w:t>Cell</w:t></w:r></w:p></w:tc></w:tr></w:tbl>` +
+		`<w:p><w:r><w:t>Third</w:t></w:r></w:p>` +
+		`<w:sectPr><w:pgSz w:w="12240" w:h="15840"/></w:sectPr>`
+
+	tests := []struct {
+		name     string
+		from, to int
+		want     []string
+	}{
+		{"first to last", 1, 3, []string{"Second", "Cell", "Third", "First"}},
+		{"last to first", 3, 1, []string{"Third", "First", "Second", "Cell"}},
+		{"first to middle", 1, 2, []string{"Second", "Cell", "First", "Third"}},
+		{"same position", 2, 2, []string{"First", "Second", "Cell", "Third"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+			if err := u.MoveParagraph(tt.from, tt.to); err != nil {
+				t.Fatalf("MoveParagraph(%d, %d): %v", tt.from, tt.to, err)
+			}
+
+			docXML := readDocXML(t, u)
+			assertTextOrder(t, docXML, tt.want)
+			if !strings.Contains(docXML, `<w:numPr><w:ilvl w:val="0"/><w:numId w:val="2"/></w:numPr></w:pPr><w:r><w:t>First</w:t>`) {
+				t.Error("list properties of the moved paragraph were lost")
+			}
+			if !strings.HasSuffix(docXML, `<w:sectPr><w:pgSz w:w="12240" w:h="15840"/></w:sectPr></w:body></w:document>`) {
+				t.Error("body sectPr is no longer the last body element")
+			}
+		})
+	}
+
+	t.Run("out of range", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		for _, r := range [][2]int{{0, 1}, {1, 0}, {4, 1}, {1, 4}} {
+			err := u.MoveParagraph(r[0], r[1])
+			var docxErr *DocxError
+			if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeValidation {
+				t.Errorf("MoveParagraph(%d, %d) error = %v, want validation error", r[0], r[1], err)
+			}
+		}
+	})
+}
+
+func TestMoveTable(t *testing.T) {
+	table := func(text string) string {
+		return `<w:tbl><w:tr><w:tc><w:p><w:r><w:t>` + text + `</w:t></w:r></w:p></w:tc></w:tr></w:tbl>`
+	}
+	nested := `<w:tbl><w:tr><w:tc>` + table("Inner") + `<w:p/></w:tc></w:tr></w:tbl>`
+	body := nested + `<w:p><w:r><w:t>Between</w:t></w:r></w:p>` + table("Second") + table("Third")
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.MoveTable(1, 3); err != nil {
+		t.Fatalf("MoveTable: %v", err)
+	}
+	docXML := readDocXML(t, u)
+	assertTextOrder(t, docXML, []string{"Between", "Second", "Third", "Inner"})
+	if !strings.Contains(docXML, nested) {
+		t.Error("nested table was not moved intact")
+	}
+
+	if err := u.MoveTable(1, 4); err == nil {
+		t.Error("expected error for out-of-range table index")
+	}
+}
+
+// assertTextOrder checks that each text appears in docXML after the previous one.
+func assertTextOrder(t *testing.T, docXML string, texts []string) {
+	t.Helper()
+	pos := 0
+	for _, text := range texts {
+		idx := strings.Index(docXML[pos:], "<w:t>"+text+"</w:t>")
+		if idx == -1 {
+			t.Errorf("%q not found in expected order %v\n%s", text, texts, docXML)
+			return
+		}
+		pos += idx
+	}
+}
+
+func TestDuplicateParagraph(t *testing.T) {
+	body := `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>` +
+		`<w:p><w:pPr><w:pStyle w:val="ListBullet"/></w:pPr><w:bookmarkStart w:id="0" w:name="item"/>` +
+		`<w:r><w:rPr><w:b/></w:rPr><w:t>Item: {{na</w:t></w:r><w:r><w:t>me}}</w:t></w:r><w:bookmarkEnd w:id="0"/></w:p>` +
+		`<w:p><w:r><w:t>Outro</w:t></w:r></w:p>`
+
+	t.Run("after with substitution", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		idx, err := u.DuplicateParagraph(2, true, map[string]string{"{{name}}": "Widget"})
+		if err != nil {
+			t.Fatalf("DuplicateParagraph: %v", err)
+		}
+		if idx != 3 {
+			t.Errorf("new index = %d, want 3", idx)
+		}
+
+		paragraphs, err := u.GetParagraphText()
+		if err != nil {
+			t.Fatalf("GetParagraphText: %v", err)
+		}
+		want := []string{"Intro", "Item: {{name}}", "Item: Widget", "Outro"}
+		if strings.Join(paragraphs, "|") != strings.Join(want, "|") {
+			t.Errorf("paragraphs = %q, want %q", paragraphs, want)
+		}
+
+		docXML := readDocXML(t, u)
+		if strings.Count(docXML, "<w:bookmarkStart") != 1 {
+			t.Error("bookmark should not be duplicated")
+		}
+		if strings.Count(docXML, `<w:pStyle w:val="ListBullet"/>`) != 2 {
+			t.Error("clone should keep the paragraph style")
+		}
+		if !strings.Contains(docXML, `<w:rPr><w:b/></w:rPr><w:t xml:space="preserve">Item: Widget</w:t>`) {
+			t.Errorf("clone should keep run formatting: %s", docXML)
+		}
+	})
+
+	t.Run("before", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		idx, err := u.DuplicateParagraph(1, false, nil)
+		if err != nil {
+			t.Fatalf("DuplicateParagraph: %v", err)
+		}
+		if idx != 1 {
+			t.Errorf("new index = %d, want 1", idx)
+		}
+		if n := strings.Count(readDocXML(t, u), "<w:t>Intro</w:t>"); n != 2 {
+			t.Errorf("Intro appears %d times, want 2", n)
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		if _, err := u.DuplicateParagraph(4, true, nil); err == nil {
+			t.Error("expected error for out-of-range index")
+		}
+	})
+}
+
+func TestDuplicateTable(t *testing.T) {
+	body := `<w:p><w:r><w:t>Before</w:t></w:r></w:p>` +
+		`<w:tbl><w:tr><w:tc><w:p><w:r><w:t>Row</w:t></w:r></w:p></w:tc></w:tr></w:tbl>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	idx, err := u.DuplicateTable(1, true)
+	if err != nil {
+		t.Fatalf("DuplicateTable: %v", err)
+	}
+	if idx != 2 {
+		t.Errorf("new index = %d, want 2", idx)
+	}
+	count, err := u.GetTableCount()
+	if err != nil || count != 2 {
+		t.Errorf("GetTableCount() = %d, %v; want 2", count, err)
+	}
+
+	if _, err := u.DuplicateTable(3, false); err == nil {
+		t.Error("expected error for out-of-range table index")
+	}
+}
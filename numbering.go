@@ -0,0 +1,323 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	abstractNumBlockPattern = regexp.MustCompile(`(?s)<w:abstractNum\b[^>]*\bw:abstractNumId="(\d+)"[^>]*>(.*?)</w:abstractNum>`)
+	numBlockPattern         = regexp.MustCompile(`(?s)<w:num\b[^>]*\bw:numId="(\d+)"[^>]*>(.*?)</w:num>`)
+	numberingLevelPattern   = regexp.MustCompile(`(?s)<w:lvl\b[^>]*\bw:ilvl="(\d+)"[^>]*>(.*?)</w:lvl>`)
+	numberingValuePattern   = regexp.MustCompile(`<w:(start|numFmt|lvlText) w:val="([^"]*)"`)
+	numberingIndentPattern  = regexp.MustCompile(`<w:ind\b[^>]*>`)
+	firstNumElementPattern  = regexp.MustCompile(`<w:num\b[^>]*\bw:numId=`)
+	numberingMarkerPattern  = regexp.MustCompile(`<!-- (\w+):(\d+) -->`)
+)
+
+// numberingLevel describes one level of a list numbering definition.
+type numberingLevel struct {
+	start   int
+	format  string // w:numFmt, e.g. "bullet" or "decimal"
+	text    string // w:lvlText, e.g. "●" or "%1."
+	left    int    // left indent of the level, in twips
+	hanging int    // hanging indent of the number, in twips
+	font    string // font of the number or bullet ("" for the paragraph font)
+}
+
+// abstractNumberingOptions describes a list numbering definition
+// (<w:abstractNum>) and how its numbering instance is labelled.
+type abstractNumberingOptions struct {
+	// name labels the definition in a comment, e.g. "Bullets".
+	name string
+	// multiLevelType is hybridMultilevel, multilevel or singleLevel.
+	multiLevelType string
+	levels         []numberingLevel
+	// marker, when set, is written as a <!-- marker:numId --> comment before
+	// a new <w:num> so the instance can be found again cheaply.
+	marker string
+	// shareInstance reuses a <w:num> of a matching definition that the
+	// document already has. Lists leave it unset so that they get their own
+	// instance, and with it their own counter, instead of continuing a list
+	// of the template.
+	shareInstance bool
+}
+
+// signature identifies the numbering a definition produces, ignoring
+// formatting that does not change the numbers, so that definitions written
+// by Word or other tools can be matched.
+func (opts abstractNumberingOptions) signature() string {
+	var b strings.Builder
+	for i, lvl := range opts.levels {
+		fmt.Fprintf(&b, "%d|%d|%s|%s|%d|%d;", i, lvl.start, lvl.format, lvl.text, lvl.left, lvl.hanging)
+	}
+	return b.String()
+}
+
+// numberingManager tracks the numbering definitions of word/numbering.xml.
+// It is created when a list first needs a definition and is re-parsed
+// whenever the part has changed since it was last read.
+type numberingManager struct {
+	// content is numbering.xml as last read or written.
+	content string
+	// abstracts maps the signature of each <w:abstractNum> to its IDs.
+	abstracts map[string][]int
+	// nums maps an abstractNumId to the numIds that reference it without
+	// level overrides.
+	nums map[int][]int
+	// marked maps a marker to the numId of the instance labelled with it.
+	marked map[string]int
+}
+
+func newNumberingManager(content string) *numberingManager {
+	m := &numberingManager{
+		content:   content,
+		abstracts: make(map[string][]int),
+		nums:      make(map[int][]int),
+		marked:    make(map[string]int),
+	}
+	for _, block := range abstractNumBlockPattern.FindAllStringSubmatch(content, -1) {
+		id, err := strconv.Atoi(block[1])
+		if err != nil {
+			continue
+		}
+		if sig := parseAbstractNumSignature(block[2]); sig != "" {
+			m.abstracts[sig] = append(m.abstracts[sig], id)
+		}
+	}
+	for _, block := range numBlockPattern.FindAllStringSubmatch(content, -1) {
+		numID, err := strconv.Atoi(block[1])
+		if err != nil || strings.Contains(block[2], "<w:lvlOverride") {
+			continue
+		}
+		ref := abstractNumRefPattern.FindStringSubmatch(block[2])
+		if ref == nil {
+			continue
+		}
+		if abstractID, err := strconv.Atoi(ref[1]); err == nil {
+			m.nums[abstractID] = append(m.nums[abstractID], numID)
+		}
+	}
+	for id := range m.nums {
+		slices.Sort(m.nums[id])
+	}
+	for _, match := range numberingMarkerPattern.FindAllStringSubmatch(content, -1) {
+		if numID, err := strconv.Atoi(match[2]); err == nil {
+			m.marked[match[1]] = numID
+		}
+	}
+	return m
+}
+
+// parseAbstractNumSignature returns the signature of the levels of an
+// existing <w:abstractNum>, or "" when it defines none (for example when it
+// links to a numbering style).
+func parseAbstractNumSignature(inner string) string {
+	var opts abstractNumberingOptions
+	for i, lvlMatch := range numberingLevelPattern.FindAllStringSubmatch(inner, -1) {
+		if lvlMatch[1] != strconv.Itoa(i) {
+			return ""
+		}
+		var lvl numberingLevel
+		for _, v := range numberingValuePattern.FindAllStringSubmatch(lvlMatch[2], -1) {
+			switch v[1] {
+			case "start":
+				lvl.start, _ = strconv.Atoi(v[2])
+			case "numFmt":
+				lvl.format = v[2]
+			case "lvlText":
+				lvl.text = xmlUnescape(v[2])
+			}
+		}
+		if ind := numberingIndentPattern.FindString(lvlMatch[2]); ind != "" {
+			for _, attr := range xmlAttrPattern.FindAllStringSubmatch(ind, -1) {
+				switch attr[1] {
+				case "w:left", "w:start":
+					lvl.left, _ = strconv.Atoi(attr[2])
+				case "w:hanging":
+					lvl.hanging, _ = strconv.Atoi(attr[2])
+				}
+			}
+		}
+		opts.levels = append(opts.levels, lvl)
+	}
+	return opts.signature()
+}
+
+// numberingManager returns the manager for the current numbering.xml,
+// creating the part with no definitions when the document has none.
+func (u *Updater) numberingManager() (*numberingManager, error) {
+	numberingPath := filepath.Join(u.tempDir, "word", "numbering.xml")
+	data, err := os.ReadFile(numberingPath)
+	if os.IsNotExist(err) {
+		data = []byte(generateNumberingXMLWith(""))
+		if err := atomicWriteFile(numberingPath, data, 0o644); err != nil {
+			return nil, NewXMLWriteError("numbering.xml", err)
+		}
+		if err := u.ensureNumberingContentType(); err != nil {
+			return nil, fmt.Errorf("update content types: %w", err)
+		}
+		if err := u.ensureNumberingRelationship(); err != nil {
+			return nil, fmt.Errorf("update relationships: %w", err)
+		}
+	} else if err != nil {
+		return nil, NewFileReadError("numbering.xml", err)
+	}
+
+	if u.numbering == nil || u.numbering.content != string(data) {
+		u.numbering = newNumberingManager(string(data))
+	}
+	return u.numbering, nil
+}
+
+// getOrCreateNumbering returns the numId of a numbering instance for opts.
+// An instance labelled with the marker of opts is reused. Otherwise an
+// existing definition with the same levels is reused and, when
+// opts.shareInstance is set, a numbering instance of it that has no level
+// overrides; the missing <w:abstractNum> and <w:num> are added to
+// numbering.xml.
+func (u *Updater) getOrCreateNumbering(opts abstractNumberingOptions) (int, error) {
+	m, err := u.numberingManager()
+	if err != nil {
+		return 0, err
+	}
+
+	content := m.content
+	abstractID := -1
+	for _, id := range m.abstracts[opts.signature()] {
+		if numID, ok := m.marked[opts.marker]; ok && opts.marker != "" && slices.Contains(m.nums[id], numID) {
+			return numID, nil
+		}
+		if nums := m.nums[id]; len(nums) > 0 && opts.shareInstance {
+			return nums[0], nil
+		}
+		if abstractID == -1 {
+			abstractID = id
+		}
+	}
+
+	// CT_Numbering lists every <w:abstractNum> before the first <w:num>.
+	if abstractID == -1 {
+		abstractID = findMaxXMLAttributeInt(content, abstractNumIDPattern) + 1
+		pos := strings.LastIndex(content, "</w:numbering>")
+		if loc := firstNumElementPattern.FindStringIndex(content); loc != nil {
+			pos = loc[0]
+		}
+		if pos == -1 {
+			return 0, NewMalformedXMLError("invalid numbering.xml: missing </w:numbering>")
+		}
+		content = content[:pos] + strings.TrimPrefix(generateAbstractNumXML(abstractID, opts), "\n") + content[pos:]
+	}
+
+	numID := findMaxXMLAttributeInt(content, numIDPattern) + 1
+	pos := strings.LastIndex(content, "</w:numbering>")
+	if pos == -1 {
+		return 0, NewMalformedXMLError("invalid numbering.xml: missing </w:numbering>")
+	}
+	content = content[:pos] + generateNumXML(numID, abstractID, opts) + content[pos:]
+
+	if err := atomicWriteFile(filepath.Join(u.tempDir, "word", "numbering.xml"), []byte(content), 0o644); err != nil {
+		return 0, NewXMLWriteError("numbering.xml", err)
+	}
+	u.numbering = newNumberingManager(content)
+	return numID, nil
+}
+
+// generateAbstractNumXML creates the <w:abstractNum> element for opts.
+func generateAbstractNumXML(abstractID int, opts abstractNumberingOptions) string {
+	var buf bytes.Buffer
+
+	if opts.name != "" {
+		buf.WriteString(fmt.Sprintf("\n  <!-- Abstract Numbering Definition for %s -->\n", opts.name))
+	}
+	buf.WriteString(fmt.Sprintf("  <w:abstractNum w:abstractNumId=\"%d\">\n", abstractID))
+	buf.WriteString(fmt.Sprintf("    <w:multiLevelType w:val=\"%s\"/>\n", opts.multiLevelType))
+	for level, lvl := range opts.levels {
+		buf.WriteString(fmt.Sprintf("    <w:lvl w:ilvl=\"%d\">\n", level))
+		buf.WriteString(fmt.Sprintf("      <w:start w:val=\"%d\"/>\n", lvl.start))
+		buf.WriteString(fmt.Sprintf("      <w:numFmt w:val=\"%s\"/>\n", lvl.format))
+		buf.WriteString(fmt.Sprintf("      <w:lvlText w:val=\"%s\"/>\n", xmlEscape(lvl.text)))
+		buf.WriteString("      <w:lvlJc w:val=\"left\"/>\n")
+		buf.WriteString("      <w:pPr>\n")
+		buf.WriteString(fmt.Sprintf("        <w:ind w:left=\"%d\" w:hanging=\"%d\"/>\n", lvl.left, lvl.hanging))
+		buf.WriteString("      </w:pPr>\n")
+		if lvl.font != "" {
+			buf.WriteString("      <w:rPr>\n")
+			buf.WriteString(fmt.Sprintf("        <w:rFonts w:ascii=\"%s\" w:hAnsi=\"%s\" w:hint=\"default\"/>\n", lvl.font, lvl.font))
+			buf.WriteString("      </w:rPr>\n")
+		}
+		buf.WriteString("    </w:lvl>\n")
+	}
+	buf.WriteString("  </w:abstractNum>\n")
+
+	return buf.String()
+}
+
+// generateNumXML creates the <w:num> element referencing abstractID,
+// preceded by the marker comment of opts when it has one.
+func generateNumXML(numID, abstractID int, opts abstractNumberingOptions) string {
+	var buf bytes.Buffer
+
+	if opts.marker != "" {
+		buf.WriteString(fmt.Sprintf("  <!-- %s:%d -->\n", opts.marker, numID))
+	}
+	buf.WriteString(fmt.Sprintf("  <w:num w:numId=\"%d\">\n", numID))
+	buf.WriteString(fmt.Sprintf("    <w:abstractNumId w:val=\"%d\"/>\n", abstractID))
+	buf.WriteString("  </w:num>\n")
+
+	return buf.String()
+}
+
+// bulletNumberingOptions is the definition of the managed bullet list.
+func bulletNumberingOptions() abstractNumberingOptions {
+	symbols := []string{"●", "○", "■", "●", "○", "■", "●", "○", "■"}
+	fonts := []string{"Symbol", "Courier New", "Wingdings", "", "", "", "", "", ""}
+
+	opts := abstractNumberingOptions{name: "Bullets", multiLevelType: "hybridMultilevel", marker: "DOCXUPDATE_BULLET_NUMID"}
+	for level := 0; level <= 8; level++ {
+		opts.levels = append(opts.levels, numberingLevel{
+			start: 1, format: "bullet", text: symbols[level], left: 720 * (level + 1), hanging: 360, font: fonts[level],
+		})
+	}
+	return opts
+}
+
+// numberedNumberingOptions is the definition of the managed numbered list.
+func numberedNumberingOptions() abstractNumberingOptions {
+	formats := []string{"decimal", "lowerLetter", "lowerRoman", "decimal", "lowerLetter", "lowerRoman", "decimal", "lowerLetter", "lowerRoman"}
+	texts := []string{"%1.", "%2.", "%3.", "%4)", "(%5)", "(%6)", "%7.", "%8.", "%9."}
+
+	opts := abstractNumberingOptions{name: "Numbered Lists", multiLevelType: "hybridMultilevel", marker: "DOCXUPDATE_NUMBERED_NUMID"}
+	for level := 0; level <= 8; level++ {
+		opts.levels = append(opts.levels, numberingLevel{
+			start: 1, format: formats[level], text: texts[level], left: 720 * (level + 1), hanging: 360,
+		})
+	}
+	return opts
+}
+
+// headingNumberingOptions is the multilevel outline numbering (1, 1.1,
+// 1.1.1, ...) of numbered headings, with Word's heading indents.
+func headingNumberingOptions() abstractNumberingOptions {
+	opts := abstractNumberingOptions{
+		name: "Headings", multiLevelType: "multilevel", marker: "DOCXUPDATE_HEADING_NUMID", shareInstance: true,
+	}
+	lvlText := ""
+	for level := 0; level <= 8; level++ {
+		if level > 0 {
+			lvlText += "."
+		}
+		lvlText += fmt.Sprintf("%%%d", level+1)
+		indent := 432 + 144*level
+		opts.levels = append(opts.levels, numberingLevel{
+			start: 1, format: "decimal", text: lvlText, left: indent, hanging: indent,
+		})
+	}
+	return opts
+}
@@ -0,0 +1,122 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetOrCreateNumberingReusesDefinitions(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	// An outline numbering written by another tool, without our markers, and
+	// a numbered list whose only instance restarts at level 0.
+	numbering := generateNumberingXMLWith(
+		strings.ReplaceAll(generateAbstractNumXML(3, headingNumberingOptions()), `<w:multiLevelType w:val="multilevel"/>`, `<w:nsid w:val="1A2B3C4D"/><w:multiLevelType w:val="multilevel"/>`) +
+			generateAbstractNumXML(4, numberedNumberingOptions()) +
+			`<w:num w:numId="5" w15:durableId="42"><w:abstractNumId w:val="3"/></w:num>` +
+			`<w:num w:numId="6"><w:abstractNumId w:val="4"/><w:lvlOverride w:ilvl="0"><w:startOverride w:val="1"/></w:lvlOverride></w:num>`)
+	if err := os.WriteFile(filepath.Join(u.TempDir(), "word", "numbering.xml"), []byte(numbering), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := u.getOrCreateNumbering(numberedNumberingOptions())
+	if err != nil {
+		t.Fatalf("getOrCreateNumbering: %v", err)
+	}
+	second, err := u.getOrCreateNumbering(numberedNumberingOptions())
+	if err != nil {
+		t.Fatalf("getOrCreateNumbering: %v", err)
+	}
+	if first != 7 || second != first {
+		t.Errorf("numbered list numIds = %d, %d; want a new instance 7 reused on the second call", first, second)
+	}
+
+	if err := u.AddHeadingWithOptions(1, "Scope", HeadingOptions{NumberingEnabled: true, Position: PositionEnd}); err != nil {
+		t.Fatalf("AddHeadingWithOptions: %v", err)
+	}
+	if doc := readDocXML(t, u); !strings.Contains(doc, `<w:numId w:val="5"/>`) {
+		t.Errorf("heading should reuse the existing outline numbering numId 5:\n%s", doc)
+	}
+
+	if ids := u.getListNumberingIDs(); ids.numberedNumID != first {
+		t.Errorf("managed numbered list numId = %d, want the reused %d", ids.numberedNumID, first)
+	}
+
+	got := readTempFile(t, u, "word/numbering.xml")
+	// Only the bullet list, which the document lacked, needs a definition.
+	if n := strings.Count(got, "<w:abstractNum "); n != 3 {
+		t.Errorf("expected the 2 existing definitions to be reused, found %d definitions:\n%s", n, got)
+	}
+	if !strings.Contains(got, "<w:num w:numId=\"7\">\n    <w:abstractNumId w:val=\"4\"/>") {
+		t.Errorf("new instance should reference the existing definition:\n%s", got)
+	}
+}
+
+func TestNumberedListDoesNotContinueTemplateList(t *testing.T) {
+	// The template already has a three-item list using a definition that
+	// matches the managed numbered list.
+	var body strings.Builder
+	for _, item := range []string{"One", "Two", "Three"} {
+		body.WriteString(`<w:p><w:pPr><w:numPr><w:ilvl w:val="0"/><w:numId w:val="5"/></w:numPr></w:pPr><w:r><w:t>` + item + `</w:t></w:r></w:p>`)
+	}
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body.String()))
+	numbering := generateNumberingXMLWith(
+		generateAbstractNumXML(4, numberedNumberingOptions()) +
+			`<w:num w:numId="5"><w:abstractNumId w:val="4"/></w:num>`)
+	if err := os.WriteFile(filepath.Join(u.TempDir(), "word", "numbering.xml"), []byte(numbering), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := u.InsertParagraph(ParagraphOptions{Text: "New item", ListType: ListTypeNumbered, Position: PositionEnd}); err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	ids := u.getListNumberingIDs()
+	if ids.numberedNumID == 5 {
+		t.Fatal("new numbered list shares the template list's instance and would continue at 4")
+	}
+	if doc := readDocXML(t, u); !strings.Contains(doc, fmt.Sprintf(`<w:numId w:val="%d"/></w:numPr></w:pPr><w:r><w:t>New item`, ids.numberedNumID)) {
+		t.Errorf("new item should use numId %d:\n%s", ids.numberedNumID, doc)
+	}
+	got := readTempFile(t, u, "word/numbering.xml")
+	if n := strings.Count(got, `<w:abstractNumId w:val="4"/>`); n != 2 {
+		t.Errorf("expected a second instance of the existing definition, found %d references:\n%s", n, got)
+	}
+}
+
+func TestGetOrCreateNumberingKeepsDefinitionsBeforeInstances(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	headingID, err := u.getOrCreateNumbering(headingNumberingOptions())
+	if err != nil {
+		t.Fatalf("getOrCreateNumbering: %v", err)
+	}
+	bulletID, err := u.getOrCreateNumbering(bulletNumberingOptions())
+	if err != nil {
+		t.Fatalf("getOrCreateNumbering: %v", err)
+	}
+	if headingID != 1 || bulletID != 2 {
+		t.Errorf("numIds = %d, %d; want 1, 2", headingID, bulletID)
+	}
+
+	got := readTempFile(t, u, "word/numbering.xml")
+	if strings.LastIndex(got, "<w:abstractNum ") > strings.Index(got, "<w:num ") {
+		t.Errorf("every <w:abstractNum> must precede the first <w:num>:\n%s", got)
+	}
+	if !strings.Contains(got, "DOCXUPDATE_BULLET_NUMID:2") {
+		t.Error("new instance should carry its marker")
+	}
+	if ct := readTempFile(t, u, "[Content_Types].xml"); !strings.Contains(ct, `PartName="/word/numbering.xml"`) {
+		t.Error("numbering part content type not registered")
+	}
+	if rels := readTempFile(t, u, "word/_rels/document.xml.rels"); !strings.Contains(rels, `Target="numbering.xml"`) {
+		t.Error("numbering part relationship not added")
+	}
+}
@@ -0,0 +1,155 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PageBorderOffset selects what the Space of a page border side is measured from.
+type PageBorderOffset string
+
+const (
+	// PageBorderOffsetText measures the border spacing from the text margins (default)
+	PageBorderOffsetText PageBorderOffset = "text"
+	// PageBorderOffsetPage measures the border spacing from the edge of the page
+	PageBorderOffsetPage PageBorderOffset = "page"
+)
+
+// PageBorderOptions defines the border drawn around every page of a section.
+// The sides use the same BorderSpec as paragraph borders; Space is in points
+// (at most 31).
+type PageBorderOptions struct {
+	Top    BorderSpec
+	Bottom BorderSpec
+	Left   BorderSpec
+	Right  BorderSpec
+
+	// OffsetFrom selects whether Space is measured from the text or the page edge
+	// (default: PageBorderOffsetText)
+	OffsetFrom PageBorderOffset
+}
+
+// SetPageBackground sets the page color of the document to a hex color
+// (RRGGBB, RGB or rgb(r,g,b)) and turns on the display of background shapes
+// in settings.xml, without which Word does not show the color.
+func (u *Updater) SetPageBackground(color string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	hex := normalizeHexColor(color)
+	if hex == "" {
+		return invalidColorError("color", color)
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	updated, err := setDocumentBackground(raw, fmt.Sprintf(`<w:background w:color="%s"/>`, hex))
+	if err != nil {
+		return fmt.Errorf("set page background: %w", err)
+	}
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+
+	return u.updateSettings(func(children []xmlChild) []xmlChild {
+		return upsertOrderedChild(children, "w:displayBackgroundShape", "<w:displayBackgroundShape/>", settingsChildOrder)
+	})
+}
+
+// SetPageBorder draws a border around the pages of the document's final
+// (body-level) section, replacing any existing page border. Options without
+// any styled side remove the page border.
+func (u *Updater) SetPageBorder(opts PageBorderOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if err := validateParagraphBorder(ParagraphBorderOptions{
+		Top: opts.Top, Bottom: opts.Bottom, Left: opts.Left, Right: opts.Right,
+	}); err != nil {
+		return err
+	}
+	for _, side := range []BorderSpec{opts.Top, opts.Bottom, opts.Left, opts.Right} {
+		if side.Space > 31 {
+			return NewValidationError("Space", fmt.Sprintf("page border space %d exceeds 31 points", side.Space))
+		}
+	}
+	switch opts.OffsetFrom {
+	case "", PageBorderOffsetText, PageBorderOffsetPage:
+	default:
+		return NewValidationError("OffsetFrom", fmt.Sprintf("invalid page border offset: %s", opts.OffsetFrom))
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	updated, err := setBodySectPrChild(raw, "w:pgBorders", generatePageBorderXML(opts))
+	if err != nil {
+		return fmt.Errorf("set page border: %w", err)
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// generatePageBorderXML creates the <w:pgBorders> element for opts, or an
+// empty string when no side has a style.
+func generatePageBorderXML(opts PageBorderOptions) string {
+	var inner strings.Builder
+	// CT_PageBorders requires the order top, left, bottom, right.
+	writeBorderSide(&inner, "top", opts.Top)
+	writeBorderSide(&inner, "left", opts.Left)
+	writeBorderSide(&inner, "bottom", opts.Bottom)
+	writeBorderSide(&inner, "right", opts.Right)
+	if inner.Len() == 0 {
+		return ""
+	}
+	offset := opts.OffsetFrom
+	if offset == "" {
+		offset = PageBorderOffsetText
+	}
+	return fmt.Sprintf(`<w:pgBorders w:offsetFrom="%s">`, offset) + inner.String() + "</w:pgBorders>"
+}
+
+// setDocumentBackground replaces the <w:background> element of document.xml
+// with backgroundXML, inserting it before <w:body> when there is none.
+func setDocumentBackground(docXML []byte, backgroundXML string) ([]byte, error) {
+	bodyStart := findNextTagStart(docXML, 0, "w:body")
+	if bodyStart == -1 {
+		return nil, NewMalformedXMLError("could not find <w:body> tag")
+	}
+
+	start, end := bodyStart, bodyStart
+	if bgStart := findNextTagStart(docXML[:bodyStart], 0, "w:background"); bgStart != -1 {
+		openEnd := bytes.IndexByte(docXML[bgStart:], '>')
+		if openEnd == -1 {
+			return nil, NewMalformedXMLError("malformed <w:background> element")
+		}
+		start, end = bgStart, bgStart+openEnd+1
+		if docXML[end-2] != '/' {
+			closeRel := findMatchingClose(docXML[bgStart:], "w:background")
+			if closeRel == -1 {
+				return nil, NewMalformedXMLError("malformed <w:background> element")
+			}
+			end = bgStart + closeRel + len("</w:background>")
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(len(docXML) + len(backgroundXML))
+	buf.Write(docXML[:start])
+	buf.WriteString(backgroundXML)
+	buf.Write(docXML[end:])
+	return buf.Bytes(), nil
+}
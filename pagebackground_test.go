@@ -0,0 +1,74 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetPageBackground(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Body</w:t></w:r></w:p>`))
+
+	if err := u.SetPageBackground("fff2cc"); err != nil {
+		t.Fatalf("SetPageBackground: %v", err)
+	}
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:background w:color="FFF2CC"/><w:body>`) {
+		t.Errorf("expected background before <w:body>, got: %s", docXML)
+	}
+	settings := readTempFile(t, u, "word/settings.xml")
+	if !strings.Contains(settings, "<w:displayBackgroundShape/>") {
+		t.Errorf("expected displayBackgroundShape in settings, got: %s", settings)
+	}
+
+	// Setting again replaces the color and keeps a single setting.
+	if err := u.SetPageBackground("#DDEEFF"); err != nil {
+		t.Fatalf("SetPageBackground: %v", err)
+	}
+	docXML = readDocXML(t, u)
+	if n := strings.Count(docXML, "<w:background"); n != 1 || !strings.Contains(docXML, `w:color="DDEEFF"`) {
+		t.Errorf("expected one replaced background, got: %s", docXML)
+	}
+	if n := strings.Count(readTempFile(t, u, "word/settings.xml"), "displayBackgroundShape"); n != 1 {
+		t.Errorf("expected one displayBackgroundShape, found %d", n)
+	}
+
+	if err := u.SetPageBackground("not a color"); err == nil {
+		t.Error("expected error for invalid color")
+	}
+}
+
+func TestSetPageBorder(t *testing.T) {
+	body := `<w:p><w:r><w:t>Body</w:t></w:r></w:p>` +
+		`<w:sectPr><w:pgSz w:w="12240" w:h="15840"/><w:pgMar w:top="1440" w:right="1440" w:bottom="1440" w:left="1440" w:header="720" w:footer="720" w:gutter="0"/><w:cols w:space="720"/></w:sectPr>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	side := BorderSpec{Style: BorderDouble, Width: 6, Color: "1F4E79", Space: 24}
+	err := u.SetPageBorder(PageBorderOptions{Top: side, Bottom: side, Left: side, Right: side, OffsetFrom: PageBorderOffsetPage})
+	if err != nil {
+		t.Fatalf("SetPageBorder: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	attrs := ` w:val="double" w:sz="6" w:space="24" w:color="1F4E79"/>`
+	want := `w:gutter="0"/><w:pgBorders w:offsetFrom="page">` +
+		`<w:top` + attrs + `<w:left` + attrs + `<w:bottom` + attrs + `<w:right` + attrs +
+		`</w:pgBorders><w:cols`
+	if !strings.Contains(docXML, want) {
+		t.Errorf("expected pgBorders between pgMar and cols, got: %s", docXML)
+	}
+
+	// Options without a styled side remove the border.
+	if err := u.SetPageBorder(PageBorderOptions{}); err != nil {
+		t.Fatalf("SetPageBorder: %v", err)
+	}
+	if docXML := readDocXML(t, u); strings.Contains(docXML, "pgBorders") {
+		t.Errorf("expected pgBorders to be removed, got: %s", docXML)
+	}
+
+	if err := u.SetPageBorder(PageBorderOptions{Top: BorderSpec{Style: BorderSingle, Space: 40}}); err == nil {
+		t.Error("expected error for space over 31 points")
+	}
+	if err := u.SetPageBorder(PageBorderOptions{Top: side, OffsetFrom: "margin"}); err == nil {
+		t.Error("expected error for invalid offset")
+	}
+}
@@ -37,11 +37,11 @@ type PageNumberOptions struct {
 // It modifies the section properties to set the starting page number and format.
 func (u *Updater) SetPageNumber(opts PageNumberOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	if opts.Start < 0 {
-		return fmt.Errorf("page number start must be >= 0")
+		return NewValidationError("Start", "page number start must be >= 0")
 	}
 	if opts.Format == "" {
 		opts.Format = PageNumDecimal
@@ -50,7 +50,7 @@ func (u *Updater) SetPageNumber(opts PageNumberOptions) error {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	updated, err := setPageNumberInSectPr(raw, opts)
@@ -59,7 +59,7 @@ func (u *Updater) SetPageNumber(opts PageNumberOptions) error {
 	}
 
 	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
@@ -82,7 +82,7 @@ func setPageNumberInSectPr(docXML []byte, opts PageNumberOptions) ([]byte, error
 	// Find the last sectPr (document-level section properties)
 	bodyEnd := bytes.Index(docXML, []byte("</w:body>"))
 	if bodyEnd == -1 {
-		return nil, fmt.Errorf("could not find </w:body> tag")
+		return nil, NewMalformedXMLError("could not find </w:body> tag")
 	}
 
 	sectPrStart := bytes.LastIndex(docXML[:bodyEnd], []byte("<w:sectPr"))
@@ -98,7 +98,7 @@ func setPageNumberInSectPr(docXML []byte, opts PageNumberOptions) ([]byte, error
 
 	sectPrEnd := bytes.Index(docXML[sectPrStart:], []byte("</w:sectPr>"))
 	if sectPrEnd == -1 {
-		return nil, fmt.Errorf("malformed sectPr element")
+		return nil, NewMalformedXMLError("malformed sectPr element")
 	}
 	sectPrEnd += sectPrStart + len("</w:sectPr>")
 
@@ -120,7 +120,7 @@ func setPageNumberInSectPr(docXML []byte, opts PageNumberOptions) ([]byte, error
 	closeTag := []byte("</w:sectPr>")
 	closeIdx := bytes.LastIndex(docXML[sectPrStart:sectPrEnd], closeTag)
 	if closeIdx == -1 {
-		return nil, fmt.Errorf("could not find </w:sectPr> closing tag")
+		return nil, NewMalformedXMLError("could not find </w:sectPr> closing tag")
 	}
 	insertPos := sectPrStart + closeIdx
 
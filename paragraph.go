@@ -63,6 +63,31 @@ const (
 	PositionBeforeText
 )
 
+// PositionAtIndex is the base of the positions built by [IndexedPosition].
+// On its own it is IndexedPosition(0): the start of the document body.
+const PositionAtIndex InsertPosition = 1 << 30
+
+// IndexedPosition returns a position that inserts after the top-level body
+// paragraph at 1-based index n, the numbering of ParagraphInfo.Index and the
+// other index-based paragraph APIs (paragraphs inside tables are not counted).
+// IndexedPosition(0) inserts at the start of the body, and n equal to the
+// paragraph count inserts right after the last paragraph. A negative n, or one
+// past the paragraph count, makes the insertion fail with a validation error.
+func IndexedPosition(n int) InsertPosition {
+	if n < 0 {
+		n = -1
+	}
+	return PositionAtIndex + InsertPosition(n)
+}
+
+// paragraphIndex reports the paragraph index encoded by IndexedPosition.
+func (p InsertPosition) paragraphIndex() (int, bool) {
+	if p < PositionAtIndex-1 {
+		return 0, false
+	}
+	return int(p - PositionAtIndex), true
+}
+
 // RunOptions defines formatting and content for a single text run within a paragraph.
 // A run is the smallest unit of text in OpenXML that can carry its own character formatting.
 // Use multiple RunOptions in ParagraphOptions.Runs to mix bold, italic, colored, and
@@ -87,16 +112,41 @@ type RunOptions struct {
 	// "darkRed", "darkYellow", "darkGray", "lightGray", "black".
 	Highlight string
 
+	// HighlightColor is the typed equivalent of Highlight. When both are set,
+	// HighlightColor wins.
+	HighlightColor HighlightColor
+
 	// FontSize is the font size in points (e.g. 12.0). Zero means inherit.
 	FontSize float64
 
 	// FontName sets the ASCII/Unicode font (e.g. "Arial", "Times New Roman").
 	FontName string
 
+	// ThemeFont uses the theme's headings (ThemeMajor) or body (ThemeMinor)
+	// font, taking precedence over FontName in Word (see SetThemeFonts).
+	ThemeFont ThemeFontRef
+
 	// URL sets an inline hyperlink on this run. When non-empty the run is emitted
 	// as a <w:hyperlink> element. Hyperlinks are always underlined; Color defaults
 	// to "0563C1" (Word's standard blue) but can be overridden by setting Color.
 	URL string
+
+	// BreakAfter appends a soft line break (<w:br/>) after the run's text.
+	BreakAfter bool
+
+	// TabAfter appends a tab character (<w:tab/>) after the run's text.
+	TabAfter bool
+
+	// NonBreakingSpace appends a non-breaking space (U+00A0) after the run's
+	// text, keeping it on the same line as the next run (e.g. "Fig." and "1").
+	NonBreakingSpace bool
+
+	// SoftHyphen appends an optional hyphen (<w:softHyphen/>) after the run's
+	// text, where Word may break the word when it reaches the line end.
+	SoftHyphen bool
+
+	// RTL marks the run as right-to-left text (Arabic, Hebrew) with <w:rtl/>.
+	RTL bool
 }
 
 // ParagraphOptions defines options for paragraph insertion
@@ -127,6 +177,54 @@ type ParagraphOptions struct {
 	// Pagination control
 	KeepNext  bool // Keep this paragraph on the same page as the next (prevents orphaned headings)
 	KeepLines bool // Keep all lines of this paragraph together on the same page
+
+	// PageBreakBefore starts the paragraph on a new page.
+	PageBreakBefore bool
+	// DisableWidowControl allows a single first or last line of the paragraph
+	// to sit alone at the top or bottom of a page. Widow control is on by
+	// default in Word.
+	DisableWidowControl bool
+
+	// OutlineLevel (1-9) gives the paragraph an outline level without a
+	// heading style, so it appears in the navigation pane and in a TOC built
+	// from outline levels. 0 keeps the level of the style.
+	OutlineLevel int
+
+	// SuppressLineNumbers excludes this paragraph from line numbering (see SetLineNumbering)
+	SuppressLineNumbers bool
+
+	// BiDi makes this a right-to-left paragraph (<w:bidi/>): lines start at the
+	// right margin and ParagraphAlignLeft/Right are mirrored. Mark the runs
+	// holding RTL text with RunOptions.RTL.
+	BiDi bool
+
+	// Spacing and indentation, in twips (1/20 pt). Zero leaves the value
+	// inherited from the style.
+	SpaceBefore int // Space above the paragraph
+	SpaceAfter  int // Space below the paragraph
+	LineSpacing int // Line spacing in 240ths of a line (240 = single, 480 = double)
+	IndentLeft  int // Left indent
+	IndentRight int // Right indent
+
+	// IndentFirstLine indents the first line further than the rest of the
+	// paragraph; IndentHanging indents all lines but the first, as in
+	// bibliography entries. OOXML allows only one of the two.
+	IndentFirstLine int
+	IndentHanging   int
+
+	// Background shading
+	BackgroundColor string         // 6-digit hex fill color, e.g. "FFF2CC"
+	ShadingPattern  ShadingPattern // Fill pattern (default: ShadingClear when BackgroundColor is set)
+
+	// ParagraphBorder draws borders around the paragraph (nil for none).
+	ParagraphBorder *ParagraphBorderOptions
+
+	// Frame places the paragraph in a positioned text frame (nil for none).
+	Frame *FrameOptions
+
+	// Tab stops
+	TabStops      []TabStop // Custom tab stops for this paragraph
+	ClearTabStops bool      // Clear tab stops inherited from the paragraph style
 }
 
 type listNumberingIDs struct {
@@ -139,15 +237,33 @@ func (u *Updater) InsertParagraph(opts ParagraphOptions) error {
 	if u == nil {
 		return &DocxError{Code: ErrCodeValidation, Message: "updater is nil"}
 	}
+	return u.insertParagraph(opts, 0)
+}
+
+// insertParagraph implements InsertParagraph. A positive numID numbers a
+// ListTypeNumbered paragraph with that numbering instance instead of the
+// managed numbered list.
+func (u *Updater) insertParagraph(opts ParagraphOptions, numID int) error {
 	if opts.Text == "" && len(opts.Runs) == 0 {
 		return NewValidationError("text", "paragraph text cannot be empty: provide Text or at least one Run")
 	}
+	if err := validateParagraphFormatting(opts); err != nil {
+		return err
+	}
 
 	// Default style to Normal if not specified
 	if opts.Style == "" {
 		opts.Style = StyleNormal
 	}
 
+	if opts.ClearTabStops {
+		tabs, err := u.withClearedTabStops(string(opts.Style), opts.TabStops)
+		if err != nil {
+			return fmt.Errorf("resolve inherited tab stops: %w", err)
+		}
+		opts.TabStops = tabs
+	}
+
 	listIDs := listNumberingIDs{bulletNumID: BulletListNumID, numberedNumID: NumberedListNumID}
 	var restartNumID int
 
@@ -159,7 +275,9 @@ func (u *Updater) InsertParagraph(opts ParagraphOptions) error {
 		listIDs = u.getListNumberingIDs()
 
 		// Allocate a fresh numId with startOverride when restarting a numbered list.
-		if opts.ListRestart && opts.ListType == ListTypeNumbered {
+		if numID > 0 {
+			restartNumID = numID
+		} else if opts.ListRestart && opts.ListType == ListTypeNumbered {
 			id, err := u.allocateRestartNumID(opts.ListLevel)
 			if err != nil {
 				return fmt.Errorf("allocate restart numId: %w", err)
@@ -172,7 +290,7 @@ func (u *Updater) InsertParagraph(opts ParagraphOptions) error {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	// Pre-resolve URL relationships for any inline hyperlink runs.
@@ -200,7 +318,7 @@ func (u *Updater) InsertParagraph(opts ParagraphOptions) error {
 
 	// Write updated document
 	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
@@ -208,6 +326,8 @@ func (u *Updater) InsertParagraph(opts ParagraphOptions) error {
 
 // InsertParagraphs inserts multiple paragraphs in a single read-modify-write pass,
 // which is significantly more efficient than calling InsertParagraph N times.
+// Each paragraph is placed according to its own Position and Anchor; use
+// InsertParagraphsAt to place a block of paragraphs at one location.
 func (u *Updater) InsertParagraphs(paragraphs []ParagraphOptions) error {
 	if u == nil {
 		return &DocxError{Code: ErrCodeValidation, Message: "updater is nil"}
@@ -216,19 +336,99 @@ func (u *Updater) InsertParagraphs(paragraphs []ParagraphOptions) error {
 		return nil
 	}
 
+	paraXMLs, err := u.buildParagraphsXML(paragraphs)
+	if err != nil {
+		return err
+	}
+
+	// Read document.xml once.
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	// Apply all insertions in memory.
+	for i, opts := range paragraphs {
+		raw, err = insertParagraphAtPosition(raw, paraXMLs[i], opts)
+		if err != nil {
+			return fmt.Errorf("insert paragraph %d: %w", i, err)
+		}
+	}
+
+	// Write document.xml once.
+	if err := atomicWriteFile(docPath, raw, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// BatchInsertOptions places a block of paragraphs inserted by InsertParagraphsAt.
+type BatchInsertOptions struct {
+	// Position where to insert the paragraphs
+	Position InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+}
+
+// InsertParagraphsAt inserts paragraphs as one contiguous block at a single
+// location, keeping them in slice order. The Position and Anchor fields of
+// the individual ParagraphOptions are ignored. document.xml is read once and
+// the whole block is spliced in with a single insertion, so the cost does not
+// grow with the number of paragraphs the way repeated InsertParagraph calls do.
+func (u *Updater) InsertParagraphsAt(paragraphs []ParagraphOptions, opts BatchInsertOptions) error {
+	if u == nil {
+		return &DocxError{Code: ErrCodeValidation, Message: "updater is nil"}
+	}
+	if len(paragraphs) == 0 {
+		return nil
+	}
+	if (opts.Position == PositionAfterText || opts.Position == PositionBeforeText) && opts.Anchor == "" {
+		return NewValidationError("anchor", "anchor text required for text-relative positions")
+	}
+
+	paraXMLs, err := u.buildParagraphsXML(paragraphs)
+	if err != nil {
+		return err
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	updated, err := insertElementAtPosition(raw, bytes.Join(paraXMLs, nil), opts.Position, opts.Anchor)
+	if err != nil {
+		return fmt.Errorf("insert paragraphs: %w", err)
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// buildParagraphsXML validates paragraphs and generates their XML, preparing
+// numbering and hyperlink relationships for the whole batch at once.
+func (u *Updater) buildParagraphsXML(paragraphs []ParagraphOptions) ([][]byte, error) {
 	// Validate all paragraphs upfront before touching any files.
 	for i, opts := range paragraphs {
 		if opts.Text == "" && len(opts.Runs) == 0 {
-			return fmt.Errorf("paragraph %d: %w", i,
+			return nil, fmt.Errorf("paragraph %d: %w", i,
 				NewValidationError("text", "paragraph text cannot be empty: provide Text or at least one Run"))
 		}
+		if err := validateParagraphFormatting(opts); err != nil {
+			return nil, fmt.Errorf("paragraph %d: %w", i, err)
+		}
 	}
 
 	// Ensure numbering.xml exists once if any paragraph uses a list.
 	for _, opts := range paragraphs {
 		if opts.ListType != "" {
 			if err := u.ensureNumberingXML(); err != nil {
-				return fmt.Errorf("ensure numbering: %w", err)
+				return nil, fmt.Errorf("ensure numbering: %w", err)
 			}
 			break
 		}
@@ -250,7 +450,7 @@ func (u *Updater) InsertParagraphs(paragraphs []ParagraphOptions) error {
 			numberingPath := filepath.Join(u.tempDir, "word", "numbering.xml")
 			data, err := os.ReadFile(numberingPath)
 			if err != nil {
-				return fmt.Errorf("read numbering.xml: %w", err)
+				return nil, NewFileReadError("numbering.xml", err)
 			}
 			content := string(data)
 			numberedNumID := listIDs.numberedNumID
@@ -258,14 +458,14 @@ func (u *Updater) InsertParagraphs(paragraphs []ParagraphOptions) error {
 				if opts.ListRestart && opts.ListType == ListTypeNumbered {
 					newNumID, updated, err := allocateRestartNumIDInContent(content, numberedNumID, opts.ListLevel)
 					if err != nil {
-						return fmt.Errorf("allocate restart numId for paragraph %d: %w", i, err)
+						return nil, fmt.Errorf("allocate restart numId for paragraph %d: %w", i, err)
 					}
 					restartNumIDs[i] = newNumID
 					content = updated
 				}
 			}
 			if err := atomicWriteFile(numberingPath, []byte(content), 0o644); err != nil {
-				return fmt.Errorf("write numbering.xml: %w", err)
+				return nil, NewXMLWriteError("numbering.xml", err)
 			}
 		}
 	}
@@ -278,7 +478,7 @@ func (u *Updater) InsertParagraphs(paragraphs []ParagraphOptions) error {
 				if _, seen := urlRelIDs[run.URL]; !seen {
 					rID, err := u.addHyperlinkRelationship(run.URL)
 					if err != nil {
-						return fmt.Errorf("register hyperlink for %q: %w", run.URL, err)
+						return nil, fmt.Errorf("register hyperlink for %q: %w", run.URL, err)
 					}
 					urlRelIDs[run.URL] = rID
 				}
@@ -286,30 +486,114 @@ func (u *Updater) InsertParagraphs(paragraphs []ParagraphOptions) error {
 		}
 	}
 
-	// Read document.xml once.
-	docPath := filepath.Join(u.tempDir, "word", "document.xml")
-	raw, err := os.ReadFile(docPath)
-	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
-	}
-
-	// Apply all insertions in memory.
+	paraXMLs := make([][]byte, len(paragraphs))
 	for i, opts := range paragraphs {
 		if opts.Style == "" {
 			opts.Style = StyleNormal
 		}
-		paraXML := generateParagraphXML(opts, listIDs, restartNumIDs[i], urlRelIDs)
-		raw, err = insertParagraphAtPosition(raw, paraXML, opts)
-		if err != nil {
-			return fmt.Errorf("insert paragraph %d: %w", i, err)
+		if opts.ClearTabStops {
+			tabs, err := u.withClearedTabStops(string(opts.Style), opts.TabStops)
+			if err != nil {
+				return nil, fmt.Errorf("resolve inherited tab stops for paragraph %d: %w", i, err)
+			}
+			opts.TabStops = tabs
 		}
+		paraXMLs[i] = generateParagraphXML(opts, listIDs, restartNumIDs[i], urlRelIDs)
 	}
+	return paraXMLs, nil
+}
 
-	// Write document.xml once.
-	if err := atomicWriteFile(docPath, raw, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+// validateParagraphFormatting checks the optional paragraph-level formatting
+// fields of opts.
+func validateParagraphFormatting(opts ParagraphOptions) error {
+	if err := validateShading(opts.BackgroundColor, opts.ShadingPattern); err != nil {
+		return err
 	}
-	return nil
+	if opts.ParagraphBorder != nil {
+		if err := validateParagraphBorder(*opts.ParagraphBorder); err != nil {
+			return err
+		}
+	}
+	if opts.Frame != nil {
+		if err := validateFrameOptions(*opts.Frame); err != nil {
+			return err
+		}
+	}
+	if opts.OutlineLevel < 0 || opts.OutlineLevel > 9 {
+		return NewValidationError("OutlineLevel", fmt.Sprintf("outline level %d out of range 1-9", opts.OutlineLevel))
+	}
+	for _, f := range []struct {
+		name  string
+		value int
+	}{
+		{"SpaceBefore", opts.SpaceBefore}, {"SpaceAfter", opts.SpaceAfter}, {"LineSpacing", opts.LineSpacing},
+		{"IndentLeft", opts.IndentLeft}, {"IndentRight", opts.IndentRight},
+		{"IndentFirstLine", opts.IndentFirstLine}, {"IndentHanging", opts.IndentHanging},
+	} {
+		if f.value < 0 {
+			return NewValidationError(f.name, "must not be negative")
+		}
+	}
+	if opts.IndentFirstLine > 0 && opts.IndentHanging > 0 {
+		return NewValidationError("IndentHanging", "cannot be combined with IndentFirstLine")
+	}
+	for i, run := range opts.Runs {
+		if err := validateColor(fmt.Sprintf("Runs[%d].Color", i), run.Color); err != nil {
+			return err
+		}
+		if err := validateThemeFontRef(fmt.Sprintf("Runs[%d].ThemeFont", i), run.ThemeFont); err != nil {
+			return err
+		}
+		if err := validateHighlightColor(fmt.Sprintf("Runs[%d].HighlightColor", i), run.HighlightColor); err != nil {
+			return err
+		}
+	}
+	return validateTabStops(opts.TabStops)
+}
+
+// generateSpacingXML returns a <w:spacing> element for the non-zero values,
+// or "" when all are zero.
+func generateSpacingXML(before, after, line int) string {
+	if before == 0 && after == 0 && line == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("<w:spacing")
+	if before > 0 {
+		fmt.Fprintf(&b, ` w:before="%d"`, before)
+	}
+	if after > 0 {
+		fmt.Fprintf(&b, ` w:after="%d"`, after)
+	}
+	if line > 0 {
+		fmt.Fprintf(&b, ` w:line="%d" w:lineRule="auto"`, line)
+	}
+	b.WriteString("/>")
+	return b.String()
+}
+
+// generateIndentXML returns a <w:ind> element for the non-zero indents, or ""
+// when all are zero.
+func generateIndentXML(left, right, firstLine, hanging int) string {
+	if left == 0 && right == 0 && firstLine == 0 && hanging == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("<w:ind")
+	if left > 0 {
+		fmt.Fprintf(&b, ` w:left="%d"`, left)
+	}
+	if right > 0 {
+		fmt.Fprintf(&b, ` w:right="%d"`, right)
+	}
+	if firstLine > 0 {
+		fmt.Fprintf(&b, ` w:firstLine="%d"`, firstLine)
+	}
+	if hanging > 0 {
+		fmt.Fprintf(&b, ` w:hanging="%d"`, hanging)
+	}
+	b.WriteString("/>")
+	return b.String()
 }
 
 // generateParagraphXML creates the XML for a paragraph with the specified options.
@@ -329,8 +613,21 @@ func generateParagraphXML(opts ParagraphOptions, listIDs listNumberingIDs, resta
 		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, xmlEscape(string(opts.Style))))
 	}
 
-	if alignment, ok := paragraphAlignmentValue(opts.Alignment); ok {
-		buf.WriteString(fmt.Sprintf(`<w:jc w:val="%s"/>`, alignment))
+	// Pagination control: keep with next paragraph (headings) and keep lines together.
+	if opts.KeepNext {
+		buf.WriteString("<w:keepNext/>")
+	}
+	if opts.KeepLines {
+		buf.WriteString("<w:keepLines/>")
+	}
+	if opts.PageBreakBefore {
+		buf.WriteString("<w:pageBreakBefore/>")
+	}
+	if opts.Frame != nil {
+		buf.WriteString(generateFramePrXML(*opts.Frame))
+	}
+	if opts.DisableWidowControl {
+		buf.WriteString(`<w:widowControl w:val="0"/>`)
 	}
 
 	// Add numbering properties if ListType is specified
@@ -357,12 +654,26 @@ func generateParagraphXML(opts ParagraphOptions, listIDs listNumberingIDs, resta
 		}
 	}
 
-	// Pagination control: keep with next paragraph (headings) and keep lines together.
-	if opts.KeepNext {
-		buf.WriteString("<w:keepNext/>")
+	if opts.SuppressLineNumbers {
+		buf.WriteString("<w:suppressLineNumbers/>")
 	}
-	if opts.KeepLines {
-		buf.WriteString("<w:keepLines/>")
+	if opts.ParagraphBorder != nil {
+		buf.WriteString(generateParagraphBorderXML(*opts.ParagraphBorder))
+	}
+	buf.WriteString(generateShadingXML(opts.BackgroundColor, opts.ShadingPattern))
+	buf.WriteString(generateTabsXML(opts.TabStops))
+	if opts.BiDi {
+		buf.WriteString("<w:bidi/>")
+	}
+	buf.WriteString(generateSpacingXML(opts.SpaceBefore, opts.SpaceAfter, opts.LineSpacing))
+	buf.WriteString(generateIndentXML(opts.IndentLeft, opts.IndentRight, opts.IndentFirstLine, opts.IndentHanging))
+
+	// Alignment comes after the other properties to respect the CT_PPr sequence.
+	if alignment, ok := paragraphAlignmentValue(opts.Alignment); ok {
+		buf.WriteString(fmt.Sprintf(`<w:jc w:val="%s"/>`, alignment))
+	}
+	if opts.OutlineLevel > 0 {
+		buf.WriteString(fmt.Sprintf(`<w:outlineLvl w:val="%d"/>`, opts.OutlineLevel-1))
 	}
 
 	buf.WriteString("</w:pPr>")
@@ -401,16 +712,20 @@ func writeRunXML(buf *bytes.Buffer, run RunOptions) {
 
 	hasRPr := run.Bold || run.Italic || run.Underline || run.Strikethrough ||
 		run.Superscript || run.Subscript ||
-		run.Color != "" || run.Highlight != "" ||
-		run.FontSize > 0 || run.FontName != ""
+		run.Color != "" || run.Highlight != "" || run.HighlightColor != "" ||
+		run.FontSize > 0 || run.FontName != "" || run.ThemeFont != "" || run.RTL
 
 	if hasRPr {
 		buf.WriteString("<w:rPr>")
-		if run.FontName != "" {
-			buf.WriteString(fmt.Sprintf(
-				`<w:rFonts w:ascii="%s" w:hAnsi="%s"/>`,
-				xmlEscape(run.FontName), xmlEscape(run.FontName),
-			))
+		if run.FontName != "" || themeFontAttrs(run.ThemeFont) != "" {
+			buf.WriteString("<w:rFonts")
+			if run.FontName != "" {
+				fmt.Fprintf(buf, ` w:ascii="%s" w:hAnsi="%s"`, xmlEscape(run.FontName), xmlEscape(run.FontName))
+			}
+			if attrs := themeFontAttrs(run.ThemeFont); attrs != "" {
+				buf.WriteString(" " + attrs)
+			}
+			buf.WriteString("/>")
 		}
 		if run.Bold {
 			buf.WriteString("<w:b/>")
@@ -422,13 +737,15 @@ func writeRunXML(buf *bytes.Buffer, run RunOptions) {
 			buf.WriteString("<w:strike/>")
 		}
 		if run.Color != "" {
-			// normalizeHexColor validates and normalises the value; skip invalid strings
-			// to avoid emitting malformed XML attribute values.
+			// Run colors are validated by validateParagraphFormatting; invalid
+			// strings are still skipped to avoid malformed attribute values.
 			if normalized := normalizeHexColor(run.Color); normalized != "" {
 				buf.WriteString(fmt.Sprintf(`<w:color w:val="%s"/>`, normalized))
 			}
 		}
-		if run.Highlight != "" {
+		if run.HighlightColor != "" {
+			buf.WriteString(fmt.Sprintf(`<w:highlight w:val="%s"/>`, xmlEscape(string(run.HighlightColor))))
+		} else if run.Highlight != "" {
 			buf.WriteString(fmt.Sprintf(`<w:highlight w:val="%s"/>`, xmlEscape(run.Highlight)))
 		}
 		if run.Underline {
@@ -445,11 +762,27 @@ func writeRunXML(buf *bytes.Buffer, run RunOptions) {
 		} else if run.Subscript {
 			buf.WriteString(`<w:vertAlign w:val="subscript"/>`)
 		}
+		if run.RTL {
+			buf.WriteString("<w:rtl/>")
+		}
 		buf.WriteString("</w:rPr>")
 	}
 
 	writeRunTextWithControls(buf, run.Text)
 
+	if run.NonBreakingSpace {
+		buf.WriteString(specialCharXML[CharNBSP])
+	}
+	if run.SoftHyphen {
+		buf.WriteString(specialCharXML[CharOptionalHyphen])
+	}
+	if run.TabAfter {
+		buf.WriteString("<w:tab/>")
+	}
+	if run.BreakAfter {
+		buf.WriteString("<w:br/>")
+	}
+
 	buf.WriteString("</w:r>")
 }
 
@@ -477,7 +810,7 @@ func writeRunTextWithControls(buf *bytes.Buffer, text string) {
 			buf.WriteString(` xml:space="preserve"`)
 		}
 		buf.WriteString(">")
-		buf.WriteString(xmlEscape(seg))
+		buf.WriteString(xmlEscapeContent(seg))
 		buf.WriteString("</w:t>")
 	}
 
@@ -513,24 +846,73 @@ func paragraphAlignmentValue(alignment ParagraphAlignment) (string, bool) {
 
 // insertParagraphAtPosition inserts the paragraph XML at the specified position
 func insertParagraphAtPosition(docXML, paraXML []byte, opts ParagraphOptions) ([]byte, error) {
-	switch opts.Position {
+	return insertElementAtPosition(docXML, paraXML, opts.Position, opts.Anchor)
+}
+
+// insertElementAtPosition inserts a body-level element (paragraph, table, ...)
+// at the specified position
+func insertElementAtPosition(docXML, paraXML []byte, position InsertPosition, anchor string) ([]byte, error) {
+	switch position {
 	case PositionBeginning:
 		return insertAtBodyStart(docXML, paraXML)
 	case PositionEnd:
 		return insertAtBodyEnd(docXML, paraXML)
 	case PositionAfterText:
-		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionAfterText")
+		if anchor == "" {
+			return nil, NewValidationError("anchor", "anchor text required for PositionAfterText")
 		}
-		return insertAfterText(docXML, paraXML, opts.Anchor)
+		return insertAfterText(docXML, paraXML, anchor)
 	case PositionBeforeText:
-		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionBeforeText")
+		if anchor == "" {
+			return nil, NewValidationError("anchor", "anchor text required for PositionBeforeText")
 		}
-		return insertBeforeText(docXML, paraXML, opts.Anchor)
-	default:
-		return nil, fmt.Errorf("invalid insert position")
+		return insertBeforeText(docXML, paraXML, anchor)
+	}
+	if n, ok := position.paragraphIndex(); ok {
+		return insertAtParagraphIndex(docXML, paraXML, n)
+	}
+	return nil, NewValidationError("position", "invalid insert position")
+}
+
+// insertAtParagraphIndex inserts paraXML after the first n top-level body
+// paragraphs.
+func insertAtParagraphIndex(docXML, paraXML []byte, n int) ([]byte, error) {
+	insertPos, err := paragraphIndexOffset(docXML, n)
+	if err != nil {
+		return nil, err
 	}
+	result := make([]byte, 0, len(docXML)+len(paraXML))
+	result = append(result, docXML[:insertPos]...)
+	result = append(result, paraXML...)
+	result = append(result, docXML[insertPos:]...)
+	return result, nil
+}
+
+// paragraphIndexOffset returns the offset in docXML just after the n-th
+// top-level body paragraph, or the start of the body content when n is 0.
+// The body is scanned once, so the cost is linear in its size.
+func paragraphIndexOffset(docXML []byte, n int) (int, error) {
+	if n < 0 {
+		return 0, NewValidationError("position", "paragraph index must not be negative")
+	}
+	bodyStart, _, children, err := splitBodyChildren(docXML)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return bodyStart, nil
+	}
+	count := 0
+	for _, child := range children {
+		if child.name == "w:p" {
+			count++
+			if count == n {
+				return bodyStart + child.offset + len(child.xml), nil
+			}
+		}
+	}
+	return 0, NewValidationError("position",
+		fmt.Sprintf("paragraph index %d out of range (document has %d paragraphs)", n, count))
 }
 
 // insertAtBodyStart inserts paragraph at the start of document body
@@ -554,7 +936,7 @@ func insertAtBodyStart(docXML, paraXML []byte) ([]byte, error) {
 func insertAtBodyEnd(docXML, paraXML []byte) ([]byte, error) {
 	bodyEnd := bytes.Index(docXML, []byte("</w:body>"))
 	if bodyEnd == -1 {
-		return nil, fmt.Errorf("could not find </w:body> tag")
+		return nil, NewMalformedXMLError("could not find </w:body> tag")
 	}
 
 	insertPos := bodyEnd
@@ -615,12 +997,12 @@ func insertAtBodyEnd(docXML, paraXML []byte) ([]byte, error) {
 func findBodyContentStart(docXML []byte) (int, error) {
 	bodyStart := bytes.Index(docXML, []byte("<w:body"))
 	if bodyStart == -1 {
-		return 0, fmt.Errorf("could not find <w:body> tag")
+		return 0, NewMalformedXMLError("could not find <w:body> tag")
 	}
 
 	openTagEnd := bytes.IndexByte(docXML[bodyStart:], '>')
 	if openTagEnd == -1 {
-		return 0, fmt.Errorf("malformed <w:body> tag")
+		return 0, NewMalformedXMLError("malformed <w:body> tag")
 	}
 
 	return bodyStart + openTagEnd + 1, nil
@@ -661,7 +1043,7 @@ func insertBeforeText(docXML, paraXML []byte, anchorText string) ([]byte, error)
 
 func findParagraphRangeByAnchor(docXML []byte, anchorText string) (int, int, error) {
 	if anchorText == "" {
-		return 0, 0, fmt.Errorf("anchor text cannot be empty")
+		return 0, 0, NewValidationError("anchor", "anchor text cannot be empty")
 	}
 
 	normalizedAnchor := normalizeWhitespace(anchorText)
@@ -675,24 +1057,58 @@ func findParagraphRangeByAnchor(docXML []byte, anchorText string) (int, int, err
 
 		paraEndRel := bytes.Index(docXML[paraStart:], []byte("</w:p>"))
 		if paraEndRel == -1 {
-			return 0, 0, fmt.Errorf("could not find paragraph end for anchor search")
+			return 0, 0, NewMalformedXMLError("could not find paragraph end for anchor search")
 		}
 		paraEnd := paraStart + paraEndRel + len("</w:p>")
 
+		// Match against the paragraph text as GetText extracts it, so anchors
+		// split across runs are found however the paragraph was broken up.
+		// The text is also tried with unpreserved spaces kept, as written by
+		// tools that omit xml:space="preserve".
 		paragraphXML := docXML[paraStart:paraEnd]
-		paragraphText := extractParagraphPlainText(paragraphXML)
-		if strings.Contains(paragraphText, anchorText) {
-			return paraStart, paraEnd, nil
-		}
-
-		if normalizedAnchor != "" && strings.Contains(normalizeWhitespace(paragraphText), normalizedAnchor) {
-			return paraStart, paraEnd, nil
+		for _, paragraphText := range []string{runText(paragraphXML, false), extractRunText(paragraphXML)} {
+			if strings.Contains(paragraphText, anchorText) {
+				return paraStart, paraEnd, nil
+			}
+			if normalizedAnchor != "" && strings.Contains(normalizeWhitespace(paragraphText), normalizedAnchor) {
+				return paraStart, paraEnd, nil
+			}
 		}
 
 		searchPos = paraEnd
 	}
 
-	return 0, 0, fmt.Errorf("anchor text %q not found in document", anchorText)
+	return 0, 0, NewTextNotFoundError(anchorText)
+}
+
+// updateParagraphByAnchor rewrites the first paragraph whose visible text contains
+// anchor. fn receives the complete <w:p>...</w:p> element and returns its replacement.
+func (u *Updater) updateParagraphByAnchor(anchor string, fn func(para []byte) ([]byte, error)) error {
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	paraStart, paraEnd, err := findParagraphRangeByAnchor(raw, anchor)
+	if err != nil {
+		return err
+	}
+
+	replacement, err := fn(raw[paraStart:paraEnd])
+	if err != nil {
+		return err
+	}
+
+	updated := make([]byte, 0, len(raw)-(paraEnd-paraStart)+len(replacement))
+	updated = append(updated, raw[:paraStart]...)
+	updated = append(updated, replacement...)
+	updated = append(updated, raw[paraEnd:]...)
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
 }
 
 func findNextParagraphStart(docXML []byte, start int) int {
@@ -716,6 +1132,12 @@ func findNextParagraphStart(docXML []byte, start int) int {
 }
 
 func extractParagraphPlainText(paragraphXML []byte) string {
+	return extractParagraphText(paragraphXML, ' ')
+}
+
+// extractParagraphText returns the text of a paragraph, writing tab for each
+// tab or line break.
+func extractParagraphText(paragraphXML []byte, tab byte) string {
 	var out strings.Builder
 	searchPos := 0
 
@@ -731,7 +1153,7 @@ func extractParagraphPlainText(paragraphXML []byte) string {
 
 		tStart = next
 		if kind == "tab" || kind == "br" {
-			out.WriteByte(' ')
+			out.WriteByte(tab)
 			tokenEndRel := bytes.IndexByte(paragraphXML[tStart:], '>')
 			if tokenEndRel == -1 {
 				break
@@ -780,7 +1202,14 @@ func nextXMLTokenAbsolute(tPos, tabPos, brPos int) (int, string) {
 }
 
 func findNextWordTagStart(docXML []byte, start int, tag string) int {
-	needle := []byte("<w:" + tag)
+	return findNextTagStart(docXML, start, "w:"+tag)
+}
+
+// findNextTagStart finds the next start tag with the qualified name qname
+// (e.g. "w:pPr") at or after start. A tag only matches when its name is complete,
+// so "w:p" does not match "<w:pPr".
+func findNextTagStart(docXML []byte, start int, qname string) int {
+	needle := []byte("<" + qname)
 	for {
 		idx := bytes.Index(docXML[start:], needle)
 		if idx == -1 {
@@ -842,7 +1271,7 @@ var headingStyles = [10]ParagraphStyle{
 // specified level (1–9), matching Word's built-in Heading 1 – Heading 9 styles.
 func (u *Updater) AddHeading(level int, text string, position InsertPosition) error {
 	if level < 1 || level > 9 {
-		return fmt.Errorf("heading level must be between 1 and 9, got %d", level)
+		return NewValidationError("level", fmt.Sprintf("heading level must be between 1 and 9, got %d", level))
 	}
 	style := headingStyles[level]
 
@@ -863,6 +1292,40 @@ func (u *Updater) AddText(text string, position InsertPosition) error {
 	})
 }
 
+// TextOptions formats a paragraph added by AddTextWithOptions. It covers the
+// common paragraph settings without the full ParagraphOptions.
+type TextOptions struct {
+	Style     ParagraphStyle // Paragraph style (default: Normal)
+	Alignment ParagraphAlignment
+
+	// Spacing and indentation in twips; LineSpacing in 240ths of a line.
+	SpaceBefore int
+	SpaceAfter  int
+	LineSpacing int
+	IndentLeft  int
+	IndentRight int
+
+	Position InsertPosition // Where to insert the paragraph
+	Anchor   string         // Anchor text for PositionAfterText/PositionBeforeText
+}
+
+// AddTextWithOptions adds a text paragraph with the alignment, spacing and
+// indentation in opts.
+func (u *Updater) AddTextWithOptions(text string, opts TextOptions) error {
+	return u.InsertParagraph(ParagraphOptions{
+		Text:        text,
+		Style:       opts.Style,
+		Alignment:   opts.Alignment,
+		SpaceBefore: opts.SpaceBefore,
+		SpaceAfter:  opts.SpaceAfter,
+		LineSpacing: opts.LineSpacing,
+		IndentLeft:  opts.IndentLeft,
+		IndentRight: opts.IndentRight,
+		Position:    opts.Position,
+		Anchor:      opts.Anchor,
+	})
+}
+
 // AddBulletItem adds a bullet list item at the specified level (0-8)
 func (u *Updater) AddBulletItem(text string, level int, position InsertPosition) error {
 	return u.InsertParagraph(ParagraphOptions{
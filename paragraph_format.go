@@ -0,0 +1,526 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// BorderSpec describes one side of a paragraph border.
+type BorderSpec struct {
+	// Style is the line style (single, double, thick, dotted, dashed).
+	// An empty Style leaves this side without a border.
+	Style BorderStyle
+
+	// Width is the line width in eighths of a point (default: 4 = 0.5pt).
+	Width int
+
+	// Color is a 6-digit hex color (default: "auto").
+	Color string
+
+	// Space is the distance between the text and the border, in points.
+	Space int
+}
+
+// ParagraphBorderOptions defines the borders drawn around a paragraph.
+type ParagraphBorderOptions struct {
+	Top    BorderSpec
+	Bottom BorderSpec
+	Left   BorderSpec
+	Right  BorderSpec
+}
+
+// SetParagraphBorder sets the borders of the first paragraph containing the
+// anchor text, replacing any borders already defined on it.
+func (u *Updater) SetParagraphBorder(anchor string, opts ParagraphBorderOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if anchor == "" {
+		return NewValidationError("anchor", "anchor text cannot be empty")
+	}
+	if err := validateParagraphBorder(opts); err != nil {
+		return err
+	}
+
+	borderXML := generateParagraphBorderXML(opts)
+	return u.updateParagraphByAnchor(anchor, func(para []byte) ([]byte, error) {
+		return setParagraphProperty(para, "pBdr", borderXML)
+	})
+}
+
+// validateParagraphBorder checks the style and color of every border side.
+func validateParagraphBorder(opts ParagraphBorderOptions) error {
+	sides := []struct {
+		name string
+		spec BorderSpec
+	}{
+		{"Top", opts.Top}, {"Bottom", opts.Bottom}, {"Left", opts.Left}, {"Right", opts.Right},
+	}
+	for _, side := range sides {
+		switch side.spec.Style {
+		case "", BorderSingle, BorderDouble, BorderThick, BorderDotted, BorderDashed, BorderNone:
+		default:
+			return NewValidationError(side.name+".Style", fmt.Sprintf("unsupported border style %q", side.spec.Style))
+		}
+		if err := validateColor(side.name+".Color", side.spec.Color); err != nil {
+			return err
+		}
+		if side.spec.Width < 0 || side.spec.Space < 0 {
+			return NewValidationError(side.name, "border width and space cannot be negative")
+		}
+	}
+	return nil
+}
+
+// generateParagraphBorderXML creates the <w:pBdr> element for opts, or an empty
+// string when no side has a style.
+func generateParagraphBorderXML(opts ParagraphBorderOptions) string {
+	var inner strings.Builder
+	// CT_PBdr requires the order top, left, bottom, right.
+	writeBorderSide(&inner, "top", opts.Top)
+	writeBorderSide(&inner, "left", opts.Left)
+	writeBorderSide(&inner, "bottom", opts.Bottom)
+	writeBorderSide(&inner, "right", opts.Right)
+	if inner.Len() == 0 {
+		return ""
+	}
+	return "<w:pBdr>" + inner.String() + "</w:pBdr>"
+}
+
+func writeBorderSide(buf *strings.Builder, side string, spec BorderSpec) {
+	if spec.Style == "" {
+		return
+	}
+	width := spec.Width
+	if width == 0 {
+		width = 4
+	}
+	color := normalizeHexColor(spec.Color)
+	if color == "" {
+		color = "auto"
+	}
+	fmt.Fprintf(buf, `<w:%s w:val="%s" w:sz="%d" w:space="%d" w:color="%s"/>`,
+		side, spec.Style, width, spec.Space, color)
+}
+
+// FrameAnchor is the object a paragraph frame is positioned relative to.
+type FrameAnchor string
+
+const (
+	FrameAnchorText   FrameAnchor = "text"
+	FrameAnchorMargin FrameAnchor = "margin"
+	FrameAnchorPage   FrameAnchor = "page"
+)
+
+// FrameAlign aligns a paragraph frame relative to its anchor. Left and Right
+// apply horizontally, Top and Bottom vertically, and Center, Inside and
+// Outside on either axis.
+type FrameAlign string
+
+const (
+	FrameAlignLeft    FrameAlign = "left"
+	FrameAlignCenter  FrameAlign = "center"
+	FrameAlignRight   FrameAlign = "right"
+	FrameAlignInside  FrameAlign = "inside"
+	FrameAlignOutside FrameAlign = "outside"
+	FrameAlignTop     FrameAlign = "top"
+	FrameAlignBottom  FrameAlign = "bottom"
+)
+
+// FrameWrap controls how surrounding text flows around a paragraph frame.
+type FrameWrap string
+
+const (
+	FrameWrapAuto      FrameWrap = "auto"
+	FrameWrapNotBeside FrameWrap = "notBeside"
+	FrameWrapAround    FrameWrap = "around"
+	FrameWrapTight     FrameWrap = "tight"
+	FrameWrapThrough   FrameWrap = "through"
+	FrameWrapNone      FrameWrap = "none"
+)
+
+// FrameOptions places a paragraph in a text frame (<w:framePr>), a legacy
+// alternative to text boxes for sidebars and margin notes. Adjacent
+// paragraphs with identical frame options share one frame.
+type FrameOptions struct {
+	// Width of the frame in twips (0 sizes it to the text).
+	Width int
+
+	// Height is the minimum height of the frame in twips (0 sizes it to the text).
+	Height int
+
+	// HSpace and VSpace are the distances between the frame and the
+	// surrounding text, in twips.
+	HSpace int
+	VSpace int
+
+	// HorizontalAnchor and VerticalAnchor select what the frame is positioned
+	// relative to (default: Word's, the margin horizontally and the
+	// paragraph text vertically).
+	HorizontalAnchor FrameAnchor
+	VerticalAnchor   FrameAnchor
+
+	// HorizontalAlign and VerticalAlign align the frame relative to its anchors.
+	HorizontalAlign FrameAlign
+	VerticalAlign   FrameAlign
+
+	// WrapStyle controls how text flows around the frame (default: Word's, auto).
+	WrapStyle FrameWrap
+}
+
+// validateFrameOptions checks the sizes and enumerated values of opts.
+func validateFrameOptions(opts FrameOptions) error {
+	for _, f := range []struct {
+		name  string
+		value int
+	}{
+		{"Frame.Width", opts.Width}, {"Frame.Height", opts.Height},
+		{"Frame.HSpace", opts.HSpace}, {"Frame.VSpace", opts.VSpace},
+	} {
+		if f.value < 0 {
+			return NewValidationError(f.name, "must not be negative")
+		}
+	}
+	for _, a := range []struct {
+		name   string
+		anchor FrameAnchor
+	}{
+		{"Frame.HorizontalAnchor", opts.HorizontalAnchor}, {"Frame.VerticalAnchor", opts.VerticalAnchor},
+	} {
+		switch a.anchor {
+		case "", FrameAnchorText, FrameAnchorMargin, FrameAnchorPage:
+		default:
+			return NewValidationError(a.name, fmt.Sprintf("unsupported frame anchor %q", a.anchor))
+		}
+	}
+	switch opts.HorizontalAlign {
+	case "", FrameAlignLeft, FrameAlignCenter, FrameAlignRight, FrameAlignInside, FrameAlignOutside:
+	default:
+		return NewValidationError("Frame.HorizontalAlign", fmt.Sprintf("unsupported horizontal frame alignment %q", opts.HorizontalAlign))
+	}
+	switch opts.VerticalAlign {
+	case "", FrameAlignTop, FrameAlignCenter, FrameAlignBottom, FrameAlignInside, FrameAlignOutside:
+	default:
+		return NewValidationError("Frame.VerticalAlign", fmt.Sprintf("unsupported vertical frame alignment %q", opts.VerticalAlign))
+	}
+	switch opts.WrapStyle {
+	case "", FrameWrapAuto, FrameWrapNotBeside, FrameWrapAround, FrameWrapTight, FrameWrapThrough, FrameWrapNone:
+	default:
+		return NewValidationError("Frame.WrapStyle", fmt.Sprintf("unsupported frame wrap style %q", opts.WrapStyle))
+	}
+	return nil
+}
+
+// generateFramePrXML creates the <w:framePr> element for opts, writing only
+// the attributes that are set.
+func generateFramePrXML(opts FrameOptions) string {
+	var b strings.Builder
+	b.WriteString("<w:framePr")
+	if opts.Width > 0 {
+		fmt.Fprintf(&b, ` w:w="%d"`, opts.Width)
+	}
+	if opts.Height > 0 {
+		fmt.Fprintf(&b, ` w:h="%d" w:hRule="atLeast"`, opts.Height)
+	}
+	if opts.HSpace > 0 {
+		fmt.Fprintf(&b, ` w:hSpace="%d"`, opts.HSpace)
+	}
+	if opts.VSpace > 0 {
+		fmt.Fprintf(&b, ` w:vSpace="%d"`, opts.VSpace)
+	}
+	for _, attr := range []struct{ name, value string }{
+		{"wrap", string(opts.WrapStyle)},
+		{"vAnchor", string(opts.VerticalAnchor)},
+		{"hAnchor", string(opts.HorizontalAnchor)},
+		{"xAlign", string(opts.HorizontalAlign)},
+		{"yAlign", string(opts.VerticalAlign)},
+	} {
+		if attr.value != "" {
+			fmt.Fprintf(&b, ` w:%s="%s"`, attr.name, attr.value)
+		}
+	}
+	b.WriteString("/>")
+	return b.String()
+}
+
+// pPrChildOrder is the element sequence mandated by CT_PPr (ECMA-376 Part 1 §17.3.1.26).
+// Word rejects documents whose paragraph properties appear out of order.
+var pPrChildOrder = []string{
+	"pStyle", "keepNext", "keepLines", "pageBreakBefore", "framePr", "widowControl",
+	"numPr", "suppressLineNumbers", "pBdr", "shd", "tabs", "suppressAutoHyphens",
+	"kinsoku", "wordWrap", "overflowPunct", "topLinePunct", "autoSpaceDE", "autoSpaceDN",
+	"bidi", "adjustRightInd", "snapToGrid", "spacing", "ind", "contextualSpacing",
+	"mirrorIndents", "suppressOverlap", "jc", "textDirection", "textAlignment",
+	"textboxTightWrap", "outlineLvl", "divId", "cnfStyle", "rPr", "sectPr", "pPrChange",
+}
+
+// rPrChildOrder is the element sequence mandated by CT_RPr (ECMA-376 Part 1 §17.3.2.28).
+var rPrChildOrder = []string{
+	"rStyle", "rFonts", "b", "bCs", "i", "iCs", "caps", "smallCaps", "strike", "dstrike",
+	"outline", "shadow", "emboss", "imprint", "noProof", "snapToGrid", "vanish", "webHidden",
+	"color", "spacing", "w", "kern", "position", "sz", "szCs", "highlight", "u", "effect",
+	"bdr", "shd", "fitText", "vertAlign", "rtl", "cs", "em", "lang", "eastAsianLayout",
+	"specVanish", "oMath",
+}
+
+// setParagraphProperty sets the <w:name> child of the paragraph's <w:pPr> to
+// elemXML, replacing any existing element with the same name and keeping the
+// CT_PPr child order. An empty elemXML removes the property. A <w:pPr> block is
+// created when the paragraph has none.
+func setParagraphProperty(para []byte, name, elemXML string) ([]byte, error) {
+	contentStart, pPrEnd, children, err := splitParagraphProperties(para)
+	if err != nil {
+		return nil, err
+	}
+
+	children = upsertOrderedChild(children, "w:"+name, elemXML, pPrChildOrder)
+
+	var buf bytes.Buffer
+	buf.Grow(len(para) + len(elemXML))
+	buf.Write(para[:contentStart])
+	if len(children) > 0 {
+		buf.WriteString("<w:pPr>")
+		for _, c := range children {
+			buf.Write(c.xml)
+		}
+		buf.WriteString("</w:pPr>")
+	}
+	buf.Write(para[pPrEnd:])
+	return buf.Bytes(), nil
+}
+
+// splitParagraphProperties returns the children of the paragraph's <w:pPr>
+// along with the offsets where the paragraph content starts and where the
+// <w:pPr> block ends (equal to contentStart when there is none).
+func splitParagraphProperties(para []byte) (contentStart, pPrEnd int, children []xmlChild, err error) {
+	openEnd := bytes.IndexByte(para, '>')
+	if openEnd == -1 || !bytes.HasPrefix(para, []byte("<w:p")) {
+		return 0, 0, nil, NewMalformedXMLError("malformed paragraph element")
+	}
+	contentStart = openEnd + 1
+
+	pPrStart := contentStart + len(para[contentStart:]) - len(bytes.TrimLeft(para[contentStart:], " \t\r\n"))
+	pPrEnd = contentStart
+	rest := para[pPrStart:]
+	switch {
+	case bytes.HasPrefix(rest, []byte("<w:pPr/>")):
+		pPrEnd = pPrStart + len("<w:pPr/>")
+	case bytes.HasPrefix(rest, []byte("<w:pPr>")) || bytes.HasPrefix(rest, []byte("<w:pPr ")):
+		innerStart := bytes.IndexByte(rest, '>') + 1
+		end := findMatchingClose(rest, "w:pPr")
+		if end == -1 {
+			return 0, 0, nil, NewMalformedXMLError("malformed paragraph properties: closing tag not found")
+		}
+		children = splitXMLChildren(rest[innerStart:end])
+		pPrEnd = pPrStart + end + len("</w:pPr>")
+	}
+	return contentStart, pPrEnd, children, nil
+}
+
+// DropCapOptions defines how the first letter of a paragraph is enlarged.
+type DropCapOptions struct {
+	// Lines is the number of text lines the cap spans (default: 3, max: 10).
+	Lines int
+
+	// Distance is the gap between the cap and the paragraph text, in twips.
+	Distance int
+
+	// FontFamily of the cap letter (default: the paragraph's font).
+	FontFamily string
+}
+
+var (
+	dropCapPStylePattern = regexp.MustCompile(`<w:pStyle w:val="[^"]*"/>`)
+	dropCapRPrPattern    = regexp.MustCompile(`(?s)<w:rPr>(.*?)</w:rPr>`)
+	dropCapTextPattern   = regexp.MustCompile(`<w:t(?:\s[^>]*)?>([^<]+)</w:t>`)
+)
+
+// AddDropCap turns the first letter of the paragraph containing the anchor
+// text into a drop cap. As Word does, the letter is moved into its own framed
+// paragraph (<w:framePr w:dropCap="drop">) placed directly before the text.
+func (u *Updater) AddDropCap(anchor string, opts DropCapOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if anchor == "" {
+		return NewValidationError("anchor", "anchor text cannot be empty")
+	}
+	if opts.Lines == 0 {
+		opts.Lines = 3
+	}
+	if opts.Lines < 1 || opts.Lines > 10 {
+		return NewValidationError("Lines", "drop cap must span between 1 and 10 lines")
+	}
+	if opts.Distance < 0 {
+		return NewValidationError("Distance", "drop cap distance cannot be negative")
+	}
+
+	return u.updateParagraphByAnchor(anchor, func(para []byte) ([]byte, error) {
+		return applyDropCap(para, opts)
+	})
+}
+
+// applyDropCap splits the first character off para and returns the drop cap
+// frame paragraph followed by the remaining paragraph.
+func applyDropCap(para []byte, opts DropCapOptions) ([]byte, error) {
+	contentStart := paragraphContentStart(para)
+
+	var runStart, runEnd int
+	var textLoc []int
+	for _, loc := range extractRunPattern.FindAllIndex(para[contentStart:], -1) {
+		run := para[contentStart+loc[0] : contentStart+loc[1]]
+		if m := dropCapTextPattern.FindSubmatchIndex(run); m != nil {
+			runStart, runEnd = contentStart+loc[0], contentStart+loc[1]
+			textLoc = m
+			break
+		}
+	}
+	if textLoc == nil {
+		return nil, NewValidationError("anchor", "paragraph has no text for a drop cap")
+	}
+
+	run := para[runStart:runEnd]
+	text := run[textLoc[2]:textLoc[3]]
+	letterLen := firstXMLCharLen(text)
+	letter := string(text[:letterLen])
+
+	// Remove the letter from the original run.
+	trimmedRun := make([]byte, 0, len(run))
+	trimmedRun = append(trimmedRun, run[:textLoc[2]]...)
+	trimmedRun = append(trimmedRun, text[letterLen:]...)
+	trimmedRun = append(trimmedRun, run[textLoc[3]:]...)
+
+	// Approximate Word's sizing for a cap spanning opts.Lines lines of body text.
+	lineHeight := opts.Lines * 370
+	fontSize := opts.Lines * 37
+
+	var capProps []xmlChild
+	if m := dropCapRPrPattern.FindSubmatch(run); m != nil {
+		// Keep the run's own formatting (font, color, bold, ...) on the cap.
+		capProps = splitXMLChildren(m[1])
+	}
+	if opts.FontFamily != "" {
+		font := xmlEscape(opts.FontFamily)
+		capProps = upsertOrderedChild(capProps, "w:rFonts",
+			fmt.Sprintf(`<w:rFonts w:ascii="%s" w:hAnsi="%s" w:cs="%s"/>`, font, font, font), rPrChildOrder)
+	}
+	capProps = upsertOrderedChild(capProps, "w:position", fmt.Sprintf(`<w:position w:val="-%d"/>`, opts.Lines*2), rPrChildOrder)
+	capProps = upsertOrderedChild(capProps, "w:sz", fmt.Sprintf(`<w:sz w:val="%d"/>`, fontSize), rPrChildOrder)
+	capProps = upsertOrderedChild(capProps, "w:szCs", fmt.Sprintf(`<w:szCs w:val="%d"/>`, fontSize), rPrChildOrder)
+
+	var capPara strings.Builder
+	capPara.WriteString(`<w:p><w:pPr>`)
+	if style := dropCapPStylePattern.Find(para[:contentStart]); style != nil {
+		capPara.Write(style)
+	}
+	fmt.Fprintf(&capPara, `<w:keepNext/><w:framePr w:dropCap="drop" w:lines="%d" w:hSpace="%d" w:wrap="around" w:vAnchor="text" w:hAnchor="text"/>`,
+		opts.Lines, opts.Distance)
+	fmt.Fprintf(&capPara, `<w:spacing w:after="0" w:line="%d" w:lineRule="exact"/>`, lineHeight)
+	capPara.WriteString(`<w:textAlignment w:val="baseline"/></w:pPr>`)
+	capPara.WriteString(`<w:r><w:rPr>`)
+	for _, c := range capProps {
+		capPara.Write(c.xml)
+	}
+	fmt.Fprintf(&capPara, `</w:rPr><w:t>%s</w:t></w:r></w:p>`, letter)
+
+	var buf bytes.Buffer
+	buf.Grow(capPara.Len() + len(para))
+	buf.WriteString(capPara.String())
+	buf.Write(para[:runStart])
+	buf.Write(trimmedRun)
+	buf.Write(para[runEnd:])
+	return buf.Bytes(), nil
+}
+
+// firstXMLCharLen returns the byte length of the first character of escaped
+// XML text, treating an entity reference such as &amp; as one character.
+func firstXMLCharLen(text []byte) int {
+	if text[0] == '&' {
+		if end := bytes.IndexByte(text, ';'); end != -1 {
+			return end + 1
+		}
+	}
+	_, size := utf8.DecodeRune(text)
+	return size
+}
+
+// SetParagraphStyle applies the paragraph style styleID (e.g. "Heading2") to
+// the top-level body paragraph at index (1-based as in ParagraphInfo.Index;
+// paragraphs inside tables are not counted), replacing any style it has. The
+// paragraph's content and its other properties are kept. Returns an
+// ErrCodeTextNotFound error when index is out of range.
+func (u *Updater) SetParagraphStyle(index int, styleID string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if index < 1 {
+		return NewValidationError("index", "paragraph index must be >= 1")
+	}
+	if styleID == "" {
+		return NewValidationError("styleID", "style ID cannot be empty")
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+	bodyStart, _, children, err := splitBodyChildren(raw)
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	for _, child := range children {
+		if child.name != "w:p" {
+			continue
+		}
+		count++
+		if count < index {
+			continue
+		}
+		para, err := setParagraphProperty(child.xml, "pStyle", paragraphStyleXML(styleID))
+		if err != nil {
+			return err
+		}
+		start := bodyStart + child.offset
+		updated := spliceBytes(raw, start, start+len(child.xml), string(para))
+		if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+			return NewXMLWriteError("document.xml", err)
+		}
+		return nil
+	}
+
+	return &DocxError{
+		Code:    ErrCodeTextNotFound,
+		Message: fmt.Sprintf("paragraph %d not found (document has %d paragraphs)", index, count),
+		Context: map[string]any{"index": index},
+	}
+}
+
+// SetParagraphStyleByAnchor applies the paragraph style styleID to the first
+// paragraph containing the anchor text, replacing any style it has.
+func (u *Updater) SetParagraphStyleByAnchor(anchor, styleID string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if anchor == "" {
+		return NewValidationError("anchor", "anchor text cannot be empty")
+	}
+	if styleID == "" {
+		return NewValidationError("styleID", "style ID cannot be empty")
+	}
+
+	return u.updateParagraphByAnchor(anchor, func(para []byte) ([]byte, error) {
+		return setParagraphProperty(para, "pStyle", paragraphStyleXML(styleID))
+	})
+}
+
+func paragraphStyleXML(styleID string) string {
+	return fmt.Sprintf(`<w:pStyle w:val="%s"/>`, xmlEscape(styleID))
+}
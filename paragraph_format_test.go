@@ -0,0 +1,240 @@
+package godocx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSetParagraphProperty_Ordering(t *testing.T) {
+	tests := []struct {
+		name string
+		para string
+		prop string
+		elem string
+		want string
+	}{
+		{
+			name: "creates pPr",
+			para: `<w:p><w:r><w:t>x</w:t></w:r></w:p>`,
+			prop: "jc", elem: `<w:jc w:val="center"/>`,
+			want: `<w:p><w:pPr><w:jc w:val="center"/></w:pPr><w:r><w:t>x</w:t></w:r></w:p>`,
+		},
+		{
+			name: "inserts in schema order",
+			para: `<w:p w:rsidR="00A1"><w:pPr><w:pStyle w:val="Quote"/><w:jc w:val="left"/></w:pPr><w:r><w:t>x</w:t></w:r></w:p>`,
+			prop: "shd", elem: `<w:shd w:val="clear" w:color="auto" w:fill="EEEEEE"/>`,
+			want: `<w:p w:rsidR="00A1"><w:pPr><w:pStyle w:val="Quote"/><w:shd w:val="clear" w:color="auto" w:fill="EEEEEE"/><w:jc w:val="left"/></w:pPr><w:r><w:t>x</w:t></w:r></w:p>`,
+		},
+		{
+			name: "replaces existing element with children",
+			para: `<w:p><w:pPr><w:pBdr><w:top w:val="single"/></w:pBdr><w:rPr><w:b/></w:rPr></w:pPr></w:p>`,
+			prop: "pBdr", elem: `<w:pBdr><w:bottom w:val="double"/></w:pBdr>`,
+			want: `<w:p><w:pPr><w:pBdr><w:bottom w:val="double"/></w:pBdr><w:rPr><w:b/></w:rPr></w:pPr></w:p>`,
+		},
+		{
+			name: "removes element",
+			para: `<w:p><w:pPr><w:jc w:val="left"/></w:pPr></w:p>`,
+			prop: "jc", elem: "",
+			want: `<w:p></w:p>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := setParagraphProperty([]byte(tt.para), tt.prop, tt.elem)
+			if err != nil {
+				t.Fatalf("setParagraphProperty: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetParagraphBorder(t *testing.T) {
+	body := `<w:p><w:pPr><w:jc w:val="center"/></w:pPr><w:r><w:t>Callout text</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Other</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	err := u.SetParagraphBorder("Callout", ParagraphBorderOptions{
+		Top:    BorderSpec{Style: BorderSingle, Width: 8, Color: "#1F4E79", Space: 4},
+		Bottom: BorderSpec{Style: BorderDouble},
+	})
+	if err != nil {
+		t.Fatalf("SetParagraphBorder: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	want := `<w:pPr><w:pBdr><w:top w:val="single" w:sz="8" w:space="4" w:color="1F4E79"/>` +
+		`<w:bottom w:val="double" w:sz="4" w:space="0" w:color="auto"/></w:pBdr><w:jc w:val="center"/></w:pPr>`
+	if !strings.Contains(docXML, want) {
+		t.Errorf("expected border in pPr, got: %s", docXML)
+	}
+	if strings.Count(docXML, "<w:pBdr>") != 1 {
+		t.Error("border must only be applied to the anchor paragraph")
+	}
+}
+
+func TestSetParagraphBorder_Validation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Text</w:t></w:r></w:p>`))
+
+	if err := u.SetParagraphBorder("Text", ParagraphBorderOptions{Top: BorderSpec{Style: BorderSingle, Color: "blue"}}); err == nil {
+		t.Error("expected error for non-hex color")
+	}
+	if err := u.SetParagraphBorder("Text", ParagraphBorderOptions{Left: BorderSpec{Style: "zigzag"}}); err == nil {
+		t.Error("expected error for unknown style")
+	}
+	if err := u.SetParagraphBorder("", ParagraphBorderOptions{}); err == nil {
+		t.Error("expected error for empty anchor")
+	}
+}
+
+func TestInsertParagraph_WithBorder(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertParagraph(ParagraphOptions{
+		Text:            "Boxed",
+		Position:        PositionEnd,
+		BackgroundColor: "F2F2F2",
+		ParagraphBorder: &ParagraphBorderOptions{
+			Top:    BorderSpec{Style: BorderSingle},
+			Bottom: BorderSpec{Style: BorderSingle},
+			Left:   BorderSpec{Style: BorderSingle},
+			Right:  BorderSpec{Style: BorderSingle},
+		},
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:pBdr><w:top `) || !strings.Contains(docXML, `</w:pBdr><w:shd `) {
+		t.Errorf("expected pBdr followed by shd, got: %s", docXML)
+	}
+}
+
+func TestAddDropCap(t *testing.T) {
+	body := `<w:p><w:r><w:t>Heading</w:t></w:r></w:p>` +
+		`<w:p><w:pPr><w:pStyle w:val="BodyText"/></w:pPr>` +
+		`<w:r><w:rPr><w:b/><w:color w:val="C00000"/><w:sz w:val="22"/></w:rPr><w:t>Once upon a time</w:t></w:r>` +
+		`<w:r><w:t xml:space="preserve"> there was a drop cap.</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.AddDropCap("upon a time", DropCapOptions{Distance: 144, FontFamily: "Georgia"}); err != nil {
+		t.Fatalf("AddDropCap: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	wantCap := `<w:p><w:pPr><w:pStyle w:val="BodyText"/><w:keepNext/>` +
+		`<w:framePr w:dropCap="drop" w:lines="3" w:hSpace="144" w:wrap="around" w:vAnchor="text" w:hAnchor="text"/>` +
+		`<w:spacing w:after="0" w:line="1110" w:lineRule="exact"/><w:textAlignment w:val="baseline"/></w:pPr>` +
+		`<w:r><w:rPr><w:rFonts w:ascii="Georgia" w:hAnsi="Georgia" w:cs="Georgia"/><w:b/><w:color w:val="C00000"/>` +
+		`<w:position w:val="-6"/><w:sz w:val="111"/><w:szCs w:val="111"/></w:rPr><w:t>O</w:t></w:r></w:p>`
+	if !strings.Contains(docXML, wantCap) {
+		t.Errorf("expected drop cap frame paragraph %s, got: %s", wantCap, docXML)
+	}
+	if !strings.Contains(docXML, wantCap+`<w:p><w:pPr><w:pStyle w:val="BodyText"/></w:pPr>`) {
+		t.Error("expected drop cap paragraph directly before the anchor paragraph")
+	}
+	if !strings.Contains(docXML, `<w:t>nce upon a time</w:t>`) {
+		t.Errorf("expected first letter removed from the anchor paragraph, got: %s", docXML)
+	}
+}
+
+func TestAddDropCap_EntityFirstCharacter(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>&amp; so on</w:t></w:r></w:p>`))
+
+	if err := u.AddDropCap("so on", DropCapOptions{Lines: 2}); err != nil {
+		t.Fatalf("AddDropCap: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `w:lines="2"`) || !strings.Contains(docXML, `<w:t>&amp;</w:t>`) {
+		t.Errorf("expected escaped ampersand as the cap letter, got: %s", docXML)
+	}
+	if !strings.Contains(docXML, `<w:t> so on</w:t>`) {
+		t.Errorf("expected remaining text without the entity, got: %s", docXML)
+	}
+}
+
+func TestAddDropCap_Validation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Text</w:t></w:r></w:p>`))
+
+	if err := u.AddDropCap("", DropCapOptions{}); err == nil {
+		t.Error("expected error for empty anchor")
+	}
+	if err := u.AddDropCap("Text", DropCapOptions{Lines: 11}); err == nil {
+		t.Error("expected error for too many lines")
+	}
+	if err := u.AddDropCap("Text", DropCapOptions{Distance: -1}); err == nil {
+		t.Error("expected error for negative distance")
+	}
+	if err := u.AddDropCap("missing", DropCapOptions{}); err == nil {
+		t.Error("expected error for missing anchor")
+	}
+}
+
+func TestSetParagraphStyle(t *testing.T) {
+	body := `<w:p><w:r><w:t>Plain</w:t></w:r></w:p>` +
+		`<w:tbl><w:tr><w:tc><w:p><w:r><w:t>In table</w:t></w:r></w:p></w:tc></w:tr></w:tbl>` +
+		`<w:p w:rsidR="00A1"><w:pPr><w:pStyle w:val="Normal"/><w:jc w:val="center"/></w:pPr><w:r><w:t>Styled</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.SetParagraphStyle(1, "Heading1"); err != nil {
+		t.Fatalf("SetParagraphStyle(1): %v", err)
+	}
+	if err := u.SetParagraphStyle(2, "Quote"); err != nil {
+		t.Fatalf("SetParagraphStyle(2): %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	for _, want := range []string{
+		`<w:p><w:pPr><w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t>Plain</w:t></w:r></w:p>`,
+		`<w:p><w:r><w:t>In table</w:t></w:r></w:p>`,
+		`<w:p w:rsidR="00A1"><w:pPr><w:pStyle w:val="Quote"/><w:jc w:val="center"/></w:pPr><w:r><w:t>Styled</w:t></w:r></w:p>`,
+	} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s, got: %s", want, docXML)
+		}
+	}
+
+	err := u.SetParagraphStyle(3, "Heading1")
+	var docxErr *DocxError
+	if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeTextNotFound {
+		t.Errorf("expected ErrCodeTextNotFound for an out-of-range index, got %v", err)
+	}
+	if err := u.SetParagraphStyle(0, "Heading1"); err == nil {
+		t.Error("expected error for index 0")
+	}
+	if err := u.SetParagraphStyle(1, ""); err == nil {
+		t.Error("expected error for an empty style ID")
+	}
+}
+
+func TestSetParagraphStyleByAnchor(t *testing.T) {
+	body := `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>` +
+		`<w:p><w:pPr><w:pStyle w:val="Normal"/><w:spacing w:after="0"/></w:pPr><w:r><w:t>Key finding</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.SetParagraphStyleByAnchor("Intro", "Title"); err != nil {
+		t.Fatalf("SetParagraphStyleByAnchor(Intro): %v", err)
+	}
+	if err := u.SetParagraphStyleByAnchor("Key finding", "IntenseQuote"); err != nil {
+		t.Fatalf("SetParagraphStyleByAnchor(Key finding): %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	for _, want := range []string{
+		`<w:p><w:pPr><w:pStyle w:val="Title"/></w:pPr><w:r><w:t>Intro</w:t></w:r></w:p>`,
+		`<w:pPr><w:pStyle w:val="IntenseQuote"/><w:spacing w:after="0"/></w:pPr><w:r><w:t>Key finding</w:t>`,
+	} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s, got: %s", want, docXML)
+		}
+	}
+	if err := u.SetParagraphStyleByAnchor("missing", "Title"); err == nil {
+		t.Error("expected error for missing anchor")
+	}
+}
@@ -0,0 +1,285 @@
+package godocx
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParagraphInfo describes one paragraph of the document body, with its
+// formatting resolved through the style hierarchy in styles.xml.
+//
+// Index uses the paragraph numbering of every index-based API
+// (DeleteParagraphByIndex, MoveParagraph, DuplicateParagraph,
+// SetParagraphStyle and IndexedPosition): the 1-based position among the
+// top-level body paragraphs. Paragraphs inside tables and other containers
+// are listed too, but have Index 0 because those APIs cannot address them.
+type ParagraphInfo struct {
+	Index        int                // 1-based position among top-level body paragraphs; 0 inside tables
+	Text         string             // Visible text of the paragraph
+	StyleID      string             // Paragraph style ID; the default paragraph style when none is set
+	IsHeading    bool               // True when the paragraph has an outline level
+	HeadingLevel int                // Heading level 1-9, or 0 for body text
+	Alignment    ParagraphAlignment // Effective alignment (ParagraphAlignLeft when unset)
+	SpaceBefore  int                // Effective spacing before, in twips
+	SpaceAfter   int                // Effective spacing after, in twips
+}
+
+// HeadingInfo is one entry of the document outline returned by GetHeadings.
+type HeadingInfo struct {
+	Index int    // Paragraph index, matching ParagraphInfo.Index (0 inside tables)
+	Level int    // Heading level (1-9)
+	Text  string // Heading text
+}
+
+// OutlineNode is a heading in the tree returned by GetDocumentOutline. The
+// root node has Level 0, no text and a ParagraphIndex of -1.
+type OutlineNode struct {
+	Level          int    // Heading level (1-9), 0 for the root
+	Text           string // Heading text
+	ParagraphIndex int    // Paragraph index, matching ParagraphInfo.Index (0 inside tables)
+	Children       []*OutlineNode
+}
+
+var (
+	paraStylePattern      = regexp.MustCompile(`<w:pStyle w:val="([^"]*)"`)
+	paraOutlineLvlPattern = regexp.MustCompile(`<w:outlineLvl w:val="(\d+)"`)
+	paraJcPattern         = regexp.MustCompile(`<w:jc w:val="([^"]*)"`)
+	paraSpacingPattern    = regexp.MustCompile(`<w:spacing\s[^>]*>`)
+	spacingBeforePattern  = regexp.MustCompile(`\sw:before="(-?\d+)"`)
+	spacingAfterPattern   = regexp.MustCompile(`\sw:after="(-?\d+)"`)
+	styleOpenTagPattern   = regexp.MustCompile(`<w:style\s[^>]*>`)
+	styleIDAttrPattern    = regexp.MustCompile(`w:styleId="([^"]*)"`)
+	builtinHeadingPattern = regexp.MustCompile(`^(?i)heading([1-9])$`)
+)
+
+// GetParagraphs lists every paragraph of the document body in order, including
+// those inside tables, with its 1-based index (see ParagraphInfo), text, style
+// and effective heading level, alignment and spacing. Properties not set on the
+// paragraph itself are taken from its style, the styles it is based on, and
+// finally the document defaults.
+func (u *Updater) GetParagraphs() ([]ParagraphInfo, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "document.xml"))
+	if err != nil {
+		return nil, NewFileReadError("document.xml", err)
+	}
+	stylesRaw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "styles.xml"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, NewFileReadError("styles.xml", err)
+	}
+
+	return parseParagraphInfos(raw, string(stylesRaw)), nil
+}
+
+// GetHeadings returns the heading paragraphs of the document with their level
+// and text, in document order. Unlike GetTOCEntries it does not require a
+// table of contents to be present.
+func (u *Updater) GetHeadings() ([]HeadingInfo, error) {
+	paragraphs, err := u.GetParagraphs()
+	if err != nil {
+		return nil, err
+	}
+
+	var headings []HeadingInfo
+	for _, p := range paragraphs {
+		if p.IsHeading {
+			headings = append(headings, HeadingInfo{Index: p.Index, Level: p.HeadingLevel, Text: p.Text})
+		}
+	}
+	return headings, nil
+}
+
+// GetDocumentOutline returns the headings of the document as a tree: each
+// heading is a child of the nearest preceding heading with a lower level. A
+// heading that skips levels, such as a Heading 3 directly after a Heading 1,
+// is attached to that nearest lower-level heading. The top-level headings are
+// the children of the returned root node.
+func (u *Updater) GetDocumentOutline() (*OutlineNode, error) {
+	headings, err := u.GetHeadings()
+	if err != nil {
+		return nil, err
+	}
+	return buildOutline(headings), nil
+}
+
+// buildOutline nests headings by level using a stack of open ancestors.
+func buildOutline(headings []HeadingInfo) *OutlineNode {
+	root := &OutlineNode{ParagraphIndex: -1}
+	stack := []*OutlineNode{root}
+	for _, h := range headings {
+		for stack[len(stack)-1].Level >= h.Level {
+			stack = stack[:len(stack)-1]
+		}
+		node := &OutlineNode{Level: h.Level, Text: h.Text, ParagraphIndex: h.Index}
+		parent := stack[len(stack)-1]
+		parent.Children = append(parent.Children, node)
+		stack = append(stack, node)
+	}
+	return root
+}
+
+// parseParagraphInfos walks the paragraphs of docXML in order.
+func parseParagraphInfos(docXML []byte, stylesXML string) []ParagraphInfo {
+	defaultStyle := defaultParagraphStyleID(stylesXML)
+	docDefaults := ""
+	if start := strings.Index(stylesXML, "<w:pPrDefault>"); start != -1 {
+		if end := strings.Index(stylesXML[start:], "</w:pPrDefault>"); end != -1 {
+			docDefaults = stylesXML[start : start+end]
+		}
+	}
+
+	// Map the start of each top-level paragraph to its 1-based index.
+	topLevel := make(map[int]int)
+	if bodyStart, _, children, err := splitBodyChildren(docXML); err == nil {
+		for _, child := range children {
+			if child.name == "w:p" {
+				topLevel[bodyStart+child.offset] = len(topLevel) + 1
+			}
+		}
+	}
+
+	var infos []ParagraphInfo
+	pos := 0
+	for {
+		start := findNextParagraphStart(docXML, pos)
+		if start == -1 {
+			break
+		}
+		end := bytes.Index(docXML[start:], []byte("</w:p>"))
+		if end == -1 {
+			break
+		}
+		end += start + len("</w:p>")
+		para := docXML[start:end]
+		pos = end
+
+		pPr := paragraphPropertiesXML(para)
+		styleID := defaultStyle
+		if m := paraStylePattern.FindStringSubmatch(pPr); m != nil {
+			styleID = m[1]
+		}
+
+		// Property sources, most specific first.
+		sources := append([]string{pPr}, styleParagraphProperties(stylesXML, styleID)...)
+		sources = append(sources, docDefaults)
+
+		info := ParagraphInfo{
+			Index:     topLevel[start],
+			StyleID:   styleID,
+			Alignment: ParagraphAlignLeft,
+		}
+		info.Text = extractRunText(para)
+
+		if lvl, ok := firstSubmatch(sources, paraOutlineLvlPattern); ok {
+			// Level 9 marks body text.
+			if n, err := strconv.Atoi(lvl); err == nil && n < 9 {
+				info.HeadingLevel = n + 1
+			}
+		} else if m := builtinHeadingPattern.FindStringSubmatch(styleID); m != nil {
+			// Built-in heading style referenced without a definition in styles.xml.
+			info.HeadingLevel, _ = strconv.Atoi(m[1])
+		}
+		info.IsHeading = info.HeadingLevel > 0
+
+		if jc, ok := firstSubmatch(sources, paraJcPattern); ok {
+			switch jc {
+			case "start":
+				info.Alignment = ParagraphAlignLeft
+			case "end":
+				info.Alignment = ParagraphAlignRight
+			default:
+				info.Alignment = ParagraphAlignment(jc)
+			}
+		}
+
+		spacing := make([]string, len(sources))
+		for i, src := range sources {
+			spacing[i] = paraSpacingPattern.FindString(src)
+		}
+		if v, ok := firstSubmatch(spacing, spacingBeforePattern); ok {
+			info.SpaceBefore, _ = strconv.Atoi(v)
+		}
+		if v, ok := firstSubmatch(spacing, spacingAfterPattern); ok {
+			info.SpaceAfter, _ = strconv.Atoi(v)
+		}
+
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// paragraphPropertiesXML returns the paragraph's own <w:pPr> element, or an
+// empty string when it has none.
+func paragraphPropertiesXML(para []byte) string {
+	openEnd := bytes.IndexByte(para, '>')
+	if openEnd == -1 {
+		return ""
+	}
+	rest := bytes.TrimLeft(para[openEnd+1:], " \t\r\n")
+	if !bytes.HasPrefix(rest, []byte("<w:pPr>")) && !bytes.HasPrefix(rest, []byte("<w:pPr ")) {
+		return ""
+	}
+	end := bytes.Index(rest, []byte("</w:pPr>"))
+	if end == -1 {
+		return ""
+	}
+	return string(rest[:end])
+}
+
+// defaultParagraphStyleID returns the ID of the style marked as the default
+// paragraph style, or an empty string when there is none.
+func defaultParagraphStyleID(stylesXML string) string {
+	for _, tag := range styleOpenTagPattern.FindAllString(stylesXML, -1) {
+		if !strings.Contains(tag, `w:type="paragraph"`) || !strings.Contains(tag, `w:default="1"`) {
+			continue
+		}
+		if m := styleIDAttrPattern.FindStringSubmatch(tag); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+// styleParagraphProperties returns the <w:pPr> content of styleID and each
+// style it is based on, nearest first.
+func styleParagraphProperties(stylesXML, styleID string) []string {
+	var props []string
+	visited := make(map[string]bool)
+	for styleID != "" && !visited[styleID] {
+		visited[styleID] = true
+
+		block := findStyleBlock(stylesXML, styleID)
+		if block == "" {
+			break
+		}
+		if start := strings.Index(block, "<w:pPr>"); start != -1 {
+			if end := strings.Index(block[start:], "</w:pPr>"); end != -1 {
+				props = append(props, block[start:start+end])
+			}
+		}
+
+		styleID = ""
+		if m := styleBasedOnPattern.FindStringSubmatch(block); m != nil {
+			styleID = m[1]
+		}
+	}
+	return props
+}
+
+// firstSubmatch returns the first capture of pattern in the first source that
+// matches it.
+func firstSubmatch(sources []string, pattern *regexp.Regexp) (string, bool) {
+	for _, src := range sources {
+		if m := pattern.FindStringSubmatch(src); m != nil {
+			return m[1], true
+		}
+	}
+	return "", false
+}
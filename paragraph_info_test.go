@@ -0,0 +1,132 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetParagraphsAndHeadings(t *testing.T) {
+	stylesXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:docDefaults><w:pPrDefault><w:pPr><w:spacing w:after="160" w:line="259" w:lineRule="auto"/></w:pPr></w:pPrDefault></w:docDefaults>` +
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/>` +
+		`<w:pPr><w:spacing w:before="240" w:after="0"/><w:outlineLvl w:val="0"/></w:pPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Chapter"><w:name w:val="Chapter"/><w:basedOn w:val="Heading1"/>` +
+		`<w:pPr><w:jc w:val="center"/><w:outlineLvl w:val="1"/></w:pPr></w:style>` +
+		`</w:styles>`
+	body := `<w:p><w:pPr><w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t>Introduction</w:t></w:r></w:p>` +
+		`<w:p><w:pPr><w:jc w:val="both"/><w:spacing w:before="120"/></w:pPr><w:r><w:t>Body &amp; text</w:t></w:r></w:p>` +
+		`<w:p><w:pPr><w:pStyle w:val="Chapter"/></w:pPr><w:r><w:t xml:space="preserve">Part </w:t></w:r><w:r><w:t>One</w:t></w:r></w:p>` +
+		`<w:tbl><w:tr><w:tc><w:p><w:pPr><w:pStyle w:val="Heading3"/></w:pPr><w:r><w:t>In table</w:t></w:r></w:p></w:tc></w:tr></w:tbl>` +
+		`<w:p><w:pPr><w:pStyle w:val="Heading1"/><w:outlineLvl w:val="9"/></w:pPr><w:r><w:t>Demoted</w:t></w:r></w:p>`
+	docXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` +
+		body + `</w:body></w:document>`
+	u := newUpdaterFromFixture(t, buildIntegrationDocxFromParts(t, docXML, stylesXML, ""))
+
+	paragraphs, err := u.GetParagraphs()
+	if err != nil {
+		t.Fatalf("GetParagraphs: %v", err)
+	}
+
+	want := []ParagraphInfo{
+		{Index: 1, Text: "Introduction", StyleID: "Heading1", IsHeading: true, HeadingLevel: 1, Alignment: ParagraphAlignLeft, SpaceBefore: 240, SpaceAfter: 0},
+		{Index: 2, Text: "Body & text", StyleID: "Normal", Alignment: ParagraphAlignJustify, SpaceBefore: 120, SpaceAfter: 160},
+		{Index: 3, Text: "Part One", StyleID: "Chapter", IsHeading: true, HeadingLevel: 2, Alignment: ParagraphAlignCenter, SpaceBefore: 240, SpaceAfter: 0},
+		{Index: 0, Text: "In table", StyleID: "Heading3", IsHeading: true, HeadingLevel: 3, Alignment: ParagraphAlignLeft, SpaceAfter: 160},
+		{Index: 4, Text: "Demoted", StyleID: "Heading1", Alignment: ParagraphAlignLeft, SpaceBefore: 240, SpaceAfter: 0},
+	}
+	if len(paragraphs) != len(want) {
+		t.Fatalf("got %d paragraphs, want %d: %+v", len(paragraphs), len(want), paragraphs)
+	}
+	for i := range want {
+		if paragraphs[i] != want[i] {
+			t.Errorf("paragraph %d = %+v, want %+v", i, paragraphs[i], want[i])
+		}
+	}
+
+	// The index addresses the same paragraph in the index-based APIs.
+	if err := u.SetParagraphStyle(paragraphs[1].Index, "Heading1"); err != nil {
+		t.Fatalf("SetParagraphStyle: %v", err)
+	}
+	if doc := readDocXML(t, u); !strings.Contains(doc, `<w:pStyle w:val="Heading1"/><w:jc w:val="both"/>`) {
+		t.Errorf("expected ParagraphInfo.Index to address the body paragraph:\n%s", doc)
+	}
+	if err := u.SetParagraphStyle(paragraphs[1].Index, "Normal"); err != nil {
+		t.Fatalf("SetParagraphStyle: %v", err)
+	}
+
+	headings, err := u.GetHeadings()
+	if err != nil {
+		t.Fatalf("GetHeadings: %v", err)
+	}
+	wantHeadings := []HeadingInfo{
+		{Index: 1, Level: 1, Text: "Introduction"},
+		{Index: 3, Level: 2, Text: "Part One"},
+		{Index: 0, Level: 3, Text: "In table"},
+	}
+	if len(headings) != len(wantHeadings) {
+		t.Fatalf("got %d headings, want %d: %+v", len(headings), len(wantHeadings), headings)
+	}
+	for i := range wantHeadings {
+		if headings[i] != wantHeadings[i] {
+			t.Errorf("heading %d = %+v, want %+v", i, headings[i], wantHeadings[i])
+		}
+	}
+}
+
+func TestBuildOutline(t *testing.T) {
+	headings := []HeadingInfo{
+		{Index: 0, Level: 2, Text: "Preface"}, // below level 1, so attached to the root
+		{Index: 1, Level: 1, Text: "Intro"},
+		{Index: 2, Level: 2, Text: "Scope"},
+		{Index: 3, Level: 3, Text: "Detail"},
+		{Index: 4, Level: 1, Text: "Methods"},
+		{Index: 6, Level: 3, Text: "Skipped level"},
+		{Index: 7, Level: 2, Text: "Data"},
+		{Index: 9, Level: 2, Text: "Orphan"},
+	}
+	root := buildOutline(headings)
+	if root.Level != 0 || root.ParagraphIndex != -1 {
+		t.Fatalf("root = %+v, want level 0 and index -1", root)
+	}
+
+	var render func(n *OutlineNode, depth int) string
+	render = func(n *OutlineNode, depth int) string {
+		out := ""
+		for _, c := range n.Children {
+			out += strings.Repeat("  ", depth) + c.Text + "\n" + render(c, depth+1)
+		}
+		return out
+	}
+	want := "Preface\n" +
+		"Intro\n  Scope\n    Detail\n" +
+		"Methods\n  Skipped level\n  Data\n  Orphan\n"
+	if got := render(root, 0); got != want {
+		t.Errorf("outline:\n%s\nwant:\n%s", got, want)
+	}
+	if n := root.Children[2].Children[0]; n.Level != 3 || n.ParagraphIndex != 6 {
+		t.Errorf("skipped-level node = %+v", n)
+	}
+}
+
+func TestGetDocumentOutline(t *testing.T) {
+	body := `<w:p><w:pPr><w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t>Chapter</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Body</w:t></w:r></w:p>` +
+		`<w:p><w:pPr><w:pStyle w:val="Heading2"/></w:pPr><w:r><w:t>Section</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	root, err := u.GetDocumentOutline()
+	if err != nil {
+		t.Fatalf("GetDocumentOutline: %v", err)
+	}
+	if len(root.Children) != 1 || root.Children[0].Text != "Chapter" {
+		t.Fatalf("root children = %+v", root.Children)
+	}
+	chapter := root.Children[0]
+	if len(chapter.Children) != 1 || chapter.Children[0].Text != "Section" ||
+		chapter.Children[0].Level != 2 || chapter.Children[0].ParagraphIndex != 3 {
+		t.Errorf("chapter children = %+v", chapter.Children)
+	}
+}
@@ -3,6 +3,7 @@ package godocx_test
 import (
 	"archive/zip"
 	"bytes"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -449,6 +450,93 @@ func TestAddHeading(t *testing.T) {
 	}
 }
 
+func TestAddHeadingWithOptions(t *testing.T) {
+	tempDir := t.TempDir()
+	inputPath := filepath.Join(tempDir, "input.docx")
+	outputPath := filepath.Join(tempDir, "output.docx")
+
+	if err := os.WriteFile(inputPath, buildFixtureDocx(t), 0o644); err != nil {
+		t.Fatalf("write input fixture: %v", err)
+	}
+
+	u, err := godocx.New(inputPath)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.AddHeadingWithOptions(1, "Chapter 1: ", godocx.HeadingOptions{
+		Position:         godocx.PositionEnd,
+		StyleOverride:    "CorpHeading1",
+		NumberingEnabled: true,
+		AdditionalRuns:   []godocx.RunOptions{{Text: "Introduction", Bold: true}},
+	})
+	if err != nil {
+		t.Fatalf("AddHeadingWithOptions failed: %v", err)
+	}
+	if err := u.AddHeadingWithOptions(2, "Scope", godocx.HeadingOptions{
+		Position:         godocx.PositionEnd,
+		NumberingEnabled: true,
+	}); err != nil {
+		t.Fatalf("AddHeadingWithOptions failed: %v", err)
+	}
+	if err := u.AddHeadingWithOptions(3, "Plain", godocx.HeadingOptions{Position: godocx.PositionEnd}); err != nil {
+		t.Fatalf("AddHeadingWithOptions failed: %v", err)
+	}
+
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	docXML := readZipEntry(t, outputPath, "word/document.xml")
+	if !strings.Contains(docXML, `<w:pStyle w:val="CorpHeading1"/>`) || strings.Contains(docXML, `<w:pStyle w:val="Heading1"/>`) {
+		t.Error("StyleOverride should replace Heading1")
+	}
+	if !strings.Contains(docXML, `<w:pStyle w:val="Heading2"/>`) || !strings.Contains(docXML, `<w:pStyle w:val="Heading3"/>`) {
+		t.Error("headings without override should use HeadingN")
+	}
+	if !strings.Contains(docXML, "<w:b/>") || !strings.Contains(docXML, "Introduction") || !strings.Contains(docXML, "Chapter 1: ") {
+		t.Error("additional runs should follow the heading text")
+	}
+
+	numbering := readZipEntry(t, outputPath, "word/numbering.xml")
+	if !strings.Contains(numbering, `<w:lvlText w:val="%1.%2"/>`) {
+		t.Error("heading outline numbering definition not found")
+	}
+	start := strings.Index(numbering, "DOCXUPDATE_HEADING_NUMID:")
+	if start < 0 {
+		t.Fatal("heading numbering marker not found")
+	}
+	numID := strings.TrimSpace(strings.TrimSuffix(strings.Fields(numbering[start+len("DOCXUPDATE_HEADING_NUMID:"):])[0], "-->"))
+	for _, ilvl := range []string{"0", "1"} {
+		want := `<w:numPr><w:ilvl w:val="` + ilvl + `"/><w:numId w:val="` + numID + `"/></w:numPr>`
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected numbered heading %s", want)
+		}
+	}
+	if strings.Count(docXML, "<w:numPr>") != 2 {
+		t.Error("only headings with NumberingEnabled should be numbered")
+	}
+}
+
+func TestAddHeadingWithOptionsValidation(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.AddHeadingWithOptions(10, "Too deep", godocx.HeadingOptions{}); err == nil {
+		t.Error("expected error for level 10")
+	}
+	if err := u.AddHeadingWithOptions(1, "", godocx.HeadingOptions{}); err == nil {
+		t.Error("expected error for empty heading")
+	}
+	if err := u.AddHeadingWithOptions(1, "After", godocx.HeadingOptions{Position: godocx.PositionAfterText}); err == nil {
+		t.Error("expected error for missing anchor")
+	}
+}
+
 func TestInsertMultipleParagraphs(t *testing.T) {
 	tempDir := t.TempDir()
 	inputPath := filepath.Join(tempDir, "input.docx")
@@ -720,3 +808,333 @@ func TestMultiRunParagraphEmptyRunsAndTextError(t *testing.T) {
 		t.Fatal("expected error for empty Text and empty Runs, got nil")
 	}
 }
+
+func TestInsertParagraphsAtKeepsOrder(t *testing.T) {
+	tempDir := t.TempDir()
+	inputPath := filepath.Join(tempDir, "input.docx")
+	outputPath := filepath.Join(tempDir, "output.docx")
+
+	if err := os.WriteFile(inputPath, buildFixtureDocx(t), 0o644); err != nil {
+		t.Fatalf("write input fixture: %v", err)
+	}
+
+	u, err := godocx.New(inputPath)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.InsertParagraph(godocx.ParagraphOptions{Text: "Anchor paragraph", Position: godocx.PositionEnd}); err != nil {
+		t.Fatalf("InsertParagraph failed: %v", err)
+	}
+
+	paragraphs := []godocx.ParagraphOptions{
+		{Text: "First item", Position: godocx.PositionEnd},
+		{Text: "Second item", Bold: true},
+		{Text: "Third item"},
+	}
+	err = u.InsertParagraphsAt(paragraphs, godocx.BatchInsertOptions{
+		Position: godocx.PositionBeforeText,
+		Anchor:   "Anchor paragraph",
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraphsAt failed: %v", err)
+	}
+
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	docXML := readZipEntry(t, outputPath, "word/document.xml")
+	first := strings.Index(docXML, "First item")
+	second := strings.Index(docXML, "Second item")
+	third := strings.Index(docXML, "Third item")
+	anchor := strings.Index(docXML, "Anchor paragraph")
+	if first == -1 || !(first < second && second < third && third < anchor) {
+		t.Errorf("paragraphs out of order: first=%d second=%d third=%d anchor=%d", first, second, third, anchor)
+	}
+}
+
+func TestInsertParagraphsAtRequiresAnchor(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.InsertParagraphsAt([]godocx.ParagraphOptions{{Text: "Item"}},
+		godocx.BatchInsertOptions{Position: godocx.PositionAfterText})
+	if err == nil {
+		t.Error("expected error for missing anchor")
+	}
+}
+
+func TestInsertParagraphAtIndexedPosition(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	for _, text := range []string{"Alpha", "Bravo", "Charlie"} {
+		if err := u.AddText(text, godocx.PositionEnd); err != nil {
+			t.Fatalf("AddText(%q) failed: %v", text, err)
+		}
+	}
+
+	inserts := []struct {
+		text  string
+		index int
+	}{
+		{"Start", 0},  // before Alpha
+		{"Middle", 2}, // after Start and Alpha
+		{"Last", 5},   // after every paragraph
+	}
+	for _, ins := range inserts {
+		err := u.InsertParagraph(godocx.ParagraphOptions{Text: ins.text, Position: godocx.IndexedPosition(ins.index)})
+		if err != nil {
+			t.Fatalf("InsertParagraph(%q at %d) failed: %v", ins.text, ins.index, err)
+		}
+	}
+	if err := u.InsertTable(godocx.TableOptions{
+		Columns:  []godocx.ColumnDefinition{{Title: "Tabled"}},
+		Position: godocx.IndexedPosition(1),
+	}); err != nil {
+		t.Fatalf("InsertTable failed: %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatalf("read document.xml: %v", err)
+	}
+	docXML := string(raw)
+	order := []string{"Start", "Tabled", "Alpha", "Middle", "Bravo", "Charlie", "Last", "<w:sectPr"}
+	prev := -1
+	for _, want := range order {
+		idx := strings.Index(docXML, want)
+		if idx <= prev {
+			t.Fatalf("%q out of order (at %d, previous at %d) in:\n%s", want, idx, prev, docXML)
+		}
+		prev = idx
+	}
+}
+
+func TestInsertParagraphAtIndexedPositionOutOfRange(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.AddText("Only", godocx.PositionEnd); err != nil {
+		t.Fatalf("AddText failed: %v", err)
+	}
+
+	for _, index := range []int{-1, 2} {
+		err := u.InsertParagraph(godocx.ParagraphOptions{Text: "X", Position: godocx.IndexedPosition(index)})
+		var docErr *godocx.DocxError
+		if !errors.As(err, &docErr) || docErr.Code != godocx.ErrCodeValidation {
+			t.Errorf("index %d: expected validation error, got %v", index, err)
+		}
+	}
+	if godocx.IndexedPosition(0) != godocx.PositionAtIndex {
+		t.Error("IndexedPosition(0) should equal PositionAtIndex")
+	}
+}
+
+func TestAddTextWithOptions(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.AddTextWithOptions("Indented note", godocx.TextOptions{
+		Style:       godocx.StyleQuote,
+		Alignment:   godocx.ParagraphAlignJustify,
+		SpaceBefore: 120,
+		SpaceAfter:  240,
+		LineSpacing: 360,
+		IndentLeft:  720,
+		IndentRight: 360,
+		Position:    godocx.PositionEnd,
+	})
+	if err != nil {
+		t.Fatalf("AddTextWithOptions failed: %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatalf("read document.xml: %v", err)
+	}
+	want := `<w:pPr><w:pStyle w:val="Quote"/>` +
+		`<w:spacing w:before="120" w:after="240" w:line="360" w:lineRule="auto"/>` +
+		`<w:ind w:left="720" w:right="360"/><w:jc w:val="both"/></w:pPr>`
+	if !strings.Contains(string(raw), want) {
+		t.Errorf("expected paragraph properties %s in:\n%s", want, raw)
+	}
+
+	if err := u.AddTextWithOptions("Bad", godocx.TextOptions{SpaceAfter: -1}); err == nil {
+		t.Error("expected error for negative spacing")
+	}
+}
+
+func TestInsertParagraphFirstLineAndHangingIndent(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.InsertParagraph(godocx.ParagraphOptions{
+		Text: "Smith, J. (2024). A very long bibliography entry.", IndentLeft: 720, IndentHanging: 720, Position: godocx.PositionEnd,
+	}); err != nil {
+		t.Fatalf("InsertParagraph failed: %v", err)
+	}
+	if err := u.InsertParagraph(godocx.ParagraphOptions{
+		Text: "Indented first line", IndentFirstLine: 360, Position: godocx.PositionEnd,
+	}); err != nil {
+		t.Fatalf("InsertParagraph failed: %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatalf("read document.xml: %v", err)
+	}
+	for _, want := range []string{`<w:ind w:left="720" w:hanging="720"/>`, `<w:ind w:firstLine="360"/>`} {
+		if !strings.Contains(string(raw), want) {
+			t.Errorf("expected %s in:\n%s", want, raw)
+		}
+	}
+
+	if err := u.InsertParagraph(godocx.ParagraphOptions{Text: "Bad", IndentFirstLine: 360, IndentHanging: 360}); err == nil {
+		t.Error("expected error when combining first-line and hanging indents")
+	}
+	if err := u.InsertParagraph(godocx.ParagraphOptions{Text: "Bad", IndentHanging: -360}); err == nil {
+		t.Error("expected error for negative hanging indent")
+	}
+}
+
+func TestInsertParagraphPaginationAndOutlineLevel(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.InsertParagraph(godocx.ParagraphOptions{
+		Text:                "Appendix",
+		KeepNext:            true,
+		KeepLines:           true,
+		PageBreakBefore:     true,
+		DisableWidowControl: true,
+		Alignment:           godocx.ParagraphAlignCenter,
+		OutlineLevel:        2,
+		Position:            godocx.PositionEnd,
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph failed: %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatalf("read document.xml: %v", err)
+	}
+	want := `<w:pPr><w:keepNext/><w:keepLines/><w:pageBreakBefore/><w:widowControl w:val="0"/>` +
+		`<w:jc w:val="center"/><w:outlineLvl w:val="1"/></w:pPr>`
+	if !strings.Contains(string(raw), want) {
+		t.Errorf("expected paragraph properties %s in:\n%s", want, raw)
+	}
+
+	for _, level := range []int{-1, 10} {
+		if err := u.InsertParagraph(godocx.ParagraphOptions{Text: "Bad", OutlineLevel: level}); err == nil {
+			t.Errorf("expected error for outline level %d", level)
+		}
+	}
+}
+
+func benchmarkParagraphs(n int) []godocx.ParagraphOptions {
+	paragraphs := make([]godocx.ParagraphOptions, n)
+	for i := range paragraphs {
+		paragraphs[i] = godocx.ParagraphOptions{
+			Text:     "Generated report paragraph with enough text to be realistic.",
+			Position: godocx.PositionEnd,
+		}
+	}
+	return paragraphs
+}
+
+func BenchmarkInsertParagraph500Individual(b *testing.B) {
+	paragraphs := benchmarkParagraphs(500)
+	for b.Loop() {
+		u, err := godocx.NewBlank()
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, p := range paragraphs {
+			if err := u.InsertParagraph(p); err != nil {
+				b.Fatal(err)
+			}
+		}
+		u.Cleanup()
+	}
+}
+
+func BenchmarkInsertParagraphsAt500(b *testing.B) {
+	paragraphs := benchmarkParagraphs(500)
+	for b.Loop() {
+		u, err := godocx.NewBlank()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := u.InsertParagraphsAt(paragraphs, godocx.BatchInsertOptions{Position: godocx.PositionEnd}); err != nil {
+			b.Fatal(err)
+		}
+		u.Cleanup()
+	}
+}
+
+func TestInsertParagraphFrame(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	frame := &godocx.FrameOptions{
+		Width:            2880,
+		Height:           1440,
+		HSpace:           180,
+		HorizontalAnchor: godocx.FrameAnchorPage,
+		VerticalAnchor:   godocx.FrameAnchorText,
+		HorizontalAlign:  godocx.FrameAlignRight,
+		WrapStyle:        godocx.FrameWrapAround,
+	}
+	if err := u.InsertParagraph(godocx.ParagraphOptions{
+		Text: "Sidebar", KeepNext: true, DisableWidowControl: true, Frame: frame, Position: godocx.PositionEnd,
+	}); err != nil {
+		t.Fatalf("InsertParagraph failed: %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatalf("read document.xml: %v", err)
+	}
+	want := `<w:pPr><w:keepNext/><w:framePr w:w="2880" w:h="1440" w:hRule="atLeast" w:hSpace="180" ` +
+		`w:wrap="around" w:vAnchor="text" w:hAnchor="page" w:xAlign="right"/><w:widowControl w:val="0"/>`
+	if !strings.Contains(string(raw), want) {
+		t.Errorf("expected paragraph properties %s in:\n%s", want, raw)
+	}
+
+	for _, bad := range []godocx.FrameOptions{
+		{Width: -1},
+		{HorizontalAnchor: "column"},
+		{HorizontalAlign: godocx.FrameAlignTop},
+		{VerticalAlign: godocx.FrameAlignLeft},
+		{WrapStyle: "square"},
+	} {
+		if err := u.InsertParagraph(godocx.ParagraphOptions{Text: "Bad", Frame: &bad}); err == nil {
+			t.Errorf("expected error for frame options %+v", bad)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package godocx
+
+import (
+	"io"
+	"io/fs"
+	"path/filepath"
+)
+
+// Option configures an Updater when it is created by New, NewBlank,
+// NewFromBytes or NewFromReader.
+type Option func(*Updater)
+
+// ProgressFunc receives the stage of a save operation and its overall
+// progress in percent (0-100).
+type ProgressFunc func(stage string, percent int)
+
+// Save progress stages reported to a ProgressFunc, with the percentage at
+// which each stage starts.
+const (
+	ProgressReading    = "reading"    // 10%: scanning the package parts
+	ProgressProcessing = "processing" // 50-89%: compressing parts into the archive
+	ProgressWriting    = "writing"    // 90%: flushing the archive to its destination
+	ProgressDone       = "done"       // 100%: the document has been saved
+)
+
+// WithProgressCallback reports the progress of Save and SaveToWriter to fn,
+// for example to drive a progress bar or a server-sent events stream. During
+// the processing stage fn is called again each time another part of the
+// package raises the percentage.
+func WithProgressCallback(fn ProgressFunc) Option {
+	return func(u *Updater) {
+		u.progress = fn
+	}
+}
+
+func (u *Updater) applyOptions(opts []Option) {
+	for _, opt := range opts {
+		if opt != nil {
+			opt(u)
+		}
+	}
+}
+
+func (u *Updater) reportProgress(stage string, percent int) {
+	if u.progress != nil {
+		u.progress(stage, percent)
+	}
+}
+
+// writePackage zips the extracted package to w, reporting the reading and
+// processing stages. Callers report writing and done once the destination
+// has been flushed.
+func (u *Updater) writePackage(w io.Writer) error {
+	if u.progress == nil {
+		return writeZipFromDir(u.tempDir, w)
+	}
+
+	u.reportProgress(ProgressReading, 10)
+	total := 0
+	err := filepath.WalkDir(u.tempDir, func(_ string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			total++
+		}
+		return err
+	})
+	if err != nil {
+		return NewFileReadError(u.tempDir, err)
+	}
+
+	u.reportProgress(ProgressProcessing, 50)
+	written, last := 0, 50
+	return writeZipFromDirReplacing(u.tempDir, w, nil, func() {
+		written++
+		if percent := 50 + 40*written/max(total, 1); percent > last && percent < 90 {
+			last = percent
+			u.reportProgress(ProgressProcessing, percent)
+		}
+	})
+}
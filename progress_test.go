@@ -0,0 +1,90 @@
+package godocx_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	godocx "github.com/falcomza/go-docx"
+)
+
+type progressEvent struct {
+	stage   string
+	percent int
+}
+
+func TestSaveReportsProgress(t *testing.T) {
+	var events []progressEvent
+	u, err := godocx.NewBlank(godocx.WithProgressCallback(func(stage string, percent int) {
+		events = append(events, progressEvent{stage, percent})
+	}))
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.Save(filepath.Join(t.TempDir(), "out.docx")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	checkProgress(t, events)
+	if len(events) < 4 || events[0] != (progressEvent{"reading", 10}) || events[1] != (progressEvent{"processing", 50}) ||
+		events[len(events)-2] != (progressEvent{"writing", 90}) || events[len(events)-1] != (progressEvent{"done", 100}) {
+		t.Errorf("unexpected stages: %v", events)
+	}
+}
+
+func TestSaveToWriterReportsPerEntryProgress(t *testing.T) {
+	var events []progressEvent
+	u, err := godocx.NewFromBytes(buildFixtureDocx(t), godocx.WithProgressCallback(func(stage string, percent int) {
+		events = append(events, progressEvent{stage, percent})
+	}))
+	if err != nil {
+		t.Fatalf("NewFromBytes: %v", err)
+	}
+	defer u.Cleanup()
+
+	var buf bytes.Buffer
+	if err := u.SaveToWriter(&buf); err != nil {
+		t.Fatalf("SaveToWriter: %v", err)
+	}
+
+	checkProgress(t, events)
+	processing := 0
+	for _, e := range events {
+		if e.stage == "processing" && e.percent > 50 {
+			processing++
+		}
+	}
+	if processing == 0 {
+		t.Errorf("expected progress while the archive entries are written: %v", events)
+	}
+	if last := events[len(events)-1]; last != (progressEvent{"done", 100}) {
+		t.Errorf("last event = %v, want done at 100%%", last)
+	}
+}
+
+func TestSaveWithoutProgressCallback(t *testing.T) {
+	u, err := godocx.NewBlank(nil)
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.Save(filepath.Join(t.TempDir(), "out.docx")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+}
+
+// checkProgress verifies that percentages never decrease and stay in range.
+func checkProgress(t *testing.T, events []progressEvent) {
+	t.Helper()
+	last := 0
+	for _, e := range events {
+		if e.percent < last || e.percent > 100 {
+			t.Errorf("progress went from %d%% to %d%% (%s): %v", last, e.percent, e.stage, events)
+			return
+		}
+		last = e.percent
+	}
+}
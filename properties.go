@@ -10,6 +10,22 @@ import (
 	"time"
 )
 
+// Document properties parts, with the content types and package relationship
+// types that register them.
+const (
+	corePropertiesPart        = "docProps/core.xml"
+	corePropertiesContentType = "application/vnd.openxmlformats-package.core-properties+xml"
+	corePropertiesRelType     = "http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties"
+
+	appPropertiesPart        = "docProps/app.xml"
+	appPropertiesContentType = "application/vnd.openxmlformats-officedocument.extended-properties+xml"
+	appPropertiesRelType     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/extended-properties"
+
+	customPropertiesPart        = "docProps/custom.xml"
+	customPropertiesContentType = "application/vnd.openxmlformats-officedocument.custom-properties+xml"
+	customPropertiesRelType     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/custom-properties"
+)
+
 // CoreProperties represents core document properties
 type CoreProperties struct {
 	// Title of the document
@@ -108,7 +124,7 @@ type CustomProperty struct {
 // SetCoreProperties sets the core document properties
 func (u *Updater) SetCoreProperties(props CoreProperties) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	corePath := filepath.Join(u.tempDir, "docProps", "core.xml")
@@ -144,7 +160,7 @@ func (u *Updater) SetCoreProperties(props CoreProperties) error {
 	}
 
 	// Write updated core.xml
-	if err := atomicWriteFile(corePath, []byte(content), 0o644); err != nil {
+	if err := u.writePropertiesPart(corePropertiesPart, corePropertiesContentType, corePropertiesRelType, content); err != nil {
 		return &DocxError{
 			Code:    "PROPERTIES_ERROR",
 			Message: "failed to write core properties",
@@ -158,7 +174,7 @@ func (u *Updater) SetCoreProperties(props CoreProperties) error {
 // SetAppProperties sets the application-specific document properties
 func (u *Updater) SetAppProperties(props AppProperties) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	appPath := filepath.Join(u.tempDir, "docProps", "app.xml")
@@ -218,7 +234,7 @@ func (u *Updater) SetAppProperties(props AppProperties) error {
 	}
 
 	// Write updated app.xml
-	if err := atomicWriteFile(appPath, []byte(content), 0o644); err != nil {
+	if err := u.writePropertiesPart(appPropertiesPart, appPropertiesContentType, appPropertiesRelType, content); err != nil {
 		return &DocxError{
 			Code:    "PROPERTIES_ERROR",
 			Message: "failed to write app properties",
@@ -232,25 +248,14 @@ func (u *Updater) SetAppProperties(props AppProperties) error {
 // SetCustomProperties sets custom document properties
 func (u *Updater) SetCustomProperties(properties []CustomProperty) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
-	customPath := filepath.Join(u.tempDir, "docProps", "custom.xml")
-
 	// Generate custom.xml content
 	content := u.generateCustomPropertiesXML(properties)
 
-	// Ensure docProps directory exists
-	if err := os.MkdirAll(filepath.Dir(customPath), 0o755); err != nil {
-		return &DocxError{
-			Code:    "PROPERTIES_ERROR",
-			Message: "failed to create docProps directory",
-			Err:     err,
-		}
-	}
-
-	// Write custom.xml
-	if err := atomicWriteFile(customPath, []byte(content), 0o644); err != nil {
+	// Write custom.xml, registering it in the package if it is new
+	if err := u.writePropertiesPart(customPropertiesPart, customPropertiesContentType, customPropertiesRelType, content); err != nil {
 		return &DocxError{
 			Code:    "PROPERTIES_ERROR",
 			Message: "failed to write custom properties",
@@ -258,28 +263,40 @@ func (u *Updater) SetCustomProperties(properties []CustomProperty) error {
 		}
 	}
 
-	// Add custom.xml to content types if not present
-	if err := u.addCustomPropertiesContentType(); err != nil {
+	return nil
+}
+
+// writePropertiesPart writes a docProps part and makes sure it is listed in
+// [Content_Types].xml and referenced from the package relationships, which
+// is needed when the document did not have the part before.
+func (u *Updater) writePropertiesPart(part, contentType, relType, content string) error {
+	partPath := filepath.Join(u.tempDir, filepath.FromSlash(part))
+	if err := os.MkdirAll(filepath.Dir(partPath), 0o755); err != nil {
+		return NewFileWriteError("docProps directory", err)
+	}
+	if err := atomicWriteFile(partPath, []byte(content), 0o644); err != nil {
 		return err
 	}
-
-	// Add custom.xml relationship if not present
-	if err := u.addCustomPropertiesRelationship(); err != nil {
+	if err := u.addPropertiesContentType(part, contentType); err != nil {
 		return err
 	}
-
-	return nil
+	return u.addPropertiesRelationship(part, relType)
 }
 
-// GetCoreProperties retrieves core document properties
+// GetCoreProperties retrieves core document properties. A document without
+// docProps/core.xml yields zero-value properties rather than an error.
 func (u *Updater) GetCoreProperties() (*CoreProperties, error) {
 	if u == nil {
-		return nil, fmt.Errorf("updater is nil")
+		return nil, NewValidationError("updater", "updater is nil")
 	}
 
 	corePath := filepath.Join(u.tempDir, "docProps", "core.xml")
 	raw, err := os.ReadFile(corePath)
 	if err != nil {
+		// The part is optional; a document without it has no core properties.
+		if os.IsNotExist(err) {
+			return &CoreProperties{}, nil
+		}
 		return nil, &DocxError{
 			Code:    "PROPERTIES_ERROR",
 			Message: "failed to read core properties",
@@ -329,7 +346,7 @@ func (u *Updater) updateCoreProperty(content, property, value string) string {
 		return content
 	}
 
-	escapedValue := xmlEscape(value)
+	escapedValue := xmlEscapeContent(value)
 
 	if re.MatchString(content) {
 		// Update existing
@@ -388,7 +405,7 @@ func (u *Updater) updateAppProperty(content, property, value string) string {
 		return content
 	}
 
-	escapedValue := xmlEscape(value)
+	escapedValue := xmlEscapeContent(value)
 
 	if re.MatchString(content) {
 		// Update existing
@@ -459,7 +476,7 @@ func (u *Updater) generateCustomPropertiesXML(properties []CustomProperty) strin
 
 		switch propType {
 		case "lpwstr": // String
-			buf.WriteString(fmt.Sprintf(`<vt:lpwstr>%s</vt:lpwstr>`, xmlEscape(fmt.Sprintf("%v", prop.Value))))
+			buf.WriteString(fmt.Sprintf(`<vt:lpwstr>%s</vt:lpwstr>`, xmlEscapeContent(fmt.Sprintf("%v", prop.Value))))
 		case "i4": // Integer
 			buf.WriteString(fmt.Sprintf(`<vt:i4>%v</vt:i4>`, prop.Value))
 		case "r8": // Float
@@ -503,48 +520,50 @@ func (u *Updater) inferCustomPropertyType(value any) string {
 	}
 }
 
-// addCustomPropertiesContentType adds custom.xml to [Content_Types].xml
-func (u *Updater) addCustomPropertiesContentType() error {
+// addPropertiesContentType adds an override for part to [Content_Types].xml
+// if it is not already listed.
+func (u *Updater) addPropertiesContentType(part, contentType string) error {
 	contentTypesPath := filepath.Join(u.tempDir, "[Content_Types].xml")
 
 	raw, err := os.ReadFile(contentTypesPath)
 	if err != nil {
-		return fmt.Errorf("read content types: %w", err)
+		return NewFileReadError("content types", err)
 	}
 
 	content := string(raw)
 
 	// Check if already exists
-	if strings.Contains(content, "docProps/custom.xml") {
+	if strings.Contains(content, `PartName="/`+part+`"`) {
 		return nil
 	}
 
-	override := `<Override PartName="/docProps/custom.xml" ContentType="application/vnd.openxmlformats-officedocument.custom-properties+xml"/>`
+	override := fmt.Sprintf(`<Override PartName="/%s" ContentType="%s"/>`, part, contentType)
 
 	// Insert before closing </Types>
 	content = strings.Replace(content, "</Types>", override+"</Types>", 1)
 
 	// Write updated content types
 	if err := atomicWriteFile(contentTypesPath, []byte(content), 0o644); err != nil {
-		return fmt.Errorf("write content types: %w", err)
+		return NewFileWriteError("content types", err)
 	}
 
 	return nil
 }
 
-// addCustomPropertiesRelationship adds custom.xml relationship to _rels/.rels
-func (u *Updater) addCustomPropertiesRelationship() error {
+// addPropertiesRelationship adds a relationship to part in _rels/.rels if
+// there is none yet.
+func (u *Updater) addPropertiesRelationship(part, relType string) error {
 	relsPath := filepath.Join(u.tempDir, "_rels", ".rels")
 
 	raw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return fmt.Errorf("read relationships: %w", err)
+		return NewFileReadError("relationships", err)
 	}
 
 	content := string(raw)
 
 	// Check if relationship already exists
-	if strings.Contains(content, "docProps/custom.xml") {
+	if strings.Contains(content, `Target="`+part+`"`) || strings.Contains(content, `Target="/`+part+`"`) {
 		return nil
 	}
 
@@ -554,31 +573,58 @@ func (u *Updater) addCustomPropertiesRelationship() error {
 		return fmt.Errorf("find next relationship id: %w", err)
 	}
 
-	newRel := fmt.Sprintf(
-		`<Relationship Id="%s" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/custom-properties" Target="docProps/custom.xml"/>`,
-		relID,
-	)
+	newRel := fmt.Sprintf(`<Relationship Id="%s" Type="%s" Target="%s"/>`, relID, relType, part)
 
 	// Insert before closing </Relationships>
 	content = strings.Replace(content, "</Relationships>", newRel+"</Relationships>", 1)
 
 	// Write updated relationships
 	if err := atomicWriteFile(relsPath, []byte(content), 0o644); err != nil {
-		return fmt.Errorf("write relationships: %w", err)
+		return NewFileWriteError("relationships", err)
 	}
 
 	return nil
 }
 
-// GetAppProperties retrieves application-specific document properties
+// CorePropertiesExist reports whether the document has a core properties
+// part (docProps/core.xml).
+func (u *Updater) CorePropertiesExist() bool {
+	return u.propertiesPartExists(corePropertiesPart)
+}
+
+// AppPropertiesExist reports whether the document has an application
+// properties part (docProps/app.xml).
+func (u *Updater) AppPropertiesExist() bool {
+	return u.propertiesPartExists(appPropertiesPart)
+}
+
+// CustomPropertiesExist reports whether the document has a custom
+// properties part (docProps/custom.xml).
+func (u *Updater) CustomPropertiesExist() bool {
+	return u.propertiesPartExists(customPropertiesPart)
+}
+
+func (u *Updater) propertiesPartExists(part string) bool {
+	if u == nil {
+		return false
+	}
+	info, err := os.Stat(filepath.Join(u.tempDir, filepath.FromSlash(part)))
+	return err == nil && !info.IsDir()
+}
+
+// GetAppProperties retrieves application-specific document properties. A
+// document without docProps/app.xml yields zero-value properties.
 func (u *Updater) GetAppProperties() (*AppProperties, error) {
 	if u == nil {
-		return nil, fmt.Errorf("updater is nil")
+		return nil, NewValidationError("updater", "updater is nil")
 	}
 
 	appPath := filepath.Join(u.tempDir, "docProps", "app.xml")
 	raw, err := os.ReadFile(appPath)
 	if err != nil {
+		if os.IsNotExist(err) {
+			return &AppProperties{}, nil
+		}
 		return nil, &DocxError{
 			Code:    "PROPERTIES_ERROR",
 			Message: "failed to read app properties",
@@ -635,10 +681,11 @@ func (u *Updater) extractAppProperty(content, property string) string {
 	return ""
 }
 
-// GetCustomProperties retrieves custom document properties
+// GetCustomProperties retrieves custom document properties. A document
+// without docProps/custom.xml has none.
 func (u *Updater) GetCustomProperties() ([]CustomProperty, error) {
 	if u == nil {
-		return nil, fmt.Errorf("updater is nil")
+		return nil, NewValidationError("updater", "updater is nil")
 	}
 
 	customPath := filepath.Join(u.tempDir, "docProps", "custom.xml")
@@ -671,14 +718,15 @@ func (u *Updater) parseCustomPropertiesXML(content string) []CustomProperty {
 			continue
 		}
 
-		name := match[1]
+		name := xmlUnescape(match[1])
 		body := match[2]
 
 		prop := CustomProperty{Name: name}
 
-		// Try each value type
-		if v := extractVTValue(body, "lpwstr"); v != "" {
-			prop.Value = v
+		// Try each value type. Strings are matched on the element so that an
+		// empty string is still read back as a string.
+		if strings.Contains(body, "<vt:lpwstr") {
+			prop.Value = xmlUnescape(extractVTValue(body, "lpwstr"))
 			prop.Type = "lpwstr"
 		} else if v := extractVTValue(body, "i4"); v != "" {
 			prop.Type = "i4"
@@ -1,6 +1,8 @@
 package godocx_test
 
 import (
+	"archive/zip"
+	"bytes"
 	"os"
 	"path/filepath"
 	"strings"
@@ -555,3 +557,109 @@ func TestPropertiesVerifyFiles(t *testing.T) {
 		t.Log("✓ custom.xml verified")
 	}
 }
+
+// buildDocxWithoutProperties creates a valid DOCX that has no docProps parts.
+func buildDocxWithoutProperties(t *testing.T) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	addZipEntry(t, w, "[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`+
+		`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`+
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`+
+		`<Default Extension="xml" ContentType="application/xml"/>`+
+		`<Override PartName="/word/document.xml" ContentType="`+godocx.DocxMainContentType+`"/>`+
+		`</Types>`)
+	addZipEntry(t, w, "_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`+
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`+
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>`+
+		`</Relationships>`)
+	addZipEntry(t, w, "word/document.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`+
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body></w:body></w:document>`)
+	addZipEntry(t, w, "word/_rels/document.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`+
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"></Relationships>`)
+	if err := w.Close(); err != nil {
+		t.Fatalf("close docx zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// TestPropertiesMissingParts verifies that documents without docProps parts
+// read as having empty properties, and that setting properties adds the parts.
+func TestPropertiesMissingParts(t *testing.T) {
+	u, err := godocx.NewFromBytes(buildDocxWithoutProperties(t))
+	if err != nil {
+		t.Fatalf("NewFromBytes: %v", err)
+	}
+	defer u.Cleanup()
+
+	if u.CorePropertiesExist() || u.AppPropertiesExist() || u.CustomPropertiesExist() {
+		t.Fatal("fixture should have no properties parts")
+	}
+
+	core, err := u.GetCoreProperties()
+	if err != nil {
+		t.Fatalf("GetCoreProperties: %v", err)
+	}
+	if *core != (godocx.CoreProperties{}) {
+		t.Errorf("expected zero core properties, got %+v", *core)
+	}
+	app, err := u.GetAppProperties()
+	if err != nil {
+		t.Fatalf("GetAppProperties: %v", err)
+	}
+	if *app != (godocx.AppProperties{}) {
+		t.Errorf("expected zero app properties, got %+v", *app)
+	}
+	custom, err := u.GetCustomProperties()
+	if err != nil || len(custom) != 0 {
+		t.Errorf("GetCustomProperties = %v, %v; want none", custom, err)
+	}
+
+	if err := u.SetCoreProperties(godocx.CoreProperties{Title: "Created"}); err != nil {
+		t.Fatalf("SetCoreProperties: %v", err)
+	}
+	if err := u.SetAppProperties(godocx.AppProperties{Company: "Acme"}); err != nil {
+		t.Fatalf("SetAppProperties: %v", err)
+	}
+	if !u.CorePropertiesExist() || !u.AppPropertiesExist() {
+		t.Fatal("properties parts should exist after setting them")
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "props.docx")
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	ct := readZipEntry(t, outputPath, "[Content_Types].xml")
+	rels := readZipEntry(t, outputPath, "_rels/.rels")
+	for _, part := range []string{"docProps/core.xml", "docProps/app.xml"} {
+		if strings.Count(ct, `PartName="/`+part+`"`) != 1 {
+			t.Errorf("content types should list %s once:\n%s", part, ct)
+		}
+		if strings.Count(rels, `Target="`+part+`"`) != 1 {
+			t.Errorf("package relationships should reference %s once:\n%s", part, rels)
+		}
+	}
+
+	// Setting again must not register the parts twice.
+	if err := u.SetCoreProperties(godocx.CoreProperties{Title: "Updated"}); err != nil {
+		t.Fatalf("SetCoreProperties: %v", err)
+	}
+	relsRaw, err := os.ReadFile(filepath.Join(u.TempDir(), "_rels", ".rels"))
+	if err != nil {
+		t.Fatalf("read rels: %v", err)
+	}
+	if strings.Count(string(relsRaw), "docProps/core.xml") != 1 {
+		t.Errorf("core properties relationship duplicated:\n%s", relsRaw)
+	}
+
+	reopened, err := godocx.New(outputPath)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer reopened.Cleanup()
+	core, err = reopened.GetCoreProperties()
+	if err != nil || core.Title != "Created" {
+		t.Errorf("reopened title = %q, %v", core.Title, err)
+	}
+}
@@ -0,0 +1,87 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// GetDocumentXML returns the current content of word/document.xml.
+//
+// This is an escape hatch for features the Updater does not cover; see
+// SetFileContent for what raw access bypasses.
+func (u *Updater) GetDocumentXML() ([]byte, error) {
+	return u.GetFileContent("word/document.xml")
+}
+
+// SetDocumentXML replaces word/document.xml with data after checking that it
+// is well-formed XML.
+//
+// Only well-formedness is checked: the content is not validated against the
+// WordprocessingML schema, and relationships, content types, numbering and
+// other parts it references are not checked or updated. See SetFileContent.
+func (u *Updater) SetDocumentXML(data []byte) error {
+	return u.SetFileContent("word/document.xml", data)
+}
+
+// GetFileContent returns the content of the package part at path, given
+// relative to the package root with forward slashes (e.g. "word/styles.xml"
+// or "[Content_Types].xml").
+func (u *Updater) GetFileContent(path string) ([]byte, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+	fullPath, partName, err := u.resolvePackagePath(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		return nil, NewFileReadError(partName, err)
+	}
+	return data, nil
+}
+
+// SetFileContent writes content to the package part at path, given as for
+// GetFileContent, creating the part when it does not exist. Parts ending in
+// .xml or .rels must be well-formed XML.
+//
+// This is an escape hatch for features the Updater does not cover, and it
+// bypasses all internal consistency checks: no relationship or content type
+// is added for a new part, nothing that refers to a replaced part is
+// updated, and the content is not validated against the Office Open XML
+// schemas. A document edited this way may not open in Word.
+func (u *Updater) SetFileContent(path string, content []byte) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	fullPath, partName, err := u.resolvePackagePath(path)
+	if err != nil {
+		return err
+	}
+	if ext := strings.ToLower(filepath.Ext(partName)); ext == ".xml" || ext == ".rels" {
+		if err := checkWellFormedXMLDocument(content); err != nil {
+			return NewValidationError("content", fmt.Sprintf("%s is not well-formed XML: %v", partName, err))
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return NewFileWriteError(partName, err)
+	}
+	if err := atomicWriteFile(fullPath, content, 0o644); err != nil {
+		return NewFileWriteError(partName, err)
+	}
+	return nil
+}
+
+// resolvePackagePath returns the file backing the package part at p and the
+// part's clean name, rejecting paths that leave the package.
+func (u *Updater) resolvePackagePath(p string) (fullPath, partName string, err error) {
+	partName = path.Clean(strings.TrimPrefix(filepath.ToSlash(p), "/"))
+	if p == "" || partName == "." || partName == ".." || strings.HasPrefix(partName, "../") {
+		return "", "", NewValidationError("path", fmt.Sprintf("invalid package path %q", p))
+	}
+	return filepath.Join(u.tempDir, filepath.FromSlash(partName)), partName, nil
+}
@@ -0,0 +1,84 @@
+package godocx_test
+
+import (
+	"strings"
+	"testing"
+
+	godocx "github.com/falcomza/go-docx"
+)
+
+func TestDocumentXMLRoundTrip(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.AddText("Original", godocx.PositionEnd); err != nil {
+		t.Fatalf("AddText failed: %v", err)
+	}
+	docXML, err := u.GetDocumentXML()
+	if err != nil {
+		t.Fatalf("GetDocumentXML failed: %v", err)
+	}
+	if !strings.Contains(string(docXML), "Original") {
+		t.Fatalf("expected paragraph text in document.xml:\n%s", docXML)
+	}
+
+	edited := strings.Replace(string(docXML), "Original", "Edited by hand", 1)
+	if err := u.SetDocumentXML([]byte(edited)); err != nil {
+		t.Fatalf("SetDocumentXML failed: %v", err)
+	}
+	text, err := u.GetText()
+	if err != nil {
+		t.Fatalf("GetText failed: %v", err)
+	}
+	if !strings.Contains(text, "Edited by hand") {
+		t.Errorf("expected edited text, got %q", text)
+	}
+
+	if err := u.SetDocumentXML([]byte(`<w:document><w:body></w:document>`)); err == nil {
+		t.Error("expected error for malformed XML")
+	}
+	if got, _ := u.GetDocumentXML(); string(got) != edited {
+		t.Error("malformed XML must not replace document.xml")
+	}
+}
+
+func TestFileContentAccess(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	ct, err := u.GetFileContent("/[Content_Types].xml")
+	if err != nil {
+		t.Fatalf("GetFileContent failed: %v", err)
+	}
+	if !strings.Contains(string(ct), "<Types") {
+		t.Errorf("unexpected content types part:\n%s", ct)
+	}
+
+	if err := u.SetFileContent("word/media/notes.txt", []byte("plain < text")); err != nil {
+		t.Fatalf("SetFileContent for a non-XML part failed: %v", err)
+	}
+	if got, err := u.GetFileContent("word/media/notes.txt"); err != nil || string(got) != "plain < text" {
+		t.Errorf("GetFileContent = %q, %v", got, err)
+	}
+	if err := u.SetFileContent("word/_rels/custom.xml.rels", []byte("<Relationships>")); err == nil {
+		t.Error("expected error for a malformed .rels part")
+	}
+
+	for _, bad := range []string{"", ".", "../outside.xml", "word/../../outside.xml"} {
+		if _, err := u.GetFileContent(bad); err == nil {
+			t.Errorf("GetFileContent(%q): expected error", bad)
+		}
+		if err := u.SetFileContent(bad, []byte("<x/>")); err == nil {
+			t.Errorf("SetFileContent(%q): expected error", bad)
+		}
+	}
+	if _, err := u.GetFileContent("word/missing.xml"); err == nil {
+		t.Error("expected error for a missing part")
+	}
+}
@@ -1,7 +1,7 @@
 package godocx
 
 import (
-	"fmt"
+	"bytes"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -61,7 +61,7 @@ func DefaultFindOptions() FindOptions {
 // GetText extracts all text from the document body
 func (u *Updater) GetText() (string, error) {
 	if u == nil {
-		return "", fmt.Errorf("updater is nil")
+		return "", NewValidationError("updater", "updater is nil")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
@@ -76,7 +76,7 @@ func (u *Updater) GetText() (string, error) {
 // GetParagraphText extracts text from all paragraphs
 func (u *Updater) GetParagraphText() ([]string, error) {
 	if u == nil {
-		return nil, fmt.Errorf("updater is nil")
+		return nil, NewValidationError("updater", "updater is nil")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
@@ -92,7 +92,7 @@ func (u *Updater) GetParagraphText() ([]string, error) {
 // Returns a 2D slice where each element represents a table, containing rows of cells
 func (u *Updater) GetTableText() ([][][]string, error) {
 	if u == nil {
-		return nil, fmt.Errorf("updater is nil")
+		return nil, NewValidationError("updater", "updater is nil")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
@@ -107,7 +107,7 @@ func (u *Updater) GetTableText() ([][][]string, error) {
 // FindText finds all occurrences of text in the document
 func (u *Updater) FindText(pattern string, opts FindOptions) ([]TextMatch, error) {
 	if u == nil {
-		return nil, fmt.Errorf("updater is nil")
+		return nil, NewValidationError("updater", "updater is nil")
 	}
 	if pattern == "" {
 		return nil, NewValidationError("pattern", "search pattern cannot be empty")
@@ -187,20 +187,55 @@ func (u *Updater) FindText(pattern string, opts FindOptions) ([]TextMatch, error
 
 // extractTextFromXML extracts all visible text from XML content
 func (u *Updater) extractTextFromXML(raw []byte) string {
-	var result strings.Builder
+	return extractRunText(raw)
+}
 
-	// Extract text from <w:t> elements only (not <w:tc>, <w:tr>, etc.)
-	// The pattern matches <w:t> or <w:t followed by a space/attribute, but not <w:tc>, <w:tr>, etc.
-	matches := extractTextPattern.FindAllSubmatch(raw, -1)
+// runTextTokenPattern matches the run content that produces visible text.
+// Tab stop definitions (<w:tabs>) are matched first so their <w:tab>
+// children are not mistaken for tab characters.
+var runTextTokenPattern = regexp.MustCompile(`(?s)<w:tabs>.*?</w:tabs>|<w:t(\s[^>]*)?>([^<]*)</w:t>|<w:(tab|br|cr)(\s[^>]*)?/>`)
+
+// extractRunText returns the text of the runs in raw as Word displays it:
+// <w:t> text (with surrounding whitespace kept only when xml:space="preserve"
+// is set, as Word discards it otherwise), tabs as '\t', line breaks and
+// carriage returns as '\n', and page breaks as '\f'. Text split across runs
+// is joined without adding characters, since runs are only formatting
+// boundaries.
+func extractRunText(raw []byte) string {
+	return runText(raw, true)
+}
 
-	for _, match := range matches {
-		if len(match) > 1 {
-			text := string(match[1])
-			text = unescapeXML(text)
+// runText implements extractRunText. When trimUnpreserved is false, the
+// whitespace around <w:t> text without xml:space="preserve" is kept too,
+// which anchor matching uses because some generators rely on it.
+func runText(raw []byte, trimUnpreserved bool) string {
+	var result strings.Builder
+	for _, m := range runTextTokenPattern.FindAllSubmatch(raw, -1) {
+		switch string(m[3]) {
+		case "tab":
+			result.WriteByte('\t')
+		case "br":
+			if bytes.Contains(m[4], []byte(`w:type="page"`)) {
+				result.WriteByte('\f')
+			} else {
+				result.WriteByte('\n')
+			}
+		case "cr":
+			result.WriteByte('\n')
+		default:
+			if m[2] == nil {
+				continue // <w:tabs> block
+			}
+			text := string(m[2])
+			if bytes.IndexByte(m[2], '&') != -1 {
+				text = xmlUnescape(text)
+			}
+			if trimUnpreserved && !bytes.Contains(m[1], []byte(`xml:space="preserve"`)) {
+				text = strings.Trim(text, " \t\r\n")
+			}
 			result.WriteString(text)
 		}
 	}
-
 	return result.String()
 }
 
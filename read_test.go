@@ -0,0 +1,73 @@
+package godocx
+
+import (
+	"slices"
+	"testing"
+)
+
+// splitRunsBody is a paragraph set whose runs are split mid-word and
+// mid-sentence the way Word splits them after editing and formatting.
+const splitRunsBody = `<w:p>` +
+	`<w:pPr><w:tabs><w:tab w:val="left" w:pos="720"/></w:tabs></w:pPr>` +
+	`<w:r><w:t xml:space="preserve">The </w:t></w:r>` +
+	`<w:r><w:rPr><w:b/></w:rPr><w:t>qu</w:t></w:r>` +
+	`<w:r><w:rPr><w:i/></w:rPr><w:t>ick</w:t></w:r>` +
+	`<w:r><w:t xml:space="preserve"> brown </w:t></w:r>` +
+	`<w:r><w:t>  fox  </w:t></w:r>` +
+	`</w:p>` +
+	`<w:p>` +
+	`<w:r><w:t>Name</w:t><w:tab/><w:t>Value</w:t></w:r>` +
+	`<w:r><w:br/><w:t>next line</w:t><w:cr/></w:r>` +
+	`<w:r><w:t>A &amp; B</w:t></w:r>` +
+	`</w:p>` +
+	`<w:p><w:r><w:t>Before</w:t><w:br w:type="page"/><w:t>After</w:t></w:r></w:p>` +
+	`<w:p><w:r><w:delText>deleted</w:delText><w:instrText> PAGE </w:instrText></w:r></w:p>`
+
+func TestGetTextSplitRuns(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, splitRunsBody))
+
+	paragraphs, err := u.GetParagraphText()
+	if err != nil {
+		t.Fatalf("GetParagraphText: %v", err)
+	}
+	want := []string{
+		"The quick brown fox",
+		"Name\tValue\nnext line\nA & B",
+		"Before\fAfter",
+	}
+	if !slices.Equal(paragraphs, want) {
+		t.Errorf("GetParagraphText() = %q, want %q", paragraphs, want)
+	}
+
+	text, err := u.GetText()
+	if err != nil {
+		t.Fatalf("GetText: %v", err)
+	}
+	if wantText := want[0] + want[1] + want[2]; text != wantText {
+		t.Errorf("GetText() = %q, want %q", text, wantText)
+	}
+
+	infos, err := u.GetParagraphs()
+	if err != nil {
+		t.Fatalf("GetParagraphs: %v", err)
+	}
+	if infos[0].Text != want[0] {
+		t.Errorf("GetParagraphs()[0].Text = %q, want %q", infos[0].Text, want[0])
+	}
+}
+
+func TestGetTableTextSplitRuns(t *testing.T) {
+	body := `<w:tbl><w:tr>` +
+		`<w:tc><w:p><w:r><w:t>To</w:t></w:r><w:r><w:rPr><w:b/></w:rPr><w:t>tal</w:t></w:r></w:p></w:tc>` +
+		`<w:tc><w:p><w:r><w:t>1</w:t><w:tab/><w:t>000</w:t></w:r></w:p></w:tc>` +
+		`</w:tr></w:tbl>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	tables, err := u.GetTableText()
+	if err != nil {
+		t.Fatalf("GetTableText: %v", err)
+	}
+	if len(tables) != 1 || !slices.Equal(tables[0][0], []string{"Total", "1\t000"}) {
+		t.Errorf("GetTableText() = %q", tables)
+	}
+}
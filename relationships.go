@@ -0,0 +1,194 @@
+package godocx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Relationship is one entry of an OPC relationships (.rels) part.
+type Relationship struct {
+	ID         string
+	Type       string // Full relationship type URI
+	Target     string // Target as written, relative to the source part's directory
+	TargetMode string // "External" for URLs, empty for parts inside the package
+}
+
+// GetRelationships returns the relationships of a package part such as
+// "word/document.xml" or "word/header1.xml". An empty part (or "/") selects
+// the package-level relationships in _rels/.rels. A part without a .rels
+// file has no relationships and returns an empty slice.
+func (u *Updater) GetRelationships(part string) ([]Relationship, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+	relsPart, err := relationshipsPartName(part)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, filepath.FromSlash(relsPart)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Relationship{}, nil
+		}
+		return nil, NewFileReadError(relsPart, err)
+	}
+	return parseRelationships(relsPart, raw)
+}
+
+// GetAllRelationships returns the relationships of every part that has a
+// .rels file, keyed by the source part path as accepted by GetRelationships
+// ("" for the package-level relationships).
+func (u *Updater) GetAllRelationships() (map[string][]Relationship, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+
+	all := make(map[string][]Relationship)
+	err := filepath.WalkDir(u.tempDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".rels") || filepath.Base(filepath.Dir(p)) != "_rels" {
+			return nil
+		}
+		rel, err := filepath.Rel(u.tempDir, p)
+		if err != nil {
+			return err
+		}
+		relsPart := filepath.ToSlash(rel)
+		raw, err := os.ReadFile(p)
+		if err != nil {
+			return NewFileReadError(relsPart, err)
+		}
+		rels, err := parseRelationships(relsPart, raw)
+		if err != nil {
+			return err
+		}
+		all[relationshipsSourcePart(relsPart)] = rels
+		return nil
+	})
+	if err != nil {
+		var docxErr *DocxError
+		if errors.As(err, &docxErr) {
+			return nil, err
+		}
+		return nil, NewFileReadError("relationships", err)
+	}
+	return all, nil
+}
+
+// AddRelationship adds a relationship to a part's .rels file, creating the
+// file if needed, and returns its ID. When rel.ID is empty the next free
+// "rIdN" is used. This is a low-level escape hatch for relationship types
+// that the higher-level API does not cover; the caller is responsible for
+// the target part and its content type.
+func (u *Updater) AddRelationship(part string, rel Relationship) (string, error) {
+	if u == nil {
+		return "", NewValidationError("updater", "updater is nil")
+	}
+	if strings.TrimSpace(rel.Type) == "" {
+		return "", NewValidationError("Type", "relationship type cannot be empty")
+	}
+	if strings.TrimSpace(rel.Target) == "" {
+		return "", NewValidationError("Target", "relationship target cannot be empty")
+	}
+	switch rel.TargetMode {
+	case "", "Internal", "External":
+	default:
+		return "", NewValidationError("TargetMode", fmt.Sprintf("unsupported target mode %q: expected Internal or External", rel.TargetMode))
+	}
+
+	existing, err := u.GetRelationships(part)
+	if err != nil {
+		return "", err
+	}
+	relsPart, _ := relationshipsPartName(part)
+	relsPath := filepath.Join(u.tempDir, filepath.FromSlash(relsPart))
+
+	if rel.ID == "" {
+		maxID := 0
+		for _, r := range existing {
+			var n int
+			if _, err := fmt.Sscanf(r.ID, "rId%d", &n); err == nil && n > maxID {
+				maxID = n
+			}
+		}
+		rel.ID = fmt.Sprintf("rId%d", maxID+1)
+	} else {
+		for _, r := range existing {
+			if r.ID == rel.ID {
+				return "", NewValidationError("ID", fmt.Sprintf("relationship %q already exists in %s", rel.ID, relsPart))
+			}
+		}
+	}
+
+	elem := fmt.Sprintf(`<Relationship Id="%s" Type="%s" Target="%s"`, xmlEscape(rel.ID), xmlEscape(rel.Type), xmlEscape(rel.Target))
+	if rel.TargetMode != "" {
+		elem += fmt.Sprintf(` TargetMode="%s"`, rel.TargetMode)
+	}
+	elem += "/>"
+
+	raw, err := os.ReadFile(relsPath)
+	switch {
+	case os.IsNotExist(err):
+		if err := os.MkdirAll(filepath.Dir(relsPath), 0o755); err != nil {
+			return "", NewFileWriteError(relsPart, err)
+		}
+		raw = []byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+			`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"></Relationships>`)
+	case err != nil:
+		return "", NewFileReadError(relsPart, err)
+	}
+
+	closer := []byte("</Relationships>")
+	pos := bytes.LastIndex(raw, closer)
+	if pos == -1 {
+		return "", NewMalformedXMLError(fmt.Sprintf("invalid %s: missing </Relationships>", relsPart))
+	}
+	if err := atomicWriteFile(relsPath, spliceBytes(raw, pos, pos, elem), 0o644); err != nil {
+		return "", NewFileWriteError(relsPart, err)
+	}
+	return rel.ID, nil
+}
+
+// relationshipsPartName maps a source part path to its .rels part path,
+// e.g. "word/document.xml" to "word/_rels/document.xml.rels".
+func relationshipsPartName(part string) (string, error) {
+	part = strings.TrimPrefix(filepath.ToSlash(part), "/")
+	if part == "" {
+		return "_rels/.rels", nil
+	}
+	cleaned := path.Clean(part)
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") || strings.HasSuffix(part, "/") {
+		return "", NewValidationError("part", fmt.Sprintf("invalid part path %q", part))
+	}
+	dir, name := path.Split(cleaned)
+	return dir + "_rels/" + name + ".rels", nil
+}
+
+// relationshipsSourcePart is the inverse of relationshipsPartName.
+func relationshipsSourcePart(relsPart string) string {
+	dir, name := path.Split(relsPart)
+	dir = strings.TrimSuffix(strings.TrimSuffix(dir, "/"), "_rels")
+	return dir + strings.TrimSuffix(name, ".rels")
+}
+
+func parseRelationships(relsPart string, raw []byte) ([]Relationship, error) {
+	var parsed relationships
+	if err := xml.Unmarshal(raw, &parsed); err != nil {
+		return nil, NewXMLParseError(relsPart, err)
+	}
+	rels := make([]Relationship, 0, len(parsed.Relationships))
+	for _, r := range parsed.Relationships {
+		rels = append(rels, Relationship{ID: r.ID, Type: r.Type, Target: r.Target, TargetMode: r.TargetMode})
+	}
+	return rels, nil
+}
@@ -0,0 +1,98 @@
+package godocx
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGetRelationships(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.InsertHyperlink("Example", "https://example.com/?a=1&b=2", HyperlinkOptions{Position: PositionEnd}); err != nil {
+		t.Fatalf("InsertHyperlink: %v", err)
+	}
+
+	rels, err := u.GetRelationships("word/document.xml")
+	if err != nil {
+		t.Fatalf("GetRelationships: %v", err)
+	}
+	idx := slices.IndexFunc(rels, func(r Relationship) bool { return strings.HasSuffix(r.Type, "/hyperlink") })
+	if idx == -1 {
+		t.Fatalf("expected a hyperlink relationship, got %+v", rels)
+	}
+	if got := rels[idx]; got.Target != "https://example.com/?a=1&b=2" || got.TargetMode != "External" || got.ID == "" {
+		t.Errorf("unexpected hyperlink relationship %+v", got)
+	}
+
+	pkg, err := u.GetRelationships("")
+	if err != nil {
+		t.Fatalf("GetRelationships(package): %v", err)
+	}
+	if !slices.ContainsFunc(pkg, func(r Relationship) bool { return r.Target == "word/document.xml" }) {
+		t.Errorf("package relationships should point at the main document: %+v", pkg)
+	}
+
+	if none, err := u.GetRelationships("word/styles.xml"); err != nil || len(none) != 0 {
+		t.Errorf("part without .rels: got %+v, %v", none, err)
+	}
+	if _, err := u.GetRelationships("../outside.xml"); err == nil {
+		t.Error("expected error for a path outside the package")
+	}
+
+	all, err := u.GetAllRelationships()
+	if err != nil {
+		t.Fatalf("GetAllRelationships: %v", err)
+	}
+	if len(all["word/document.xml"]) != len(rels) || len(all[""]) != len(pkg) {
+		t.Errorf("GetAllRelationships keys = %v", slices.Sorted(maps.Keys(all)))
+	}
+}
+
+func TestAddRelationship(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	const customType = "http://example.com/relationships/custom"
+	before, _ := u.GetRelationships("word/document.xml")
+	id, err := u.AddRelationship("word/document.xml", Relationship{Type: customType, Target: "custom/data.xml"})
+	if err != nil {
+		t.Fatalf("AddRelationship: %v", err)
+	}
+	after, err := u.GetRelationships("word/document.xml")
+	if err != nil {
+		t.Fatalf("GetRelationships: %v", err)
+	}
+	if len(after) != len(before)+1 || after[len(after)-1] != (Relationship{ID: id, Type: customType, Target: "custom/data.xml"}) {
+		t.Errorf("unexpected relationships after add: %+v", after)
+	}
+	if slices.ContainsFunc(before, func(r Relationship) bool { return r.ID == id }) {
+		t.Errorf("generated ID %q collides with an existing relationship", id)
+	}
+
+	// A part without relationships gets a new .rels file.
+	if id, err := u.AddRelationship("word/footer9.xml", Relationship{ID: "rIdLogo", Type: customType, Target: "https://example.com/logo.png", TargetMode: "External"}); err != nil || id != "rIdLogo" {
+		t.Fatalf("AddRelationship to a new part: %q, %v", id, err)
+	}
+	if got := readTempFile(t, u, "word/_rels/footer9.xml.rels"); !strings.Contains(got, `<Relationship Id="rIdLogo" Type="`+customType+`" Target="https://example.com/logo.png" TargetMode="External"/>`) {
+		t.Errorf("unexpected new relationships part:\n%s", got)
+	}
+
+	if _, err := u.AddRelationship("word/footer9.xml", Relationship{ID: "rIdLogo", Type: customType, Target: "x.xml"}); err == nil {
+		t.Error("expected error for duplicate ID")
+	}
+	if _, err := u.AddRelationship("word/document.xml", Relationship{Target: "x.xml"}); err == nil {
+		t.Error("expected error for missing type")
+	}
+	if _, err := u.AddRelationship("word/document.xml", Relationship{Type: customType, Target: "x.xml", TargetMode: "Remote"}); err == nil {
+		t.Error("expected error for unsupported target mode")
+	}
+}
@@ -1,11 +1,14 @@
 package godocx
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 )
 
 // ReplaceOptions defines options for text replacement
@@ -49,12 +52,13 @@ func DefaultReplaceOptions() ReplaceOptions {
 // Returns the number of replacements made
 func (u *Updater) ReplaceText(old, new string, opts ReplaceOptions) (int, error) {
 	if u == nil {
-		return 0, fmt.Errorf("updater is nil")
+		return 0, NewValidationError("updater", "updater is nil")
 	}
 	if old == "" {
 		return 0, NewValidationError("old", "old text cannot be empty")
 	}
 
+	start := time.Now()
 	count := 0
 
 	// Replace in document body (paragraphs and tables)
@@ -88,6 +92,7 @@ func (u *Updater) ReplaceText(old, new string, opts ReplaceOptions) (int, error)
 		}
 	}
 
+	u.recordOperation(MetricReplaceText, start, map[string]any{"replacements": count})
 	return count, nil
 }
 
@@ -95,7 +100,7 @@ func (u *Updater) ReplaceText(old, new string, opts ReplaceOptions) (int, error)
 // Returns the number of replacements made
 func (u *Updater) ReplaceTextRegex(pattern *regexp.Regexp, replacement string, opts ReplaceOptions) (int, error) {
 	if u == nil {
-		return 0, fmt.Errorf("updater is nil")
+		return 0, NewValidationError("updater", "updater is nil")
 	}
 	if pattern == nil {
 		return 0, NewValidationError("pattern", "regex pattern cannot be nil")
@@ -141,14 +146,19 @@ func (u *Updater) ReplaceTextRegex(pattern *regexp.Regexp, replacement string, o
 func (u *Updater) replaceInFile(path, old, new string, opts ReplaceOptions, count *int) (int, error) {
 	raw, err := os.ReadFile(path)
 	if err != nil {
-		return 0, err
+		return 0, NewFileReadError(filepath.Base(path), err)
 	}
 
+	part := map[string]any{"part": filepath.Base(path)}
+	start := time.Now()
 	updated, replaced := u.replaceTextInXML(raw, old, new, opts, count)
+	u.recordOperation(MetricReplaceTextParse, start, part)
 	if replaced > 0 {
+		start = time.Now()
 		if err := os.WriteFile(path, updated, 0o644); err != nil {
-			return 0, err
+			return 0, NewXMLWriteError(filepath.Base(path), err)
 		}
+		u.recordOperation(MetricReplaceTextWrite, start, part)
 	}
 
 	return replaced, nil
@@ -158,13 +168,13 @@ func (u *Updater) replaceInFile(path, old, new string, opts ReplaceOptions, coun
 func (u *Updater) replaceRegexInFile(path string, pattern *regexp.Regexp, replacement string, opts ReplaceOptions, count *int) (int, error) {
 	raw, err := os.ReadFile(path)
 	if err != nil {
-		return 0, err
+		return 0, NewFileReadError(filepath.Base(path), err)
 	}
 
 	updated, replaced := u.replaceRegexInXML(raw, pattern, replacement, opts, count)
 	if replaced > 0 {
 		if err := os.WriteFile(path, updated, 0o644); err != nil {
-			return 0, err
+			return 0, NewXMLWriteError(filepath.Base(path), err)
 		}
 	}
 
@@ -243,7 +253,7 @@ func (u *Updater) replaceTextInXML(raw []byte, old, new string, opts ReplaceOpti
 		}
 
 		if replacedText != text {
-			return strings.Replace(match, rawText, xmlEscape(replacedText), 1)
+			return strings.Replace(match, rawText, xmlEscapeContent(replacedText), 1)
 		}
 		return match
 	})
@@ -282,10 +292,243 @@ func (u *Updater) replaceRegexInXML(raw []byte, pattern *regexp.Regexp, replacem
 		})
 
 		if replacedText != text {
-			return strings.Replace(match, rawText, xmlEscape(replacedText), 1)
+			return strings.Replace(match, rawText, xmlEscapeContent(replacedText), 1)
 		}
 		return match
 	})
 
 	return []byte(content), replaced
 }
+
+// ApplyBulkReplacements replaces every key of replacements with its value in
+// a single pass over each part, instead of reading and writing the files once
+// per pattern as repeated ReplaceText calls would.
+//
+// Matching is done on the combined text of each paragraph, so a pattern split
+// across several runs (as Word often does while editing) is still found. The
+// replacement takes the formatting of the run where the match starts. When
+// patterns overlap, the longest one wins. MaxReplacements caps the total
+// across all patterns.
+//
+// The returned map holds the number of replacements made for each pattern,
+// including patterns that were not found.
+func (u *Updater) ApplyBulkReplacements(replacements map[string]string, opts ReplaceOptions) (map[string]int, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+
+	counts := make(map[string]int, len(replacements))
+	patterns := make([]string, 0, len(replacements))
+	for old := range replacements {
+		if old == "" {
+			return nil, NewValidationError("replacements", "pattern cannot be empty")
+		}
+		counts[old] = 0
+		patterns = append(patterns, old)
+	}
+	if len(patterns) == 0 {
+		return counts, nil
+	}
+
+	r := newBulkReplacer(patterns, replacements, opts)
+
+	var paths []string
+	if opts.InParagraphs || opts.InTables {
+		paths = append(paths, filepath.Join(u.tempDir, "word", "document.xml"))
+	}
+	if opts.InHeaders {
+		headerFiles, _ := filepath.Glob(filepath.Join(u.tempDir, "word", "header*.xml"))
+		paths = append(paths, headerFiles...)
+	}
+	if opts.InFooters {
+		footerFiles, _ := filepath.Glob(filepath.Join(u.tempDir, "word", "footer*.xml"))
+		paths = append(paths, footerFiles...)
+	}
+
+	total := 0
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return counts, NewFileReadError(filepath.Base(path), err)
+		}
+		updated, replaced := r.replaceInXML(raw, counts, &total)
+		if replaced == 0 {
+			continue
+		}
+		if err := atomicWriteFile(path, updated, 0o644); err != nil {
+			return counts, NewFileWriteError(filepath.Base(path), err)
+		}
+	}
+
+	return counts, nil
+}
+
+// bulkReplacer matches a set of literal patterns with one combined regex.
+type bulkReplacer struct {
+	re           *regexp.Regexp
+	replacements map[string]string
+	byFold       map[string]string // lower-cased match -> pattern, for case-insensitive matching
+	patterns     []string
+	opts         ReplaceOptions
+}
+
+func newBulkReplacer(patterns []string, replacements map[string]string, opts ReplaceOptions) *bulkReplacer {
+	// Longest first, so the leftmost-first alternation prefers the longest
+	// pattern at any position.
+	sort.Slice(patterns, func(i, j int) bool {
+		if len(patterns[i]) != len(patterns[j]) {
+			return len(patterns[i]) > len(patterns[j])
+		}
+		return patterns[i] < patterns[j]
+	})
+
+	quoted := make([]string, len(patterns))
+	for i, p := range patterns {
+		quoted[i] = regexp.QuoteMeta(p)
+	}
+	expr := `(?:` + strings.Join(quoted, "|") + `)`
+	if opts.WholeWord {
+		expr = `\b` + expr + `\b`
+	}
+	if !opts.MatchCase {
+		expr = `(?i)` + expr
+	}
+
+	r := &bulkReplacer{
+		re:           regexp.MustCompile(expr),
+		replacements: replacements,
+		patterns:     patterns,
+		opts:         opts,
+	}
+	if !opts.MatchCase {
+		r.byFold = make(map[string]string, len(patterns))
+		for _, p := range patterns {
+			if _, ok := r.byFold[strings.ToLower(p)]; !ok {
+				r.byFold[strings.ToLower(p)] = p
+			}
+		}
+	}
+	return r
+}
+
+// patternFor returns the pattern that produced a match.
+func (r *bulkReplacer) patternFor(match string) string {
+	if r.opts.MatchCase {
+		return match
+	}
+	if p, ok := r.byFold[strings.ToLower(match)]; ok {
+		return p
+	}
+	for _, p := range r.patterns {
+		if strings.EqualFold(p, match) {
+			return p
+		}
+	}
+	return match
+}
+
+// replaceInXML applies the replacements to every paragraph of one part.
+func (r *bulkReplacer) replaceInXML(raw []byte, counts map[string]int, total *int) ([]byte, int) {
+	var out bytes.Buffer
+	out.Grow(len(raw))
+	replaced := 0
+	pos := 0
+	for {
+		start := findNextParagraphStart(raw, pos)
+		if start == -1 {
+			break
+		}
+		end := bytes.Index(raw[start:], []byte("</w:p>"))
+		if end == -1 {
+			break
+		}
+		end += start + len("</w:p>")
+		out.Write(raw[pos:start])
+		para, n := r.replaceInParagraph(raw[start:end], counts, total)
+		out.Write(para)
+		replaced += n
+		pos = end
+	}
+	if replaced == 0 {
+		return raw, 0
+	}
+	out.Write(raw[pos:])
+	return out.Bytes(), replaced
+}
+
+// replaceInParagraph matches against the paragraph's joined text and writes
+// each replacement into the <w:t> where the match starts, removing the rest
+// of the match from the following <w:t> elements.
+func (r *bulkReplacer) replaceInParagraph(para []byte, counts map[string]int, total *int) ([]byte, int) {
+	locs := extractTextPattern.FindAllSubmatchIndex(para, -1)
+	if len(locs) == 0 {
+		return para, 0
+	}
+
+	segments := make([]string, len(locs))
+	segStart := make([]int, len(locs))
+	var joined strings.Builder
+	for i, loc := range locs {
+		segments[i] = xmlUnescape(string(para[loc[2]:loc[3]]))
+		segStart[i] = joined.Len()
+		joined.WriteString(segments[i])
+	}
+	text := joined.String()
+
+	matches := r.re.FindAllStringIndex(text, -1)
+	if limit := r.opts.MaxReplacements; limit > 0 {
+		remaining := limit - *total
+		if remaining <= 0 {
+			return para, 0
+		}
+		if len(matches) > remaining {
+			matches = matches[:remaining]
+		}
+	}
+	if len(matches) == 0 {
+		return para, 0
+	}
+
+	segmentAt := func(offset int) int {
+		return sort.Search(len(segStart), func(i int) bool { return segStart[i] > offset }) - 1
+	}
+
+	// Apply from last to first so earlier offsets stay valid.
+	changed := make([]bool, len(locs))
+	for i := len(matches) - 1; i >= 0; i-- {
+		ms, me := matches[i][0], matches[i][1]
+		pattern := r.patternFor(text[ms:me])
+		counts[pattern]++
+		*total++
+
+		first, last := segmentAt(ms), segmentAt(me-1)
+		head := segments[first][:ms-segStart[first]] + r.replacements[pattern]
+		if first == last {
+			segments[first] = head + segments[first][me-segStart[first]:]
+		} else {
+			segments[first] = head
+			for s := first + 1; s < last; s++ {
+				segments[s] = ""
+				changed[s] = true
+			}
+			segments[last] = segments[last][me-segStart[last]:]
+			changed[last] = true
+		}
+		changed[first] = true
+	}
+
+	var out bytes.Buffer
+	prev := 0
+	for i, loc := range locs {
+		if !changed[i] {
+			continue
+		}
+		out.Write(para[prev:loc[0]])
+		out.WriteString(`<w:t xml:space="preserve">`)
+		out.WriteString(xmlEscapeContent(segments[i]))
+		out.WriteString(`</w:t>`)
+		prev = loc[1]
+	}
+	out.Write(para[prev:])
+	return out.Bytes(), len(matches)
+}
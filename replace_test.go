@@ -0,0 +1,98 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyBulkReplacements(t *testing.T) {
+	body := `<w:p><w:r><w:t>Dear {{name}}, your order {{order}} ships {{date}}.</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:rPr><w:b/></w:rPr><w:t>{{na</w:t></w:r><w:r><w:t>me}}</w:t></w:r><w:r><w:t xml:space="preserve"> &amp; {{name}}</w:t></w:r></w:p>` +
+		`<w:tbl><w:tr><w:tc><w:p><w:r><w:t>{{order}}</w:t></w:r></w:p></w:tc></w:tr></w:tbl>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	counts, err := u.ApplyBulkReplacements(map[string]string{
+		"{{name}}":    "Ann & Co",
+		"{{order}}":   "#42",
+		"{{missing}}": "x",
+	}, ReplaceOptions{MatchCase: true, InParagraphs: true, InTables: true})
+	if err != nil {
+		t.Fatalf("ApplyBulkReplacements: %v", err)
+	}
+
+	want := map[string]int{"{{name}}": 3, "{{order}}": 2, "{{missing}}": 0}
+	for k, v := range want {
+		if counts[k] != v {
+			t.Errorf("counts[%q] = %d, want %d", k, counts[k], v)
+		}
+	}
+
+	docXML := readDocXML(t, u)
+	for _, s := range []string{
+		`Dear Ann &amp; Co, your order #42 ships {{date}}.`,
+		`<w:rPr><w:b/></w:rPr><w:t xml:space="preserve">Ann &amp; Co</w:t>`,
+		`<w:t xml:space="preserve"></w:t>`,
+		`<w:t xml:space="preserve"> &amp; Ann &amp; Co</w:t>`,
+		`<w:tc><w:p><w:r><w:t xml:space="preserve">#42</w:t>`,
+	} {
+		if !strings.Contains(docXML, s) {
+			t.Errorf("document missing %q\n%s", s, docXML)
+		}
+	}
+}
+
+func TestApplyBulkReplacementsOptions(t *testing.T) {
+	body := `<w:p><w:r><w:t>Cat catalog CAT cat</w:t></w:r></w:p>`
+
+	t.Run("longest pattern wins", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		counts, err := u.ApplyBulkReplacements(map[string]string{"cat": "dog", "catalog": "list"},
+			ReplaceOptions{MatchCase: true, InParagraphs: true})
+		if err != nil {
+			t.Fatalf("ApplyBulkReplacements: %v", err)
+		}
+		if counts["cat"] != 1 || counts["catalog"] != 1 {
+			t.Errorf("counts = %v", counts)
+		}
+		if !strings.Contains(readDocXML(t, u), `Cat list CAT dog`) {
+			t.Errorf("unexpected document: %s", readDocXML(t, u))
+		}
+	})
+
+	t.Run("case-insensitive whole word", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		counts, err := u.ApplyBulkReplacements(map[string]string{"cat": "dog"},
+			ReplaceOptions{WholeWord: true, InParagraphs: true})
+		if err != nil {
+			t.Fatalf("ApplyBulkReplacements: %v", err)
+		}
+		if counts["cat"] != 3 {
+			t.Errorf("counts[cat] = %d, want 3", counts["cat"])
+		}
+		if !strings.Contains(readDocXML(t, u), `dog catalog dog dog`) {
+			t.Errorf("unexpected document: %s", readDocXML(t, u))
+		}
+	})
+
+	t.Run("max replacements", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		counts, err := u.ApplyBulkReplacements(map[string]string{"cat": "dog"},
+			ReplaceOptions{InParagraphs: true, MaxReplacements: 2})
+		if err != nil {
+			t.Fatalf("ApplyBulkReplacements: %v", err)
+		}
+		if counts["cat"] != 2 {
+			t.Errorf("counts[cat] = %d, want 2", counts["cat"])
+		}
+		if !strings.Contains(readDocXML(t, u), `dog dogalog CAT cat`) {
+			t.Errorf("unexpected document: %s", readDocXML(t, u))
+		}
+	})
+
+	t.Run("empty pattern", func(t *testing.T) {
+		u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+		if _, err := u.ApplyBulkReplacements(map[string]string{"": "x"}, DefaultReplaceOptions()); err == nil {
+			t.Error("expected error for empty pattern")
+		}
+	})
+}
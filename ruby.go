@@ -0,0 +1,196 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// RubyAlignment defines how phonetic guide text aligns over its base text.
+type RubyAlignment string
+
+const (
+	RubyAlignCenter           RubyAlignment = "center"
+	RubyAlignDistributeLetter RubyAlignment = "distributeLetter"
+	RubyAlignDistributeSpace  RubyAlignment = "distributeSpace"
+	RubyAlignLeft             RubyAlignment = "left"
+	RubyAlignRight            RubyAlignment = "right"
+)
+
+// RubyOptions defines options for ruby (phonetic guide) insertion.
+type RubyOptions struct {
+	// Alignment of the phonetic text over the base text
+	// (default: RubyAlignDistributeSpace, Word's "1-2-1")
+	Alignment RubyAlignment
+
+	// PhoneticFontSize is the phonetic text size in half-points (default: 10 = 5pt).
+	// The base text is assumed to be twice this size.
+	PhoneticFontSize int
+
+	// PhoneticFontFamily is the font for the phonetic text (default: inherited)
+	PhoneticFontFamily string
+
+	// Language of the annotated text (default: "ja-JP")
+	Language string
+
+	// Position where to insert the ruby text. With PositionAfterText or
+	// PositionBeforeText it is inserted into the paragraph containing the
+	// anchor text, next to the run holding it; otherwise in a new paragraph.
+	Position InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+}
+
+// RubyEntry describes a ruby annotation found in the document.
+type RubyEntry struct {
+	Base      string        // Annotated base text
+	Phonetic  string        // Phonetic guide text
+	Alignment RubyAlignment // Alignment of the phonetic text
+}
+
+// InsertRuby inserts base text annotated with a phonetic guide (furigana for
+// Japanese, pinyin or bopomofo for Chinese) shown above it.
+func (u *Updater) InsertRuby(base, phonetic string, opts RubyOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if base == "" {
+		return NewValidationError("base", "base text cannot be empty")
+	}
+	if phonetic == "" {
+		return NewValidationError("phonetic", "phonetic text cannot be empty")
+	}
+	switch opts.Alignment {
+	case "", RubyAlignCenter, RubyAlignDistributeLetter, RubyAlignDistributeSpace, RubyAlignLeft, RubyAlignRight:
+	default:
+		return NewValidationError("Alignment", fmt.Sprintf("unsupported ruby alignment %q", opts.Alignment))
+	}
+	if opts.PhoneticFontSize < 0 || opts.PhoneticFontSize > 1638 {
+		return NewValidationError("PhoneticFontSize", "phonetic font size must be between 1 and 1638 half-points")
+	}
+
+	if opts.Alignment == "" {
+		opts.Alignment = RubyAlignDistributeSpace
+	}
+	if opts.PhoneticFontSize == 0 {
+		opts.PhoneticFontSize = 10
+	}
+	if opts.Language == "" {
+		opts.Language = "ja-JP"
+	}
+
+	rubyXML := generateRubyXML(base, phonetic, opts)
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	var updated []byte
+	if opts.Position == PositionAfterText || opts.Position == PositionBeforeText {
+		if opts.Anchor == "" {
+			return NewValidationError("anchor", "anchor text required for position-based insertion")
+		}
+		paraStart, paraEnd, err := findParagraphRangeByAnchor(raw, opts.Anchor)
+		if err != nil {
+			return err
+		}
+		para, err := insertRunInParagraph(raw[paraStart:paraEnd], rubyXML, opts.Anchor, opts.Position)
+		if err != nil {
+			return err
+		}
+		updated = make([]byte, 0, len(raw)+len(rubyXML))
+		updated = append(updated, raw[:paraStart]...)
+		updated = append(updated, para...)
+		updated = append(updated, raw[paraEnd:]...)
+	} else {
+		updated, err = insertElementAtPosition(raw, []byte("<w:p>"+string(rubyXML)+"</w:p>"), opts.Position, opts.Anchor)
+		if err != nil {
+			return fmt.Errorf("insert ruby: %w", err)
+		}
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// generateRubyXML creates a run holding a <w:ruby> element. The rubyPr
+// children are written in schema order; hpsRaise lifts the phonetic text
+// just above the base text.
+func generateRubyXML(base, phonetic string, opts RubyOptions) []byte {
+	baseSize := opts.PhoneticFontSize * 2
+
+	var buf strings.Builder
+	buf.WriteString("<w:r><w:ruby><w:rubyPr>")
+	fmt.Fprintf(&buf, `<w:rubyAlign w:val="%s"/>`, opts.Alignment)
+	fmt.Fprintf(&buf, `<w:hps w:val="%d"/>`, opts.PhoneticFontSize)
+	fmt.Fprintf(&buf, `<w:hpsRaise w:val="%d"/>`, baseSize-2)
+	fmt.Fprintf(&buf, `<w:hpsBaseText w:val="%d"/>`, baseSize)
+	fmt.Fprintf(&buf, `<w:lid w:val="%s"/>`, xmlEscape(opts.Language))
+	buf.WriteString("</w:rubyPr>")
+
+	buf.WriteString("<w:rt><w:r><w:rPr>")
+	if opts.PhoneticFontFamily != "" {
+		font := xmlEscape(opts.PhoneticFontFamily)
+		fmt.Fprintf(&buf, `<w:rFonts w:ascii="%s" w:eastAsia="%s" w:hAnsi="%s"/>`, font, font, font)
+	}
+	fmt.Fprintf(&buf, `<w:sz w:val="%d"/>`, opts.PhoneticFontSize)
+	buf.WriteString("</w:rPr>")
+	fmt.Fprintf(&buf, `<w:t xml:space="preserve">%s</w:t>`, xmlEscapeContent(phonetic))
+	buf.WriteString("</w:r></w:rt>")
+
+	buf.WriteString("<w:rubyBase><w:r><w:rPr>")
+	fmt.Fprintf(&buf, `<w:sz w:val="%d"/>`, baseSize)
+	buf.WriteString("</w:rPr>")
+	fmt.Fprintf(&buf, `<w:t xml:space="preserve">%s</w:t>`, xmlEscapeContent(base))
+	buf.WriteString("</w:r></w:rubyBase>")
+
+	buf.WriteString("</w:ruby></w:r>")
+	return []byte(buf.String())
+}
+
+var (
+	rubyPattern      = regexp.MustCompile(`(?s)<w:ruby>(.*?)</w:ruby>`)
+	rubyTextPattern  = regexp.MustCompile(`(?s)<w:rt>(.*?)</w:rt>`)
+	rubyBasePattern  = regexp.MustCompile(`(?s)<w:rubyBase>(.*?)</w:rubyBase>`)
+	rubyAlignPattern = regexp.MustCompile(`<w:rubyAlign w:val="([^"]*)"`)
+)
+
+// GetRubyText returns the ruby annotations in the document body, in
+// document order.
+func (u *Updater) GetRubyText() ([]RubyEntry, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "document.xml"))
+	if err != nil {
+		return nil, NewFileReadError("document.xml", err)
+	}
+	return parseRubyEntries(raw), nil
+}
+
+// parseRubyEntries extracts the base and phonetic text of every <w:ruby>.
+func parseRubyEntries(docXML []byte) []RubyEntry {
+	var entries []RubyEntry
+	for _, m := range rubyPattern.FindAllSubmatch(docXML, -1) {
+		var entry RubyEntry
+		if rt := rubyTextPattern.FindSubmatch(m[1]); rt != nil {
+			entry.Phonetic = extractRunText(rt[1])
+		}
+		if rb := rubyBasePattern.FindSubmatch(m[1]); rb != nil {
+			entry.Base = extractRunText(rb[1])
+		}
+		if align := rubyAlignPattern.FindSubmatch(m[1]); align != nil {
+			entry.Alignment = RubyAlignment(align[1])
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
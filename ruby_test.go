@@ -0,0 +1,105 @@
+package godocx
+
+import (
+	"encoding/xml"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGenerateRubyXML(t *testing.T) {
+	got := string(generateRubyXML("漢字", "かんじ", RubyOptions{
+		Alignment:          RubyAlignCenter,
+		PhoneticFontSize:   12,
+		PhoneticFontFamily: "MS Mincho",
+		Language:           "ja-JP",
+	}))
+
+	var run struct {
+		Ruby struct {
+			Props struct {
+				Inner string `xml:",innerxml"`
+			} `xml:"rubyPr"`
+			Phonetic string `xml:"rt>r>t"`
+			Base     string `xml:"rubyBase>r>t"`
+		} `xml:"ruby"`
+	}
+	doc := `<w:r xmlns:w="w">` + strings.TrimPrefix(got, "<w:r>")
+	if err := xml.Unmarshal([]byte(doc), &run); err != nil {
+		t.Fatalf("generated XML does not parse: %v\n%s", err, got)
+	}
+	if run.Ruby.Phonetic != "かんじ" || run.Ruby.Base != "漢字" {
+		t.Errorf("phonetic = %q, base = %q", run.Ruby.Phonetic, run.Ruby.Base)
+	}
+
+	wantPr := `<w:rubyAlign w:val="center"/><w:hps w:val="12"/><w:hpsRaise w:val="22"/>` +
+		`<w:hpsBaseText w:val="24"/><w:lid w:val="ja-JP"/>`
+	if run.Ruby.Props.Inner != wantPr {
+		t.Errorf("rubyPr = %s, want %s", run.Ruby.Props.Inner, wantPr)
+	}
+	if !strings.Contains(got, `<w:rt><w:r><w:rPr><w:rFonts w:ascii="MS Mincho" w:eastAsia="MS Mincho" w:hAnsi="MS Mincho"/><w:sz w:val="12"/></w:rPr>`) {
+		t.Errorf("phonetic run should use the phonetic font and size:\n%s", got)
+	}
+	if rt, base := strings.Index(got, "<w:rt>"), strings.Index(got, "<w:rubyBase>"); rt == -1 || base < rt {
+		t.Errorf("<w:rt> must precede <w:rubyBase>:\n%s", got)
+	}
+}
+
+func TestInsertRuby(t *testing.T) {
+	body := `<w:p><w:r><w:t>東京は</w:t></w:r></w:p><w:sectPr/>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.InsertRuby("日本", "にほん", RubyOptions{Position: PositionAfterText, Anchor: "東京は"}); err != nil {
+		t.Fatalf("InsertRuby anchored: %v", err)
+	}
+	if err := u.InsertRuby("北京", "Běijīng", RubyOptions{Position: PositionEnd, Language: "zh-CN", Alignment: RubyAlignDistributeLetter}); err != nil {
+		t.Fatalf("InsertRuby at end: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:t>東京は</w:t></w:r><w:r><w:ruby>`) {
+		t.Errorf("anchored ruby should follow the anchor run in the same paragraph:\n%s", docXML)
+	}
+	if !strings.Contains(docXML, `<w:rubyAlign w:val="distributeSpace"/><w:hps w:val="10"/>`) {
+		t.Errorf("expected default alignment and size:\n%s", docXML)
+	}
+	if !strings.Contains(docXML, `</w:ruby></w:r></w:p><w:sectPr/>`) {
+		t.Errorf("ruby at end should be a new paragraph before sectPr:\n%s", docXML)
+	}
+
+	entries, err := u.GetRubyText()
+	if err != nil {
+		t.Fatalf("GetRubyText: %v", err)
+	}
+	want := []RubyEntry{
+		{Base: "日本", Phonetic: "にほん", Alignment: RubyAlignDistributeSpace},
+		{Base: "北京", Phonetic: "Běijīng", Alignment: RubyAlignDistributeLetter},
+	}
+	if !slices.Equal(entries, want) {
+		t.Errorf("GetRubyText() = %+v, want %+v", entries, want)
+	}
+}
+
+func TestInsertRubyValidation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	if err := u.InsertRuby("", "かな", RubyOptions{}); err == nil {
+		t.Error("expected error for empty base text")
+	}
+	if err := u.InsertRuby("漢字", "", RubyOptions{}); err == nil {
+		t.Error("expected error for empty phonetic text")
+	}
+	if err := u.InsertRuby("漢字", "かんじ", RubyOptions{Alignment: "justify"}); err == nil {
+		t.Error("expected error for unsupported alignment")
+	}
+	if err := u.InsertRuby("漢字", "かんじ", RubyOptions{PhoneticFontSize: -1}); err == nil {
+		t.Error("expected error for negative font size")
+	}
+	if err := u.InsertRuby("漢字", "かんじ", RubyOptions{Position: PositionAfterText}); err == nil {
+		t.Error("expected error for missing anchor")
+	}
+	var nilU *Updater
+	if err := nilU.InsertRuby("漢字", "かんじ", RubyOptions{}); err == nil {
+		t.Error("expected error for nil updater")
+	}
+}
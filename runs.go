@@ -0,0 +1,254 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// InsertLineBreak adds a soft line break (<w:br/>) to the paragraph containing
+// the anchor text. Unlike InsertPageBreak, the break stays within the paragraph,
+// equivalent to pressing Shift+Enter in Word.
+//
+// position controls where in the paragraph the break is placed:
+//   - PositionBeginning: before the first run
+//   - PositionEnd: after the last run
+//   - PositionBeforeText: before the run containing the anchor text
+//   - PositionAfterText: after the run containing the anchor text
+//
+// When the anchor text spans several runs, PositionBeforeText and
+// PositionAfterText fall back to the start and end of the paragraph.
+func (u *Updater) InsertLineBreak(anchor string, position InsertPosition) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if anchor == "" {
+		return NewValidationError("anchor", "anchor text cannot be empty")
+	}
+	return u.updateParagraphByAnchor(anchor, func(para []byte) ([]byte, error) {
+		return insertRunInParagraph(para, []byte("<w:r><w:br/></w:r>"), anchor, position)
+	})
+}
+
+// InsertLineBreakAt adds a soft line break (<w:br/>) at a character offset
+// inside the paragraph containing the anchor text, splitting the run's text
+// so that its formatting is kept on both lines.
+//
+// positionInParagraph is counted in characters of the paragraph text from a
+// reference point chosen by position:
+//   - PositionBeginning: forward from the start of the paragraph
+//   - PositionEnd: backward from the end of the paragraph
+//   - PositionAfterText: forward from the end of the anchor text
+//   - PositionBeforeText: backward from the start of the anchor text
+//
+// Only text content counts towards the offset; tabs and existing breaks do not.
+func (u *Updater) InsertLineBreakAt(anchor string, positionInParagraph int, position InsertPosition) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if anchor == "" {
+		return NewValidationError("anchor", "anchor text cannot be empty")
+	}
+	if positionInParagraph < 0 {
+		return NewValidationError("positionInParagraph", "character position cannot be negative")
+	}
+	return u.updateParagraphByAnchor(anchor, func(para []byte) ([]byte, error) {
+		return insertBreakAtCharacter(para, anchor, positionInParagraph, position)
+	})
+}
+
+// insertBreakAtCharacter inserts <w:br/> into para at the character offset
+// described by InsertLineBreakAt.
+func insertBreakAtCharacter(para []byte, anchor string, offset int, position InsertPosition) ([]byte, error) {
+	segments := extractTextPattern.FindAllSubmatchIndex(para, -1)
+	var text strings.Builder
+	for _, seg := range segments {
+		text.WriteString(xmlUnescape(string(para[seg[2]:seg[3]])))
+	}
+	plain := []rune(text.String())
+
+	var target int
+	switch position {
+	case PositionBeginning:
+		target = offset
+	case PositionEnd:
+		target = len(plain) - offset
+	case PositionAfterText, PositionBeforeText:
+		idx := strings.Index(string(plain), anchor)
+		if idx == -1 {
+			return nil, NewTextNotFoundError(anchor)
+		}
+		start := utf8.RuneCountInString(string(plain)[:idx])
+		if position == PositionAfterText {
+			target = start + utf8.RuneCountInString(anchor) + offset
+		} else {
+			target = start - offset
+		}
+	default:
+		return nil, NewValidationError("position", fmt.Sprintf("invalid insert position: %d", position))
+	}
+	if target < 0 || target > len(plain) {
+		return nil, NewValidationError("positionInParagraph", fmt.Sprintf("character position %d is outside the paragraph text (%d characters)", target, len(plain)))
+	}
+
+	if len(segments) == 0 {
+		return insertRunInParagraph(para, []byte("<w:r><w:br/></w:r>"), anchor, PositionEnd)
+	}
+
+	// Find the text segment holding the target character boundary.
+	for _, seg := range segments {
+		raw := para[seg[2]:seg[3]]
+		n := utf8.RuneCountInString(xmlUnescape(string(raw)))
+		if target > n {
+			target -= n
+			continue
+		}
+
+		var replacement string
+		switch target {
+		case 0:
+			replacement = "<w:br/>" + string(para[seg[0]:seg[1]])
+		case n:
+			replacement = string(para[seg[0]:seg[1]]) + "<w:br/>"
+		default:
+			split := 0
+			for range target {
+				split += firstXMLCharLen(raw[split:])
+			}
+			replacement = `<w:t xml:space="preserve">` + string(raw[:split]) + `</w:t><w:br/>` +
+				`<w:t xml:space="preserve">` + string(raw[split:]) + `</w:t>`
+		}
+
+		result := make([]byte, 0, len(para)+len(replacement))
+		result = append(result, para[:seg[0]]...)
+		result = append(result, replacement...)
+		result = append(result, para[seg[1]:]...)
+		return result, nil
+	}
+	return nil, NewMalformedXMLError("character position not found in paragraph")
+}
+
+// InsertTabStop adds a tab character (<w:tab/>) to the paragraph containing
+// the anchor text. The position argument behaves as in InsertLineBreak. The
+// tab advances to the paragraph's next tab stop; see AddTabStopDefinition to
+// define one.
+func (u *Updater) InsertTabStop(anchor string, position InsertPosition) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if anchor == "" {
+		return NewValidationError("anchor", "anchor text cannot be empty")
+	}
+	return u.updateParagraphByAnchor(anchor, func(para []byte) ([]byte, error) {
+		return insertRunInParagraph(para, []byte("<w:r><w:tab/></w:r>"), anchor, position)
+	})
+}
+
+// SpecialChar identifies a typographic character for InsertSpecialCharacter.
+type SpecialChar string
+
+const (
+	CharNBSP           SpecialChar = "nbsp"           // Non-breaking space (U+00A0)
+	CharNBHyphen       SpecialChar = "nbHyphen"       // Non-breaking hyphen (<w:noBreakHyphen/>)
+	CharOptionalHyphen SpecialChar = "optionalHyphen" // Optional (soft) hyphen (<w:softHyphen/>)
+	CharEnDash         SpecialChar = "enDash"         // En dash (U+2013)
+	CharEmDash         SpecialChar = "emDash"         // Em dash (U+2014)
+	CharEllipsis       SpecialChar = "ellipsis"       // Horizontal ellipsis (U+2026)
+)
+
+// specialCharXML maps each SpecialChar to its run content. Hyphens that
+// affect line breaking have dedicated elements; the others are plain text.
+var specialCharXML = map[SpecialChar]string{
+	CharNBSP:           "<w:t xml:space=\"preserve\">\u00a0</w:t>",
+	CharNBHyphen:       "<w:noBreakHyphen/>",
+	CharOptionalHyphen: "<w:softHyphen/>",
+	CharEnDash:         "<w:t>–</w:t>",
+	CharEmDash:         "<w:t>—</w:t>",
+	CharEllipsis:       "<w:t>…</w:t>",
+}
+
+// InsertSpecialCharacter adds a special character in its own run to the
+// paragraph containing the anchor text. The position argument behaves as in
+// InsertLineBreak.
+func (u *Updater) InsertSpecialCharacter(char SpecialChar, anchor string, position InsertPosition) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	content, ok := specialCharXML[char]
+	if !ok {
+		return NewValidationError("char", fmt.Sprintf("unsupported special character %q", char))
+	}
+	if anchor == "" {
+		return NewValidationError("anchor", "anchor text cannot be empty")
+	}
+	return u.updateParagraphByAnchor(anchor, func(para []byte) ([]byte, error) {
+		return insertRunInParagraph(para, []byte("<w:r>"+content+"</w:r>"), anchor, position)
+	})
+}
+
+// insertRunInParagraph places runXML inside a single <w:p> element at the
+// location described by position.
+func insertRunInParagraph(para, runXML []byte, anchor string, position InsertPosition) ([]byte, error) {
+	var insertPos int
+	switch position {
+	case PositionBeginning:
+		insertPos = paragraphContentStart(para)
+	case PositionEnd:
+		insertPos = paragraphContentEnd(para)
+	case PositionBeforeText:
+		if start, _, ok := findRunContainingText(para, anchor); ok {
+			insertPos = start
+		} else {
+			insertPos = paragraphContentStart(para)
+		}
+	case PositionAfterText:
+		if _, end, ok := findRunContainingText(para, anchor); ok {
+			insertPos = end
+		} else {
+			insertPos = paragraphContentEnd(para)
+		}
+	default:
+		return nil, NewValidationError("position", fmt.Sprintf("invalid insert position: %d", position))
+	}
+
+	result := make([]byte, 0, len(para)+len(runXML))
+	result = append(result, para[:insertPos]...)
+	result = append(result, runXML...)
+	result = append(result, para[insertPos:]...)
+	return result, nil
+}
+
+// paragraphContentStart returns the offset just after the paragraph's opening
+// tag and, when present, its <w:pPr> block.
+func paragraphContentStart(para []byte) int {
+	openEnd := bytes.IndexByte(para, '>') + 1
+	if bytes.HasPrefix(para[openEnd:], []byte("<w:pPr/>")) {
+		return openEnd + len("<w:pPr/>")
+	}
+	if bytes.HasPrefix(para[openEnd:], []byte("<w:pPr")) {
+		if end := bytes.Index(para[openEnd:], []byte("</w:pPr>")); end != -1 {
+			return openEnd + end + len("</w:pPr>")
+		}
+	}
+	return openEnd
+}
+
+// paragraphContentEnd returns the offset of the paragraph's closing </w:p> tag.
+func paragraphContentEnd(para []byte) int {
+	if idx := bytes.LastIndex(para, []byte("</w:p>")); idx != -1 {
+		return idx
+	}
+	return len(para)
+}
+
+// findRunContainingText returns the byte range of the first run whose visible
+// text contains text.
+func findRunContainingText(para []byte, text string) (int, int, bool) {
+	for _, loc := range extractRunPattern.FindAllIndex(para, -1) {
+		if strings.Contains(extractParagraphPlainText(para[loc[0]:loc[1]]), text) {
+			return loc[0], loc[1], true
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,245 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInsertLineBreak_AppendsSoftReturn(t *testing.T) {
+	body := `<w:p><w:pPr><w:jc w:val="left"/></w:pPr><w:r><w:t>First line</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.InsertLineBreak("First line", PositionEnd); err != nil {
+		t.Fatalf("InsertLineBreak: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:r><w:t>First line</w:t></w:r><w:r><w:br/></w:r></w:p>`) {
+		t.Errorf("expected soft return at paragraph end, got: %s", docXML)
+	}
+	if strings.Contains(docXML, `w:type="page"`) {
+		t.Error("soft return must not be a page break")
+	}
+}
+
+func TestInsertLineBreak_Positions(t *testing.T) {
+	body := `<w:p><w:pPr><w:jc w:val="left"/></w:pPr><w:r><w:t>Alpha</w:t></w:r><w:r><w:t>Beta</w:t></w:r></w:p>`
+
+	tests := []struct {
+		name     string
+		position InsertPosition
+		anchor   string
+		want     string
+	}{
+		{"beginning", PositionBeginning, "Beta", `</w:pPr><w:r><w:br/></w:r><w:r><w:t>Alpha</w:t></w:r>`},
+		{"after text", PositionAfterText, "Alpha", `<w:r><w:t>Alpha</w:t></w:r><w:r><w:br/></w:r><w:r><w:t>Beta</w:t></w:r>`},
+		{"before text", PositionBeforeText, "Beta", `<w:r><w:t>Alpha</w:t></w:r><w:r><w:br/></w:r><w:r><w:t>Beta</w:t></w:r>`},
+		{"split anchor falls back to end", PositionAfterText, "AlphaBeta", `<w:r><w:t>Beta</w:t></w:r><w:r><w:br/></w:r></w:p>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+			if err := u.InsertLineBreak(tt.anchor, tt.position); err != nil {
+				t.Fatalf("InsertLineBreak: %v", err)
+			}
+			if docXML := readDocXML(t, u); !strings.Contains(docXML, tt.want) {
+				t.Errorf("expected %s in: %s", tt.want, docXML)
+			}
+		})
+	}
+}
+
+func TestInsertLineBreakAt(t *testing.T) {
+	body := `<w:p><w:r><w:rPr><w:b/></w:rPr><w:t>Hello world</w:t></w:r><w:r><w:t xml:space="preserve"> &amp; goodbye</w:t></w:r></w:p>`
+
+	tests := []struct {
+		name     string
+		anchor   string
+		offset   int
+		position InsertPosition
+		want     string
+	}{
+		{"beginning offset splits run", "Hello", 5, PositionBeginning,
+			`<w:rPr><w:b/></w:rPr><w:t xml:space="preserve">Hello</w:t><w:br/><w:t xml:space="preserve"> world</w:t></w:r>`},
+		{"after anchor at run boundary", "world", 0, PositionAfterText,
+			`<w:t>Hello world</w:t><w:br/></w:r>`},
+		{"end offset counts entities as one character", "goodbye", 8, PositionEnd,
+			`<w:t xml:space="preserve"> &amp;</w:t><w:br/><w:t xml:space="preserve"> goodbye</w:t>`},
+		{"before anchor", "goodbye", 1, PositionBeforeText,
+			`<w:t xml:space="preserve"> &amp;</w:t><w:br/><w:t xml:space="preserve"> goodbye</w:t>`},
+		{"start of paragraph", "Hello", 0, PositionBeginning,
+			`<w:rPr><w:b/></w:rPr><w:br/><w:t>Hello world</w:t>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+			if err := u.InsertLineBreakAt(tt.anchor, tt.offset, tt.position); err != nil {
+				t.Fatalf("InsertLineBreakAt: %v", err)
+			}
+			docXML := readDocXML(t, u)
+			if !strings.Contains(docXML, tt.want) {
+				t.Errorf("expected %s in: %s", tt.want, docXML)
+			}
+			if n := strings.Count(docXML, "<w:br/>"); n != 1 {
+				t.Errorf("expected exactly one break, found %d", n)
+			}
+		})
+	}
+}
+
+func TestInsertLineBreakAt_Errors(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Short</w:t></w:r></w:p>`))
+
+	if err := u.InsertLineBreakAt("Short", 6, PositionBeginning); err == nil {
+		t.Error("expected error for offset past the end")
+	}
+	if err := u.InsertLineBreakAt("Short", 1, PositionBeforeText); err == nil {
+		t.Error("expected error for offset before the start")
+	}
+	if err := u.InsertLineBreakAt("Short", -1, PositionBeginning); err == nil {
+		t.Error("expected error for negative offset")
+	}
+	if err := u.InsertLineBreakAt("", 0, PositionBeginning); err == nil {
+		t.Error("expected error for empty anchor")
+	}
+}
+
+func TestInsertTabStop(t *testing.T) {
+	body := `<w:p><w:r><w:t>Name</w:t></w:r><w:r><w:t>Value</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.InsertTabStop("Name", PositionAfterText); err != nil {
+		t.Fatalf("InsertTabStop: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:r><w:t>Name</w:t></w:r><w:r><w:tab/></w:r><w:r><w:t>Value</w:t></w:r>`) {
+		t.Errorf("expected tab between runs, got: %s", docXML)
+	}
+}
+
+func TestInsertLineBreak_Errors(t *testing.T) {
+	var nilUpdater *Updater
+	if err := nilUpdater.InsertLineBreak("x", PositionEnd); err == nil {
+		t.Error("expected error for nil updater")
+	}
+
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Text</w:t></w:r></w:p>`))
+	if err := u.InsertLineBreak("", PositionEnd); err == nil {
+		t.Error("expected error for empty anchor")
+	}
+	if err := u.InsertTabStop("missing", PositionEnd); err == nil {
+		t.Error("expected error for missing anchor")
+	}
+}
+
+func TestRunOptions_BreakAndTabAfter_RoundTrip(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertParagraph(ParagraphOptions{
+		Position: PositionEnd,
+		Runs: []RunOptions{
+			{Text: "Label", TabAfter: true},
+			{Text: "Line one", BreakAfter: true},
+			{Text: "Line two"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	outPath := filepath.Join(t.TempDir(), "out.docx")
+	if err := u.Save(outPath); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	reopened, err := New(outPath)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer reopened.Cleanup()
+
+	raw, err := os.ReadFile(filepath.Join(reopened.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatalf("read document.xml: %v", err)
+	}
+	docXML := string(raw)
+
+	if !strings.Contains(docXML, `<w:t>Label</w:t><w:tab/></w:r>`) {
+		t.Error("expected tab after Label run")
+	}
+	if !strings.Contains(docXML, `<w:t>Line one</w:t><w:br/></w:r>`) {
+		t.Error("expected soft return after first line")
+	}
+	if strings.Contains(docXML, `w:type="page"`) {
+		t.Error("soft return must not be a page break")
+	}
+}
+
+func TestInsertSpecialCharacter(t *testing.T) {
+	tests := []struct {
+		char SpecialChar
+		want string
+	}{
+		{CharNBSP, "<w:r><w:t xml:space=\"preserve\">\u00a0</w:t></w:r>"},
+		{CharNBHyphen, `<w:r><w:noBreakHyphen/></w:r>`},
+		{CharOptionalHyphen, `<w:r><w:softHyphen/></w:r>`},
+		{CharEnDash, `<w:r><w:t>–</w:t></w:r>`},
+		{CharEmDash, `<w:r><w:t>—</w:t></w:r>`},
+		{CharEllipsis, `<w:r><w:t>…</w:t></w:r>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.char), func(t *testing.T) {
+			body := `<w:p><w:r><w:t>Fig.</w:t></w:r><w:r><w:t>1</w:t></w:r></w:p>`
+			u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+			if err := u.InsertSpecialCharacter(tt.char, "Fig.", PositionAfterText); err != nil {
+				t.Fatalf("InsertSpecialCharacter: %v", err)
+			}
+			docXML := readDocXML(t, u)
+			if !strings.Contains(docXML, `<w:t>Fig.</w:t></w:r>`+tt.want+`<w:r><w:t>1</w:t></w:r>`) {
+				t.Errorf("expected %s after the anchor run, got: %s", tt.want, docXML)
+			}
+		})
+	}
+
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Text</w:t></w:r></w:p>`))
+	if err := u.InsertSpecialCharacter("pilcrow", "Text", PositionEnd); err == nil {
+		t.Error("expected error for unsupported character")
+	}
+	if err := u.InsertSpecialCharacter(CharEmDash, "", PositionEnd); err == nil {
+		t.Error("expected error for empty anchor")
+	}
+}
+
+func TestRunOptions_NonBreakingSpaceAndSoftHyphen(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertParagraph(ParagraphOptions{
+		Position: PositionEnd,
+		Runs: []RunOptions{
+			{Text: "See Fig.", NonBreakingSpace: true},
+			{Text: "1 for the super", SoftHyphen: true},
+			{Text: "califragilistic result"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, "<w:t>See Fig.</w:t><w:t xml:space=\"preserve\">\u00a0</w:t></w:r>") {
+		t.Errorf("expected non-breaking space after the run text, got: %s", docXML)
+	}
+	if !strings.Contains(docXML, `<w:t>1 for the super</w:t><w:softHyphen/></w:r>`) {
+		t.Errorf("expected soft hyphen after the run text, got: %s", docXML)
+	}
+	if strings.Contains(docXML, "<w:noBreakHyphen/>") {
+		t.Error("a non-breaking space must not be written as a non-breaking hyphen")
+	}
+}
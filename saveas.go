@@ -0,0 +1,252 @@
+package godocx
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// OutputFormat selects the file format written by SaveAs.
+type OutputFormat string
+
+const (
+	// FormatDOCX is a regular Word document (.docx), the format written by Save.
+	FormatDOCX OutputFormat = "docx"
+
+	// FormatDOCM is a macro-enabled Word document (.docm).
+	FormatDOCM OutputFormat = "docm"
+
+	// FormatDOT is a Word template (.dotx).
+	FormatDOT OutputFormat = "dotx"
+
+	// FormatDOTM is a macro-enabled Word template (.dotm).
+	FormatDOTM OutputFormat = "dotm"
+
+	// FormatFlatOPC is the single-file XML form of the package (Word XML
+	// Document, .xml), readable by Word and by XML tooling without unzipping.
+	FormatFlatOPC OutputFormat = "flatopc"
+)
+
+// flatOPCNamespace is the namespace of the pkg:package root of a Flat OPC file.
+const flatOPCNamespace = "http://schemas.microsoft.com/office/2006/xmlPackage"
+
+// mainContentType returns the content type of word/document.xml for format.
+func (f OutputFormat) mainContentType() (string, error) {
+	switch f {
+	case FormatDOCX, FormatFlatOPC:
+		return DocxMainContentType, nil
+	case FormatDOCM:
+		return DocmMainContentType, nil
+	case FormatDOT:
+		return DotxMainContentType, nil
+	case FormatDOTM:
+		return DotmMainContentType, nil
+	}
+	return "", NewValidationError("format", fmt.Sprintf("unsupported output format %q", string(f)))
+}
+
+// SaveAs writes the document to outputPath in the given format. The formats
+// differ only in the content type of the main document part, so the same
+// document can be saved as a document, a template or a Flat OPC XML file.
+//
+// For the macro-enabled formats the macro project content type is registered
+// in [Content_Types].xml so a vbaProject.bin part can be added later; SaveAs
+// does not create any macros. The Updater itself is not modified, and a later
+// Save still writes a regular DOCX.
+//
+// Like SaveAtomically, SaveAs writes to outputPath + ".tmp" and renames it
+// into place, so a failed save leaves no partial file at outputPath.
+func (u *Updater) SaveAs(outputPath string, format OutputFormat) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if outputPath == "" {
+		return NewValidationError("outputPath", "output path is required")
+	}
+	mainType, err := format.mainContentType()
+	if err != nil {
+		return err
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "[Content_Types].xml"))
+	if err != nil {
+		return NewFileReadError("[Content_Types].xml", err)
+	}
+	contentTypes := setMainContentType(string(raw), mainType)
+	if format == FormatDOCM || format == FormatDOTM {
+		contentTypes = ensureVBAProjectContentType(contentTypes)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return NewFileWriteError("output dir", err)
+	}
+	tmpPath := outputPath + ".tmp"
+	out, err := os.Create(tmpPath)
+	if err != nil {
+		return NewFileWriteError("output file", err)
+	}
+
+	if format == FormatFlatOPC {
+		err = writeFlatOPC(u.tempDir, contentTypes, out)
+	} else {
+		err = writeZipFromDirReplacing(u.tempDir, out, map[string][]byte{
+			"[Content_Types].xml": []byte(contentTypes),
+		}, nil)
+	}
+	if err != nil {
+		out.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		os.Remove(tmpPath)
+		return NewFileWriteError(outputPath, err)
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmpPath)
+		return NewFileWriteError(outputPath, err)
+	}
+	if err := renameWithRetry(tmpPath, outputPath); err != nil {
+		os.Remove(tmpPath)
+		return NewFileWriteError(outputPath, err)
+	}
+	return nil
+}
+
+// setMainContentType replaces the content type of the main document part,
+// whichever of the document and template types it currently has.
+func setMainContentType(contentTypes, mainType string) string {
+	for _, ct := range []string{DocxMainContentType, DocmMainContentType, DotxMainContentType, DotmMainContentType} {
+		if ct != mainType {
+			contentTypes = strings.ReplaceAll(contentTypes, `"`+ct+`"`, `"`+mainType+`"`)
+		}
+	}
+	return contentTypes
+}
+
+// ensureVBAProjectContentType registers the content type of the .bin macro
+// project part unless .bin files already have a default content type.
+func ensureVBAProjectContentType(contentTypes string) string {
+	if strings.Contains(contentTypes, `Extension="bin"`) {
+		return contentTypes
+	}
+	def := fmt.Sprintf(`<Default Extension="bin" ContentType="%s"/>`, VBAProjectContentType)
+	return strings.Replace(contentTypes, "</Types>", def+"</Types>", 1)
+}
+
+// flatOPCContentTypes is the subset of [Content_Types].xml needed to find the
+// content type of each part.
+type flatOPCContentTypes struct {
+	Defaults []struct {
+		Extension   string `xml:"Extension,attr"`
+		ContentType string `xml:"ContentType,attr"`
+	} `xml:"Default"`
+	Overrides []struct {
+		PartName    string `xml:"PartName,attr"`
+		ContentType string `xml:"ContentType,attr"`
+	} `xml:"Override"`
+}
+
+// writeFlatOPC writes the package in sourceDir as a Flat OPC document: every
+// part becomes a pkg:part element carrying its content type, with XML parts
+// embedded as-is and other parts base64 encoded. [Content_Types].xml itself
+// is not written, as its information moves onto the parts.
+func writeFlatOPC(sourceDir, contentTypesXML string, w io.Writer) error {
+	var types flatOPCContentTypes
+	if err := xml.Unmarshal([]byte(contentTypesXML), &types); err != nil {
+		return NewXMLParseError("[Content_Types].xml", err)
+	}
+	defaults := make(map[string]string, len(types.Defaults))
+	for _, d := range types.Defaults {
+		defaults[strings.ToLower(d.Extension)] = d.ContentType
+	}
+	overrides := make(map[string]string, len(types.Overrides))
+	for _, o := range types.Overrides {
+		overrides[strings.ToLower(o.PartName)] = o.ContentType
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(`<?xml version="1.0" standalone="yes"?>` + "\n")
+	bw.WriteString(`<?mso-application progid="Word.Document"?>` + "\n")
+	fmt.Fprintf(bw, `<pkg:package xmlns:pkg="%s">`, flatOPCNamespace)
+
+	walkErr := filepath.WalkDir(sourceDir, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return NewFileReadError(p, walkErr)
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return NewFileReadError(p, err)
+		}
+		partName := "/" + filepath.ToSlash(rel)
+		if partName == "/[Content_Types].xml" {
+			return nil
+		}
+
+		contentType, ok := overrides[strings.ToLower(partName)]
+		if !ok {
+			ext := strings.TrimPrefix(path.Ext(partName), ".")
+			if contentType, ok = defaults[strings.ToLower(ext)]; !ok {
+				return NewInvalidFileError(fmt.Sprintf("no content type for part %s", partName), nil)
+			}
+		}
+
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return NewFileReadError(partName, err)
+		}
+
+		if strings.HasSuffix(contentType, "xml") {
+			fmt.Fprintf(bw, `<pkg:part pkg:name="%s" pkg:contentType="%s"><pkg:xmlData>`,
+				xmlEscape(partName), xmlEscape(contentType))
+			bw.Write(stripXMLDeclaration(data))
+			bw.WriteString(`</pkg:xmlData></pkg:part>`)
+			return nil
+		}
+
+		fmt.Fprintf(bw, `<pkg:part pkg:name="%s" pkg:contentType="%s" pkg:compression="store"><pkg:binaryData>`,
+			xmlEscape(partName), xmlEscape(contentType))
+		encoded := base64.StdEncoding.EncodeToString(data)
+		for len(encoded) > 76 {
+			bw.WriteString(encoded[:76])
+			bw.WriteByte('\n')
+			encoded = encoded[76:]
+		}
+		bw.WriteString(encoded)
+		bw.WriteString(`</pkg:binaryData></pkg:part>`)
+		return nil
+	})
+	if walkErr != nil {
+		return walkErr
+	}
+
+	bw.WriteString(`</pkg:package>`)
+	if err := bw.Flush(); err != nil {
+		return NewFileWriteError("flat OPC package", err)
+	}
+	return nil
+}
+
+// stripXMLDeclaration removes a leading byte order mark and <?xml ...?>
+// declaration, which may not appear inside pkg:xmlData.
+func stripXMLDeclaration(data []byte) []byte {
+	s := strings.TrimPrefix(string(data), "\ufeff")
+	s = strings.TrimLeft(s, " \t\r\n")
+	if strings.HasPrefix(s, "<?xml ") {
+		if end := strings.Index(s, "?>"); end != -1 {
+			s = strings.TrimLeft(s[end+2:], " \t\r\n")
+		}
+	}
+	return []byte(s)
+}
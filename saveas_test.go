@@ -0,0 +1,235 @@
+package godocx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readZipFileEntry(t *testing.T, zipPath, name string) string {
+	t.Helper()
+	r, err := zip.OpenReader(zipPath)
+	if err != nil {
+		t.Fatalf("open zip: %v", err)
+	}
+	defer r.Close()
+	for _, f := range r.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", name, err)
+		}
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		return string(data)
+	}
+	t.Fatalf("zip entry %s not found", name)
+	return ""
+}
+
+func TestSaveAsPackageFormats(t *testing.T) {
+	tests := []struct {
+		format   OutputFormat
+		mainType string
+		macros   bool
+	}{
+		{FormatDOCX, DocxMainContentType, false},
+		{FormatDOCM, DocmMainContentType, true},
+		{FormatDOT, DotxMainContentType, false},
+		{FormatDOTM, DotmMainContentType, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			u, err := NewBlank()
+			if err != nil {
+				t.Fatalf("NewBlank: %v", err)
+			}
+			defer u.Cleanup()
+
+			out := filepath.Join(t.TempDir(), "out."+string(tt.format))
+			if err := u.SaveAs(out, tt.format); err != nil {
+				t.Fatalf("SaveAs: %v", err)
+			}
+
+			ct := readZipFileEntry(t, out, "[Content_Types].xml")
+			if !strings.Contains(ct, `PartName="/word/document.xml" ContentType="`+tt.mainType+`"`) {
+				t.Errorf("main document content type should be %s:\n%s", tt.mainType, ct)
+			}
+			if got := strings.Contains(ct, VBAProjectContentType); got != tt.macros {
+				t.Errorf("VBA project content type present = %v, want %v", got, tt.macros)
+			}
+			if !strings.Contains(readZipFileEntry(t, out, "word/document.xml"), "<w:body>") {
+				t.Error("document.xml should be copied unchanged")
+			}
+
+			// The working copy keeps the regular document content type.
+			raw, err := os.ReadFile(filepath.Join(u.tempDir, "[Content_Types].xml"))
+			if err != nil {
+				t.Fatalf("read content types: %v", err)
+			}
+			if !strings.Contains(string(raw), DocxMainContentType) {
+				t.Error("SaveAs should not modify the updater's content types")
+			}
+		})
+	}
+}
+
+func TestSaveAsTemplateToDocument(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	dotm := filepath.Join(t.TempDir(), "template.dotm")
+	if err := u.SaveAs(dotm, FormatDOTM); err != nil {
+		t.Fatalf("SaveAs dotm: %v", err)
+	}
+	tmpl, err := New(dotm)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer tmpl.Cleanup()
+
+	docx := filepath.Join(t.TempDir(), "doc.docx")
+	if err := tmpl.SaveAs(docx, FormatDOCX); err != nil {
+		t.Fatalf("SaveAs docx: %v", err)
+	}
+	ct := readZipFileEntry(t, docx, "[Content_Types].xml")
+	if strings.Contains(ct, DotmMainContentType) || !strings.Contains(ct, DocxMainContentType) {
+		t.Errorf("expected document content type after conversion:\n%s", ct)
+	}
+}
+
+func TestSaveAsFlatOPC(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.AddText("Flat & simple", PositionEnd); err != nil {
+		t.Fatalf("AddText: %v", err)
+	}
+	image := []byte{0x89, 'P', 'N', 'G', 0, 1, 2, 3}
+	if err := os.MkdirAll(filepath.Join(u.tempDir, "word", "media"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(u.tempDir, "word", "media", "image1.png"), image, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.addImageContentType("png", ImagePNGType); err != nil {
+		t.Fatalf("addImageContentType: %v", err)
+	}
+
+	out := filepath.Join(t.TempDir(), "flat.xml")
+	if err := u.SaveAs(out, FormatFlatOPC); err != nil {
+		t.Fatalf("SaveAs: %v", err)
+	}
+	raw, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("read output: %v", err)
+	}
+	if !bytes.Contains(raw, []byte(`<?mso-application progid="Word.Document"?>`)) {
+		t.Error("missing mso-application processing instruction")
+	}
+
+	var pkg struct {
+		Parts []struct {
+			Name        string `xml:"name,attr"`
+			ContentType string `xml:"contentType,attr"`
+			XMLData     struct {
+				Inner string `xml:",innerxml"`
+			} `xml:"xmlData"`
+			BinaryData string `xml:"binaryData"`
+		} `xml:"part"`
+	}
+	if err := xml.Unmarshal(raw, &pkg); err != nil {
+		t.Fatalf("output is not well-formed XML: %v", err)
+	}
+
+	parts := make(map[string]int)
+	for i, p := range pkg.Parts {
+		parts[p.Name] = i
+	}
+	if _, ok := parts["/[Content_Types].xml"]; ok {
+		t.Error("[Content_Types].xml should not be written as a part")
+	}
+	for _, name := range []string{"/_rels/.rels", "/word/document.xml", "/word/media/image1.png"} {
+		if _, ok := parts[name]; !ok {
+			t.Fatalf("missing part %s", name)
+		}
+	}
+
+	doc := pkg.Parts[parts["/word/document.xml"]]
+	if doc.ContentType != DocxMainContentType {
+		t.Errorf("document content type = %q", doc.ContentType)
+	}
+	if strings.Contains(doc.XMLData.Inner, "<?xml") || !strings.Contains(doc.XMLData.Inner, "Flat &amp; simple") {
+		t.Errorf("unexpected document xmlData: %s", doc.XMLData.Inner)
+	}
+
+	img := pkg.Parts[parts["/word/media/image1.png"]]
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(img.BinaryData, "\n", ""))
+	if err != nil || !bytes.Equal(decoded, image) {
+		t.Errorf("image binaryData round trip = %v, %v", decoded, err)
+	}
+}
+
+func TestSaveAsValidation(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.SaveAs(filepath.Join(t.TempDir(), "out.doc"), OutputFormat("doc")); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+	if err := u.SaveAs("", FormatDOCX); err == nil {
+		t.Error("expected error for empty path")
+	}
+	var nilU *Updater
+	if err := nilU.SaveAs("out.docx", FormatDOCX); err == nil {
+		t.Error("expected error for nil updater")
+	}
+}
+
+func TestSaveAsFailureLeavesNoPartialOutput(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	// A part without a content type makes the Flat OPC writer fail midway.
+	if err := os.WriteFile(filepath.Join(u.TempDir(), "word", "stray.unknown"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("write stray part: %v", err)
+	}
+	outPath := filepath.Join(t.TempDir(), "out.xml")
+	if err := os.WriteFile(outPath, []byte("previous"), 0o644); err != nil {
+		t.Fatalf("write previous output: %v", err)
+	}
+
+	if err := u.SaveAs(outPath, FormatFlatOPC); err == nil {
+		t.Fatal("expected error for part without content type")
+	}
+	if got, err := os.ReadFile(outPath); err != nil || string(got) != "previous" {
+		t.Errorf("output after failed SaveAs = %q, %v; want previous file untouched", got, err)
+	}
+	if _, err := os.Stat(outPath + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("temporary file left behind: %v", err)
+	}
+}
@@ -18,7 +18,7 @@ func TestGenerateScatterChartXML(t *testing.T) {
 		},
 	}
 
-	result := generateScatterChartXML(opts)
+	result := generateScatterChartXML(opts, newChartAxisIDs(1))
 
 	if !strings.Contains(result, "<c:scatterChart>") {
 		t.Error("expected scatterChart element")
@@ -46,7 +46,7 @@ func TestGenerateScatterChartXML_DefaultStyle(t *testing.T) {
 		},
 	}
 
-	result := generateScatterChartXML(opts)
+	result := generateScatterChartXML(opts, newChartAxisIDs(1))
 
 	if !strings.Contains(result, `<c:scatterStyle val="marker"/>`) {
 		t.Error("expected default marker style")
@@ -69,7 +69,7 @@ func TestGenerateScatterChartXML_WithDataLabels(t *testing.T) {
 		},
 	}
 
-	result := generateScatterChartXML(opts)
+	result := generateScatterChartXML(opts, newChartAxisIDs(1))
 
 	if !strings.Contains(result, `<c:showVal val="1"/>`) {
 		t.Error("expected data labels with showVal")
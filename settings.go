@@ -0,0 +1,194 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DocumentSettings holds document-wide options stored in word/settings.xml.
+// Zero-valued fields leave the corresponding setting unchanged.
+type DocumentSettings struct {
+	// DefaultTabStop is the interval between automatic tab stops, in twips
+	// (Word's default is 720 = 0.5").
+	DefaultTabStop int
+}
+
+// SetDocumentSettings applies document-wide settings, creating word/settings.xml
+// when the document does not have one yet.
+func (u *Updater) SetDocumentSettings(settings DocumentSettings) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if settings.DefaultTabStop < 0 {
+		return NewValidationError("DefaultTabStop", "default tab stop cannot be negative")
+	}
+
+	return u.updateSettings(func(children []xmlChild) []xmlChild {
+		if settings.DefaultTabStop > 0 {
+			children = upsertOrderedChild(children, "w:defaultTabStop",
+				fmt.Sprintf(`<w:defaultTabStop w:val="%d"/>`, settings.DefaultTabStop), settingsChildOrder)
+		}
+		return children
+	})
+}
+
+// settingsChildOrder is the element sequence mandated by CT_Settings
+// (ECMA-376 Part 1 §17.15.1.78).
+var settingsChildOrder = []string{
+	"writeProtection", "view", "zoom", "removePersonalInformation", "removeDateAndTime",
+	"doNotDisplayPageBoundaries", "displayBackgroundShape", "printPostScriptOverText",
+	"printFractionalCharacterWidth", "printFormsData", "embedTrueTypeFonts", "embedSystemFonts",
+	"saveSubsetFonts", "saveFormsData", "mirrorMargins", "alignBordersAndEdges",
+	"bordersDoNotSurroundHeader", "bordersDoNotSurroundFooter", "gutterAtTop",
+	"hideSpellingErrors", "hideGrammaticalErrors", "activeWritingStyle", "proofState",
+	"formsDesign", "attachedTemplate", "linkStyles", "stylePaneFormatFilter",
+	"stylePaneSortMethod", "documentType", "mailMerge", "revisionView", "trackRevisions",
+	"doNotTrackMoves", "doNotTrackFormatting", "documentProtection", "autoFormatOverride",
+	"styleLockTheme", "styleLockQFSet", "defaultTabStop", "autoHyphenation",
+	"consecutiveHyphenLimit", "hyphenationZone", "doNotHyphenateCaps", "showEnvelope",
+	"summaryLength", "clickAndTypeStyle", "defaultTableStyle", "evenAndOddHeaders",
+	"bookFoldRevPrinting", "bookFoldPrinting", "bookFoldPrintingSheets",
+	"drawingGridHorizontalSpacing", "drawingGridVerticalSpacing",
+	"displayHorizontalDrawingGridEvery", "displayVerticalDrawingGridEvery",
+	"doNotUseMarginsForDrawingGridOrigin", "drawingGridHorizontalOrigin",
+	"drawingGridVerticalOrigin", "doNotShadeFormData", "noPunctuationKerning",
+	"characterSpacingControl", "printTwoOnOne", "strictFirstAndLastChars",
+	"noLineBreaksAfter", "noLineBreaksBefore", "savePreviewPicture",
+	"doNotValidateAgainstSchema", "saveInvalidXml", "ignoreMixedContent",
+	"alwaysShowPlaceholderText", "doNotDemarcateInvalidXml", "saveXmlDataOnly",
+	"useXSLTWhenSaving", "saveThroughXslt", "showXMLTags", "alwaysMergeEmptyNamespace",
+	"updateFields", "hdrShapeDefaults", "footnotePr", "endnotePr", "compat", "docVars",
+	"rsids", "mathPr", "attachedSchema", "themeFontLang", "clrSchemeMapping",
+	"doNotIncludeSubdocsInStats", "doNotAutoCompressPictures", "forceUpgrade", "captions",
+	"readModeInkLockDown", "smartTagType", "schemaLibrary", "shapeDefaults",
+	"doNotEmbedSmartTags", "decimalSymbol", "listSeparator",
+}
+
+// updateSettings rewrites the children of the <w:settings> root element with fn.
+func (u *Updater) updateSettings(fn func(children []xmlChild) []xmlChild) error {
+	if err := u.ensureSettingsXML(); err != nil {
+		return err
+	}
+
+	settingsPath := filepath.Join(u.tempDir, "word", "settings.xml")
+	raw, err := os.ReadFile(settingsPath)
+	if err != nil {
+		return NewFileReadError("settings.xml", err)
+	}
+
+	rootStart := findNextTagStart(raw, 0, "w:settings")
+	if rootStart == -1 {
+		return NewMalformedXMLError("settings.xml has no <w:settings> root element")
+	}
+	openEnd := bytes.IndexByte(raw[rootStart:], '>')
+	if openEnd == -1 {
+		return NewMalformedXMLError("malformed <w:settings> element")
+	}
+	innerStart := rootStart + openEnd + 1
+	openTag := raw[rootStart:innerStart]
+
+	var children []xmlChild
+	tailStart := innerStart
+	if bytes.HasSuffix(openTag, []byte("/>")) {
+		openTag = append(bytes.TrimSuffix(bytes.Clone(openTag), []byte("/>")), '>')
+	} else {
+		closeRel := findMatchingClose(raw[rootStart:], "w:settings")
+		if closeRel == -1 {
+			return NewMalformedXMLError("malformed settings.xml: closing tag not found")
+		}
+		innerEnd := rootStart + closeRel
+		children = splitXMLChildren(raw[innerStart:innerEnd])
+		tailStart = innerEnd + len("</w:settings>")
+	}
+
+	children = fn(children)
+
+	var buf bytes.Buffer
+	buf.Write(raw[:rootStart])
+	buf.Write(openTag)
+	for _, c := range children {
+		buf.Write(c.xml)
+	}
+	buf.WriteString("</w:settings>")
+	buf.Write(raw[tailStart:])
+
+	if err := atomicWriteFile(settingsPath, buf.Bytes(), 0o644); err != nil {
+		return NewXMLWriteError("settings.xml", err)
+	}
+	return nil
+}
+
+// ensureSettingsXML creates word/settings.xml with its relationship and content
+// type when they are missing.
+func (u *Updater) ensureSettingsXML() error {
+	settingsPath := filepath.Join(u.tempDir, "word", "settings.xml")
+	if _, err := os.Stat(settingsPath); os.IsNotExist(err) {
+		if err := atomicWriteFile(settingsPath, []byte(generateInitialSettingsXML()), 0o644); err != nil {
+			return NewXMLWriteError("settings.xml", err)
+		}
+	} else if err != nil {
+		return NewFileReadError("settings.xml", err)
+	}
+
+	if err := u.ensureSettingsRelationship(); err != nil {
+		return fmt.Errorf("update relationships: %w", err)
+	}
+	if err := u.ensureSettingsContentType(); err != nil {
+		return fmt.Errorf("update content types: %w", err)
+	}
+	return nil
+}
+
+func generateInitialSettingsXML() string {
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+		`<w:settings xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:defaultTabStop w:val="720"/>` +
+		`<w:characterSpacingControl w:val="doNotCompress"/>` +
+		`</w:settings>`
+}
+
+// ensureSettingsRelationship adds the settings.xml relationship to document.xml.rels if not present
+func (u *Updater) ensureSettingsRelationship() error {
+	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
+	data, err := os.ReadFile(relsPath)
+	if err != nil {
+		return NewFileReadError("document.xml.rels", err)
+	}
+
+	content := string(data)
+	if strings.Contains(content, `Target="settings.xml"`) {
+		return nil
+	}
+
+	relID, err := getNextRelIDFromFile(relsPath)
+	if err != nil {
+		return fmt.Errorf("find next relationship id: %w", err)
+	}
+
+	settingsRel := fmt.Sprintf(`<Relationship Id="%s" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/settings" Target="settings.xml"/>`, relID)
+	content = strings.Replace(content, "</Relationships>", settingsRel+"</Relationships>", 1)
+
+	return atomicWriteFile(relsPath, []byte(content), 0o644)
+}
+
+// ensureSettingsContentType adds the settings.xml override to [Content_Types].xml if not present
+func (u *Updater) ensureSettingsContentType() error {
+	contentTypesPath := filepath.Join(u.tempDir, "[Content_Types].xml")
+	data, err := os.ReadFile(contentTypesPath)
+	if err != nil {
+		return NewFileReadError("[Content_Types].xml", err)
+	}
+
+	content := string(data)
+	if strings.Contains(content, `PartName="/word/settings.xml"`) {
+		return nil
+	}
+
+	override := `<Override PartName="/word/settings.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.settings+xml"/>`
+	content = strings.Replace(content, "</Types>", override+"</Types>", 1)
+
+	return atomicWriteFile(contentTypesPath, []byte(content), 0o644)
+}
@@ -0,0 +1,126 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetDocumentSettings_CreatesSettingsPart(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	if err := u.SetDocumentSettings(DocumentSettings{DefaultTabStop: 360}); err != nil {
+		t.Fatalf("SetDocumentSettings: %v", err)
+	}
+
+	settings := readTempFile(t, u, "word/settings.xml")
+	if !strings.Contains(settings, `<w:defaultTabStop w:val="360"/><w:characterSpacingControl`) {
+		t.Errorf("expected updated defaultTabStop, got: %s", settings)
+	}
+	if strings.Count(settings, "<w:defaultTabStop") != 1 {
+		t.Errorf("expected exactly one defaultTabStop, got: %s", settings)
+	}
+
+	rels := readTempFile(t, u, "word/_rels/document.xml.rels")
+	if !strings.Contains(rels, `Target="settings.xml"`) {
+		t.Errorf("expected settings relationship, got: %s", rels)
+	}
+	ct := readTempFile(t, u, "[Content_Types].xml")
+	if !strings.Contains(ct, `PartName="/word/settings.xml"`) {
+		t.Errorf("expected settings content type override, got: %s", ct)
+	}
+}
+
+func TestSetDocumentSettings_KeepsElementOrder(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	existing := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:settings xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:zoom w:percent="100"/><w:compat/></w:settings>`
+	if err := os.WriteFile(filepath.Join(u.TempDir(), "word", "settings.xml"), []byte(existing), 0o644); err != nil {
+		t.Fatalf("write settings.xml: %v", err)
+	}
+
+	if err := u.SetDocumentSettings(DocumentSettings{DefaultTabStop: 1440}); err != nil {
+		t.Fatalf("SetDocumentSettings: %v", err)
+	}
+
+	settings := readTempFile(t, u, "word/settings.xml")
+	if !strings.Contains(settings, `<w:zoom w:percent="100"/><w:defaultTabStop w:val="1440"/><w:compat/>`) {
+		t.Errorf("expected defaultTabStop between zoom and compat, got: %s", settings)
+	}
+}
+
+func TestSetDocumentSettings_Validation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	if err := u.SetDocumentSettings(DocumentSettings{DefaultTabStop: -1}); err == nil {
+		t.Error("expected error for negative default tab stop")
+	}
+
+	var nilUpdater *Updater
+	if err := nilUpdater.SetDocumentSettings(DocumentSettings{}); err == nil {
+		t.Error("expected error for nil updater")
+	}
+}
+
+func readTempFile(t *testing.T, u *Updater, relPath string) string {
+	t.Helper()
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), filepath.FromSlash(relPath)))
+	if err != nil {
+		t.Fatalf("read %s: %v", relPath, err)
+	}
+	return string(raw)
+}
+
+func TestSetCompatibilityMode(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	if mode, err := u.GetCompatibilityMode(); err != nil || mode != "" {
+		t.Fatalf("GetCompatibilityMode on a document without settings = %q, %v", mode, err)
+	}
+
+	existing := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:settings xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:zoom w:percent="100"/><w:compat><w:useFELayout/>` +
+		`<w:compatSetting w:name="compatibilityMode" w:uri="http://schemas.microsoft.com/office/word" w:val="14"/>` +
+		`</w:compat><w:rsids/></w:settings>`
+	if err := os.WriteFile(filepath.Join(u.TempDir(), "word", "settings.xml"), []byte(existing), 0o644); err != nil {
+		t.Fatalf("write settings.xml: %v", err)
+	}
+	if mode, err := u.GetCompatibilityMode(); err != nil || mode != CompatWord2010 {
+		t.Fatalf("GetCompatibilityMode = %q, %v; want %q", mode, err, CompatWord2010)
+	}
+
+	if err := u.SetCompatibilityMode(CompatWord365); err != nil {
+		t.Fatalf("SetCompatibilityMode: %v", err)
+	}
+	settings := readTempFile(t, u, "word/settings.xml")
+	if !strings.Contains(settings, `<w:compat><w:useFELayout/><w:compatSetting w:name="compatibilityMode" w:uri="http://schemas.microsoft.com/office/word" w:val="15"/>`) {
+		t.Errorf("expected legacy option kept before mode 15, got: %s", settings)
+	}
+	if n := strings.Count(settings, `w:name="compatibilityMode"`); n != 1 {
+		t.Errorf("expected one compatibilityMode setting, found %d", n)
+	}
+	if !strings.Contains(settings, "</w:compat><w:rsids/>") {
+		t.Errorf("expected compat to stay before rsids, got: %s", settings)
+	}
+	if mode, err := u.GetCompatibilityMode(); err != nil || mode != CompatWord2019 {
+		t.Errorf("GetCompatibilityMode = %q, %v; want %q", mode, err, CompatWord2019)
+	}
+
+	if err := u.SetCompatibilityMode(CompatWord2016); err != nil {
+		t.Fatalf("SetCompatibilityMode: %v", err)
+	}
+	if mode, _ := u.GetCompatibilityMode(); mode != CompatWord2013 {
+		t.Errorf("GetCompatibilityMode = %q, want %q", mode, CompatWord2013)
+	}
+	if strings.Contains(readTempFile(t, u, "word/settings.xml"), "useWord2013TrackBottomHyphenation") {
+		t.Error("expected Word 2019 setting to be removed")
+	}
+
+	if err := u.SetCompatibilityMode("Word97"); err == nil {
+		t.Error("expected error for unsupported version")
+	}
+}
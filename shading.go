@@ -0,0 +1,111 @@
+package godocx
+
+import "fmt"
+
+// ShadingPattern is the fill pattern of a paragraph or style background
+// (the w:val attribute of <w:shd>).
+type ShadingPattern string
+
+const (
+	ShadingClear                 ShadingPattern = "clear" // Solid background fill, no pattern
+	ShadingSolid                 ShadingPattern = "solid" // Pattern color fills the whole area
+	ShadingHorzStripe            ShadingPattern = "horzStripe"
+	ShadingVertStripe            ShadingPattern = "vertStripe"
+	ShadingDiagStripe            ShadingPattern = "diagStripe"
+	ShadingReverseDiagStripe     ShadingPattern = "reverseDiagStripe"
+	ShadingHorzCross             ShadingPattern = "horzCross"
+	ShadingDiagCross             ShadingPattern = "diagCross"
+	ShadingThinHorzStripe        ShadingPattern = "thinHorzStripe"
+	ShadingThinVertStripe        ShadingPattern = "thinVertStripe"
+	ShadingThinDiagStripe        ShadingPattern = "thinDiagStripe"
+	ShadingThinReverseDiagStripe ShadingPattern = "thinReverseDiagStripe"
+	ShadingThinHorzCross         ShadingPattern = "thinHorzCross"
+	ShadingThinDiagCross         ShadingPattern = "thinDiagCross"
+	ShadingPct10                 ShadingPattern = "pct10"
+	ShadingPct25                 ShadingPattern = "pct25"
+	ShadingPct50                 ShadingPattern = "pct50"
+	ShadingPct75                 ShadingPattern = "pct75"
+)
+
+// validShadingPatterns lists the ST_Shd values accepted by ShadingPattern.
+var validShadingPatterns = map[ShadingPattern]bool{
+	ShadingClear: true, ShadingSolid: true,
+	ShadingHorzStripe: true, ShadingVertStripe: true,
+	ShadingDiagStripe: true, ShadingReverseDiagStripe: true,
+	ShadingHorzCross: true, ShadingDiagCross: true,
+	ShadingThinHorzStripe: true, ShadingThinVertStripe: true,
+	ShadingThinDiagStripe: true, ShadingThinReverseDiagStripe: true,
+	ShadingThinHorzCross: true, ShadingThinDiagCross: true,
+	ShadingPct10: true, ShadingPct25: true, ShadingPct50: true, ShadingPct75: true,
+}
+
+// HighlightColor is one of Word's named text highlight colors.
+type HighlightColor string
+
+const (
+	HighlightYellow      HighlightColor = "yellow"
+	HighlightGreen       HighlightColor = "green"
+	HighlightCyan        HighlightColor = "cyan"
+	HighlightMagenta     HighlightColor = "magenta"
+	HighlightBlue        HighlightColor = "blue"
+	HighlightRed         HighlightColor = "red"
+	HighlightDarkBlue    HighlightColor = "darkBlue"
+	HighlightDarkCyan    HighlightColor = "darkCyan"
+	HighlightDarkGreen   HighlightColor = "darkGreen"
+	HighlightDarkMagenta HighlightColor = "darkMagenta"
+	HighlightDarkRed     HighlightColor = "darkRed"
+	HighlightDarkYellow  HighlightColor = "darkYellow"
+	HighlightDarkGray    HighlightColor = "darkGray"
+	HighlightLightGray   HighlightColor = "lightGray"
+	HighlightBlack       HighlightColor = "black"
+	HighlightNone        HighlightColor = "none" // Removes a highlight inherited from the style
+)
+
+// validHighlightColors lists the ST_HighlightColor values accepted by
+// HighlightColor.
+var validHighlightColors = map[HighlightColor]bool{
+	HighlightYellow: true, HighlightGreen: true, HighlightCyan: true,
+	HighlightMagenta: true, HighlightBlue: true, HighlightRed: true,
+	HighlightDarkBlue: true, HighlightDarkCyan: true, HighlightDarkGreen: true,
+	HighlightDarkMagenta: true, HighlightDarkRed: true, HighlightDarkYellow: true,
+	HighlightDarkGray: true, HighlightLightGray: true, HighlightBlack: true,
+	HighlightNone: true,
+}
+
+// validateHighlightColor checks that a non-empty highlight is one of Word's
+// named highlight colors.
+func validateHighlightColor(field string, color HighlightColor) error {
+	if color != "" && !validHighlightColors[color] {
+		return NewValidationError(field, fmt.Sprintf("unsupported highlight color %q", color))
+	}
+	return nil
+}
+
+// validateShading checks a background color and pattern pair. Both are optional;
+// a non-empty color must be a hex (RRGGBB or RGB) or rgb(r,g,b) value.
+func validateShading(color string, pattern ShadingPattern) error {
+	if err := validateColor("BackgroundColor", color); err != nil {
+		return err
+	}
+	if pattern != "" && !validShadingPatterns[pattern] {
+		return NewValidationError("ShadingPattern", fmt.Sprintf("unsupported shading pattern %q", pattern))
+	}
+	return nil
+}
+
+// generateShadingXML returns a <w:shd> element for the given background color
+// and pattern, or an empty string when neither is set. Callers must validate
+// the inputs with validateShading first.
+func generateShadingXML(color string, pattern ShadingPattern) string {
+	if color == "" && pattern == "" {
+		return ""
+	}
+	if pattern == "" {
+		pattern = ShadingClear
+	}
+	fill := "auto"
+	if c := normalizeHexColor(color); c != "" {
+		fill = c
+	}
+	return fmt.Sprintf(`<w:shd w:val="%s" w:color="auto" w:fill="%s"/>`, pattern, fill)
+}
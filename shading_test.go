@@ -0,0 +1,140 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateShadingXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		color   string
+		pattern ShadingPattern
+		want    string
+	}{
+		{"none", "", "", ""},
+		{"fill only", "#fff2cc", "", `<w:shd w:val="clear" w:color="auto" w:fill="FFF2CC"/>`},
+		{"pattern and fill", "D9D9D9", ShadingDiagStripe, `<w:shd w:val="diagStripe" w:color="auto" w:fill="D9D9D9"/>`},
+		{"pattern only", "", ShadingPct25, `<w:shd w:val="pct25" w:color="auto" w:fill="auto"/>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := generateShadingXML(tt.color, tt.pattern); got != tt.want {
+				t.Errorf("generateShadingXML(%q, %q) = %q, want %q", tt.color, tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateShading(t *testing.T) {
+	if err := validateShading("FF0000", ShadingHorzStripe); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateShading("red", ""); err == nil {
+		t.Error("expected error for non-hex color")
+	}
+	if err := validateShading("FFFF", ""); err == nil {
+		t.Error("expected error for wrong-length color")
+	}
+	if err := validateShading("", "zigzag"); err == nil {
+		t.Error("expected error for unknown pattern")
+	}
+}
+
+func TestInsertParagraph_BackgroundColor(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertParagraph(ParagraphOptions{
+		Text:            "Shaded callout",
+		Position:        PositionEnd,
+		Alignment:       ParagraphAlignCenter,
+		BackgroundColor: "FFF2CC",
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `<w:shd w:val="clear" w:color="auto" w:fill="FFF2CC"/><w:jc w:val="center"/>`) {
+		t.Errorf("expected shading before alignment in pPr, got: %s", docXML)
+	}
+
+	err = u.InsertParagraph(ParagraphOptions{Text: "Bad", BackgroundColor: "yellow"})
+	if err == nil {
+		t.Error("expected validation error for non-hex background color")
+	}
+}
+
+func TestAddStyle_Shading(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.AddStyle(StyleDefinition{
+		ID:              "Callout",
+		BackgroundColor: "DEEAF6",
+		ShadingPattern:  ShadingHorzStripe,
+		Alignment:       ParagraphAlignCenter,
+		SpaceAfter:      120,
+	})
+	if err != nil {
+		t.Fatalf("AddStyle: %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "styles.xml"))
+	if err != nil {
+		t.Fatalf("read styles.xml: %v", err)
+	}
+	styles := string(raw)
+	// CT_PPr places shd before spacing and jc.
+	want := `<w:pPr><w:shd w:val="horzStripe" w:color="auto" w:fill="DEEAF6"/><w:spacing w:after="120"/><w:jc w:val="center"/></w:pPr>`
+	if !strings.Contains(styles, want) {
+		t.Errorf("expected %s in styles.xml, got: %s", want, styles)
+	}
+
+	if err := u.AddStyle(StyleDefinition{ID: "Bad", BackgroundColor: "nothex"}); err == nil {
+		t.Error("expected validation error for invalid style background color")
+	}
+}
+
+func TestRunOptions_HighlightColor(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertParagraph(ParagraphOptions{
+		Position: PositionEnd,
+		Runs: []RunOptions{
+			{Text: "Important", HighlightColor: HighlightYellow},
+		},
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	if docXML := readDocXML(t, u); !strings.Contains(docXML, `<w:highlight w:val="yellow"/>`) {
+		t.Errorf("expected yellow highlight, got: %s", docXML)
+	}
+}
+
+func TestRunOptions_HighlightColorValidation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertParagraph(ParagraphOptions{
+		Position: PositionEnd,
+		Runs:     []RunOptions{{Text: "Bad", HighlightColor: "orange"}},
+	})
+	if err == nil || !strings.Contains(err.Error(), `unsupported highlight color "orange"`) {
+		t.Errorf("expected unsupported highlight color error, got %v", err)
+	}
+
+	err = u.InsertParagraph(ParagraphOptions{
+		Position: PositionEnd,
+		Runs:     []RunOptions{{Text: "Plain", HighlightColor: HighlightNone}},
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph with HighlightNone: %v", err)
+	}
+	if docXML := readDocXML(t, u); !strings.Contains(docXML, `<w:highlight w:val="none"/>`) {
+		t.Errorf("expected highlight none, got: %s", docXML)
+	}
+}
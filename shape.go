@@ -0,0 +1,198 @@
+package godocx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ShapeKind defines the geometry of a drawing shape.
+type ShapeKind string
+
+const (
+	ShapeRectangle ShapeKind = "rectangle"
+	ShapeOval      ShapeKind = "oval"
+	ShapeArrow     ShapeKind = "arrow"
+	ShapeTriangle  ShapeKind = "triangle"
+	ShapeDiamond   ShapeKind = "diamond"
+)
+
+// shapePresetGeometry maps each ShapeKind to its DrawingML preset geometry.
+var shapePresetGeometry = map[ShapeKind]string{
+	ShapeRectangle: "rect",
+	ShapeOval:      "ellipse",
+	ShapeArrow:     "rightArrow",
+	ShapeTriangle:  "triangle",
+	ShapeDiamond:   "diamond",
+}
+
+// ShapeOptions defines options for basic shape insertion.
+type ShapeOptions struct {
+	// Kind of shape (default: ShapeRectangle)
+	Kind ShapeKind
+
+	// Offset from the anchoring paragraph in EMUs
+	X int
+	Y int
+
+	// Size of the shape in EMUs (required)
+	Width  int
+	Height int
+
+	// FillColor is a 6-digit hex color (empty for no fill)
+	FillColor string
+
+	// LineColor is a 6-digit hex outline color (default: "000000" when LineWidth is set)
+	LineColor string
+
+	// LineWidth is the outline width in EMUs (default: 9525 = 0.75pt when LineColor is set)
+	LineWidth int
+
+	// Text displayed inside the shape (optional)
+	Text string
+
+	// TextAlignment of the text inside the shape
+	TextAlignment ParagraphAlignment
+
+	// Position where to insert the shape
+	Position InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+}
+
+// ShapeGroupOptions defines options for inserting several shapes as one group.
+type ShapeGroupOptions struct {
+	// Shapes in the group; their X/Y offsets are relative to the anchoring
+	// paragraph and their Position/Anchor fields are ignored (required)
+	Shapes []ShapeOptions
+
+	// Position where to insert the group
+	Position InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+}
+
+// InsertShape inserts a floating geometric shape anchored to a new paragraph.
+func (u *Updater) InsertShape(opts ShapeOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if err := validateShapeOptions(opts); err != nil {
+		return err
+	}
+
+	return u.insertFloatingDrawing(opts.Position, opts.Anchor, "wps", func(docPrID int) string {
+		var graphic strings.Builder
+		graphic.WriteString(`<a:graphic xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main">`)
+		graphic.WriteString(`<a:graphicData uri="http://schemas.microsoft.com/office/word/2010/wordprocessingShape">`)
+		graphic.WriteString(generateShapeWspXML(opts, 0, 0))
+		graphic.WriteString(`</a:graphicData></a:graphic>`)
+
+		pos := floatingPosition{
+			relativeFromH: "column", relativeFromV: "paragraph",
+			x: opts.X, y: opts.Y, width: opts.Width, height: opts.Height,
+		}
+		return generateAnchorDrawingXML(pos, docPrID, fmt.Sprintf("Shape %d", docPrID), graphic.String())
+	})
+}
+
+// InsertShapeGroup inserts several shapes as a single grouped drawing, so they
+// move and resize together.
+func (u *Updater) InsertShapeGroup(opts ShapeGroupOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if len(opts.Shapes) == 0 {
+		return NewValidationError("Shapes", "shape group must contain at least one shape")
+	}
+	for i, shape := range opts.Shapes {
+		if err := validateShapeOptions(shape); err != nil {
+			return fmt.Errorf("shape %d: %w", i, err)
+		}
+	}
+
+	// The group frame is the bounding box of its shapes.
+	minX, minY := opts.Shapes[0].X, opts.Shapes[0].Y
+	maxX, maxY := minX+opts.Shapes[0].Width, minY+opts.Shapes[0].Height
+	for _, shape := range opts.Shapes[1:] {
+		minX, minY = min(minX, shape.X), min(minY, shape.Y)
+		maxX, maxY = max(maxX, shape.X+shape.Width), max(maxY, shape.Y+shape.Height)
+	}
+	width, height := maxX-minX, maxY-minY
+
+	return u.insertFloatingDrawing(opts.Position, opts.Anchor, "wpg", func(docPrID int) string {
+		var graphic strings.Builder
+		graphic.WriteString(`<a:graphic xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main">`)
+		graphic.WriteString(`<a:graphicData uri="http://schemas.microsoft.com/office/word/2010/wordprocessingGroup">`)
+		graphic.WriteString(`<wpg:wgp><wpg:cNvGrpSpPr/><wpg:grpSpPr><a:xfrm>`)
+		fmt.Fprintf(&graphic, `<a:off x="0" y="0"/><a:ext cx="%d" cy="%d"/>`, width, height)
+		fmt.Fprintf(&graphic, `<a:chOff x="%d" y="%d"/><a:chExt cx="%d" cy="%d"/>`, minX, minY, width, height)
+		graphic.WriteString(`</a:xfrm></wpg:grpSpPr>`)
+		for _, shape := range opts.Shapes {
+			graphic.WriteString(generateShapeWspXML(shape, shape.X, shape.Y))
+		}
+		graphic.WriteString(`</wpg:wgp></a:graphicData></a:graphic>`)
+
+		pos := floatingPosition{
+			relativeFromH: "column", relativeFromV: "paragraph",
+			x: minX, y: minY, width: width, height: height,
+		}
+		return generateAnchorDrawingXML(pos, docPrID, fmt.Sprintf("Group %d", docPrID), graphic.String())
+	})
+}
+
+func validateShapeOptions(opts ShapeOptions) error {
+	if opts.Kind != "" {
+		if _, ok := shapePresetGeometry[opts.Kind]; !ok {
+			return NewValidationError("Kind", fmt.Sprintf("unsupported shape kind %q", opts.Kind))
+		}
+	}
+	if opts.Width <= 0 || opts.Height <= 0 {
+		return NewValidationError("Width/Height", "shape size must be positive")
+	}
+	if err := validateColor("FillColor", opts.FillColor); err != nil {
+		return err
+	}
+	if err := validateColor("LineColor", opts.LineColor); err != nil {
+		return err
+	}
+	if opts.LineWidth < 0 {
+		return NewValidationError("LineWidth", "line width cannot be negative")
+	}
+	if opts.TextAlignment != "" {
+		if _, ok := paragraphAlignmentValue(opts.TextAlignment); !ok {
+			return NewValidationError("TextAlignment", fmt.Sprintf("unsupported alignment %q", opts.TextAlignment))
+		}
+	}
+	return nil
+}
+
+// generateShapeWspXML creates a <wps:wsp> element for the shape at the given
+// offset (zero for a standalone shape, child coordinates inside a group).
+func generateShapeWspXML(opts ShapeOptions, offX, offY int) string {
+	kind := opts.Kind
+	if kind == "" {
+		kind = ShapeRectangle
+	}
+
+	var buf strings.Builder
+	buf.WriteString(`<wps:wsp><wps:cNvSpPr/><wps:spPr>`)
+	fmt.Fprintf(&buf, `<a:xfrm><a:off x="%d" y="%d"/><a:ext cx="%d" cy="%d"/></a:xfrm>`, offX, offY, opts.Width, opts.Height)
+	fmt.Fprintf(&buf, `<a:prstGeom prst="%s"><a:avLst/></a:prstGeom>`, shapePresetGeometry[kind])
+	buf.WriteString(generateShapeFillXML(opts.FillColor))
+	buf.WriteString(generateShapeLineXML(opts.LineColor, opts.LineWidth))
+	buf.WriteString(`</wps:spPr>`)
+
+	if opts.Text != "" {
+		jc, _ := paragraphAlignmentValue(opts.TextAlignment)
+		buf.WriteString(`<wps:txbx>`)
+		buf.WriteString(generateTxbxContentXML(opts.Text, jc))
+		buf.WriteString(`</wps:txbx>`)
+		buf.WriteString(`<wps:bodyPr rot="0" vert="horz" wrap="square" lIns="91440" tIns="45720" rIns="91440" bIns="45720" anchor="ctr" anchorCtr="0"><a:noAutofit/></wps:bodyPr>`)
+	} else {
+		buf.WriteString(`<wps:bodyPr/>`)
+	}
+	buf.WriteString(`</wps:wsp>`)
+	return buf.String()
+}
@@ -0,0 +1,115 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInsertShape(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertShape(ShapeOptions{
+		Kind:          ShapeOval,
+		X:             914400,
+		Y:             0,
+		Width:         1828800,
+		Height:        914400,
+		FillColor:     "FFC000",
+		LineColor:     "7F6000",
+		LineWidth:     19050,
+		Text:          "Start",
+		TextAlignment: ParagraphAlignCenter,
+		Position:      PositionEnd,
+	})
+	if err != nil {
+		t.Fatalf("InsertShape: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	for _, want := range []string{
+		`<mc:Choice Requires="wps"><w:drawing><wp:anchor `,
+		`<wp:positionH relativeFrom="column"><wp:posOffset>914400</wp:posOffset></wp:positionH>`,
+		`<wp:docPr id="1" name="Shape 1"/>`,
+		`<a:prstGeom prst="ellipse"><a:avLst/></a:prstGeom>`,
+		`<a:solidFill><a:srgbClr val="FFC000"/></a:solidFill>`,
+		`<a:ln w="19050"><a:solidFill><a:srgbClr val="7F6000"/></a:solidFill></a:ln>`,
+		`<w:p><w:pPr><w:jc w:val="center"/></w:pPr><w:r><w:t xml:space="preserve">Start</w:t></w:r></w:p>`,
+	} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s in document, got: %s", want, docXML)
+		}
+	}
+}
+
+func TestInsertShape_Kinds(t *testing.T) {
+	for kind, prst := range shapePresetGeometry {
+		t.Run(string(kind), func(t *testing.T) {
+			u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+			if err := u.InsertShape(ShapeOptions{Kind: kind, Width: 914400, Height: 914400, Position: PositionEnd}); err != nil {
+				t.Fatalf("InsertShape: %v", err)
+			}
+			docXML := readDocXML(t, u)
+			if !strings.Contains(docXML, `<a:prstGeom prst="`+prst+`">`) {
+				t.Errorf("expected preset geometry %q, got: %s", prst, docXML)
+			}
+			if !strings.Contains(docXML, `<wps:bodyPr/>`) {
+				t.Errorf("expected empty body properties for shape without text, got: %s", docXML)
+			}
+		})
+	}
+}
+
+func TestInsertShapeGroup(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertShapeGroup(ShapeGroupOptions{
+		Shapes: []ShapeOptions{
+			{Kind: ShapeRectangle, X: 457200, Y: 0, Width: 914400, Height: 457200, Text: "A"},
+			{Kind: ShapeArrow, X: 1371600, Y: 114300, Width: 457200, Height: 228600},
+			{Kind: ShapeDiamond, X: 1828800, Y: 0, Width: 914400, Height: 685800, Text: "B"},
+		},
+		Position: PositionAfterText,
+		Anchor:   "Intro",
+	})
+	if err != nil {
+		t.Fatalf("InsertShapeGroup: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	for _, want := range []string{
+		`xmlns:wpg="http://schemas.microsoft.com/office/word/2010/wordprocessingGroup"`,
+		`<mc:Choice Requires="wpg">`,
+		`<wp:positionH relativeFrom="column"><wp:posOffset>457200</wp:posOffset></wp:positionH>`,
+		`<wp:extent cx="2286000" cy="685800"/>`,
+		`<a:chOff x="457200" y="0"/><a:chExt cx="2286000" cy="685800"/>`,
+		`<a:off x="1371600" y="114300"/>`,
+	} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s in document, got: %s", want, docXML)
+		}
+	}
+	if n := strings.Count(docXML, "<wps:wsp>"); n != 3 {
+		t.Errorf("expected 3 shapes in group, got %d", n)
+	}
+}
+
+func TestInsertShape_Validation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	cases := []ShapeOptions{
+		{Kind: "hexagon", Width: 100, Height: 100},
+		{Width: 0, Height: 100},
+		{Width: 100, Height: 100, FillColor: "orange"},
+		{Width: 100, Height: 100, LineWidth: -1},
+		{Width: 100, Height: 100, TextAlignment: "middle"},
+	}
+	for i, opts := range cases {
+		if err := u.InsertShape(opts); err == nil {
+			t.Errorf("case %d: expected validation error", i)
+		}
+	}
+
+	if err := u.InsertShapeGroup(ShapeGroupOptions{}); err == nil {
+		t.Error("expected error for empty shape group")
+	}
+}
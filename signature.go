@@ -0,0 +1,186 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// SignatureLineOptions defines a Microsoft Office signature line: a
+// placeholder that Word users can select to sign the document digitally or
+// with a drawn or typed signature.
+type SignatureLineOptions struct {
+	// SignerName is the suggested signer shown below the line
+	SignerName string
+
+	// SignerTitle is the suggested signer's title, shown below the name
+	SignerTitle string
+
+	// Instructions are shown to the signer in the signing dialog
+	Instructions string
+
+	// AllowComments lets the signer add a purpose for signing
+	AllowComments bool
+
+	// ShowSignatureDate shows the signing date on the line
+	ShowSignatureDate bool
+
+	// Position where to insert the signature line paragraph
+	Position InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+}
+
+// Signature line placeholder size: Word's default of 192pt x 96pt, rendered
+// at twice the screen resolution.
+const (
+	signatureLineWidthPt  = 192
+	signatureLineHeightPt = 96
+	signatureImageWidth   = 512
+	signatureImageHeight  = 256
+)
+
+// signatureLineNamespaces are the root declarations required by the VML
+// signature line shape.
+var signatureLineNamespaces = []xmlNamespace{
+	{"v", "urn:schemas-microsoft-com:vml"},
+	{"o", "urn:schemas-microsoft-com:office:office"},
+	{"r", "http://schemas.openxmlformats.org/officeDocument/2006/relationships"},
+}
+
+var vmlShapeIDPattern = regexp.MustCompile(`id="_x0000_i(\d+)"`)
+
+// InsertSignatureLine inserts a signature line in its own paragraph. The line
+// is a VML picture carrying an <o:signatureline> element, which is what Word
+// writes for Insert > Signature Line; the picture is a placeholder (an "X"
+// and a line) that Word replaces when the document is signed.
+func (u *Updater) InsertSignatureLine(opts SignatureLineOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if (opts.Position == PositionAfterText || opts.Position == PositionBeforeText) && opts.Anchor == "" {
+		return NewValidationError("anchor", "anchor text required for position-based insertion")
+	}
+
+	imageIndex, err := u.getNextImageIndex()
+	if err != nil {
+		return fmt.Errorf("get next image index: %w", err)
+	}
+	imageFileName := fmt.Sprintf("image%d.png", imageIndex)
+	imagePath := filepath.Join(u.tempDir, "word", "media", imageFileName)
+	if err := atomicWriteFile(imagePath, generateSignatureLineImage(), 0o644); err != nil {
+		return NewFileWriteError(imageFileName, err)
+	}
+	relID, err := u.addImageRelationship(imageFileName)
+	if err != nil {
+		return fmt.Errorf("add image relationship: %w", err)
+	}
+	if err := u.addImageContentType(".png", ImagePNGType); err != nil {
+		return fmt.Errorf("add image content type: %w", err)
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+	raw, err = ensureDocumentNamespaces(raw, signatureLineNamespaces)
+	if err != nil {
+		return err
+	}
+
+	shapeID := 1025
+	for _, m := range vmlShapeIDPattern.FindAllSubmatch(raw, -1) {
+		var n int
+		if _, err := fmt.Sscanf(string(m[1]), "%d", &n); err == nil && n >= shapeID {
+			shapeID = n + 1
+		}
+	}
+	withShapeType := !bytes.Contains(raw, []byte(`id="_x0000_t75"`))
+
+	paraXML := generateSignatureLineXML(opts, relID, shapeID, newGUID(), withShapeType)
+	updated, err := insertElementAtPosition(raw, paraXML, opts.Position, opts.Anchor)
+	if err != nil {
+		return fmt.Errorf("insert signature line: %w", err)
+	}
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// generateSignatureLineXML creates the paragraph holding the signature line
+// picture. The picture shape type (_x0000_t75) is declared once per document.
+func generateSignatureLineXML(opts SignatureLineOptions, relID string, shapeID int, guid string, withShapeType bool) []byte {
+	boolAttr := func(b bool) string {
+		if b {
+			return "t"
+		}
+		return "f"
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`<w:p><w:r><w:pict>`)
+	if withShapeType {
+		buf.WriteString(`<v:shapetype id="_x0000_t75" coordsize="21600,21600" o:spt="75" o:preferrelative="t" path="m@4@5l@4@11@9@11@9@5xe" filled="f" stroked="f">`)
+		buf.WriteString(`<v:stroke joinstyle="miter"/><v:formulas>`)
+		for _, eqn := range []string{
+			"if lineDrawn pixelLineWidth 0", "sum @0 1 0", "sum 0 0 @1", "prod @2 1 2",
+			"prod @3 21600 pixelWidth", "prod @3 21600 pixelHeight", "sum @0 0 1", "prod @6 1 2",
+			"prod @7 21600 pixelWidth", "sum @8 21600 0", "prod @7 21600 pixelHeight", "sum @10 21600 0",
+		} {
+			fmt.Fprintf(&buf, `<v:f eqn="%s"/>`, eqn)
+		}
+		buf.WriteString(`</v:formulas><v:path o:extrusionok="f" gradientshapeok="t" o:connecttype="rect"/>`)
+		buf.WriteString(`<o:lock v:ext="edit" aspectratio="t"/></v:shapetype>`)
+	}
+
+	fmt.Fprintf(&buf, `<v:shape id="_x0000_i%d" type="#_x0000_t75" alt="Microsoft Office Signature Line..." style="width:%dpt;height:%dpt">`,
+		shapeID, signatureLineWidthPt, signatureLineHeightPt)
+	fmt.Fprintf(&buf, `<v:imagedata r:id="%s" o:title=""/>`, relID)
+	buf.WriteString(`<o:lock v:ext="edit" ungrouping="t" rotation="t" cropping="t" verticies="t" text="t" grouping="t"/>`)
+	fmt.Fprintf(&buf, `<o:signatureline v:ext="edit" id="%s" provid="{00000000-0000-0000-0000-000000000000}"`, guid)
+	fmt.Fprintf(&buf, ` o:suggestedsigner="%s" o:suggestedsigner2="%s" o:suggestedsigneremail=""`,
+		xmlEscape(opts.SignerName), xmlEscape(opts.SignerTitle))
+	if strings.TrimSpace(opts.Instructions) != "" {
+		fmt.Fprintf(&buf, ` o:signinginstructions="%s" signinginstructionsset="t"`, xmlEscape(opts.Instructions))
+	}
+	fmt.Fprintf(&buf, ` allowcomments="%s" showsigndate="%s" issignatureline="t"/>`,
+		boolAttr(opts.AllowComments), boolAttr(opts.ShowSignatureDate))
+	buf.WriteString(`</v:shape></w:pict></w:r></w:p>`)
+	return buf.Bytes()
+}
+
+// generateSignatureLineImage draws the unsigned placeholder: an "X" resting
+// on a horizontal signing line, on a transparent background.
+func generateSignatureLineImage() []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, signatureImageWidth, signatureImageHeight))
+	ink := color.NRGBA{A: 255}
+
+	const lineY, margin = 176, 24
+	for x := margin; x < signatureImageWidth-margin; x++ {
+		for y := lineY; y < lineY+3; y++ {
+			img.SetNRGBA(x, y, ink)
+		}
+	}
+
+	const size, stroke = 40, 4
+	top := lineY - 12 - size
+	for i := range size {
+		for w := range stroke {
+			img.SetNRGBA(margin+i+w, top+i, ink)
+			img.SetNRGBA(margin+size-1-i+w, top+i, ink)
+		}
+	}
+
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, img) // encoding an in-memory image cannot fail
+	return buf.Bytes()
+}
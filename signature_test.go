@@ -0,0 +1,76 @@
+package godocx
+
+import (
+	"bytes"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInsertSignatureLine(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Agreed by:</w:t></w:r></w:p><w:sectPr/>`))
+
+	err := u.InsertSignatureLine(SignatureLineOptions{
+		SignerName:        "Jane Doe",
+		SignerTitle:       "CEO, Smith & Co",
+		Instructions:      "Sign to approve the contract.",
+		AllowComments:     true,
+		ShowSignatureDate: true,
+		Position:          PositionAfterText,
+		Anchor:            "Agreed by:",
+	})
+	if err != nil {
+		t.Fatalf("InsertSignatureLine: %v", err)
+	}
+	if err := u.InsertSignatureLine(SignatureLineOptions{SignerName: "John Roe", Position: PositionEnd}); err != nil {
+		t.Fatalf("InsertSignatureLine at end: %v", err)
+	}
+
+	doc := readDocXML(t, u)
+	for _, want := range []string{
+		`xmlns:v="urn:schemas-microsoft-com:vml"`,
+		`xmlns:o="urn:schemas-microsoft-com:office:office"`,
+		`<w:t>Agreed by:</w:t></w:r></w:p><w:p><w:r><w:pict><v:shapetype id="_x0000_t75"`,
+		`<v:shape id="_x0000_i1025" type="#_x0000_t75" alt="Microsoft Office Signature Line..." style="width:192pt;height:96pt">`,
+		`<v:shape id="_x0000_i1026" type="#_x0000_t75"`,
+		`o:suggestedsigner="Jane Doe" o:suggestedsigner2="CEO, Smith &amp; Co"`,
+		`o:signinginstructions="Sign to approve the contract." signinginstructionsset="t" allowcomments="t" showsigndate="t" issignatureline="t"/>`,
+		`o:suggestedsigner="John Roe" o:suggestedsigner2="" o:suggestedsigneremail="" allowcomments="f" showsigndate="f" issignatureline="t"/></v:shape></w:pict></w:r></w:p><w:sectPr/>`,
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("document missing %s:\n%s", want, doc)
+		}
+	}
+	if n := strings.Count(doc, `<v:shapetype id="_x0000_t75"`); n != 1 {
+		t.Errorf("picture shape type declared %d times, want 1", n)
+	}
+
+	rels := readTempFile(t, u, "word/_rels/document.xml.rels")
+	if !strings.Contains(rels, `Target="media/image1.png"`) || !strings.Contains(rels, `Target="media/image2.png"`) {
+		t.Errorf("expected image relationships for the placeholders:\n%s", rels)
+	}
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "media", "image1.png"))
+	if err != nil {
+		t.Fatalf("read placeholder image: %v", err)
+	}
+	cfg, err := png.DecodeConfig(bytes.NewReader(raw))
+	if err != nil || cfg.Width != signatureImageWidth || cfg.Height != signatureImageHeight {
+		t.Errorf("unexpected placeholder image %+v, %v", cfg, err)
+	}
+	if ct := readTempFile(t, u, "[Content_Types].xml"); !strings.Contains(ct, `Extension="png"`) {
+		t.Errorf("expected png content type:\n%s", ct)
+	}
+}
+
+func TestInsertSignatureLineValidation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Text</w:t></w:r></w:p>`))
+	if err := u.InsertSignatureLine(SignatureLineOptions{Position: PositionAfterText}); err == nil {
+		t.Error("expected error for missing anchor")
+	}
+	var nilU *Updater
+	if err := nilU.InsertSignatureLine(SignatureLineOptions{}); err == nil {
+		t.Error("expected error for nil updater")
+	}
+}
@@ -0,0 +1,240 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// statisticsCharsPerLine is the line length used to estimate LineCount.
+const statisticsCharsPerLine = 40
+
+// defaultWordsPerMinute is the reading speed assumed by GetReadingTime.
+const defaultWordsPerMinute = 200
+
+// DocumentStatistics holds word and character counts for the document body
+type DocumentStatistics struct {
+	WordCount           int // Words separated by whitespace or punctuation
+	CharCount           int // Characters excluding whitespace
+	CharCountWithSpaces int // Characters including whitespace
+	ParagraphCount      int // Paragraphs containing text
+	LineCount           int // Estimated lines at 40 characters per line
+}
+
+// ComputeStatistics counts words, characters, paragraphs and lines in the
+// document body, including text in tables. Punctuation-only tokens such as
+// "-" are not counted as words.
+func (u *Updater) ComputeStatistics() (DocumentStatistics, error) {
+	if u == nil {
+		return DocumentStatistics{}, NewValidationError("updater", "updater is nil")
+	}
+
+	paragraphs, err := u.GetParagraphText()
+	if err != nil {
+		return DocumentStatistics{}, err
+	}
+
+	var stats DocumentStatistics
+	for _, para := range paragraphs {
+		stats.ParagraphCount++
+		stats.WordCount += countWords(para)
+		n := utf8.RuneCountInString(para)
+		stats.CharCountWithSpaces += n
+		for _, r := range para {
+			if !unicode.IsSpace(r) {
+				stats.CharCount++
+			}
+		}
+		stats.LineCount += max(1, (n+statisticsCharsPerLine-1)/statisticsCharsPerLine)
+	}
+	return stats, nil
+}
+
+// SetStatisticsFromDocument computes the document statistics and writes them
+// to the application properties (docProps/app.xml).
+func (u *Updater) SetStatisticsFromDocument() error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+
+	stats, err := u.ComputeStatistics()
+	if err != nil {
+		return err
+	}
+
+	return u.SetAppProperties(AppProperties{
+		Words:                stats.WordCount,
+		Characters:           stats.CharCount,
+		CharactersWithSpaces: stats.CharCountWithSpaces,
+		Lines:                stats.LineCount,
+		Paragraphs:           stats.ParagraphCount,
+	})
+}
+
+// GetWordCount returns the number of words in the document body, counted as
+// in ComputeStatistics.
+func (u *Updater) GetWordCount() (int, error) {
+	if u == nil {
+		return 0, NewValidationError("updater", "updater is nil")
+	}
+
+	paragraphs, err := u.GetParagraphText()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, para := range paragraphs {
+		count += countWords(para)
+	}
+	return count, nil
+}
+
+// GetCharacterCount returns the number of characters in the document. With
+// includeSpaces, whitespace is counted and the text of headers, footers,
+// footnotes and endnotes is included as well; without it, only non-whitespace
+// characters in the document body are counted.
+func (u *Updater) GetCharacterCount(includeSpaces bool) (int, error) {
+	if u == nil {
+		return 0, NewValidationError("updater", "updater is nil")
+	}
+
+	paragraphs, err := u.GetParagraphText()
+	if err != nil {
+		return 0, err
+	}
+	if !includeSpaces {
+		count := 0
+		for _, para := range paragraphs {
+			for _, r := range para {
+				if !unicode.IsSpace(r) {
+					count++
+				}
+			}
+		}
+		return count, nil
+	}
+
+	extra, err := u.supplementaryParagraphText()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, para := range append(paragraphs, extra...) {
+		count += utf8.RuneCountInString(para)
+	}
+	return count, nil
+}
+
+// GetReadingTime estimates how long the document body takes to read at the
+// given speed (default: 200 words per minute when wordsPerMinute <= 0).
+func (u *Updater) GetReadingTime(wordsPerMinute int) (time.Duration, error) {
+	if u == nil {
+		return 0, NewValidationError("updater", "updater is nil")
+	}
+	if wordsPerMinute <= 0 {
+		wordsPerMinute = defaultWordsPerMinute
+	}
+
+	words, err := u.GetWordCount()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(float64(words) / float64(wordsPerMinute) * float64(time.Minute)), nil
+}
+
+// GetSentenceCount returns the number of sentences in the document body. A
+// sentence ends at '.', '!' or '?' followed by whitespace and an upper-case
+// letter, or at the end of a paragraph.
+func (u *Updater) GetSentenceCount() (int, error) {
+	if u == nil {
+		return 0, NewValidationError("updater", "updater is nil")
+	}
+
+	paragraphs, err := u.GetParagraphText()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, para := range paragraphs {
+		count += countSentences(para)
+	}
+	return count, nil
+}
+
+// supplementaryParagraphText returns the paragraph text of the headers,
+// footers, footnotes and endnotes.
+func (u *Updater) supplementaryParagraphText() ([]string, error) {
+	wordDir := filepath.Join(u.tempDir, "word")
+	headers, _ := filepath.Glob(filepath.Join(wordDir, "header*.xml"))
+	footers, _ := filepath.Glob(filepath.Join(wordDir, "footer*.xml"))
+	files := append(headers, footers...)
+	files = append(files, filepath.Join(wordDir, "footnotes.xml"), filepath.Join(wordDir, "endnotes.xml"))
+
+	var paragraphs []string
+	for _, path := range files {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, NewFileReadError(filepath.Base(path), err)
+		}
+		paragraphs = append(paragraphs, u.extractParagraphsFromXML(raw)...)
+	}
+	return paragraphs, nil
+}
+
+// countWords counts the words in text. Words are separated by whitespace and
+// by punctuation, except that apostrophes and hyphens join the parts of a
+// word ("don't", "well-known"); only tokens containing a letter or digit
+// count. Text in scripts written without spaces (Chinese, Japanese, Thai)
+// counts as one word per run of characters, an approximation of Word's count.
+func countWords(text string) int {
+	count := 0
+	for _, word := range strings.FieldsFunc(text, isWordSeparator) {
+		if strings.IndexFunc(word, isWordRune) != -1 {
+			count++
+		}
+	}
+	return count
+}
+
+// isWordSeparator reports whether r ends a word: whitespace, or punctuation
+// other than the apostrophes and hyphens used inside words.
+func isWordSeparator(r rune) bool {
+	switch r {
+	case '\'', '\u2019', '-', '\u2010', '\u2011':
+		return false
+	}
+	return unicode.IsSpace(r) || unicode.IsPunct(r)
+}
+
+// countSentences counts the sentences in one paragraph of text.
+func countSentences(text string) int {
+	if strings.IndexFunc(text, isWordRune) == -1 {
+		return 0
+	}
+	count := 1
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '.' && runes[i] != '!' && runes[i] != '?' {
+			continue
+		}
+		j := i + 1
+		for j < len(runes) && unicode.IsSpace(runes[j]) {
+			j++
+		}
+		if j > i+1 && j < len(runes) && unicode.IsUpper(runes[j]) {
+			count++
+			i = j
+		}
+	}
+	return count
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,158 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComputeStatistics(t *testing.T) {
+	body := `<w:p><w:r><w:t>Hello, world – it's a test.</w:t></w:r></w:p>` +
+		`<w:p/>` +
+		`<w:tbl><w:tr><w:tc><w:p><w:r><w:t>Cell</w:t></w:r><w:r><w:t xml:space="preserve"> text</w:t></w:r></w:p></w:tc></w:tr></w:tbl>` +
+		`<w:p><w:r><w:t>Lorem ipsum dolor sit amet consectetur adipiscing elit sed do</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	stats, err := u.ComputeStatistics()
+	if err != nil {
+		t.Fatalf("ComputeStatistics: %v", err)
+	}
+
+	want := DocumentStatistics{
+		WordCount:           5 + 2 + 10,
+		CharCount:           22 + 8 + 52,
+		CharCountWithSpaces: 27 + 9 + 61,
+		ParagraphCount:      3,
+		LineCount:           1 + 1 + 2,
+	}
+	if stats != want {
+		t.Errorf("ComputeStatistics() = %+v, want %+v", stats, want)
+	}
+}
+
+func TestSetStatisticsFromDocument(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+	if err := u.InsertParagraph(ParagraphOptions{Text: "Three little words", Position: PositionEnd}); err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	if err := u.SetStatisticsFromDocument(); err != nil {
+		t.Fatalf("SetStatisticsFromDocument: %v", err)
+	}
+
+	props, err := u.GetAppProperties()
+	if err != nil {
+		t.Fatalf("GetAppProperties: %v", err)
+	}
+	if props.Words != 3 || props.Characters != 16 || props.CharactersWithSpaces != 18 || props.Paragraphs != 1 || props.Lines != 1 {
+		t.Errorf("unexpected app properties: %+v", props)
+	}
+}
+
+func TestTextAnalysisHelpers(t *testing.T) {
+	body := `<w:p><w:r><w:t>The quick fox jumps. It runs away! Does it stop? no, e.g. never.</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Heading without a full stop</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	header := `<w:hdr xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:p><w:r><w:t>Header</w:t></w:r></w:p></w:hdr>`
+	footnotes := `<w:footnotes xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:footnote w:id="1"><w:p><w:r><w:t>A note</w:t></w:r></w:p></w:footnote></w:footnotes>`
+	for name, content := range map[string]string{"header1.xml": header, "footnotes.xml": footnotes} {
+		if err := os.WriteFile(filepath.Join(u.TempDir(), "word", name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	words, err := u.GetWordCount()
+	// "e.g." splits into two words at the full stop.
+	if err != nil || words != 19 {
+		t.Errorf("GetWordCount() = %d, %v; want 19", words, err)
+	}
+
+	withoutSpaces, err := u.GetCharacterCount(false)
+	if err != nil || withoutSpaces != 52+23 {
+		t.Errorf("GetCharacterCount(false) = %d, %v; want %d", withoutSpaces, err, 52+23)
+	}
+	withSpaces, err := u.GetCharacterCount(true)
+	if err != nil || withSpaces != 64+27+6+6 {
+		t.Errorf("GetCharacterCount(true) = %d, %v; want %d", withSpaces, err, 64+27+6+6)
+	}
+
+	sentences, err := u.GetSentenceCount()
+	if err != nil || sentences != 4 {
+		t.Errorf("GetSentenceCount() = %d, %v; want 4", sentences, err)
+	}
+
+	readingTime, err := u.GetReadingTime(0)
+	if err != nil || readingTime != 19*time.Minute/200 {
+		t.Errorf("GetReadingTime(0) = %v, %v; want %v", readingTime, err, 19*time.Minute/200)
+	}
+	readingTime, err = u.GetReadingTime(60)
+	if err != nil || readingTime != 19*time.Second {
+		t.Errorf("GetReadingTime(60) = %v, %v; want 19s", readingTime, err)
+	}
+}
+
+func TestCountWords_PunctuationJoined(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"end.Start of next", 4},
+		{"well-known and/or co-op", 4},
+		{"don't stop—it’s fine", 4},
+		{"one - two / three", 3},
+		{"“quoted”, (parenthesised)!", 2},
+		{"... -- !!", 0},
+	}
+	for _, tt := range tests {
+		if got := countWords(tt.text); got != tt.want {
+			t.Errorf("countWords(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func benchmarkTextAnalysis(b *testing.B, chars int) {
+	sentence := "The quick brown fox jumps over the lazy dog. "
+	var body strings.Builder
+	for written := 0; written < chars; {
+		para := strings.Repeat(sentence, 20)
+		fmt.Fprintf(&body, `<w:p><w:r><w:t xml:space="preserve">%s</w:t></w:r></w:p>`, para)
+		written += len(para)
+	}
+
+	u, err := NewBlank()
+	if err != nil {
+		b.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+	docXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` +
+		body.String() + `</w:body></w:document>`
+	if err := os.WriteFile(filepath.Join(u.TempDir(), "word", "document.xml"), []byte(docXML), 0o644); err != nil {
+		b.Fatalf("write document: %v", err)
+	}
+
+	b.ResetTimer()
+	for b.Loop() {
+		if _, err := u.GetWordCount(); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := u.GetCharacterCount(true); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := u.GetSentenceCount(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTextAnalysis10K(b *testing.B)  { benchmarkTextAnalysis(b, 10_000) }
+func BenchmarkTextAnalysis100K(b *testing.B) { benchmarkTextAnalysis(b, 100_000) }
+func BenchmarkTextAnalysis1M(b *testing.B)   { benchmarkTextAnalysis(b, 1_000_000) }
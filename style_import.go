@@ -0,0 +1,156 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// StyleImportOptions defines which styles ImportStyles copies.
+type StyleImportOptions struct {
+	// StyleIDs lists the styles to import (empty imports all styles)
+	StyleIDs []string
+
+	// OverwriteExisting replaces styles that already exist in the document.
+	// When false, conflicting styles are left unchanged and reported as skipped.
+	OverwriteExisting bool
+
+	// IncludeBasedOn also imports the styles each selected style is based on,
+	// transitively, so that inherited formatting is preserved
+	IncludeBasedOn bool
+}
+
+// StyleImportResult reports the outcome of ImportStyles.
+type StyleImportResult struct {
+	Imported []string // IDs of styles added or replaced
+	Skipped  []string // IDs of styles left unchanged because they already exist
+}
+
+var (
+	styleElementPattern = regexp.MustCompile(`(?s)<w:style\s[^>]*>.*?</w:style>`)
+	styleDefaultAttr    = regexp.MustCompile(`\s+w:default="(?:1|true|on)"`)
+)
+
+// ImportStyles copies style definitions from source's styles.xml into this
+// document, for example before merging content written with source's styles.
+//
+// Imported styles lose their default flag unless they replace the document's
+// current default style, so the document keeps its own default styles.
+// Numbering referenced by imported list styles is not copied.
+func (u *Updater) ImportStyles(source *Updater, opts StyleImportOptions) (*StyleImportResult, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+	if source == nil {
+		return nil, NewValidationError("source", "source document cannot be nil")
+	}
+
+	sourceRaw, err := os.ReadFile(filepath.Join(source.tempDir, "word", "styles.xml"))
+	if err != nil {
+		return nil, NewFileReadError("source styles.xml", err)
+	}
+	sourceXML := string(sourceRaw)
+
+	ids, err := selectImportStyles(sourceXML, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	stylesPath := filepath.Join(u.tempDir, "word", "styles.xml")
+	raw, err := os.ReadFile(stylesPath)
+	created := false
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, NewFileReadError("styles.xml", err)
+		}
+		raw = generateStylesDocument(nil)
+		created = true
+	}
+	target := string(raw)
+
+	result := &StyleImportResult{}
+	for _, id := range ids {
+		block := findStyleBlock(sourceXML, id)
+		existing := findStyleBlock(target, id)
+
+		if existing == "" {
+			updated, err := injectStyle([]byte(target), []byte(styleDefaultAttr.ReplaceAllString(block, "")))
+			if err != nil {
+				return nil, fmt.Errorf("inject style %s: %w", id, err)
+			}
+			target = string(updated)
+			result.Imported = append(result.Imported, id)
+			continue
+		}
+		if !opts.OverwriteExisting {
+			result.Skipped = append(result.Skipped, id)
+			continue
+		}
+		if !styleDefaultAttr.MatchString(styleOpenTagPattern.FindString(existing)) {
+			block = styleDefaultAttr.ReplaceAllString(block, "")
+		}
+		target = strings.Replace(target, existing, block, 1)
+		result.Imported = append(result.Imported, id)
+	}
+
+	if len(result.Imported) == 0 {
+		return result, nil
+	}
+	if err := atomicWriteFile(stylesPath, []byte(target), 0o644); err != nil {
+		return nil, NewXMLWriteError("styles.xml", err)
+	}
+	if created {
+		if err := u.ensureStylesRelationship(); err != nil {
+			return nil, fmt.Errorf("ensure styles relationship: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// selectImportStyles returns the IDs of the source styles to import, with
+// each style's basedOn ancestors before it when requested.
+func selectImportStyles(sourceXML string, opts StyleImportOptions) ([]string, error) {
+	requested := opts.StyleIDs
+	if len(requested) == 0 {
+		for _, block := range styleElementPattern.FindAllString(sourceXML, -1) {
+			if m := styleIDAttrPattern.FindStringSubmatch(styleOpenTagPattern.FindString(block)); m != nil {
+				requested = append(requested, m[1])
+			}
+		}
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+	var add func(id string, required bool) error
+	add = func(id string, required bool) error {
+		if seen[id] {
+			return nil
+		}
+		block := findStyleBlock(sourceXML, id)
+		if block == "" {
+			if required {
+				return NewValidationError("StyleIDs", fmt.Sprintf("style %q not found in source document", id))
+			}
+			// A dangling basedOn reference; nothing to import.
+			return nil
+		}
+		seen[id] = true
+		if opts.IncludeBasedOn {
+			if m := styleBasedOnPattern.FindStringSubmatch(block); m != nil {
+				if err := add(m[1], false); err != nil {
+					return err
+				}
+			}
+		}
+		ids = append(ids, id)
+		return nil
+	}
+	for _, id := range requested {
+		if err := add(id, true); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,142 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const importStylesDoc = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+	`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+	`<w:body><w:p><w:r><w:t>Body</w:t></w:r></w:p></w:body></w:document>`
+
+func importStylesXML(styles string) string {
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		styles + `</w:styles>`
+}
+
+func newImportStylesUpdater(t *testing.T, stylesXML string) *Updater {
+	t.Helper()
+	return newUpdaterFromFixture(t, buildIntegrationDocxFromParts(t, importStylesDoc, stylesXML, ""))
+}
+
+func readStylesXML(t *testing.T, u *Updater) string {
+	t.Helper()
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "styles.xml"))
+	if err != nil {
+		t.Fatalf("read styles.xml: %v", err)
+	}
+	return string(raw)
+}
+
+func TestImportStyles(t *testing.T) {
+	sourceStyles := importStylesXML(
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:rPr><w:sz w:val="22"/></w:rPr></w:style>` +
+			`<w:style w:type="paragraph" w:styleId="Base"><w:name w:val="Base"/><w:basedOn w:val="Normal"/><w:rPr><w:b/></w:rPr></w:style>` +
+			`<w:style w:type="paragraph" w:styleId="Fancy"><w:name w:val="Fancy"/><w:basedOn w:val="Base"/><w:rPr><w:i/></w:rPr></w:style>`)
+	targetStyles := importStylesXML(
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:rPr><w:sz w:val="24"/></w:rPr></w:style>`)
+
+	t.Run("selected style only", func(t *testing.T) {
+		src := newImportStylesUpdater(t, sourceStyles)
+		u := newImportStylesUpdater(t, targetStyles)
+
+		res, err := u.ImportStyles(src, StyleImportOptions{StyleIDs: []string{"Fancy"}})
+		if err != nil {
+			t.Fatalf("ImportStyles: %v", err)
+		}
+		if !slices.Equal(res.Imported, []string{"Fancy"}) || len(res.Skipped) != 0 {
+			t.Fatalf("result = %+v", res)
+		}
+		styles := readStylesXML(t, u)
+		if findStyleBlock(styles, "Fancy") == "" || findStyleBlock(styles, "Base") != "" {
+			t.Errorf("expected only Fancy to be imported:\n%s", styles)
+		}
+	})
+
+	t.Run("include basedOn chain", func(t *testing.T) {
+		src := newImportStylesUpdater(t, sourceStyles)
+		u := newImportStylesUpdater(t, targetStyles)
+
+		res, err := u.ImportStyles(src, StyleImportOptions{StyleIDs: []string{"Fancy"}, IncludeBasedOn: true})
+		if err != nil {
+			t.Fatalf("ImportStyles: %v", err)
+		}
+		if !slices.Equal(res.Imported, []string{"Base", "Fancy"}) || !slices.Equal(res.Skipped, []string{"Normal"}) {
+			t.Fatalf("result = %+v", res)
+		}
+		styles := readStylesXML(t, u)
+		if !strings.Contains(findStyleBlock(styles, "Normal"), `w:val="24"`) {
+			t.Error("existing Normal style should be kept")
+		}
+		if strings.Index(styles, `w:styleId="Base"`) > strings.Index(styles, `w:styleId="Fancy"`) {
+			t.Error("Base should be written before Fancy")
+		}
+	})
+
+	t.Run("all styles with overwrite", func(t *testing.T) {
+		src := newImportStylesUpdater(t, sourceStyles)
+		u := newImportStylesUpdater(t, targetStyles)
+
+		res, err := u.ImportStyles(src, StyleImportOptions{OverwriteExisting: true})
+		if err != nil {
+			t.Fatalf("ImportStyles: %v", err)
+		}
+		if !slices.Equal(res.Imported, []string{"Normal", "Base", "Fancy"}) {
+			t.Fatalf("result = %+v", res)
+		}
+		normal := findStyleBlock(readStylesXML(t, u), "Normal")
+		if !strings.Contains(normal, `w:val="22"`) || !strings.Contains(normal, `w:default="1"`) {
+			t.Errorf("Normal should be replaced and stay the default: %s", normal)
+		}
+	})
+
+	t.Run("new default style loses default flag", func(t *testing.T) {
+		src := newImportStylesUpdater(t, sourceStyles)
+		u := newImportStylesUpdater(t, importStylesXML(""))
+
+		if _, err := u.ImportStyles(src, StyleImportOptions{StyleIDs: []string{"Normal"}}); err != nil {
+			t.Fatalf("ImportStyles: %v", err)
+		}
+		if normal := findStyleBlock(readStylesXML(t, u), "Normal"); strings.Contains(normal, "w:default") {
+			t.Errorf("imported style should not be the default: %s", normal)
+		}
+	})
+
+	t.Run("creates styles part", func(t *testing.T) {
+		src := newImportStylesUpdater(t, sourceStyles)
+		u := newImportStylesUpdater(t, "")
+
+		if _, err := u.ImportStyles(src, StyleImportOptions{StyleIDs: []string{"Base"}}); err != nil {
+			t.Fatalf("ImportStyles: %v", err)
+		}
+		if findStyleBlock(readStylesXML(t, u), "Base") == "" {
+			t.Error("Base should be imported into a new styles.xml")
+		}
+		rels, err := os.ReadFile(filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels"))
+		if err != nil {
+			t.Fatalf("read rels: %v", err)
+		}
+		if !strings.Contains(string(rels), "styles.xml") {
+			t.Error("styles relationship should be added")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		src := newImportStylesUpdater(t, sourceStyles)
+		u := newImportStylesUpdater(t, targetStyles)
+
+		if _, err := u.ImportStyles(nil, StyleImportOptions{}); err == nil {
+			t.Error("expected error for nil source")
+		}
+		if _, err := u.ImportStyles(src, StyleImportOptions{StyleIDs: []string{"Missing"}}); err == nil {
+			t.Error("expected error for unknown style ID")
+		}
+		if _, err := u.ImportStyles(newImportStylesUpdater(t, ""), StyleImportOptions{}); err == nil {
+			t.Error("expected error for source without styles.xml")
+		}
+	})
+}
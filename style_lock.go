@@ -0,0 +1,164 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var latentStylesOpenTagPattern = regexp.MustCompile(`<w:latentStyles\b[^>]*>`)
+
+// ProtectStyles locks (locked true) or unlocks every style defined in
+// word/styles.xml and turns Word's formatting restrictions on or off in
+// word/settings.xml. With the restrictions enforced, Word greys out locked
+// styles in the style gallery and does not let users apply direct
+// formatting or modify them. Built-in styles not defined in styles.xml
+// follow the lock state of the latent style defaults.
+func (u *Updater) ProtectStyles(locked bool) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+
+	stylesPath := filepath.Join(u.tempDir, "word", "styles.xml")
+	raw, err := os.ReadFile(stylesPath)
+	if err != nil {
+		return NewFileReadError("styles.xml", err)
+	}
+	stylesXML := string(raw)
+	updated := styleElementPattern.ReplaceAllStringFunc(stylesXML, func(block string) string {
+		return setStyleLocked(block, locked)
+	})
+	updated = latentStylesOpenTagPattern.ReplaceAllStringFunc(updated, func(tag string) string {
+		state := "0"
+		if locked {
+			state = "1"
+		}
+		return setOpenTagAttr(tag, "w:defLockedState", state)
+	})
+	if updated != stylesXML {
+		if err := atomicWriteFile(stylesPath, []byte(updated), 0o644); err != nil {
+			return NewXMLWriteError("styles.xml", err)
+		}
+	}
+
+	return u.updateSettings(func(children []xmlChild) []xmlChild {
+		protection := ""
+		for _, child := range children {
+			if child.name == "w:documentProtection" {
+				protection = string(child.xml)
+			}
+		}
+		return upsertOrderedChild(children, "w:documentProtection", formattingProtectionXML(protection, locked), settingsChildOrder)
+	})
+}
+
+// LockStyle locks a single style, so that Word does not let users apply or
+// modify it once formatting restrictions are enforced (see ProtectStyles).
+func (u *Updater) LockStyle(styleID string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	return u.setStyleLock(styleID, true)
+}
+
+// UnlockStyle removes the lock that LockStyle or ProtectStyles placed on a
+// style, keeping it available while formatting restrictions are enforced.
+func (u *Updater) UnlockStyle(styleID string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	return u.setStyleLock(styleID, false)
+}
+
+// setStyleLock implements LockStyle and UnlockStyle.
+func (u *Updater) setStyleLock(styleID string, locked bool) error {
+	if styleID == "" {
+		return NewValidationError("styleID", "style ID cannot be empty")
+	}
+	stylesPath := filepath.Join(u.tempDir, "word", "styles.xml")
+	raw, err := os.ReadFile(stylesPath)
+	if err != nil {
+		return NewFileReadError("styles.xml", err)
+	}
+	stylesXML := string(raw)
+
+	block := findStyleBlock(stylesXML, styleID)
+	if block == "" {
+		return NewValidationError("styleID", fmt.Sprintf("style %q not found", styleID))
+	}
+	updated := strings.Replace(stylesXML, block, setStyleLocked(block, locked), 1)
+	if updated == stylesXML {
+		return nil
+	}
+	if err := atomicWriteFile(stylesPath, []byte(updated), 0o644); err != nil {
+		return NewXMLWriteError("styles.xml", err)
+	}
+	return nil
+}
+
+// setStyleLocked adds or removes the <w:locked/> element of a style
+// definition, keeping the CT_Style child order.
+func setStyleLocked(block string, locked bool) string {
+	openTag := styleOpenTagPattern.FindString(block)
+	if openTag == "" || !strings.HasSuffix(block, "</w:style>") {
+		return block
+	}
+	children := splitXMLChildren([]byte(styleBody(block)))
+	hasLock := false
+	for _, child := range children {
+		hasLock = hasLock || child.name == "w:locked"
+	}
+	if hasLock == locked {
+		return block
+	}
+
+	elemXML := ""
+	if locked {
+		elemXML = "<w:locked/>"
+	}
+	children = upsertOrderedChild(children, "w:locked", elemXML, styleChildOrder)
+
+	var buf strings.Builder
+	buf.WriteString(openTag)
+	for _, child := range children {
+		buf.Write(child.xml)
+	}
+	buf.WriteString("</w:style>")
+	return buf.String()
+}
+
+// formattingProtectionXML returns the <w:documentProtection> element that
+// enforces (or stops enforcing) formatting restrictions, keeping an editing
+// restriction that protection already sets. It returns "" when no
+// restriction is left.
+func formattingProtectionXML(protection string, locked bool) string {
+	if protection == "" {
+		protection = "<w:documentProtection/>"
+	}
+	if locked {
+		protection = setOpenTagAttr(protection, "w:formatting", "1")
+		return setOpenTagAttr(protection, "w:enforcement", "1")
+	}
+	protection = setOpenTagAttr(protection, "w:formatting", "")
+	if !strings.Contains(protection, "w:edit=") {
+		return ""
+	}
+	return protection
+}
+
+// setOpenTagAttr sets attribute name of the empty element or opening tag
+// tag to value, replacing an existing value; an empty value removes it.
+func setOpenTagAttr(tag, name, value string) string {
+	attrPattern := regexp.MustCompile(`\s` + regexp.QuoteMeta(name) + `="[^"]*"`)
+	tag = attrPattern.ReplaceAllString(tag, "")
+	if value == "" {
+		return tag
+	}
+	end := len(tag) - len(">")
+	if strings.HasSuffix(tag, "/>") {
+		end = len(tag) - len("/>")
+	}
+	return tag[:end] + fmt.Sprintf(` %s="%s"`, name, xmlEscape(value)) + tag[end:]
+}
@@ -0,0 +1,223 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// styleChildOrder is the element sequence mandated by CT_Style
+// (ECMA-376 Part 1 §17.7.4.17).
+var styleChildOrder = []string{
+	"name", "aliases", "basedOn", "next", "link", "autoRedefine", "hidden", "uiPriority",
+	"semiHidden", "unhideWhenUsed", "qFormat", "locked", "personal", "personalCompose",
+	"personalReply", "rsid", "pPr", "rPr", "tblPr", "trPr", "tcPr", "tblStylePr",
+}
+
+var (
+	styleLinkPattern = regexp.MustCompile(`<w:link w:val="([^"]*)"`)
+	xmlAttrPattern   = regexp.MustCompile(`([\w:]+)="([^"]*)"`)
+)
+
+// PinStyles makes the given styles, and the styles they are based on,
+// self-contained: the paragraph and run properties each inherits through its
+// basedOn chain are written into its own definition in word/styles.xml, so
+// the formatting survives when the document is opened without the template
+// it was created from. A basedOn or link reference to a style that is not
+// defined in the document is removed, as is the setting that makes Word
+// refresh styles from the attached template.
+func (u *Updater) PinStyles(styleIDs []string) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if len(styleIDs) == 0 {
+		return NewValidationError("styleIDs", "at least one style ID is required")
+	}
+	return u.pinStyles(styleIDs)
+}
+
+// PinAllStyles pins every style defined in word/styles.xml, as PinStyles does.
+func (u *Updater) PinAllStyles() error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	return u.pinStyles(nil)
+}
+
+// pinStyles implements PinStyles; nil styleIDs pins all styles.
+func (u *Updater) pinStyles(styleIDs []string) error {
+	stylesPath := filepath.Join(u.tempDir, "word", "styles.xml")
+	raw, err := os.ReadFile(stylesPath)
+	if err != nil {
+		return NewFileReadError("styles.xml", err)
+	}
+	stylesXML := string(raw)
+
+	for _, id := range styleIDs {
+		if findStyleBlock(stylesXML, id) == "" {
+			return NewValidationError("styleIDs", fmt.Sprintf("style %q not found", id))
+		}
+	}
+	ids, err := selectImportStyles(stylesXML, StyleImportOptions{StyleIDs: styleIDs, IncludeBasedOn: true})
+	if err != nil {
+		return err
+	}
+
+	// Each style is resolved against the original definitions; pinning is
+	// idempotent, so the order of replacement does not matter.
+	updated := stylesXML
+	for _, id := range ids {
+		block := findStyleBlock(stylesXML, id)
+		pinned, err := pinStyleBlock(stylesXML, id)
+		if err != nil {
+			return fmt.Errorf("pin style %s: %w", id, err)
+		}
+		updated = strings.Replace(updated, block, pinned, 1)
+	}
+
+	if updated != stylesXML {
+		if err := atomicWriteFile(stylesPath, []byte(updated), 0o644); err != nil {
+			return NewXMLWriteError("styles.xml", err)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(u.tempDir, "word", "settings.xml")); err == nil {
+		return u.updateSettings(func(children []xmlChild) []xmlChild {
+			return upsertOrderedChild(children, "w:linkStyles", "", settingsChildOrder)
+		})
+	}
+	return nil
+}
+
+// pinStyleBlock returns the definition of styleID with the pPr and rPr of its
+// basedOn ancestors merged in and dangling references removed.
+func pinStyleBlock(stylesXML, styleID string) (string, error) {
+	block := findStyleBlock(stylesXML, styleID)
+	openTag := styleOpenTagPattern.FindString(block)
+	if openTag == "" || !strings.HasSuffix(block, "</w:style>") {
+		return "", NewMalformedXMLError(fmt.Sprintf("malformed definition of style %s", styleID))
+	}
+
+	// Collect the chain from the root ancestor down to the style itself.
+	chain := []string{block}
+	seen := map[string]bool{styleID: true}
+	danglingBasedOn := false
+	for current := block; ; {
+		m := styleBasedOnPattern.FindStringSubmatch(current)
+		if m == nil || seen[m[1]] {
+			break
+		}
+		parent := findStyleBlock(stylesXML, m[1])
+		if parent == "" {
+			danglingBasedOn = current == block
+			break
+		}
+		seen[m[1]] = true
+		chain = append([]string{parent}, chain...)
+		current = parent
+	}
+
+	var pPr, rPr []xmlChild
+	for _, b := range chain {
+		for _, child := range splitXMLChildren([]byte(styleBody(b))) {
+			switch child.name {
+			case "w:pPr":
+				pPr = mergeProperties(pPr, elementChildren(child), pPrChildOrder)
+			case "w:rPr":
+				rPr = mergeProperties(rPr, elementChildren(child), rPrChildOrder)
+			}
+		}
+	}
+
+	children := splitXMLChildren([]byte(styleBody(block)))
+	children = upsertOrderedChild(children, "w:pPr", wrapProperties("w:pPr", pPr), styleChildOrder)
+	children = upsertOrderedChild(children, "w:rPr", wrapProperties("w:rPr", rPr), styleChildOrder)
+	if danglingBasedOn {
+		children = upsertOrderedChild(children, "w:basedOn", "", styleChildOrder)
+	}
+	if m := styleLinkPattern.FindStringSubmatch(block); m != nil && findStyleBlock(stylesXML, m[1]) == "" {
+		children = upsertOrderedChild(children, "w:link", "", styleChildOrder)
+	}
+
+	var buf strings.Builder
+	buf.WriteString(openTag)
+	for _, c := range children {
+		buf.Write(c.xml)
+	}
+	buf.WriteString("</w:style>")
+	return buf.String(), nil
+}
+
+// styleBody returns the content between a style's open and close tags.
+func styleBody(block string) string {
+	openTag := styleOpenTagPattern.FindString(block)
+	return strings.TrimSuffix(block[len(openTag):], "</w:style>")
+}
+
+// elementChildren returns the children of an element such as <w:pPr>.
+func elementChildren(elem xmlChild) []xmlChild {
+	openEnd := bytes.IndexByte(elem.xml, '>')
+	if openEnd == -1 || elem.xml[openEnd-1] == '/' {
+		return nil
+	}
+	inner := bytes.TrimSuffix(elem.xml[openEnd+1:], []byte("</"+elem.name+">"))
+	return splitXMLChildren(inner)
+}
+
+// mergeProperties applies the property elements of a derived style on top of
+// the inherited ones. Empty elements set on both are merged attribute by
+// attribute, as Word does for e.g. <w:spacing>; other elements, and the
+// revision records of the derived style, replace the inherited element.
+func mergeProperties(inherited, own []xmlChild, order []string) []xmlChild {
+	for _, child := range own {
+		if strings.HasSuffix(child.name, "Change") {
+			continue
+		}
+		elemXML := string(child.xml)
+		for _, prev := range inherited {
+			if prev.name == child.name {
+				elemXML = mergeEmptyElementAttrs(string(prev.xml), elemXML)
+				break
+			}
+		}
+		inherited = upsertOrderedChild(inherited, child.name, elemXML, order)
+	}
+	return inherited
+}
+
+// mergeEmptyElementAttrs returns derived with the attributes of base it does
+// not set, when both are empty elements; otherwise it returns derived. w:val
+// is never inherited: a bare <w:b/> means on, whatever the base says.
+func mergeEmptyElementAttrs(base, derived string) string {
+	if !strings.HasSuffix(base, "/>") || !strings.HasSuffix(derived, "/>") {
+		return derived
+	}
+	set := make(map[string]bool)
+	for _, m := range xmlAttrPattern.FindAllStringSubmatch(derived, -1) {
+		set[m[1]] = true
+	}
+	var extra strings.Builder
+	for _, m := range xmlAttrPattern.FindAllStringSubmatch(base, -1) {
+		if !set[m[1]] && m[1] != "w:val" {
+			fmt.Fprintf(&extra, ` %s="%s"`, m[1], m[2])
+		}
+	}
+	return strings.TrimSuffix(derived, "/>") + extra.String() + "/>"
+}
+
+// wrapProperties returns children wrapped in a qname element, or "" for none.
+func wrapProperties(qname string, children []xmlChild) string {
+	if len(children) == 0 {
+		return ""
+	}
+	var buf strings.Builder
+	buf.WriteString("<" + qname + ">")
+	for _, c := range children {
+		buf.Write(c.xml)
+	}
+	buf.WriteString("</" + qname + ">")
+	return buf.String()
+}
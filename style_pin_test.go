@@ -0,0 +1,73 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPinStyles(t *testing.T) {
+	stylesXML := importStylesXML(
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/>` +
+			`<w:pPr><w:spacing w:after="160" w:line="259" w:lineRule="auto"/></w:pPr><w:rPr><w:b w:val="0"/><w:sz w:val="22"/></w:rPr></w:style>` +
+			`<w:style w:type="paragraph" w:styleId="Base"><w:name w:val="Base"/><w:basedOn w:val="Normal"/>` +
+			`<w:link w:val="TemplateChar"/><w:pPr><w:spacing w:before="120"/></w:pPr><w:rPr><w:b/></w:rPr></w:style>` +
+			`<w:style w:type="paragraph" w:styleId="Fancy"><w:name w:val="Fancy"/><w:basedOn w:val="Base"/>` +
+			`<w:rPr><w:i/><w:sz w:val="28"/></w:rPr></w:style>` +
+			`<w:style w:type="paragraph" w:styleId="Orphan"><w:name w:val="Orphan"/><w:basedOn w:val="MissingTemplateStyle"/></w:style>` +
+			`<w:style w:type="paragraph" w:styleId="Other"><w:name w:val="Other"/><w:basedOn w:val="Normal"/></w:style>`)
+	u := newImportStylesUpdater(t, stylesXML)
+	settings := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:settings xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:linkStyles/><w:defaultTabStop w:val="720"/></w:settings>`
+	if err := os.WriteFile(filepath.Join(u.tempDir, "word", "settings.xml"), []byte(settings), 0o644); err != nil {
+		t.Fatalf("write settings.xml: %v", err)
+	}
+
+	if err := u.PinStyles([]string{"Fancy", "Orphan"}); err != nil {
+		t.Fatalf("PinStyles: %v", err)
+	}
+	got := readStylesXML(t, u)
+
+	for _, want := range []string{
+		// Fancy carries the whole chain, with its own values winning.
+		`<w:basedOn w:val="Base"/><w:pPr><w:spacing w:before="120" w:after="160" w:line="259" w:lineRule="auto"/></w:pPr>` +
+			`<w:rPr><w:b/><w:i/><w:sz w:val="28"/></w:rPr></w:style>`,
+		// Base is pinned as an ancestor and loses its dangling link.
+		`w:styleId="Base"><w:name w:val="Base"/><w:basedOn w:val="Normal"/><w:pPr><w:spacing w:before="120" w:after="160"`,
+		// Orphan loses its reference to the missing template style.
+		`w:styleId="Orphan"><w:name w:val="Orphan"/></w:style>`,
+		// Styles not requested are left alone.
+		`w:styleId="Other"><w:name w:val="Other"/><w:basedOn w:val="Normal"/></w:style>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %s in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "TemplateChar") {
+		t.Errorf("expected the dangling link to be removed:\n%s", got)
+	}
+	if s := readTempFile(t, u, "word/settings.xml"); strings.Contains(s, "linkStyles") || !strings.Contains(s, "defaultTabStop") {
+		t.Errorf("expected only linkStyles removed from settings: %s", s)
+	}
+
+	// Pinning is idempotent.
+	if err := u.PinAllStyles(); err != nil {
+		t.Fatalf("PinAllStyles: %v", err)
+	}
+	if err := u.PinAllStyles(); err != nil {
+		t.Fatalf("PinAllStyles: %v", err)
+	}
+	again := readStylesXML(t, u)
+	if !strings.Contains(again, `w:styleId="Other"><w:name w:val="Other"/><w:basedOn w:val="Normal"/>`+
+		`<w:pPr><w:spacing w:after="160" w:line="259" w:lineRule="auto"/></w:pPr><w:rPr><w:b w:val="0"/><w:sz w:val="22"/></w:rPr></w:style>`) {
+		t.Errorf("expected Other to be pinned by PinAllStyles:\n%s", again)
+	}
+	if strings.Count(again, "<w:spacing") != 4 {
+		t.Errorf("expected one spacing element per style based on Normal:\n%s", again)
+	}
+
+	if err := u.PinStyles([]string{"Nope"}); err == nil {
+		t.Error("expected error for unknown style")
+	}
+}
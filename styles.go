@@ -36,9 +36,10 @@ type StyleDefinition struct {
 	NextStyle string
 
 	// Font settings
-	FontFamily string // e.g., "Arial", "Times New Roman"
-	FontSize   int    // Font size in half-points (e.g., 24 = 12pt)
-	Color      string // Hex color code without '#' (e.g., "FF0000")
+	FontFamily string       // e.g., "Arial", "Times New Roman"
+	ThemeFont  ThemeFontRef // Theme headings or body font, taking precedence over FontFamily
+	FontSize   int          // Font size in half-points (e.g., 24 = 12pt)
+	Color      string       // Hex color code without '#' (e.g., "FF0000")
 
 	// Text formatting
 	Bold          bool
@@ -62,6 +63,14 @@ type StyleDefinition struct {
 
 	// Outline level (0-8, paragraph styles only, used for TOC)
 	OutlineLevel int
+
+	// Background shading (paragraph styles only)
+	BackgroundColor string         // 6-digit hex fill color
+	ShadingPattern  ShadingPattern // Fill pattern (default: ShadingClear when BackgroundColor is set)
+
+	// Tab stops (paragraph styles only)
+	TabStops      []TabStop // Custom tab stops defined by the style
+	ClearTabStops bool      // Clear tab stops inherited from the BasedOn style
 }
 
 // AddStyle adds a custom style definition to the document.
@@ -69,10 +78,10 @@ type StyleDefinition struct {
 // ParagraphOptions.Style to the style ID.
 func (u *Updater) AddStyle(def StyleDefinition) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if def.ID == "" {
-		return fmt.Errorf("style ID cannot be empty")
+		return NewValidationError("ID", "style ID cannot be empty")
 	}
 	if def.Name == "" {
 		def.Name = def.ID
@@ -80,6 +89,25 @@ func (u *Updater) AddStyle(def StyleDefinition) error {
 	if def.Type == "" {
 		def.Type = StyleTypeParagraph
 	}
+	if err := validateColor("Color", def.Color); err != nil {
+		return err
+	}
+	if err := validateThemeFontRef("ThemeFont", def.ThemeFont); err != nil {
+		return err
+	}
+	if err := validateShading(def.BackgroundColor, def.ShadingPattern); err != nil {
+		return err
+	}
+	if err := validateTabStops(def.TabStops); err != nil {
+		return err
+	}
+	if def.ClearTabStops && def.BasedOn != "" {
+		tabs, err := u.withClearedTabStops(def.BasedOn, def.TabStops)
+		if err != nil {
+			return fmt.Errorf("resolve inherited tab stops: %w", err)
+		}
+		def.TabStops = tabs
+	}
 
 	styleXML := generateStyleXML(def)
 
@@ -91,7 +119,7 @@ func (u *Updater) AddStyle(def StyleDefinition) error {
 		// Create new styles.xml
 		updated := generateStylesDocument(styleXML)
 		if err := atomicWriteFile(stylesPath, updated, 0o644); err != nil {
-			return fmt.Errorf("write styles.xml: %w", err)
+			return NewXMLWriteError("styles.xml", err)
 		}
 		// Ensure relationship and content type
 		if err := u.ensureStylesRelationship(); err != nil {
@@ -107,7 +135,7 @@ func (u *Updater) AddStyle(def StyleDefinition) error {
 	}
 
 	if err := atomicWriteFile(stylesPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write styles.xml: %w", err)
+		return NewXMLWriteError("styles.xml", err)
 	}
 
 	return nil
@@ -160,71 +188,71 @@ func generateStyleXML(def StyleDefinition) []byte {
 
 // generateStyleParagraphProps creates paragraph properties XML for a style
 func generateStyleParagraphProps(def StyleDefinition) string {
-	var buf strings.Builder
-	hasProps := false
-
-	var inner strings.Builder
+	// Children are placed in CT_PPr order whatever order they are added in
+	var props []xmlChild
+	set := func(name, elemXML string) {
+		props = upsertOrderedChild(props, "w:"+name, elemXML, pPrChildOrder)
+	}
 
 	if alignment, ok := paragraphAlignmentValue(def.Alignment); ok {
-		inner.WriteString(fmt.Sprintf(`<w:jc w:val="%s"/>`, alignment))
-		hasProps = true
+		set("jc", fmt.Sprintf(`<w:jc w:val="%s"/>`, alignment))
 	}
 
 	if def.SpaceBefore > 0 || def.SpaceAfter > 0 || def.LineSpacing > 0 {
-		inner.WriteString("<w:spacing")
+		var spacing strings.Builder
+		spacing.WriteString("<w:spacing")
 		if def.SpaceBefore > 0 {
-			inner.WriteString(fmt.Sprintf(` w:before="%d"`, def.SpaceBefore))
+			spacing.WriteString(fmt.Sprintf(` w:before="%d"`, def.SpaceBefore))
 		}
 		if def.SpaceAfter > 0 {
-			inner.WriteString(fmt.Sprintf(` w:after="%d"`, def.SpaceAfter))
+			spacing.WriteString(fmt.Sprintf(` w:after="%d"`, def.SpaceAfter))
 		}
 		if def.LineSpacing > 0 {
-			inner.WriteString(fmt.Sprintf(` w:line="%d" w:lineRule="auto"`, def.LineSpacing))
+			spacing.WriteString(fmt.Sprintf(` w:line="%d" w:lineRule="auto"`, def.LineSpacing))
 		}
-		inner.WriteString("/>")
-		hasProps = true
+		spacing.WriteString("/>")
+		set("spacing", spacing.String())
 	}
 
 	if def.IndentLeft > 0 || def.IndentRight > 0 || def.IndentFirst != 0 {
-		inner.WriteString("<w:ind")
+		var ind strings.Builder
+		ind.WriteString("<w:ind")
 		if def.IndentLeft > 0 {
-			inner.WriteString(fmt.Sprintf(` w:left="%d"`, def.IndentLeft))
+			ind.WriteString(fmt.Sprintf(` w:left="%d"`, def.IndentLeft))
 		}
 		if def.IndentRight > 0 {
-			inner.WriteString(fmt.Sprintf(` w:right="%d"`, def.IndentRight))
+			ind.WriteString(fmt.Sprintf(` w:right="%d"`, def.IndentRight))
 		}
 		if def.IndentFirst != 0 {
-			inner.WriteString(fmt.Sprintf(` w:firstLine="%d"`, def.IndentFirst))
+			ind.WriteString(fmt.Sprintf(` w:firstLine="%d"`, def.IndentFirst))
 		}
-		inner.WriteString("/>")
-		hasProps = true
+		ind.WriteString("/>")
+		set("ind", ind.String())
 	}
 
 	if def.KeepNext {
-		inner.WriteString("<w:keepNext/>")
-		hasProps = true
+		set("keepNext", "<w:keepNext/>")
 	}
 	if def.KeepLines {
-		inner.WriteString("<w:keepLines/>")
-		hasProps = true
+		set("keepLines", "<w:keepLines/>")
 	}
 	if def.PageBreakBef {
-		inner.WriteString("<w:pageBreakBefore/>")
-		hasProps = true
+		set("pageBreakBefore", "<w:pageBreakBefore/>")
 	}
 
-	if def.OutlineLevel > 0 && def.OutlineLevel <= 9 {
-		inner.WriteString(fmt.Sprintf(`<w:outlineLvl w:val="%d"/>`, def.OutlineLevel-1))
-		hasProps = true
+	if shd := generateShadingXML(def.BackgroundColor, def.ShadingPattern); shd != "" {
+		set("shd", shd)
 	}
 
-	if hasProps {
-		buf.WriteString("<w:pPr>")
-		buf.WriteString(inner.String())
-		buf.WriteString("</w:pPr>")
+	if tabs := generateTabsXML(def.TabStops); tabs != "" {
+		set("tabs", tabs)
 	}
 
-	return buf.String()
+	if def.OutlineLevel > 0 && def.OutlineLevel <= 9 {
+		set("outlineLvl", fmt.Sprintf(`<w:outlineLvl w:val="%d"/>`, def.OutlineLevel-1))
+	}
+
+	return wrapProperties("w:pPr", props)
 }
 
 // generateStyleRunProps creates run properties XML for a style
@@ -234,10 +262,16 @@ func generateStyleRunProps(def StyleDefinition) string {
 
 	var inner strings.Builder
 
-	if def.FontFamily != "" {
-		escaped := xmlEscape(def.FontFamily)
-		inner.WriteString(fmt.Sprintf(`<w:rFonts w:ascii="%s" w:hAnsi="%s" w:cs="%s"/>`,
-			escaped, escaped, escaped))
+	if def.FontFamily != "" || themeFontAttrs(def.ThemeFont) != "" {
+		inner.WriteString("<w:rFonts")
+		if def.FontFamily != "" {
+			escaped := xmlEscape(def.FontFamily)
+			inner.WriteString(fmt.Sprintf(` w:ascii="%s" w:hAnsi="%s" w:cs="%s"`, escaped, escaped, escaped))
+		}
+		if attrs := themeFontAttrs(def.ThemeFont); attrs != "" {
+			inner.WriteString(" " + attrs)
+		}
+		inner.WriteString("/>")
 		hasProps = true
 	}
 
@@ -273,11 +307,7 @@ func generateStyleRunProps(def StyleDefinition) string {
 	}
 
 	if def.Color != "" {
-		color := normalizeHexColor(def.Color)
-		if color == "" {
-			color = def.Color
-		}
-		inner.WriteString(fmt.Sprintf(`<w:color w:val="%s"/>`, xmlEscape(color)))
+		inner.WriteString(fmt.Sprintf(`<w:color w:val="%s"/>`, normalizeHexColor(def.Color)))
 		hasProps = true
 	}
 
@@ -310,7 +340,7 @@ func injectStyle(stylesXML, styleXML []byte) ([]byte, error) {
 	closeTag := []byte("</w:styles>")
 	closeIdx := bytes.LastIndex(stylesXML, closeTag)
 	if closeIdx == -1 {
-		return nil, fmt.Errorf("could not find </w:styles> closing tag")
+		return nil, NewMalformedXMLError("could not find </w:styles> closing tag")
 	}
 
 	result := make([]byte, 0, len(stylesXML)+len(styleXML)+1)
@@ -328,7 +358,7 @@ func (u *Updater) ensureStylesRelationship() error {
 	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
 	raw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return fmt.Errorf("read rels: %w", err)
+		return NewFileReadError("rels", err)
 	}
 
 	content := string(raw)
@@ -343,7 +373,7 @@ func (u *Updater) ensureStylesRelationship() error {
 		)
 		content = strings.Replace(content, "</Relationships>", newRel+"</Relationships>", 1)
 		if err := atomicWriteFile(relsPath, []byte(content), 0o644); err != nil {
-			return fmt.Errorf("write rels: %w", err)
+			return NewXMLWriteError("rels", err)
 		}
 	}
 
@@ -351,7 +381,7 @@ func (u *Updater) ensureStylesRelationship() error {
 	ctPath := filepath.Join(u.tempDir, "[Content_Types].xml")
 	ctRaw, err := os.ReadFile(ctPath)
 	if err != nil {
-		return fmt.Errorf("read content types: %w", err)
+		return NewFileReadError("content types", err)
 	}
 
 	ctContent := string(ctRaw)
@@ -359,7 +389,7 @@ func (u *Updater) ensureStylesRelationship() error {
 		override := `<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>`
 		ctContent = strings.Replace(ctContent, "</Types>", override+"</Types>", 1)
 		if err := atomicWriteFile(ctPath, []byte(ctContent), 0o644); err != nil {
-			return fmt.Errorf("write content types: %w", err)
+			return NewFileWriteError("content types", err)
 		}
 	}
 
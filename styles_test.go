@@ -1,6 +1,7 @@
 package godocx
 
 import (
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -232,3 +233,170 @@ func TestGenerateStylesDocument(t *testing.T) {
 		t.Error("expected closing tag")
 	}
 }
+
+func TestSetDefaultParagraphAndCharacterStyle(t *testing.T) {
+	stylesXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:docDefaults><w:rPrDefault><w:rPr><w:rFonts w:asciiTheme="minorHAnsi" w:eastAsiaTheme="minorEastAsia" w:hAnsiTheme="minorHAnsi" w:cstheme="minorBidi"/>` +
+		`<w:sz w:val="22"/><w:lang w:val="en-US" w:eastAsia="ja-JP" w:bidi="ar-SA"/></w:rPr></w:rPrDefault>` +
+		`<w:pPrDefault><w:pPr><w:spacing w:after="160" w:line="259" w:lineRule="auto"/></w:pPr></w:pPrDefault></w:docDefaults>` +
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/></w:style>` +
+		`</w:styles>`
+	docXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body><w:p/></w:body></w:document>`
+	u := newUpdaterFromFixture(t, buildIntegrationDocxFromParts(t, docXML, stylesXML, ""))
+
+	if got, err := u.GetDefaultParagraphStyle(); err != nil || got != (DefaultParaStyle{FontSize: 22, LineSpacing: 259, SpaceAfter: 160}) {
+		t.Errorf("GetDefaultParagraphStyle = %+v, %v", got, err)
+	}
+
+	if err := u.SetDefaultParagraphStyle(DefaultParaStyle{FontFamily: "Arial", LineSpacing: 276, Alignment: ParagraphAlignJustify}); err != nil {
+		t.Fatalf("SetDefaultParagraphStyle: %v", err)
+	}
+	if err := u.SetDefaultCharacterStyle(DefaultCharStyle{FontSize: 24, Color: "#1f3864", Language: "en-GB"}); err != nil {
+		t.Fatalf("SetDefaultCharacterStyle: %v", err)
+	}
+
+	styles := readStylesXML(t, u)
+	want := `<w:docDefaults><w:rPrDefault><w:rPr>` +
+		`<w:rFonts w:ascii="Arial" w:hAnsi="Arial" w:cs="Arial" w:eastAsiaTheme="minorEastAsia"/>` +
+		`<w:color w:val="1F3864"/><w:sz w:val="24"/><w:szCs w:val="24"/>` +
+		`<w:lang w:val="en-GB" w:eastAsia="ja-JP" w:bidi="ar-SA"/></w:rPr></w:rPrDefault>` +
+		`<w:pPrDefault><w:pPr><w:spacing w:line="276" w:lineRule="auto" w:after="160"/><w:jc w:val="both"/></w:pPr></w:pPrDefault>` +
+		`</w:docDefaults><w:style`
+	if !strings.Contains(styles, want) {
+		t.Errorf("unexpected document defaults:\n%s\nwant:\n%s", styles, want)
+	}
+
+	char, err := u.GetDefaultCharacterStyle()
+	if err != nil {
+		t.Fatalf("GetDefaultCharacterStyle: %v", err)
+	}
+	if char != (DefaultCharStyle{FontFamily: "Arial", FontSize: 24, Color: "1F3864", Language: "en-GB"}) {
+		t.Errorf("GetDefaultCharacterStyle = %+v", char)
+	}
+	para, err := u.GetDefaultParagraphStyle()
+	if err != nil {
+		t.Fatalf("GetDefaultParagraphStyle: %v", err)
+	}
+	if para != (DefaultParaStyle{FontFamily: "Arial", FontSize: 24, LineSpacing: 276, SpaceAfter: 160, Alignment: ParagraphAlignJustify}) {
+		t.Errorf("GetDefaultParagraphStyle = %+v", para)
+	}
+
+	if err := u.SetDefaultParagraphStyle(DefaultParaStyle{Alignment: "middle"}); err == nil {
+		t.Error("expected error for unsupported alignment")
+	}
+	if err := u.SetDefaultCharacterStyle(DefaultCharStyle{Color: "blue"}); err == nil {
+		t.Error("expected error for invalid color")
+	}
+}
+
+func TestSetDefaultCharacterStyleCreatesDocDefaults(t *testing.T) {
+	stylesXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/></w:style>` +
+		`</w:styles>`
+	docXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body><w:p/></w:body></w:document>`
+	u := newUpdaterFromFixture(t, buildIntegrationDocxFromParts(t, docXML, stylesXML, ""))
+
+	if err := u.SetDefaultCharacterStyle(DefaultCharStyle{FontFamily: "Georgia"}); err != nil {
+		t.Fatalf("SetDefaultCharacterStyle: %v", err)
+	}
+	want := `main"><w:docDefaults><w:rPrDefault><w:rPr><w:rFonts w:ascii="Georgia" w:hAnsi="Georgia" w:cs="Georgia"/></w:rPr></w:rPrDefault></w:docDefaults><w:style`
+	if styles := readStylesXML(t, u); !strings.Contains(styles, want) {
+		t.Errorf("expected docDefaults as the first child of w:styles:\n%s", styles)
+	}
+}
+
+func TestLockStyleSurvivesSave(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	if err := u.AddStyle(StyleDefinition{ID: "Brand", Name: "Brand", BasedOn: "Normal", Bold: true}); err != nil {
+		t.Fatalf("AddStyle: %v", err)
+	}
+	if err := u.LockStyle("Brand"); err != nil {
+		t.Fatalf("LockStyle: %v", err)
+	}
+	if err := u.LockStyle("Brand"); err != nil {
+		t.Fatalf("LockStyle twice: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "locked.docx")
+	if err := u.Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	reopened, err := New(path)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer reopened.Cleanup()
+
+	block := findStyleBlock(readStylesXML(t, reopened), "Brand")
+	if !strings.Contains(block, `<w:basedOn w:val="Normal"/><w:locked/><w:rPr>`) || strings.Count(block, "<w:locked/>") != 1 {
+		t.Errorf("expected one <w:locked/> in schema order:\n%s", block)
+	}
+
+	if err := reopened.UnlockStyle("Brand"); err != nil {
+		t.Fatalf("UnlockStyle: %v", err)
+	}
+	if block := findStyleBlock(readStylesXML(t, reopened), "Brand"); strings.Contains(block, "<w:locked/>") {
+		t.Errorf("expected the lock removed:\n%s", block)
+	}
+	if err := reopened.LockStyle("Missing"); err == nil {
+		t.Error("expected error for unknown style")
+	}
+}
+
+func TestProtectStyles(t *testing.T) {
+	stylesXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:latentStyles w:defLockedState="0" w:defUIPriority="99"/>` +
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/></w:style>` +
+		`<w:style w:type="character" w:styleId="Strong"><w:name w:val="Strong"/><w:rPr><w:b/></w:rPr></w:style>` +
+		`</w:styles>`
+	docXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body><w:p/></w:body></w:document>`
+	u := newUpdaterFromFixture(t, buildIntegrationDocxFromParts(t, docXML, stylesXML, ""))
+
+	if err := u.ProtectStyles(true); err != nil {
+		t.Fatalf("ProtectStyles(true): %v", err)
+	}
+	styles := readStylesXML(t, u)
+	for _, want := range []string{
+		`<w:latentStyles w:defUIPriority="99" w:defLockedState="1"/>`,
+		`<w:name w:val="Normal"/><w:qFormat/><w:locked/></w:style>`,
+		`<w:name w:val="Strong"/><w:locked/><w:rPr><w:b/></w:rPr></w:style>`,
+	} {
+		if !strings.Contains(styles, want) {
+			t.Errorf("expected %s in:\n%s", want, styles)
+		}
+	}
+	if settings := readTempFile(t, u, "word/settings.xml"); !strings.Contains(settings, `<w:documentProtection w:formatting="1" w:enforcement="1"/>`) {
+		t.Errorf("expected formatting restrictions to be enforced:\n%s", settings)
+	}
+
+	if err := u.ProtectStyles(false); err != nil {
+		t.Fatalf("ProtectStyles(false): %v", err)
+	}
+	if styles := readStylesXML(t, u); strings.Contains(styles, "<w:locked/>") || !strings.Contains(styles, `w:defLockedState="0"`) {
+		t.Errorf("expected all styles unlocked:\n%s", styles)
+	}
+	if settings := readTempFile(t, u, "word/settings.xml"); strings.Contains(settings, "documentProtection") {
+		t.Errorf("expected the formatting restriction removed:\n%s", settings)
+	}
+}
+
+func TestFormattingProtectionKeepsEditRestriction(t *testing.T) {
+	got := formattingProtectionXML(`<w:documentProtection w:edit="readOnly" w:enforcement="1"/>`, true)
+	if got != `<w:documentProtection w:edit="readOnly" w:formatting="1" w:enforcement="1"/>` {
+		t.Errorf("locked: got %s", got)
+	}
+	if got := formattingProtectionXML(got, false); got != `<w:documentProtection w:edit="readOnly" w:enforcement="1"/>` {
+		t.Errorf("unlocked: got %s", got)
+	}
+}
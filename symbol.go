@@ -0,0 +1,134 @@
+package godocx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// SymbolCode is the character inserted by AddSymbol: a Unicode code point,
+// or the character code within a symbol font such as Wingdings.
+type SymbolCode rune
+
+// Common symbols. Any other Unicode character can be passed as a rune literal,
+// e.g. AddSymbol('∑', opts).
+const (
+	SymbolCopyright    SymbolCode = '©'
+	SymbolRegistered   SymbolCode = '®'
+	SymbolTrademark    SymbolCode = '™'
+	SymbolDegree       SymbolCode = '°'
+	SymbolBulletDot    SymbolCode = '•'
+	SymbolSection      SymbolCode = '§'
+	SymbolParagraph    SymbolCode = '¶'
+	SymbolPlusMinus    SymbolCode = '±'
+	SymbolMultiply     SymbolCode = '×'
+	SymbolDivide       SymbolCode = '÷'
+	SymbolNotEqual     SymbolCode = '≠'
+	SymbolLessEqual    SymbolCode = '≤'
+	SymbolGreaterEqual SymbolCode = '≥'
+	SymbolInfinity     SymbolCode = '∞'
+	SymbolEuro         SymbolCode = '€'
+	SymbolPound        SymbolCode = '£'
+	SymbolYen          SymbolCode = '¥'
+	SymbolCent         SymbolCode = '¢'
+	SymbolArrowRight   SymbolCode = '→'
+	SymbolArrowLeft    SymbolCode = '←'
+	SymbolCheckMark    SymbolCode = '✓'
+	SymbolBallotX      SymbolCode = '✗'
+)
+
+// SymbolOptions controls how AddSymbol inserts a symbol.
+type SymbolOptions struct {
+	// FontFamily selects the font of the symbol. With a symbol font (Symbol,
+	// Wingdings, Wingdings 2/3, Webdings) the code is a character code of that
+	// font, e.g. 0xFC for the Wingdings check mark, and is written as <w:sym>.
+	FontFamily string
+
+	// FontSize in points (0 inherits the paragraph's size)
+	FontSize float64
+
+	// Position and Anchor place the symbol. With an Anchor, the symbol is added
+	// as a run to the paragraph containing the anchor text, with Position
+	// interpreted as in InsertLineBreak. Without one, the symbol gets a
+	// paragraph of its own at Position.
+	Position InsertPosition
+	Anchor   string
+}
+
+// symbolFonts are the fonts whose characters Word stores as <w:sym>.
+var symbolFonts = map[string]bool{
+	"symbol": true, "wingdings": true, "wingdings 2": true, "wingdings 3": true, "webdings": true,
+}
+
+// AddSymbol inserts a symbol character, such as SymbolCopyright or a
+// Wingdings glyph.
+func (u *Updater) AddSymbol(code SymbolCode, opts SymbolOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if opts.FontSize < 0 {
+		return NewValidationError("FontSize", "font size cannot be negative")
+	}
+	runXML, err := generateSymbolRunXML(code, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.Anchor != "" {
+		return u.updateParagraphByAnchor(opts.Anchor, func(para []byte) ([]byte, error) {
+			return insertRunInParagraph(para, runXML, opts.Anchor, opts.Position)
+		})
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	paraXML := append(append([]byte("<w:p>"), runXML...), "</w:p>"...)
+	updated, err := insertElementAtPosition(raw, paraXML, opts.Position, "")
+	if err != nil {
+		return fmt.Errorf("insert symbol: %w", err)
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
+
+// generateSymbolRunXML creates the run holding the symbol: a <w:sym> for
+// symbol fonts, otherwise the character as text.
+func generateSymbolRunXML(code SymbolCode, opts SymbolOptions) ([]byte, error) {
+	if !symbolFonts[strings.ToLower(strings.TrimSpace(opts.FontFamily))] {
+		if code < 0x20 || code > unicode.MaxRune || !isXMLText(string(rune(code))) {
+			return nil, NewValidationError("code", fmt.Sprintf("U+%04X cannot be written as document text", code))
+		}
+		var buf bytes.Buffer
+		writeRunXML(&buf, RunOptions{Text: string(rune(code)), FontName: opts.FontFamily, FontSize: opts.FontSize})
+		return buf.Bytes(), nil
+	}
+
+	// Word addresses symbol font characters in the private use area at F000.
+	char := rune(code)
+	if char < 0x100 {
+		char += 0xF000
+	}
+	if char < 0xF000 || char > 0xF0FF {
+		return nil, NewValidationError("code", fmt.Sprintf("code %#x is outside the symbol font range", code))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("<w:r>")
+	if opts.FontSize > 0 {
+		hp := int(opts.FontSize * FontSizeHalfPointsFactor)
+		fmt.Fprintf(&buf, `<w:rPr><w:sz w:val="%d"/><w:szCs w:val="%d"/></w:rPr>`, hp, hp)
+	}
+	fmt.Fprintf(&buf, `<w:sym w:font="%s" w:char="%04X"/>`, xmlEscape(strings.TrimSpace(opts.FontFamily)), char)
+	buf.WriteString("</w:r>")
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,53 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddSymbol(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Acme Corp</w:t></w:r></w:p>`))
+
+	if err := u.AddSymbol(SymbolCopyright, SymbolOptions{Position: PositionBeforeText, Anchor: "Acme"}); err != nil {
+		t.Fatalf("AddSymbol (anchor): %v", err)
+	}
+	if err := u.AddSymbol('€', SymbolOptions{FontFamily: "Arial", FontSize: 14, Position: PositionEnd}); err != nil {
+		t.Fatalf("AddSymbol (paragraph): %v", err)
+	}
+	if err := u.AddSymbol(0xFC, SymbolOptions{FontFamily: "Wingdings", Position: PositionEnd}); err != nil {
+		t.Fatalf("AddSymbol (symbol font): %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	for _, want := range []string{
+		`<w:p><w:r><w:t>©</w:t></w:r><w:r><w:t>Acme Corp</w:t></w:r></w:p>`,
+		`<w:p><w:r><w:rPr><w:rFonts w:ascii="Arial" w:hAnsi="Arial"/><w:sz w:val="28"/><w:szCs w:val="28"/></w:rPr><w:t>€</w:t></w:r></w:p>`,
+		`<w:p><w:r><w:sym w:font="Wingdings" w:char="F0FC"/></w:r></w:p>`,
+	} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s in:\n%s", want, docXML)
+		}
+	}
+}
+
+func TestAddSymbolInvalid(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Text</w:t></w:r></w:p>`))
+
+	tests := []struct {
+		name string
+		code SymbolCode
+		opts SymbolOptions
+	}{
+		{"control character", 0x07, SymbolOptions{}},
+		{"outside symbol font range", 0x2713, SymbolOptions{FontFamily: "Wingdings"}},
+		{"negative font size", SymbolDegree, SymbolOptions{FontSize: -1}},
+		{"missing anchor text", SymbolDegree, SymbolOptions{Position: PositionAfterText}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := u.AddSymbol(tt.code, tt.opts); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
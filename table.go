@@ -47,12 +47,22 @@ const (
 	VerticalAlignBottom VerticalAlignment = "bottom"
 )
 
-// BorderStyle defines table border style
+// TextDirection defines the direction of text in table cells
+type TextDirection string
+
+const (
+	TextDirectionHorizontal  TextDirection = "lrTb" // Left to right, top to bottom (default)
+	TextDirectionVertical90  TextDirection = "btLr" // Rotated 90°, reading bottom to top
+	TextDirectionVertical270 TextDirection = "tbRl" // Rotated 270°, reading top to bottom
+)
+
+// BorderStyle defines table and paragraph border style
 type BorderStyle string
 
 const (
 	BorderSingle BorderStyle = "single"
 	BorderDouble BorderStyle = "double"
+	BorderThick  BorderStyle = "thick"
 	BorderDashed BorderStyle = "dashed"
 	BorderDotted BorderStyle = "dotted"
 	BorderNone   BorderStyle = "none"
@@ -105,11 +115,18 @@ type TableOptions struct {
 	// Header styling
 	HeaderStyle      CellStyle     // Style for header row
 	HeaderStyleName  string        // Named Word style for header paragraphs (e.g., "Heading 1")
-	RepeatHeader     bool          // Repeat header row on each page
+	RepeatHeader     bool          // Repeat the header rows at the top of each page
+	HeaderRows       int           // Header rows: the column titles plus the first HeaderRows-1 data rows (default: 1)
+	FreezeHeader     bool          // Keep header rows whole and repeated on each page (implies RepeatHeader)
 	HeaderBackground string        // Hex color for header background (e.g., "4472C4")
 	HeaderBold       bool          // Make header text bold
 	HeaderAlignment  CellAlignment // Header text alignment
 
+	// HeaderSpanRow adds a row of grouped headers above the column titles, e.g.
+	// "2025" spanning the Q1-Q4 columns. The spans must add up to the number of
+	// columns. The row is part of the header and repeats with RepeatHeader.
+	HeaderSpanRow []HeaderSpanCell
+
 	// Row styling
 	RowStyle          CellStyle         // Style for data rows
 	RowStyleName      string            // Named Word style for data row paragraphs (e.g., "Normal")
@@ -122,6 +139,7 @@ type TableOptions struct {
 	HeaderHeightRule RowHeightRule // Header height rule (auto, atLeast, exact)
 	RowHeight        int           // Data row height in twips, 0 for auto
 	RowHeightRule    RowHeightRule // Data row height rule (auto, atLeast, exact)
+	KeepRowsTogether bool          // Keep each row on one page instead of letting it break across pages
 
 	// Table properties
 	TableAlignment TableAlignment // Table alignment on page
@@ -130,7 +148,12 @@ type TableOptions struct {
 	TableStyle     TableStyle     // Predefined table style
 
 	// Border properties
-	BorderStyle BorderStyle // Border style
+	BorderStyle BorderStyle // Border style (default: single, or the TableStyle's borders when styles.xml defines it)
+
+	// UseStyleBorders suppresses all direct border formatting so the borders
+	// of TableStyle apply, even when BorderStyle is set or styles.xml does not
+	// define the style
+	UseStyleBorders bool
 
 	// Caption options (nil for no caption)
 	Caption     *CaptionOptions
@@ -154,6 +177,17 @@ type ColumnDefinition struct {
 	Width     int           // Optional: width in twips, 0 for auto
 	Alignment CellAlignment // Optional: alignment for this column
 	Bold      bool          // Make header bold
+
+	// TextDirection rotates the header cell text, letting long titles sit
+	// above narrow columns (default: TextDirectionHorizontal)
+	TextDirection TextDirection
+}
+
+// HeaderSpanCell is a cell of TableOptions.HeaderSpanRow
+type HeaderSpanCell struct {
+	Text      string        // Group header text
+	Span      int           // Number of columns covered (at least 1)
+	Alignment CellAlignment // Optional: text alignment (default: centered)
 }
 
 // CellStyle defines styling for table cells
@@ -168,7 +202,7 @@ type CellStyle struct {
 // InsertTable inserts a new table into the document
 func (u *Updater) InsertTable(opts TableOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	// Validate options
@@ -176,6 +210,17 @@ func (u *Updater) InsertTable(opts TableOptions) error {
 		return fmt.Errorf("invalid table options: %w", err)
 	}
 
+	// A table style only supplies borders when styles.xml defines it
+	if opts.BorderStyle == "" && opts.TableStyle != "" && !opts.UseStyleBorders {
+		defined, err := u.styleDefined(string(opts.TableStyle))
+		if err != nil {
+			return err
+		}
+		if !defined {
+			opts.BorderStyle = BorderSingle
+		}
+	}
+
 	// Set defaults
 	opts = applyTableDefaults(opts)
 
@@ -183,43 +228,89 @@ func (u *Updater) InsertTable(opts TableOptions) error {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	// Generate table XML
 	tableXML := generateTableXML(opts)
 
 	// Insert table at the specified position
+	opts.Caption = u.applyCaptionCounter(opts.Caption)
 	updated, err := insertTableAtPosition(raw, tableXML, opts)
 	if err != nil {
 		return fmt.Errorf("insert table: %w", err)
 	}
+	if opts.Caption != nil {
+		updated = numberCaptionField(updated)
+	}
 
 	// Write updated document
 	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
+	u.consumeCaptionReset(opts.Caption)
 
 	return nil
 }
 
+// styleDefined reports whether styles.xml defines styleID. A document
+// without a styles part defines no styles.
+func (u *Updater) styleDefined(styleID string) (bool, error) {
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "styles.xml"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, NewFileReadError("styles.xml", err)
+	}
+	return findStyleBlock(string(raw), styleID) != "", nil
+}
+
 // validateTableOptions validates table creation options
 func validateTableOptions(opts TableOptions) error {
 	if len(opts.Columns) == 0 {
-		return fmt.Errorf("at least one column is required")
+		return NewValidationError("Columns", "at least one column is required")
 	}
 
 	// Check that all rows have the correct number of cells
 	expectedCols := len(opts.Columns)
 	for i, row := range opts.Rows {
 		if len(row) != expectedCols {
-			return fmt.Errorf("row %d has %d cells, expected %d", i, len(row), expectedCols)
+			return NewValidationError("Rows", fmt.Sprintf("row %d has %d cells, expected %d", i, len(row), expectedCols))
+		}
+	}
+
+	if opts.HeaderRows < 0 {
+		return NewValidationError("HeaderRows", "cannot be negative")
+	}
+	if opts.HeaderRows > 1+len(opts.Rows) {
+		return NewValidationError("HeaderRows", fmt.Sprintf("%d header rows requested but the table has only %d rows", opts.HeaderRows, 1+len(opts.Rows)))
+	}
+
+	if len(opts.HeaderSpanRow) > 0 {
+		total := 0
+		for i, cell := range opts.HeaderSpanRow {
+			if cell.Span < 1 {
+				return NewValidationError("HeaderSpanRow", fmt.Sprintf("cell %d spans %d columns, expected at least 1", i, cell.Span))
+			}
+			total += cell.Span
+		}
+		if total != expectedCols {
+			return NewValidationError("HeaderSpanRow", fmt.Sprintf("spans add up to %d columns, expected %d", total, expectedCols))
+		}
+	}
+
+	for i, col := range opts.Columns {
+		switch col.TextDirection {
+		case "", TextDirectionHorizontal, TextDirectionVertical90, TextDirectionVertical270:
+		default:
+			return NewValidationError("Columns", fmt.Sprintf("column %d has unsupported text direction %q", i, col.TextDirection))
 		}
 	}
 
 	// Validate column widths if specified
 	if len(opts.ColumnWidths) > 0 && len(opts.ColumnWidths) != expectedCols {
-		return fmt.Errorf("column widths count (%d) must match columns count (%d)", len(opts.ColumnWidths), expectedCols)
+		return NewValidationError("ColumnWidths", fmt.Sprintf("column widths count (%d) must match columns count (%d)", len(opts.ColumnWidths), expectedCols))
 	}
 
 	return nil
@@ -236,7 +327,8 @@ func applyTableDefaults(opts TableOptions) TableOptions {
 	if opts.CellPadding == 0 {
 		opts.CellPadding = 108 // 0.075 inch
 	}
-	if opts.BorderStyle == "" {
+	// A table style supplies its own borders unless BorderStyle overrides them
+	if opts.BorderStyle == "" && opts.TableStyle == "" {
 		opts.BorderStyle = BorderSingle
 	}
 	if opts.TableAlignment == "" {
@@ -268,6 +360,12 @@ func applyTableDefaults(opts TableOptions) TableOptions {
 	if opts.RowHeightRule == "" {
 		opts.RowHeightRule = RowHeightAuto
 	}
+	if opts.HeaderRows == 0 {
+		opts.HeaderRows = 1
+	}
+	if opts.FreezeHeader {
+		opts.RepeatHeader = true
+	}
 	// Default row style to table style when not explicitly configured
 	if opts.RowStyleName == "" && opts.TableStyle != "" {
 		opts.RowStyleName = string(opts.TableStyle)
@@ -287,7 +385,7 @@ func generateTableXML(opts TableOptions) []byte {
 
 	// Table style
 	if opts.TableStyle != "" {
-		buf.WriteString(fmt.Sprintf(`<w:tblStyle w:val="%s"/>`, opts.TableStyle))
+		buf.WriteString(fmt.Sprintf(`<w:tblStyle w:val="%s"/>`, xmlEscape(string(opts.TableStyle))))
 	}
 
 	// Table width
@@ -324,11 +422,23 @@ func generateTableXML(opts TableOptions) []byte {
 	// Table grid (column definitions)
 	buf.WriteString(generateTableGrid(opts))
 
-	// Header row
-	buf.WriteString(generateHeaderRow(opts))
+	// Header rows: the grouped headers, the column titles, then any data rows
+	// promoted to headers
+	if len(opts.HeaderSpanRow) > 0 {
+		buf.WriteString(generateHeaderSpanRow(opts))
+	}
+	titles := make([]string, len(opts.Columns))
+	for i, col := range opts.Columns {
+		titles[i] = col.Title
+	}
+	buf.WriteString(generateHeaderRow(opts, titles))
+	headerData := max(opts.HeaderRows-1, 0)
+	for _, rowData := range opts.Rows[:headerData] {
+		buf.WriteString(generateHeaderRow(opts, rowData))
+	}
 
 	// Data rows
-	for i, rowData := range opts.Rows {
+	for i, rowData := range opts.Rows[headerData:] {
 		isAlternate := (i % 2) == 1
 		buf.WriteString(generateDataRow(opts, rowData, isAlternate))
 	}
@@ -340,6 +450,9 @@ func generateTableXML(opts TableOptions) []byte {
 
 // generateTableBorders creates border XML for the table
 func generateTableBorders(opts TableOptions) string {
+	if opts.UseStyleBorders || opts.BorderStyle == "" {
+		return ""
+	}
 	if opts.BorderStyle == BorderNone {
 		return `<w:tblBorders>
 			<w:top w:val="none"/>
@@ -367,6 +480,15 @@ func generateTableBorders(opts TableOptions) string {
 		opts.BorderStyle, opts.BorderSize, opts.BorderColor)
 }
 
+// verticalHeaderLength is the content length, in characters, that a rotated
+// column title takes up across its column: a line of header text plus the
+// cell padding
+const verticalHeaderLength = 4
+
+func (d TextDirection) isVertical() bool {
+	return d == TextDirectionVertical90 || d == TextDirectionVertical270
+}
+
 // calculateProportionalColumnWidths calculates column widths based on content length
 // Returns widths in twips, distributed proportionally to available space
 func calculateProportionalColumnWidths(opts TableOptions, totalWidth int) []int {
@@ -380,8 +502,12 @@ func calculateProportionalColumnWidths(opts TableOptions, totalWidth int) []int
 	totalContentLength := 0
 
 	for i, col := range opts.Columns {
-		// Start with header length
+		// Start with header length; a rotated header only needs the height
+		// of its line of text
 		length := len(col.Title)
+		if col.TextDirection.isVertical() {
+			length = min(length, verticalHeaderLength)
+		}
 
 		// Add length of longest cell in this column
 		for _, row := range opts.Rows {
@@ -486,14 +612,48 @@ func generateTableGrid(opts TableOptions) string {
 	return buf.String()
 }
 
-// generateHeaderRow creates the table header row
-func generateHeaderRow(opts TableOptions) string {
+// generateHeaderRow creates a table header row holding cells
+func generateHeaderRow(opts TableOptions, cells []string) string {
 	var buf bytes.Buffer
 
 	buf.WriteString("<w:tr>")
+	writeHeaderRowProperties(&buf, opts)
 
-	// Row properties for header
+	// Header cells
+	for i, col := range opts.Columns {
+		alignment := opts.HeaderAlignment
+		if col.Alignment != "" {
+			alignment = col.Alignment
+		}
+
+		bold := opts.HeaderBold || col.Bold
+
+		cellXML := generateCell(
+			cells[i],
+			alignment,
+			opts.VerticalAlign,
+			opts.HeaderBackground,
+			bold,
+			false, // italic
+			opts.HeaderStyle,
+			opts.HeaderStyleName,
+		)
+		if col.TextDirection.isVertical() {
+			cellXML = strings.Replace(cellXML, "<w:vAlign ", fmt.Sprintf(`<w:textDirection w:val="%s"/><w:vAlign `, col.TextDirection), 1)
+		}
+		buf.WriteString(cellXML)
+	}
+
+	buf.WriteString("</w:tr>")
+	return buf.String()
+}
+
+// writeHeaderRowProperties writes the <w:trPr> shared by all header rows
+func writeHeaderRowProperties(buf *bytes.Buffer, opts TableOptions) {
 	buf.WriteString("<w:trPr>")
+	if opts.FreezeHeader || opts.KeepRowsTogether {
+		buf.WriteString("<w:cantSplit/>") // Never break a header row across pages
+	}
 	if opts.RepeatHeader {
 		buf.WriteString("<w:tblHeader/>") // Repeat on each page
 	}
@@ -506,28 +666,35 @@ func generateHeaderRow(opts TableOptions) string {
 		buf.WriteString(fmt.Sprintf(`<w:trHeight w:val="%d" w:hRule="%s"/>`, height, opts.HeaderHeightRule))
 	}
 	buf.WriteString("</w:trPr>")
+}
 
-	// Header cells
-	for i, col := range opts.Columns {
-		alignment := opts.HeaderAlignment
-		if col.Alignment != "" {
-			alignment = col.Alignment
-		}
+// generateHeaderSpanRow creates the grouped header row, whose cells span
+// several grid columns
+func generateHeaderSpanRow(opts TableOptions) string {
+	var buf bytes.Buffer
 
-		bold := opts.HeaderBold || col.Bold
+	buf.WriteString("<w:tr>")
+	writeHeaderRowProperties(&buf, opts)
 
-		buf.WriteString(generateCell(
-			col.Title,
+	for _, cell := range opts.HeaderSpanRow {
+		alignment := cell.Alignment
+		if alignment == "" {
+			alignment = CellAlignCenter
+		}
+		cellXML := generateCell(
+			cell.Text,
 			alignment,
 			opts.VerticalAlign,
 			opts.HeaderBackground,
-			bold,
+			opts.HeaderBold,
 			false, // italic
 			opts.HeaderStyle,
 			opts.HeaderStyleName,
-		))
-
-		_ = i // unused but kept for potential future use
+		)
+		if cell.Span > 1 {
+			cellXML = strings.Replace(cellXML, "<w:tcPr>", fmt.Sprintf(`<w:tcPr><w:gridSpan w:val="%d"/>`, cell.Span), 1)
+		}
+		buf.WriteString(cellXML)
 	}
 
 	buf.WriteString("</w:tr>")
@@ -575,6 +742,9 @@ func generateDataRow(opts TableOptions, rowData []string, isAlternate bool) stri
 
 	// Row properties
 	buf.WriteString("<w:trPr>")
+	if opts.KeepRowsTogether {
+		buf.WriteString("<w:cantSplit/>")
+	}
 	// Data row height
 	if opts.RowHeight > 0 || opts.RowHeightRule != RowHeightAuto {
 		height := opts.RowHeight
@@ -621,14 +791,14 @@ func generateCell(content string, align CellAlignment, vAlign VerticalAlignment,
 	// Cell properties
 	buf.WriteString("<w:tcPr>")
 
-	// Vertical alignment
-	buf.WriteString(fmt.Sprintf(`<w:vAlign w:val="%s"/>`, vAlign))
-
 	// Background color
 	if background != "" {
 		buf.WriteString(fmt.Sprintf(`<w:shd w:val="clear" w:color="auto" w:fill="%s"/>`, background))
 	}
 
+	// Vertical alignment (after shd and textDirection in CT_TcPr)
+	buf.WriteString(fmt.Sprintf(`<w:vAlign w:val="%s"/>`, vAlign))
+
 	buf.WriteString("</w:tcPr>")
 
 	// Cell content (paragraph)
@@ -672,7 +842,7 @@ func generateCell(content string, align CellAlignment, vAlign VerticalAlignment,
 		buf.WriteString(` xml:space="preserve"`)
 	}
 	buf.WriteString(">")
-	buf.WriteString(xmlEscape(content))
+	buf.WriteString(xmlEscapeContent(content))
 	buf.WriteString("</w:t>")
 
 	buf.WriteString("</w:r>")
@@ -705,22 +875,5 @@ func insertTableAtPosition(docXML, tableXML []byte, opts TableOptions) ([]byte,
 		contentToInsert = insertCaptionWithElement(captionXML, tableXML, opts.Caption.Position)
 	}
 
-	switch opts.Position {
-	case PositionBeginning:
-		return insertAtBodyStart(docXML, contentToInsert)
-	case PositionEnd:
-		return insertAtBodyEnd(docXML, contentToInsert)
-	case PositionAfterText:
-		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionAfterText")
-		}
-		return insertAfterText(docXML, contentToInsert, opts.Anchor)
-	case PositionBeforeText:
-		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionBeforeText")
-		}
-		return insertBeforeText(docXML, contentToInsert, opts.Anchor)
-	default:
-		return nil, fmt.Errorf("invalid insert position")
-	}
+	return insertElementAtPosition(docXML, contentToInsert, opts.Position, opts.Anchor)
 }
@@ -163,6 +163,297 @@ func TestInsertTableWithRepeatHeader(t *testing.T) {
 	}
 }
 
+func TestInsertTableRepeatHeaderOnlyOnHeaderRows(t *testing.T) {
+	tempDir := t.TempDir()
+	inputPath := filepath.Join(tempDir, "input.docx")
+	outputPath := filepath.Join(tempDir, "output.docx")
+
+	if err := os.WriteFile(inputPath, buildFixtureDocx(t), 0o644); err != nil {
+		t.Fatalf("write input fixture: %v", err)
+	}
+
+	u, err := godocx.New(inputPath)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.InsertTable(godocx.TableOptions{
+		Position: godocx.PositionEnd,
+		Columns:  []godocx.ColumnDefinition{{Title: "Region"}, {Title: "Sales"}},
+		Rows: [][]string{
+			{"", "(USD)"}, // second header row
+			{"North", "10"},
+			{"South", "20"},
+		},
+		HeaderRows:   2,
+		RepeatHeader: true,
+	})
+	if err != nil {
+		t.Fatalf("InsertTable failed: %v", err)
+	}
+	if err := u.Save(outputPath); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	reopened, err := godocx.New(outputPath)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer reopened.Cleanup()
+	docXML, err := os.ReadFile(filepath.Join(reopened.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := strings.Split(string(docXML), "<w:tr>")[1:]
+	if len(rows) != 4 {
+		t.Fatalf("expected 4 rows, got %d", len(rows))
+	}
+	for i, row := range rows {
+		header := strings.Contains(row, "<w:tblHeader/>")
+		if header != (i < 2) {
+			t.Errorf("row %d: tblHeader = %v, want %v", i, header, i < 2)
+		}
+	}
+	if strings.Contains(string(docXML), "<w:cantSplit/>") {
+		t.Error("cantSplit should only be emitted with FreezeHeader")
+	}
+}
+
+func TestInsertTableFreezeHeader(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.InsertTable(godocx.TableOptions{
+		Position:     godocx.PositionEnd,
+		Columns:      []godocx.ColumnDefinition{{Title: "A"}},
+		Rows:         [][]string{{"1"}, {"2"}},
+		FreezeHeader: true,
+	})
+	if err != nil {
+		t.Fatalf("InsertTable failed: %v", err)
+	}
+	docXML, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := string(docXML)
+	if !strings.Contains(doc, `<w:tblLook w:firstRow="1"`) {
+		t.Error("FreezeHeader should mark the first row in tblLook")
+	}
+	if strings.Count(doc, "<w:trPr><w:cantSplit/><w:tblHeader/>") != 1 || strings.Count(doc, "<w:tblHeader/>") != 1 {
+		t.Errorf("expected a single unsplittable repeated header row:\n%s", doc)
+	}
+
+	if err := u.InsertTable(godocx.TableOptions{
+		Columns:    []godocx.ColumnDefinition{{Title: "A"}},
+		Rows:       [][]string{{"1"}},
+		HeaderRows: 3,
+	}); err == nil {
+		t.Error("expected error for more header rows than rows")
+	}
+}
+
+func TestInsertTableKeepRowsTogether(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.InsertTable(godocx.TableOptions{
+		Position:         godocx.PositionEnd,
+		Columns:          []godocx.ColumnDefinition{{Title: "A"}},
+		Rows:             [][]string{{"1"}, {"2"}},
+		RepeatHeader:     true,
+		RowHeight:        400,
+		RowHeightRule:    godocx.RowHeightExact,
+		KeepRowsTogether: true,
+	})
+	if err != nil {
+		t.Fatalf("InsertTable failed: %v", err)
+	}
+	docXML, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := string(docXML)
+	if strings.Count(doc, "<w:cantSplit/>") != 3 {
+		t.Errorf("expected every row to be unsplittable:\n%s", doc)
+	}
+	if strings.Count(doc, `<w:trPr><w:cantSplit/><w:trHeight w:val="400" w:hRule="exact"/></w:trPr>`) != 2 {
+		t.Errorf("expected unsplittable data rows with exact height:\n%s", doc)
+	}
+	if !strings.Contains(doc, `<w:tblLook w:firstRow="1" w:lastRow="0" w:firstColumn="1" w:lastColumn="0" w:noHBand="0" w:noVBand="1"/>`) {
+		t.Error("expected the header row to be marked in tblLook")
+	}
+}
+
+func TestInsertTableHeaderSpanRow(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.InsertTable(godocx.TableOptions{
+		Position: godocx.PositionEnd,
+		Columns: []godocx.ColumnDefinition{
+			{Title: "Region"}, {Title: "Q1"}, {Title: "Q2"}, {Title: "Q3"}, {Title: "Q4"},
+		},
+		HeaderSpanRow: []godocx.HeaderSpanCell{{Text: "", Span: 1}, {Text: "2025", Span: 4}},
+		Rows:          [][]string{{"North", "1", "2", "3", "4"}},
+		RepeatHeader:  true,
+	})
+	if err != nil {
+		t.Fatalf("InsertTable failed: %v", err)
+	}
+	docXML, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := string(docXML)
+	spanIdx := strings.Index(doc, `<w:tcPr><w:gridSpan w:val="4"/>`)
+	if spanIdx == -1 || strings.Count(doc, "<w:gridSpan") != 1 {
+		t.Fatalf("expected one cell spanning four columns:\n%s", doc)
+	}
+	if yearIdx, titleIdx := strings.Index(doc, ">2025<"), strings.Index(doc, ">Q1<"); yearIdx < spanIdx || titleIdx < yearIdx {
+		t.Error("expected the grouped header row before the column titles")
+	}
+	if !strings.Contains(doc[spanIdx:], `<w:jc w:val="center"/>`) {
+		t.Error("expected grouped header cells to be centered")
+	}
+	if n := strings.Count(doc, "<w:tblHeader/>"); n != 2 {
+		t.Errorf("expected both header rows to repeat, got %d", n)
+	}
+
+	err = u.InsertTable(godocx.TableOptions{
+		Columns:       []godocx.ColumnDefinition{{Title: "A"}, {Title: "B"}},
+		HeaderSpanRow: []godocx.HeaderSpanCell{{Text: "All", Span: 3}},
+	})
+	if err == nil {
+		t.Error("expected error when spans do not match the column count")
+	}
+	err = u.InsertTable(godocx.TableOptions{
+		Columns:       []godocx.ColumnDefinition{{Title: "A"}, {Title: "B"}},
+		HeaderSpanRow: []godocx.HeaderSpanCell{{Text: "A", Span: 2}, {Text: "Empty", Span: 0}},
+	})
+	if err == nil {
+		t.Error("expected error for a zero span")
+	}
+}
+
+func TestInsertTableVerticalHeaderText(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	err = u.InsertTable(godocx.TableOptions{
+		Position: godocx.PositionEnd,
+		Columns: []godocx.ColumnDefinition{
+			{Title: "Requirement"},
+			{Title: "Implemented by vendor", TextDirection: godocx.TextDirectionVertical90},
+			{Title: "Verified on site", TextDirection: godocx.TextDirectionVertical270},
+		},
+		Rows:                     [][]string{{"Encrypted backups", "Yes", "No"}},
+		HeaderBackground:         "D9D9D9",
+		ProportionalColumnWidths: true,
+	})
+	if err != nil {
+		t.Fatalf("InsertTable failed: %v", err)
+	}
+	docXML, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "document.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := string(docXML)
+
+	for _, want := range []string{
+		`<w:shd w:val="clear" w:color="auto" w:fill="D9D9D9"/><w:textDirection w:val="btLr"/><w:vAlign`,
+		`<w:shd w:val="clear" w:color="auto" w:fill="D9D9D9"/><w:textDirection w:val="tbRl"/><w:vAlign`,
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("expected %s in header cell properties:\n%s", want, doc)
+		}
+	}
+	if n := strings.Count(doc, "<w:textDirection"); n != 2 {
+		t.Errorf("expected text direction only on the two rotated header cells, got %d", n)
+	}
+
+	// Rotated titles must not widen their columns past the first one.
+	var widths [3]int
+	if _, err := fmt.Sscanf(doc[strings.Index(doc, "<w:tblGrid>"):], `<w:tblGrid><w:gridCol w:w="%d"/><w:gridCol w:w="%d"/><w:gridCol w:w="%d"/>`, &widths[0], &widths[1], &widths[2]); err != nil {
+		t.Fatalf("parse table grid: %v", err)
+	}
+	if widths[1] >= widths[0] || widths[2] >= widths[0] {
+		t.Errorf("expected narrow rotated columns, got grid widths %v", widths)
+	}
+
+	err = u.InsertTable(godocx.TableOptions{
+		Columns: []godocx.ColumnDefinition{{Title: "A", TextDirection: "diagonal"}},
+	})
+	if err == nil {
+		t.Error("expected error for an unsupported text direction")
+	}
+}
+
+func TestInsertTableStyleBorders(t *testing.T) {
+	u, err := godocx.NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+
+	docPath := filepath.Join(u.TempDir(), "word", "document.xml")
+	// insert adds a one-cell table at the end and returns its XML
+	insert := func(opts godocx.TableOptions) string {
+		t.Helper()
+		opts.Columns = []godocx.ColumnDefinition{{Title: "A"}}
+		opts.Rows = [][]string{{"1"}}
+		if err := u.InsertTable(opts); err != nil {
+			t.Fatalf("InsertTable failed: %v", err)
+		}
+		docXML, err := os.ReadFile(docPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc := string(docXML)
+		return doc[strings.LastIndex(doc, "<w:tbl>"):]
+	}
+
+	if got := insert(godocx.TableOptions{Position: godocx.PositionEnd}); !strings.Contains(got, `<w:top w:val="single"`) {
+		t.Errorf("expected single borders without a table style:\n%s", got)
+	}
+	// NewBlank does not define TableGrid, so direct borders are kept.
+	if got := insert(godocx.TableOptions{Position: godocx.PositionEnd, TableStyle: godocx.TableStyleGrid}); !strings.Contains(got, `<w:top w:val="single"`) {
+		t.Errorf("expected single borders for a table style missing from styles.xml:\n%s", got)
+	}
+
+	styles := `<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:style w:type="table" w:styleId="TableGrid"><w:name w:val="Table Grid"/><w:tblPr><w:tblBorders>` +
+		`<w:top w:val="single" w:sz="4" w:space="0" w:color="auto"/></w:tblBorders></w:tblPr></w:style></w:styles>`
+	if err := os.WriteFile(filepath.Join(u.TempDir(), "word", "styles.xml"), []byte(styles), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got := insert(godocx.TableOptions{Position: godocx.PositionEnd, TableStyle: godocx.TableStyleGrid}); strings.Contains(got, "<w:tblBorders>") {
+		t.Errorf("expected the table style's borders to apply:\n%s", got)
+	}
+	got := insert(godocx.TableOptions{Position: godocx.PositionEnd, TableStyle: godocx.TableStyleGrid, BorderStyle: godocx.BorderDashed})
+	if !strings.Contains(got, `<w:top w:val="dashed"`) {
+		t.Errorf("expected explicit borders to override the table style:\n%s", got)
+	}
+	got = insert(godocx.TableOptions{Position: godocx.PositionEnd, TableStyle: godocx.TableStyleGrid, BorderStyle: godocx.BorderDashed, UseStyleBorders: true})
+	if strings.Contains(got, "<w:tblBorders>") {
+		t.Errorf("expected UseStyleBorders to suppress direct borders:\n%s", got)
+	}
+}
+
 func TestInsertTableInvalidRows(t *testing.T) {
 	tempDir := t.TempDir()
 	inputPath := filepath.Join(tempDir, "input.docx")
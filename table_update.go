@@ -13,22 +13,22 @@ import (
 // Note: nested tables (a table inside a table cell) are not supported.
 func (u *Updater) UpdateTableCell(tableIndex, row, col int, value string) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if tableIndex < 1 {
-		return fmt.Errorf("tableIndex must be >= 1")
+		return NewValidationError("tableIndex", "tableIndex must be >= 1")
 	}
 	if row < 1 {
-		return fmt.Errorf("row must be >= 1")
+		return NewValidationError("row", "row must be >= 1")
 	}
 	if col < 1 {
-		return fmt.Errorf("col must be >= 1")
+		return NewValidationError("col", "col must be >= 1")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	updated, err := updateTableCellContent(raw, tableIndex, row, col, value)
@@ -36,7 +36,10 @@ func (u *Updater) UpdateTableCell(tableIndex, row, col int, value string) error
 		return err
 	}
 
-	return os.WriteFile(docPath, updated, 0o644)
+	if err := os.WriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
 }
 
 // updateTableCellContent performs the XML surgery.
@@ -107,13 +110,13 @@ func findNthXMLBlock(content, tag string, n int) (start, end int, err error) {
 			idx = ia
 		}
 		if idx < 0 {
-			return 0, 0, fmt.Errorf("only %d %s element(s) found", count, tag)
+			return 0, 0, NewValidationError(tag, fmt.Sprintf("only %d %s element(s) found", count, tag))
 		}
 		count++
 		absStart := offset + idx
 		closeIdx := strings.Index(remaining[idx:], closeTag)
 		if closeIdx < 0 {
-			return 0, 0, fmt.Errorf("unclosed <%s>", tag)
+			return 0, 0, NewMalformedXMLError(fmt.Sprintf("unclosed <%s>", tag))
 		}
 		absEnd := absStart + closeIdx + len(closeTag)
 		if count == n {
@@ -127,7 +130,7 @@ func findNthXMLBlock(content, tag string, n int) (start, end int, err error) {
 
 // replaceCellText replaces all run text inside a <w:tc> with value, preserving <w:tcPr>.
 func replaceCellText(tcContent, value string) (string, error) {
-	escaped := xmlEscape(value)
+	escaped := xmlEscapeContent(value)
 
 	// Preserve <w:tcPr> cell properties block if present
 	tcPr := ""
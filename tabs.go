@@ -0,0 +1,229 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// TabAlignment defines how text aligns at a tab stop.
+type TabAlignment string
+
+const (
+	TabAlignLeft    TabAlignment = "left"
+	TabAlignCenter  TabAlignment = "center"
+	TabAlignRight   TabAlignment = "right"
+	TabAlignDecimal TabAlignment = "decimal"
+	TabAlignBar     TabAlignment = "bar"
+	// TabAlignClear removes an inherited tab stop at the given position.
+	TabAlignClear TabAlignment = "clear"
+)
+
+// TabLeader defines the character that fills the space before a tab stop.
+type TabLeader string
+
+const (
+	TabLeaderNone       TabLeader = "none"
+	TabLeaderDot        TabLeader = "dot"
+	TabLeaderDash       TabLeader = "hyphen"
+	TabLeaderUnderscore TabLeader = "underscore"
+	TabLeaderMiddleDot  TabLeader = "middleDot"
+)
+
+// TabStop defines a custom tab stop on a paragraph or paragraph style.
+type TabStop struct {
+	// Position is the tab stop position in twips from the left indent.
+	Position int
+
+	// Alignment of text at the tab stop (default: TabAlignLeft).
+	Alignment TabAlignment
+
+	// Leader fills the space before the tab stop (default: TabLeaderNone).
+	Leader TabLeader
+}
+
+// validateTabStops checks tab stop alignments, leaders and positions.
+func validateTabStops(tabs []TabStop) error {
+	for i, tab := range tabs {
+		if tab.Position < 0 {
+			return NewValidationError(fmt.Sprintf("TabStops[%d].Position", i), "tab stop position cannot be negative")
+		}
+		switch tab.Alignment {
+		case "", TabAlignLeft, TabAlignCenter, TabAlignRight, TabAlignDecimal, TabAlignBar, TabAlignClear:
+		default:
+			return NewValidationError(fmt.Sprintf("TabStops[%d].Alignment", i), fmt.Sprintf("unsupported tab alignment %q", tab.Alignment))
+		}
+		switch tab.Leader {
+		case "", TabLeaderNone, TabLeaderDot, TabLeaderDash, TabLeaderUnderscore, TabLeaderMiddleDot:
+		default:
+			return NewValidationError(fmt.Sprintf("TabStops[%d].Leader", i), fmt.Sprintf("unsupported tab leader %q", tab.Leader))
+		}
+	}
+	return nil
+}
+
+// generateTabsXML creates a <w:tabs> element with the tab stops sorted by
+// position, or an empty string when tabs is empty.
+func generateTabsXML(tabs []TabStop) string {
+	if len(tabs) == 0 {
+		return ""
+	}
+	sorted := slices.Clone(tabs)
+	slices.SortStableFunc(sorted, func(a, b TabStop) int { return a.Position - b.Position })
+
+	var buf strings.Builder
+	buf.WriteString("<w:tabs>")
+	for _, tab := range sorted {
+		align := tab.Alignment
+		if align == "" {
+			align = TabAlignLeft
+		}
+		fmt.Fprintf(&buf, `<w:tab w:val="%s"`, align)
+		if tab.Leader != "" && tab.Leader != TabLeaderNone {
+			fmt.Fprintf(&buf, ` w:leader="%s"`, tab.Leader)
+		}
+		fmt.Fprintf(&buf, ` w:pos="%d"/>`, tab.Position)
+	}
+	buf.WriteString("</w:tabs>")
+	return buf.String()
+}
+
+// AddTabStopDefinition adds a custom tab stop (<w:tabs>/<w:tab>) to the
+// paragraph containing the anchor text. A tab stop the paragraph already
+// defines at the same position is replaced; its other tab stops are kept. To
+// insert a tab character into the text, use InsertTabStop.
+func (u *Updater) AddTabStopDefinition(anchor string, stop TabStop) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if anchor == "" {
+		return NewValidationError("anchor", "anchor text cannot be empty")
+	}
+	if err := validateTabStops([]TabStop{stop}); err != nil {
+		return err
+	}
+	return u.updateParagraphByAnchor(anchor, func(para []byte) ([]byte, error) {
+		return addParagraphTabStop(para, stop)
+	})
+}
+
+// addParagraphTabStop merges stop into the <w:tabs> of the paragraph's
+// properties, keeping the tab stops sorted by position.
+func addParagraphTabStop(para []byte, stop TabStop) ([]byte, error) {
+	_, _, pPr, err := splitParagraphProperties(para)
+	if err != nil {
+		return nil, err
+	}
+	var tabStops []xmlChild
+	for _, c := range pPr {
+		if c.name == "w:tabs" {
+			tabStops = elementChildren(c)
+		}
+	}
+
+	tabPos := func(c xmlChild) int {
+		if m := styleTabPosPattern.FindSubmatch(c.xml); m != nil {
+			pos, _ := strconv.Atoi(string(m[1]))
+			return pos
+		}
+		return 0
+	}
+	tabStops = slices.DeleteFunc(tabStops, func(c xmlChild) bool {
+		return c.name == "w:tab" && tabPos(c) == stop.Position
+	})
+	tabStops = append(tabStops, elementChildren(splitXMLChildren([]byte(generateTabsXML([]TabStop{stop})))[0])...)
+	slices.SortStableFunc(tabStops, func(a, b xmlChild) int { return tabPos(a) - tabPos(b) })
+
+	return setParagraphProperty(para, "tabs", wrapProperties("w:tabs", tabStops))
+}
+
+var (
+	styleTabPattern     = regexp.MustCompile(`<w:tab\s[^>]*/>`)
+	styleTabValPattern  = regexp.MustCompile(`w:val="([^"]*)"`)
+	styleTabPosPattern  = regexp.MustCompile(`w:pos="(-?\d+)"`)
+	styleBasedOnPattern = regexp.MustCompile(`<w:basedOn w:val="([^"]*)"`)
+)
+
+// inheritedTabStops returns the positions of the tab stops a paragraph using
+// styleID inherits from styles.xml, following the basedOn chain.
+func (u *Updater) inheritedTabStops(styleID string) ([]int, error) {
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "styles.xml"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, NewFileReadError("styles.xml", err)
+	}
+	stylesXML := string(raw)
+
+	var positions []int
+	visited := make(map[string]bool)
+	for styleID != "" && !visited[styleID] {
+		visited[styleID] = true
+
+		block := findStyleBlock(stylesXML, styleID)
+		if block == "" {
+			break
+		}
+		if start := strings.Index(block, "<w:tabs>"); start != -1 {
+			if end := strings.Index(block[start:], "</w:tabs>"); end != -1 {
+				for _, tab := range styleTabPattern.FindAllString(block[start:start+end], -1) {
+					val := styleTabValPattern.FindStringSubmatch(tab)
+					pos := styleTabPosPattern.FindStringSubmatch(tab)
+					if val == nil || pos == nil || val[1] == string(TabAlignClear) {
+						continue
+					}
+					if p, err := strconv.Atoi(pos[1]); err == nil && !slices.Contains(positions, p) {
+						positions = append(positions, p)
+					}
+				}
+			}
+		}
+
+		styleID = ""
+		if m := styleBasedOnPattern.FindStringSubmatch(block); m != nil {
+			styleID = m[1]
+		}
+	}
+	return positions, nil
+}
+
+// findStyleBlock returns the <w:style> element with the given style ID, or an
+// empty string when it is not defined.
+func findStyleBlock(stylesXML, styleID string) string {
+	marker := fmt.Sprintf(`w:styleId="%s"`, xmlEscape(styleID))
+	idx := strings.Index(stylesXML, marker)
+	if idx == -1 {
+		return ""
+	}
+	start := strings.LastIndex(stylesXML[:idx], "<w:style ")
+	if start == -1 {
+		return ""
+	}
+	end := strings.Index(stylesXML[idx:], "</w:style>")
+	if end == -1 {
+		return ""
+	}
+	return stylesXML[start : idx+end+len("</w:style>")]
+}
+
+// withClearedTabStops prepends clear entries for every inherited tab stop of
+// styleID that tabs does not redefine.
+func (u *Updater) withClearedTabStops(styleID string, tabs []TabStop) ([]TabStop, error) {
+	inherited, err := u.inheritedTabStops(styleID)
+	if err != nil {
+		return nil, err
+	}
+	var result []TabStop
+	for _, pos := range inherited {
+		redefined := slices.ContainsFunc(tabs, func(t TabStop) bool { return t.Position == pos })
+		if !redefined {
+			result = append(result, TabStop{Position: pos, Alignment: TabAlignClear})
+		}
+	}
+	return append(result, tabs...), nil
+}
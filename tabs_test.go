@@ -0,0 +1,165 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInsertParagraph_TabStops(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertParagraph(ParagraphOptions{
+		Text:     "Name\tQty\tPrice",
+		Position: PositionEnd,
+		TabStops: []TabStop{
+			{Position: 7200, Alignment: TabAlignDecimal, Leader: TabLeaderDot},
+			{Position: 1440},
+			{Position: 4320, Alignment: TabAlignCenter},
+		},
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	want := `<w:tabs>` +
+		`<w:tab w:val="left" w:pos="1440"/>` +
+		`<w:tab w:val="center" w:pos="4320"/>` +
+		`<w:tab w:val="decimal" w:leader="dot" w:pos="7200"/>` +
+		`</w:tabs>`
+	if !strings.Contains(docXML, want) {
+		t.Errorf("expected sorted tab stops %s, got: %s", want, docXML)
+	}
+}
+
+func TestInsertParagraph_TabStopsValidation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	cases := []TabStop{
+		{Position: -1},
+		{Position: 720, Alignment: "justify"},
+		{Position: 720, Leader: "wave"},
+	}
+	for _, tab := range cases {
+		if err := u.InsertParagraph(ParagraphOptions{Text: "x", TabStops: []TabStop{tab}}); err == nil {
+			t.Errorf("expected validation error for %+v", tab)
+		}
+	}
+}
+
+func TestAddTabStopDefinition(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t,
+		`<w:p><w:pPr><w:tabs><w:tab w:val="left" w:pos="1440"/><w:tab w:val="left" w:pos="7200"/></w:tabs><w:jc w:val="center"/></w:pPr>`+
+			`<w:r><w:t>Name</w:t><w:tab/><w:t>Price</w:t></w:r></w:p>`+
+			`<w:p><w:r><w:t>Plain</w:t></w:r></w:p>`))
+
+	if err := u.AddTabStopDefinition("Price", TabStop{Position: 4320, Alignment: TabAlignCenter}); err != nil {
+		t.Fatalf("AddTabStopDefinition: %v", err)
+	}
+	if err := u.AddTabStopDefinition("Price", TabStop{Position: 7200, Alignment: TabAlignRight, Leader: TabLeaderDot}); err != nil {
+		t.Fatalf("AddTabStopDefinition replacing: %v", err)
+	}
+	if err := u.AddTabStopDefinition("Plain", TabStop{Position: 720}); err != nil {
+		t.Fatalf("AddTabStopDefinition without pPr: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	want := `<w:pPr><w:tabs>` +
+		`<w:tab w:val="left" w:pos="1440"/>` +
+		`<w:tab w:val="center" w:pos="4320"/>` +
+		`<w:tab w:val="right" w:leader="dot" w:pos="7200"/>` +
+		`</w:tabs><w:jc w:val="center"/></w:pPr>`
+	if !strings.Contains(docXML, want) {
+		t.Errorf("expected merged tab stops %s, got: %s", want, docXML)
+	}
+	if !strings.Contains(docXML, `<w:p><w:pPr><w:tabs><w:tab w:val="left" w:pos="720"/></w:tabs></w:pPr><w:r><w:t>Plain</w:t>`) {
+		t.Errorf("expected new pPr with tab stop on plain paragraph, got: %s", docXML)
+	}
+
+	if err := u.AddTabStopDefinition("Price", TabStop{Position: -1}); err == nil {
+		t.Error("expected validation error for negative position")
+	}
+	if err := u.AddTabStopDefinition("missing", TabStop{Position: 720}); err == nil {
+		t.Error("expected error for missing anchor")
+	}
+}
+
+func TestInsertParagraph_ClearTabStops(t *testing.T) {
+	docXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:body><w:p><w:r><w:t>Intro</w:t></w:r></w:p></w:body></w:document>`
+	stylesXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:style w:type="paragraph" w:styleId="Base"><w:name w:val="Base"/>` +
+		`<w:pPr><w:tabs><w:tab w:val="left" w:pos="1000"/></w:tabs></w:pPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Derived"><w:name w:val="Derived"/><w:basedOn w:val="Base"/>` +
+		`<w:pPr><w:tabs><w:tab w:val="right" w:pos="2000"/><w:tab w:val="left" w:pos="3000"/></w:tabs></w:pPr></w:style>` +
+		`</w:styles>`
+	u := newUpdaterFromFixture(t, buildIntegrationDocxFromParts(t, docXML, stylesXML, ""))
+
+	err := u.InsertParagraph(ParagraphOptions{
+		Text:          "Own tabs only",
+		Style:         "Derived",
+		Position:      PositionEnd,
+		TabStops:      []TabStop{{Position: 3000, Alignment: TabAlignRight}},
+		ClearTabStops: true,
+	})
+	if err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+
+	got := readDocXML(t, u)
+	want := `<w:tabs>` +
+		`<w:tab w:val="clear" w:pos="1000"/>` +
+		`<w:tab w:val="clear" w:pos="2000"/>` +
+		`<w:tab w:val="right" w:pos="3000"/>` +
+		`</w:tabs>`
+	if !strings.Contains(got, want) {
+		t.Errorf("expected inherited tabs to be cleared with %s, got: %s", want, got)
+	}
+}
+
+func TestAddStyle_TabStops(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.AddStyle(StyleDefinition{
+		ID:       "TOCLine",
+		Type:     StyleTypeParagraph,
+		TabStops: []TabStop{{Position: 9360, Alignment: TabAlignRight, Leader: TabLeaderDot}},
+	})
+	if err != nil {
+		t.Fatalf("AddStyle: %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.TempDir(), "word", "styles.xml"))
+	if err != nil {
+		t.Fatalf("read styles.xml: %v", err)
+	}
+	if !strings.Contains(string(raw), `<w:tabs><w:tab w:val="right" w:leader="dot" w:pos="9360"/></w:tabs>`) {
+		t.Errorf("expected tab stops in style definition, got: %s", raw)
+	}
+}
+
+func TestGenerateStyleParagraphProps_ChildOrder(t *testing.T) {
+	got := generateStyleParagraphProps(StyleDefinition{
+		Alignment:       ParagraphAlignRight,
+		SpaceBefore:     120,
+		IndentLeft:      360,
+		OutlineLevel:    2,
+		BackgroundColor: "F2F2F2",
+		TabStops:        []TabStop{{Position: 4680, Alignment: TabAlignCenter}},
+		KeepNext:        true,
+	})
+
+	// CT_PPr: keepNext, shd, tabs, spacing, ind, jc, outlineLvl
+	var names []string
+	for _, c := range elementChildren(splitXMLChildren([]byte(got))[0]) {
+		names = append(names, c.name)
+	}
+	want := []string{"w:keepNext", "w:shd", "w:tabs", "w:spacing", "w:ind", "w:jc", "w:outlineLvl"}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("pPr children = %v, want %v\n%s", names, want, got)
+	}
+}
@@ -0,0 +1,490 @@
+package godocx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TemplateOptions controls JSON template rendering.
+type TemplateOptions struct {
+	// InHeaders renders placeholders in headers
+	InHeaders bool
+
+	// InFooters renders placeholders in footers
+	InFooters bool
+
+	// StrictMissingKeys returns an error for placeholders without a matching
+	// key. By default such placeholders are left unchanged.
+	StrictMissingKeys bool
+}
+
+var (
+	// templateTagPattern matches any {{...}} template tag.
+	templateTagPattern = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+
+	// templateEndPattern matches the optional {{end}} closing a range row.
+	templateEndPattern = regexp.MustCompile(`\{\{\s*end\s*\}\}`)
+)
+
+// RenderFromJSON renders the document as a template using JSON data.
+//
+// The JSON must be an object. Supported tags:
+//   - {{key}} and {{nested.key}}: replaced with the value (dot notation walks
+//     nested objects; numeric segments index arrays)
+//   - {{range key}}: placed in a table row, repeats the row once per element of
+//     the key's array, resolving the row's placeholders against each element
+//     first ({{.}} is the element itself)
+//   - {{if key}} / {{else}} / {{end}}: each on its own paragraph, keeps the
+//     enclosed paragraphs only when the value is truthy (not null, false, 0,
+//     "" or empty)
+//
+// Placeholders that Word split across several runs are merged before
+// rendering, keeping the formatting of the run where the tag starts.
+func (u *Updater) RenderFromJSON(jsonData []byte, opts TemplateOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+
+	data, err := parseTemplateJSON(jsonData)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{filepath.Join(u.tempDir, "word", "document.xml")}
+	if opts.InHeaders {
+		headers, _ := filepath.Glob(filepath.Join(u.tempDir, "word", "header*.xml"))
+		paths = append(paths, headers...)
+	}
+	if opts.InFooters {
+		footers, _ := filepath.Glob(filepath.Join(u.tempDir, "word", "footer*.xml"))
+		paths = append(paths, footers...)
+	}
+
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return NewFileReadError(filepath.Base(path), err)
+		}
+		rendered, err := renderTemplateXML(raw, data, opts)
+		if err != nil {
+			return fmt.Errorf("render %s: %w", filepath.Base(path), err)
+		}
+		if !bytes.Equal(raw, rendered) {
+			if err := atomicWriteFile(path, rendered, 0o644); err != nil {
+				return NewFileWriteError(filepath.Base(path), err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseTemplateJSON decodes a JSON object, reporting syntax errors with their
+// line and column.
+func parseTemplateJSON(jsonData []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(jsonData))
+	dec.UseNumber()
+
+	var data map[string]any
+	if err := dec.Decode(&data); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		offset := int64(-1)
+		switch {
+		case errors.As(err, &syntaxErr):
+			// Offset counts the offending byte itself.
+			offset = max(syntaxErr.Offset-1, 0)
+		case errors.As(err, &typeErr):
+			offset = typeErr.Offset
+		}
+		if offset < 0 {
+			return nil, &DocxError{Code: ErrCodeValidation, Message: "invalid JSON", Err: err}
+		}
+		line, col := jsonLineColumn(jsonData, offset)
+		return nil, &DocxError{
+			Code:    ErrCodeValidation,
+			Message: fmt.Sprintf("invalid JSON at line %d, column %d", line, col),
+			Err:     err,
+			Context: map[string]any{"line": line, "column": col},
+		}
+	}
+	if data == nil {
+		return nil, NewValidationError("jsonData", "JSON data must be an object")
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		line, col := jsonLineColumn(jsonData, dec.InputOffset())
+		return nil, &DocxError{
+			Code:    ErrCodeValidation,
+			Message: fmt.Sprintf("invalid JSON at line %d, column %d: unexpected data after top-level object", line, col),
+			Context: map[string]any{"line": line, "column": col},
+		}
+	}
+	return data, nil
+}
+
+// jsonLineColumn converts a byte offset into a 1-based line and column.
+func jsonLineColumn(data []byte, offset int64) (int, int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	prefix := data[:offset]
+	line := bytes.Count(prefix, []byte("\n")) + 1
+	col := len(prefix) - bytes.LastIndexByte(prefix, '\n')
+	return line, col
+}
+
+// renderTemplateXML applies data to one WordprocessingML part.
+func renderTemplateXML(raw []byte, data map[string]any, opts TemplateOptions) ([]byte, error) {
+	if !bytes.Contains(raw, []byte("{{")) {
+		return raw, nil
+	}
+
+	content := mergeSplitTemplateTags(raw)
+
+	// Ranges go first so that an {{end}} closing a range row is not mistaken
+	// for the end of an {{if}} block.
+	content, err := renderTemplateRanges(content, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	content, err = renderTemplateConditionals(content, data)
+	if err != nil {
+		return nil, err
+	}
+	return renderTemplatePlaceholders(content, []any{data}, opts)
+}
+
+// mergeSplitTemplateTags rewrites every paragraph so that each {{...}} tag is
+// contained in a single <w:t> element.
+func mergeSplitTemplateTags(raw []byte) []byte {
+	var out bytes.Buffer
+	out.Grow(len(raw))
+	pos := 0
+	for {
+		start := findNextParagraphStart(raw, pos)
+		if start == -1 {
+			break
+		}
+		end := bytes.Index(raw[start:], []byte("</w:p>"))
+		if end == -1 {
+			break
+		}
+		end += start + len("</w:p>")
+		out.Write(raw[pos:start])
+		out.Write(mergeParagraphTemplateTags(raw[start:end]))
+		pos = end
+	}
+	out.Write(raw[pos:])
+	return out.Bytes()
+}
+
+func mergeParagraphTemplateTags(para []byte) []byte {
+	locs := extractTextPattern.FindAllSubmatchIndex(para, -1)
+	if len(locs) < 2 || !bytes.Contains(para, []byte("{{")) {
+		return para
+	}
+
+	segments := make([]string, len(locs))
+	var joined strings.Builder
+	segStart := make([]int, len(locs))
+	for i, loc := range locs {
+		segments[i] = string(para[loc[2]:loc[3]])
+		segStart[i] = joined.Len()
+		joined.WriteString(segments[i])
+	}
+	segmentAt := func(offset int) int {
+		idx := 0
+		for i, s := range segStart {
+			if s <= offset {
+				idx = i
+			}
+		}
+		return idx
+	}
+
+	// Process tags from last to first so earlier offsets stay valid.
+	tags := templateTagPattern.FindAllStringIndex(joined.String(), -1)
+	changed := false
+	for i := len(tags) - 1; i >= 0; i-- {
+		first, last := segmentAt(tags[i][0]), segmentAt(tags[i][1]-1)
+		if first == last {
+			continue
+		}
+		changed = true
+		tag := joined.String()[tags[i][0]:tags[i][1]]
+		segments[first] = segments[first][:tags[i][0]-segStart[first]] + tag
+		for s := first + 1; s < last; s++ {
+			segments[s] = ""
+		}
+		segments[last] = segments[last][tags[i][1]-segStart[last]:]
+	}
+	if !changed {
+		return para
+	}
+
+	var out bytes.Buffer
+	prev := 0
+	for i, loc := range locs {
+		out.Write(para[prev:loc[0]])
+		out.WriteString(`<w:t xml:space="preserve">`)
+		out.WriteString(segments[i])
+		out.WriteString(`</w:t>`)
+		prev = loc[1]
+	}
+	out.Write(para[prev:])
+	return out.Bytes()
+}
+
+// templateParagraph is a paragraph holding a control tag.
+type templateParagraph struct {
+	start, end int
+	tag        string // "if", "else" or "end"
+	key        string
+}
+
+// renderTemplateConditionals resolves {{if}}/{{else}}/{{end}} paragraph blocks,
+// innermost first.
+func renderTemplateConditionals(content []byte, data map[string]any) ([]byte, error) {
+	for {
+		markers := findTemplateControlParagraphs(content)
+		if len(markers) == 0 {
+			return content, nil
+		}
+
+		// Find the first {{end}} and the {{if}} (and optional {{else}}) it closes.
+		endIdx := -1
+		for i, m := range markers {
+			if m.tag == "end" {
+				endIdx = i
+				break
+			}
+		}
+		if endIdx == -1 {
+			return nil, NewValidationError("template", fmt.Sprintf("{{if %s}} has no matching {{end}}", markers[0].key))
+		}
+		ifIdx, elseIdx := -1, -1
+		for i := endIdx - 1; i >= 0; i-- {
+			if markers[i].tag == "else" && elseIdx == -1 {
+				elseIdx = i
+				continue
+			}
+			if markers[i].tag == "if" {
+				ifIdx = i
+				break
+			}
+		}
+		if ifIdx == -1 {
+			return nil, NewValidationError("template", "{{end}} without matching {{if}}")
+		}
+
+		ifM, endM := markers[ifIdx], markers[endIdx]
+		value, _ := lookupTemplateValue([]any{data}, ifM.key)
+		truthy := isTemplateTruthy(value)
+
+		var out bytes.Buffer
+		out.Write(content[:ifM.start])
+		switch {
+		case elseIdx != -1 && truthy:
+			out.Write(content[ifM.end:markers[elseIdx].start])
+		case elseIdx != -1:
+			out.Write(content[markers[elseIdx].end:endM.start])
+		case truthy:
+			out.Write(content[ifM.end:endM.start])
+		}
+		out.Write(content[endM.end:])
+		content = out.Bytes()
+	}
+}
+
+var templateControlPattern = regexp.MustCompile(`^\{\{\s*(if\s+([^{}\s]+)|else|end)\s*\}\}$`)
+
+// findTemplateControlParagraphs lists the paragraphs whose whole text is an
+// {{if key}}, {{else}} or {{end}} tag, in document order.
+func findTemplateControlParagraphs(content []byte) []templateParagraph {
+	var markers []templateParagraph
+	pos := 0
+	for {
+		start := findNextParagraphStart(content, pos)
+		if start == -1 {
+			return markers
+		}
+		end := bytes.Index(content[start:], []byte("</w:p>"))
+		if end == -1 {
+			return markers
+		}
+		end += start + len("</w:p>")
+		pos = end
+
+		text := strings.TrimSpace(extractParagraphPlainText(content[start:end]))
+		m := templateControlPattern.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+		marker := templateParagraph{start: start, end: end, tag: m[1]}
+		if m[2] != "" {
+			marker.tag, marker.key = "if", m[2]
+		}
+		markers = append(markers, marker)
+	}
+}
+
+var templateRangePattern = regexp.MustCompile(`\{\{\s*range\s+([^{}\s]+)\s*\}\}`)
+
+// renderTemplateRanges repeats every table row containing {{range key}} once
+// per element of the key's array.
+func renderTemplateRanges(content []byte, data map[string]any, opts TemplateOptions) ([]byte, error) {
+	var renderErr error
+	result := extractRowPattern.ReplaceAllFunc(content, func(row []byte) []byte {
+		if renderErr != nil {
+			return row
+		}
+		m := templateRangePattern.FindSubmatch(row)
+		if m == nil {
+			return row
+		}
+		key := string(m[1])
+		row = templateRangePattern.ReplaceAll(row, nil)
+		row = templateEndPattern.ReplaceAll(row, nil)
+
+		value, ok := lookupTemplateValue([]any{data}, key)
+		if !ok && opts.StrictMissingKeys {
+			renderErr = NewValidationError("template", fmt.Sprintf("missing key %q", key))
+			return row
+		}
+		items, _ := value.([]any)
+
+		var out bytes.Buffer
+		for _, item := range items {
+			rendered, err := renderTemplatePlaceholders(row, []any{item, data}, opts)
+			if err != nil {
+				renderErr = err
+				return row
+			}
+			out.Write(rendered)
+		}
+		return out.Bytes()
+	})
+	if renderErr != nil {
+		return nil, renderErr
+	}
+	return result, nil
+}
+
+// renderTemplatePlaceholders replaces {{key}} tags in <w:t> elements, looking
+// keys up in each scope in turn.
+func renderTemplatePlaceholders(content []byte, scopes []any, opts TemplateOptions) ([]byte, error) {
+	var renderErr error
+	result := extractTextPattern.ReplaceAllFunc(content, func(elem []byte) []byte {
+		if renderErr != nil || !bytes.Contains(elem, []byte("{{")) {
+			return elem
+		}
+		m := extractTextPattern.FindSubmatchIndex(elem)
+		text := string(elem[m[2]:m[3]])
+		replaced := templateTagPattern.ReplaceAllStringFunc(text, func(tag string) string {
+			key := templateTagPattern.FindStringSubmatch(tag)[1]
+			value, ok := lookupTemplateValue(scopes, xmlUnescape(key))
+			if !ok {
+				if opts.StrictMissingKeys {
+					renderErr = NewValidationError("template", fmt.Sprintf("missing key %q", key))
+				}
+				return tag
+			}
+			return xmlEscapeContent(formatTemplateValue(value))
+		})
+		if replaced == text {
+			return elem
+		}
+		var out bytes.Buffer
+		out.Write(elem[:m[2]])
+		out.WriteString(replaced)
+		out.Write(elem[m[3]:])
+		return out.Bytes()
+	})
+	if renderErr != nil {
+		return nil, renderErr
+	}
+	return result, nil
+}
+
+// lookupTemplateValue resolves a dotted key against each scope in turn.
+func lookupTemplateValue(scopes []any, key string) (any, bool) {
+	for _, scope := range scopes {
+		if key == "." {
+			return scope, true
+		}
+		if value, ok := lookupTemplatePath(scope, strings.Split(key, ".")); ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func lookupTemplatePath(value any, path []string) (any, bool) {
+	for _, segment := range path {
+		switch v := value.(type) {
+		case map[string]any:
+			next, ok := v[segment]
+			if !ok {
+				return nil, false
+			}
+			value = next
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, false
+			}
+			value = v[idx]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// formatTemplateValue renders a JSON value as document text.
+func formatTemplateValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+}
+
+// isTemplateTruthy reports whether a JSON value enables an {{if}} block.
+func isTemplateTruthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case json.Number:
+		f, err := v.Float64()
+		return err != nil || f != 0
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
@@ -0,0 +1,115 @@
+package godocx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRenderFromJSON_Placeholders(t *testing.T) {
+	body := `<w:p><w:r><w:t>{{report.title}}</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:rPr><w:b/></w:rPr><w:t>Author: {{re</w:t></w:r><w:r><w:t>port.au</w:t></w:r><w:r><w:t>thor}} (v{{version}})</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>First tag: {{ tags.0 }}, approved: {{approved}}, missing: {{nope}}</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	jsonData := `{"report": {"title": "Q3 <Review>", "author": "Ops & Finance"}, "version": 2, "tags": ["alpha"], "approved": true}`
+	if err := u.RenderFromJSON([]byte(jsonData), TemplateOptions{}); err != nil {
+		t.Fatalf("RenderFromJSON: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	for _, want := range []string{
+		`<w:t>Q3 &lt;Review&gt;</w:t>`,
+		`<w:rPr><w:b/></w:rPr><w:t xml:space="preserve">Author: Ops &amp; Finance</w:t>`,
+		`(v2)`,
+		`First tag: alpha, approved: true, missing: {{nope}}`,
+	} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s in document, got: %s", want, docXML)
+		}
+	}
+}
+
+func TestRenderFromJSON_RangeRows(t *testing.T) {
+	body := `<w:tbl>` +
+		`<w:tr><w:tc><w:p><w:r><w:t>Item</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>Qty</w:t></w:r></w:p></w:tc></w:tr>` +
+		`<w:tr><w:tc><w:p><w:r><w:t>{{range rows}}{{name}}</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>{{qty}} {{unit}}</w:t></w:r></w:p></w:tc></w:tr>` +
+		`</w:tbl>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	jsonData := `{"unit": "pcs", "rows": [{"name": "Bolt", "qty": 10}, {"name": "Nut", "qty": 25, "unit": "boxes"}]}`
+	if err := u.RenderFromJSON([]byte(jsonData), TemplateOptions{}); err != nil {
+		t.Fatalf("RenderFromJSON: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if n := strings.Count(docXML, "</w:tr>"); n != 3 {
+		t.Errorf("expected header plus 2 rendered rows, got %d rows: %s", n, docXML)
+	}
+	for _, want := range []string{`>Bolt<`, `>10 pcs<`, `>Nut<`, `>25 boxes<`} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s in table, got: %s", want, docXML)
+		}
+	}
+	if strings.Contains(docXML, "{{") {
+		t.Errorf("expected all tags to be rendered, got: %s", docXML)
+	}
+}
+
+func TestRenderFromJSON_Conditionals(t *testing.T) {
+	body := `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>{{if discount}}</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Discount applied</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>{{else}}</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Full price</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>{{end}}</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>{{if notes}}</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>{{if notes.internal}}</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Internal notes</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>{{end}}</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Notes: {{notes.text}}</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>{{end}}</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Outro</w:t></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.RenderFromJSON([]byte(`{"discount": 0, "notes": {"text": "ok", "internal": false}}`), TemplateOptions{}); err != nil {
+		t.Fatalf("RenderFromJSON: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	want := `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Full price</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Notes: ok</w:t></w:r></w:p>` +
+		`<w:p><w:r><w:t>Outro</w:t></w:r></w:p>`
+	if !strings.Contains(docXML, want) {
+		t.Errorf("expected rendered body %s, got: %s", want, docXML)
+	}
+}
+
+func TestRenderFromJSON_Errors(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>{{missing}}</w:t></w:r></w:p>`))
+
+	err := u.RenderFromJSON([]byte("{\n  \"a\": 1,\n  \"b\": }"), TemplateOptions{})
+	var docxErr *DocxError
+	if !errors.As(err, &docxErr) {
+		t.Fatalf("expected DocxError, got %v", err)
+	}
+	if docxErr.Context["line"] != 3 || docxErr.Context["column"] != 8 {
+		t.Errorf("expected error at line 3, column 8, got %v", docxErr.Context)
+	}
+
+	if err := u.RenderFromJSON([]byte(`[1, 2]`), TemplateOptions{}); err == nil {
+		t.Error("expected error for non-object JSON")
+	}
+	if err := u.RenderFromJSON([]byte(`{} {}`), TemplateOptions{}); err == nil {
+		t.Error("expected error for trailing data")
+	}
+	if err := u.RenderFromJSON([]byte(`{}`), TemplateOptions{StrictMissingKeys: true}); err == nil {
+		t.Error("expected error for missing key in strict mode")
+	}
+
+	u = newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>{{if x}}</w:t></w:r></w:p>`))
+	if err := u.RenderFromJSON([]byte(`{"x": true}`), TemplateOptions{}); err == nil {
+		t.Error("expected error for unterminated if block")
+	}
+}
@@ -0,0 +1,304 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TextBoxPositionType defines what a floating text box is positioned against.
+type TextBoxPositionType string
+
+const (
+	// TextBoxAbsolute positions the box relative to the paragraph it is anchored to
+	TextBoxAbsolute TextBoxPositionType = "absolute"
+	// TextBoxRelativeToPage positions the box relative to the page edges
+	TextBoxRelativeToPage TextBoxPositionType = "relativeToPage"
+	// TextBoxRelativeToMargin positions the box relative to the page margins
+	TextBoxRelativeToMargin TextBoxPositionType = "relativeToMargin"
+)
+
+// TextBoxBorder defines the outline of a text box.
+type TextBoxBorder struct {
+	// Color is a 6-digit hex color (default: "000000").
+	Color string
+
+	// Width is the line width in EMUs (default: 9525 = 0.75pt).
+	Width int
+}
+
+// TextBoxOptions defines options for floating text box insertion.
+type TextBoxOptions struct {
+	// Text content of the box; each line becomes a separate paragraph (required)
+	Text string
+
+	// Offset of the box in EMUs (ignored on an axis that has an alignment set)
+	X int
+	Y int
+
+	// Size of the box in EMUs (required)
+	Width  int
+	Height int
+
+	// Position selects the reference frame for X/Y (default: TextBoxAbsolute)
+	Position TextBoxPositionType
+
+	// HorizontalAlignment aligns the box instead of using X
+	// ("left", "center", "right", "inside", "outside")
+	HorizontalAlignment string
+
+	// VerticalAlignment aligns the box instead of using Y
+	// ("top", "center", "bottom", "inside", "outside")
+	VerticalAlignment string
+
+	// Border outline (nil for no border)
+	Border *TextBoxBorder
+
+	// Background fill as a 6-digit hex color (empty for no fill)
+	Background string
+
+	// InsertAt selects where the paragraph anchoring the box is inserted
+	InsertAt InsertPosition
+
+	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
+	Anchor string
+}
+
+// InsertTextBox inserts a floating text box anchored to a new paragraph.
+func (u *Updater) InsertTextBox(opts TextBoxOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if err := validateTextBoxOptions(opts); err != nil {
+		return err
+	}
+
+	return u.insertFloatingDrawing(opts.InsertAt, opts.Anchor, "wps", func(docPrID int) string {
+		return generateTextBoxDrawingXML(opts, docPrID)
+	})
+}
+
+func validateTextBoxOptions(opts TextBoxOptions) error {
+	if opts.Text == "" {
+		return NewValidationError("Text", "text box text cannot be empty")
+	}
+	if opts.Width <= 0 || opts.Height <= 0 {
+		return NewValidationError("Width/Height", "text box size must be positive")
+	}
+	switch opts.Position {
+	case "", TextBoxAbsolute, TextBoxRelativeToPage, TextBoxRelativeToMargin:
+	default:
+		return NewValidationError("Position", fmt.Sprintf("unsupported text box position %q", opts.Position))
+	}
+	switch opts.HorizontalAlignment {
+	case "", "left", "center", "right", "inside", "outside":
+	default:
+		return NewValidationError("HorizontalAlignment", fmt.Sprintf("unsupported alignment %q", opts.HorizontalAlignment))
+	}
+	switch opts.VerticalAlignment {
+	case "", "top", "center", "bottom", "inside", "outside":
+	default:
+		return NewValidationError("VerticalAlignment", fmt.Sprintf("unsupported alignment %q", opts.VerticalAlignment))
+	}
+	if err := validateColor("Background", opts.Background); err != nil {
+		return err
+	}
+	if opts.Border != nil {
+		if err := validateColor("Border.Color", opts.Border.Color); err != nil {
+			return err
+		}
+		if opts.Border.Width < 0 {
+			return NewValidationError("Border.Width", "border width cannot be negative")
+		}
+	}
+	return nil
+}
+
+// floatingPosition describes where an anchored drawing sits on the page and
+// how text wraps around it. The zero wrap, distText and noOverlap values give
+// square wrapping with Word's default spacing and overlap allowed.
+type floatingPosition struct {
+	relativeFromH, relativeFromV string
+	x, y                         int
+	alignH, alignV               string
+	width, height                int
+	wrap                         TextWrapKind
+	distText                     int
+	noOverlap                    bool
+}
+
+func textBoxFloatingPosition(opts TextBoxOptions) floatingPosition {
+	pos := floatingPosition{
+		relativeFromH: "column", relativeFromV: "paragraph",
+		x: opts.X, y: opts.Y,
+		alignH: opts.HorizontalAlignment, alignV: opts.VerticalAlignment,
+		width: opts.Width, height: opts.Height,
+	}
+	switch opts.Position {
+	case TextBoxRelativeToPage:
+		pos.relativeFromH, pos.relativeFromV = "page", "page"
+	case TextBoxRelativeToMargin:
+		pos.relativeFromH, pos.relativeFromV = "margin", "margin"
+	}
+	return pos
+}
+
+// generateAnchorDrawingXML wraps graphicXML (an <a:graphic> element) in a
+// floating <wp:anchor> drawing.
+func generateAnchorDrawingXML(pos floatingPosition, docPrID int, name, graphicXML string) string {
+	distTB, distLR := 0, 114300
+	if pos.distText > 0 {
+		distTB, distLR = pos.distText, pos.distText
+	}
+	allowOverlap := 1
+	if pos.noOverlap {
+		allowOverlap = 0
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, `<w:drawing><wp:anchor distT="%d" distB="%d" distL="%d" distR="%d" simplePos="0" `, distTB, distTB, distLR, distLR)
+	fmt.Fprintf(&buf, `relativeHeight="%d" behindDoc="0" locked="0" layoutInCell="1" allowOverlap="%d">`, 251658240+docPrID, allowOverlap)
+	buf.WriteString(`<wp:simplePos x="0" y="0"/>`)
+
+	fmt.Fprintf(&buf, `<wp:positionH relativeFrom="%s">`, pos.relativeFromH)
+	if pos.alignH != "" {
+		fmt.Fprintf(&buf, `<wp:align>%s</wp:align>`, pos.alignH)
+	} else {
+		fmt.Fprintf(&buf, `<wp:posOffset>%d</wp:posOffset>`, pos.x)
+	}
+	buf.WriteString(`</wp:positionH>`)
+
+	fmt.Fprintf(&buf, `<wp:positionV relativeFrom="%s">`, pos.relativeFromV)
+	if pos.alignV != "" {
+		fmt.Fprintf(&buf, `<wp:align>%s</wp:align>`, pos.alignV)
+	} else {
+		fmt.Fprintf(&buf, `<wp:posOffset>%d</wp:posOffset>`, pos.y)
+	}
+	buf.WriteString(`</wp:positionV>`)
+
+	fmt.Fprintf(&buf, `<wp:extent cx="%d" cy="%d"/>`, pos.width, pos.height)
+	buf.WriteString(`<wp:effectExtent l="0" t="0" r="0" b="0"/>`)
+	switch pos.wrap {
+	case TextWrapNone:
+		buf.WriteString(`<wp:wrapNone/>`)
+	case TextWrapTight:
+		// A rectangular contour on the 21600-unit wrap polygon grid.
+		buf.WriteString(`<wp:wrapTight wrapText="bothSides"><wp:wrapPolygon edited="0"><wp:start x="0" y="0"/>`)
+		buf.WriteString(`<wp:lineTo x="0" y="21600"/><wp:lineTo x="21600" y="21600"/><wp:lineTo x="21600" y="0"/>`)
+		buf.WriteString(`<wp:lineTo x="0" y="0"/></wp:wrapPolygon></wp:wrapTight>`)
+	default:
+		buf.WriteString(`<wp:wrapSquare wrapText="bothSides"/>`)
+	}
+	fmt.Fprintf(&buf, `<wp:docPr id="%d" name="%s"/>`, docPrID, xmlEscape(name))
+	buf.WriteString(`<wp:cNvGraphicFramePr/>`)
+	buf.WriteString(graphicXML)
+	buf.WriteString(`</wp:anchor></w:drawing>`)
+	return buf.String()
+}
+
+// generateShapeFillXML returns the DrawingML fill for a hex color, or <a:noFill/>.
+func generateShapeFillXML(color string) string {
+	if c := normalizeHexColor(color); c != "" {
+		return fmt.Sprintf(`<a:solidFill><a:srgbClr val="%s"/></a:solidFill>`, c)
+	}
+	return `<a:noFill/>`
+}
+
+// generateShapeLineXML returns the DrawingML outline for a hex color and width
+// in EMUs; an empty color with zero width yields no outline.
+func generateShapeLineXML(color string, width int) string {
+	if color == "" && width == 0 {
+		return `<a:ln><a:noFill/></a:ln>`
+	}
+	c := normalizeHexColor(color)
+	if c == "" {
+		c = "000000"
+	}
+	if width == 0 {
+		width = 9525
+	}
+	return fmt.Sprintf(`<a:ln w="%d"><a:solidFill><a:srgbClr val="%s"/></a:solidFill></a:ln>`, width, c)
+}
+
+// generateTxbxContentXML renders text as <w:txbxContent>, one paragraph per
+// line, with the given paragraph alignment (empty for the default).
+func generateTxbxContentXML(text string, jc string) string {
+	var buf strings.Builder
+	buf.WriteString(`<w:txbxContent>`)
+	for _, line := range strings.Split(text, "\n") {
+		buf.WriteString(`<w:p>`)
+		if jc != "" {
+			fmt.Fprintf(&buf, `<w:pPr><w:jc w:val="%s"/></w:pPr>`, jc)
+		}
+		if line != "" {
+			fmt.Fprintf(&buf, `<w:r><w:t xml:space="preserve">%s</w:t></w:r>`, xmlEscapeContent(line))
+		}
+		buf.WriteString(`</w:p>`)
+	}
+	buf.WriteString(`</w:txbxContent>`)
+	return buf.String()
+}
+
+// generateTextBoxDrawingXML creates the floating drawing for a text box, a
+// DrawingML word-processing shape (wps) with a text box body.
+func generateTextBoxDrawingXML(opts TextBoxOptions, docPrID int) string {
+	line := generateShapeLineXML("", 0)
+	if opts.Border != nil {
+		color := opts.Border.Color
+		if color == "" {
+			color = "000000"
+		}
+		line = generateShapeLineXML(color, opts.Border.Width)
+	}
+
+	var graphic strings.Builder
+	graphic.WriteString(`<a:graphic xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main">`)
+	graphic.WriteString(`<a:graphicData uri="http://schemas.microsoft.com/office/word/2010/wordprocessingShape">`)
+	graphic.WriteString(`<wps:wsp><wps:cNvSpPr txBox="1"/><wps:spPr>`)
+	fmt.Fprintf(&graphic, `<a:xfrm><a:off x="0" y="0"/><a:ext cx="%d" cy="%d"/></a:xfrm>`, opts.Width, opts.Height)
+	graphic.WriteString(`<a:prstGeom prst="rect"><a:avLst/></a:prstGeom>`)
+	graphic.WriteString(generateShapeFillXML(opts.Background))
+	graphic.WriteString(line)
+	graphic.WriteString(`</wps:spPr><wps:txbx>`)
+	graphic.WriteString(generateTxbxContentXML(opts.Text, ""))
+	graphic.WriteString(`</wps:txbx><wps:bodyPr rot="0" vert="horz" wrap="square" lIns="91440" tIns="45720" rIns="91440" bIns="45720" anchor="t" anchorCtr="0"><a:noAutofit/></wps:bodyPr></wps:wsp>`)
+	graphic.WriteString(`</a:graphicData></a:graphic>`)
+
+	return generateAnchorDrawingXML(textBoxFloatingPosition(opts), docPrID,
+		fmt.Sprintf("Text Box %d", docPrID), graphic.String())
+}
+
+// insertFloatingDrawing wraps the drawing produced by drawingXML in a new
+// paragraph inside mc:AlternateContent and inserts it into document.xml.
+// requires is the namespace prefix the drawing depends on ("wps" or "wpg").
+func (u *Updater) insertFloatingDrawing(position InsertPosition, anchor, requires string, drawingXML func(docPrID int) string) error {
+	docPrID, err := u.getNextDocPrId()
+	if err != nil {
+		return fmt.Errorf("get next docPr id: %w", err)
+	}
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	raw, err = ensureDocumentNamespaces(raw, drawingShapeNamespaces)
+	if err != nil {
+		return err
+	}
+
+	paraXML := []byte(`<w:p><w:r><mc:AlternateContent><mc:Choice Requires="` + requires + `">` +
+		drawingXML(docPrID) + `</mc:Choice></mc:AlternateContent></w:r></w:p>`)
+
+	updated, err := insertElementAtPosition(raw, paraXML, position, anchor)
+	if err != nil {
+		return fmt.Errorf("insert drawing: %w", err)
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package godocx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInsertTextBox(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	err := u.InsertTextBox(TextBoxOptions{
+		Text:                "Sidebar title\nSidebar & notes",
+		Y:                   457200,
+		Width:               2286000,
+		Height:              914400,
+		Position:            TextBoxRelativeToMargin,
+		HorizontalAlignment: "right",
+		Border:              &TextBoxBorder{Color: "1F4E79", Width: 12700},
+		Background:          "DEEAF6",
+		InsertAt:            PositionAfterText,
+		Anchor:              "Intro",
+	})
+	if err != nil {
+		t.Fatalf("InsertTextBox: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	for _, want := range []string{
+		`xmlns:wps="http://schemas.microsoft.com/office/word/2010/wordprocessingShape"`,
+		`xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006"`,
+		`<mc:Choice Requires="wps"><w:drawing><wp:anchor `,
+		`<wp:positionH relativeFrom="margin"><wp:align>right</wp:align></wp:positionH>`,
+		`<wp:positionV relativeFrom="margin"><wp:posOffset>457200</wp:posOffset></wp:positionV>`,
+		`<wp:extent cx="2286000" cy="914400"/>`,
+		`<wps:cNvSpPr txBox="1"/>`,
+		`<a:solidFill><a:srgbClr val="DEEAF6"/></a:solidFill>`,
+		`<a:ln w="12700"><a:solidFill><a:srgbClr val="1F4E79"/></a:solidFill></a:ln>`,
+		`<w:txbxContent><w:p><w:r><w:t xml:space="preserve">Sidebar title</w:t></w:r></w:p>` +
+			`<w:p><w:r><w:t xml:space="preserve">Sidebar &amp; notes</w:t></w:r></w:p></w:txbxContent>`,
+	} {
+		if !strings.Contains(docXML, want) {
+			t.Errorf("expected %s in document, got: %s", want, docXML)
+		}
+	}
+
+	if strings.Index(docXML, "Intro") > strings.Index(docXML, "<wps:wsp>") {
+		t.Error("expected text box to be inserted after the anchor paragraph")
+	}
+}
+
+func TestInsertTextBox_NamespacesAddedOnce(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	for i := 0; i < 2; i++ {
+		if err := u.InsertTextBox(TextBoxOptions{Text: "Box", Width: 914400, Height: 457200, InsertAt: PositionEnd}); err != nil {
+			t.Fatalf("InsertTextBox: %v", err)
+		}
+	}
+
+	docXML := readDocXML(t, u)
+	if n := strings.Count(docXML, `xmlns:wps=`); n != 1 {
+		t.Errorf("expected one wps namespace declaration, got %d", n)
+	}
+	if !strings.Contains(docXML, `<wp:docPr id="1" name="Text Box 1"/>`) || !strings.Contains(docXML, `<wp:docPr id="2" name="Text Box 2"/>`) {
+		t.Errorf("expected unique docPr ids, got: %s", docXML)
+	}
+	if !strings.Contains(docXML, `<a:noFill/><a:ln><a:noFill/></a:ln>`) {
+		t.Errorf("expected no fill and no border by default, got: %s", docXML)
+	}
+}
+
+func TestInsertTextBox_Validation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	cases := []TextBoxOptions{
+		{Width: 100, Height: 100},
+		{Text: "x"},
+		{Text: "x", Width: 100, Height: 100, Position: "floating"},
+		{Text: "x", Width: 100, Height: 100, HorizontalAlignment: "middle"},
+		{Text: "x", Width: 100, Height: 100, Background: "blue"},
+		{Text: "x", Width: 100, Height: 100, Border: &TextBoxBorder{Width: -1}},
+	}
+	for i, opts := range cases {
+		if err := u.InsertTextBox(opts); err == nil {
+			t.Errorf("case %d: expected validation error", i)
+		}
+	}
+}
@@ -0,0 +1,482 @@
+package godocx
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ThemeColors defines the color scheme of an Office theme. All values are
+// 6-digit hex colors.
+type ThemeColors struct {
+	Dark1   string // Dark text/background color (usually black)
+	Light1  string // Light text/background color (usually white)
+	Dark2   string
+	Light2  string
+	Accent1 string // Used by the first chart series and Heading styles
+	Accent2 string
+	Accent3 string
+	Accent4 string
+	Accent5 string
+	Accent6 string
+}
+
+// ThemeDefinition defines an Office document theme.
+type ThemeDefinition struct {
+	// Name of the theme and its color scheme
+	Name string
+
+	// Colors of the theme color scheme
+	Colors ThemeColors
+}
+
+// ThemeFontOptions sets the fonts of the theme font scheme. Major fonts are
+// used by headings, minor fonts by body text; each has a font for Latin,
+// East Asian (EA) and complex script (CS) text. Empty fields keep the
+// current font.
+type ThemeFontOptions struct {
+	MajorFontLatin string
+	MajorFontEA    string
+	MajorFontCS    string
+	MinorFontLatin string
+	MinorFontEA    string
+	MinorFontCS    string
+}
+
+// ThemeFontRef makes a run or style use a font of the theme font scheme
+// instead of a named font, so that it follows the theme when it changes.
+type ThemeFontRef string
+
+const (
+	ThemeMajor ThemeFontRef = "major" // Headings font
+	ThemeMinor ThemeFontRef = "minor" // Body font
+)
+
+// officeThemeColors are the colors of the default Office theme, used when a
+// theme part has to be created for SetThemeFonts.
+var officeThemeColors = ThemeColors{
+	Dark1: "000000", Light1: "FFFFFF", Dark2: "44546A", Light2: "E7E6E6",
+	Accent1: "4472C4", Accent2: "ED7D31", Accent3: "A5A5A5",
+	Accent4: "FFC000", Accent5: "5B9BD5", Accent6: "70AD47",
+}
+
+// themeColorSlots lists the CT_ColorScheme elements in schema order, with the
+// ThemeColors field each one maps to.
+var themeColorSlots = []struct {
+	element string
+	field   func(*ThemeColors) *string
+}{
+	{"dk1", func(c *ThemeColors) *string { return &c.Dark1 }},
+	{"lt1", func(c *ThemeColors) *string { return &c.Light1 }},
+	{"dk2", func(c *ThemeColors) *string { return &c.Dark2 }},
+	{"lt2", func(c *ThemeColors) *string { return &c.Light2 }},
+	{"accent1", func(c *ThemeColors) *string { return &c.Accent1 }},
+	{"accent2", func(c *ThemeColors) *string { return &c.Accent2 }},
+	{"accent3", func(c *ThemeColors) *string { return &c.Accent3 }},
+	{"accent4", func(c *ThemeColors) *string { return &c.Accent4 }},
+	{"accent5", func(c *ThemeColors) *string { return &c.Accent5 }},
+	{"accent6", func(c *ThemeColors) *string { return &c.Accent6 }},
+}
+
+var (
+	themeNamePattern       = regexp.MustCompile(`<a:theme\b[^>]*\bname="([^"]*)"`)
+	themeClrSchemePattern  = regexp.MustCompile(`(?s)<a:clrScheme\b[^>]*>.*?</a:clrScheme>`)
+	themeColorValuePattern = regexp.MustCompile(`(?:lastClr|val)="([0-9A-Fa-f]{6})"`)
+	themeRelTargetPattern  = regexp.MustCompile(`<Relationship\b[^>]*Type="[^"]*/relationships/theme"[^>]*/>`)
+	relTargetAttrPattern   = regexp.MustCompile(`Target="([^"]*)"`)
+	themeFontSlotPattern   = regexp.MustCompile(`<a:(latin|ea|cs)\b[^>]*?(?:/>|>.*?</a:(?:latin|ea|cs)>)`)
+)
+
+const (
+	themeRelationshipType = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/theme"
+	themeContentType      = "application/vnd.openxmlformats-officedocument.theme+xml"
+)
+
+// SetDocumentTheme applies the theme color scheme. When the document already
+// has a theme, only its color scheme and name are replaced so that theme fonts
+// and effects are kept; otherwise a theme part is created at the path named by
+// the document's theme relationship, or at word/theme/theme1.xml.
+//
+// Heading styles, charts and any other content that refers to theme colors
+// (<a:schemeClr>, w:themeColor) pick up the new colors when Word renders the
+// document.
+func (u *Updater) SetDocumentTheme(theme ThemeDefinition) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if err := validateThemeColors(theme.Colors); err != nil {
+		return err
+	}
+	if theme.Name == "" {
+		theme.Name = "Custom"
+	}
+
+	themePath, err := u.themePartPath()
+	if err != nil {
+		return err
+	}
+
+	raw, err := os.ReadFile(themePath)
+	if os.IsNotExist(err) {
+		themeXML := generateThemeXML(theme)
+		if err := os.MkdirAll(filepath.Dir(themePath), 0o755); err != nil {
+			return NewFileWriteError("theme folder", err)
+		}
+		if err := atomicWriteFile(themePath, []byte(themeXML), 0o644); err != nil {
+			return NewFileWriteError("theme", err)
+		}
+		if err := u.ensureThemeRelationship(themePath); err != nil {
+			return fmt.Errorf("update relationships: %w", err)
+		}
+		if err := u.ensureThemeContentType(themePath); err != nil {
+			return fmt.Errorf("update content types: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return NewFileReadError("theme", err)
+	}
+
+	content := string(raw)
+	loc := themeClrSchemePattern.FindStringIndex(content)
+	if loc == nil {
+		return NewMalformedXMLError("theme has no color scheme")
+	}
+
+	// Keep the existing hyperlink colors, which ThemeColors does not cover.
+	existing := content[loc[0]:loc[1]]
+	hlink := themeSlotXML(existing, "hlink", "0563C1")
+	folHlink := themeSlotXML(existing, "folHlink", "954F72")
+
+	content = content[:loc[0]] + generateClrSchemeXML(theme, hlink, folHlink) + content[loc[1]:]
+	if m := themeNamePattern.FindStringSubmatchIndex(content); m != nil {
+		content = content[:m[2]] + xmlEscape(theme.Name) + content[m[3]:]
+	}
+
+	if err := atomicWriteFile(themePath, []byte(content), 0o644); err != nil {
+		return NewFileWriteError("theme", err)
+	}
+	return nil
+}
+
+// GetDocumentTheme reads the document theme. It returns nil when the document
+// has no theme part.
+func (u *Updater) GetDocumentTheme() (*ThemeDefinition, error) {
+	if u == nil {
+		return nil, NewValidationError("updater", "updater is nil")
+	}
+
+	themePath, err := u.themePartPath()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := os.ReadFile(themePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, NewFileReadError("theme", err)
+	}
+
+	content := string(raw)
+	theme := &ThemeDefinition{}
+	if m := themeNamePattern.FindStringSubmatch(content); m != nil {
+		theme.Name = m[1]
+	}
+
+	scheme := themeClrSchemePattern.FindString(content)
+	for _, slot := range themeColorSlots {
+		elem := themeSlotXML(scheme, slot.element, "")
+		if m := themeColorValuePattern.FindStringSubmatch(elem); m != nil {
+			*slot.field(&theme.Colors) = strings.ToUpper(m[1])
+		}
+	}
+	return theme, nil
+}
+
+// SetThemeFonts sets the major (headings) and minor (body) fonts of the
+// theme font scheme of the document theme, creating an Office theme when
+// the document has none. Text formatted with ThemeFontRef, and Word's
+// built-in styles, pick up the new fonts.
+func (u *Updater) SetThemeFonts(opts ThemeFontOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if opts == (ThemeFontOptions{}) {
+		return NewValidationError("opts", "at least one theme font is required")
+	}
+
+	themePath, err := u.themePartPath()
+	if err != nil {
+		return err
+	}
+	raw, err := os.ReadFile(themePath)
+	created := os.IsNotExist(err)
+	switch {
+	case created:
+		raw = []byte(generateThemeXML(ThemeDefinition{Name: "Office", Colors: officeThemeColors}))
+	case err != nil:
+		return NewFileReadError("theme", err)
+	}
+
+	content := string(raw)
+	for _, f := range []struct {
+		collection string
+		fonts      [3]string // latin, ea, cs
+	}{
+		{"majorFont", [3]string{opts.MajorFontLatin, opts.MajorFontEA, opts.MajorFontCS}},
+		{"minorFont", [3]string{opts.MinorFontLatin, opts.MinorFontEA, opts.MinorFontCS}},
+	} {
+		if f.fonts == [3]string{} {
+			continue
+		}
+		if content, err = setThemeFontCollection(content, f.collection, f.fonts); err != nil {
+			return err
+		}
+	}
+
+	if created {
+		if err := os.MkdirAll(filepath.Dir(themePath), 0o755); err != nil {
+			return NewFileWriteError("theme folder", err)
+		}
+	}
+	if err := atomicWriteFile(themePath, []byte(content), 0o644); err != nil {
+		return NewFileWriteError("theme", err)
+	}
+	if created {
+		if err := u.ensureThemeRelationship(themePath); err != nil {
+			return fmt.Errorf("update relationships: %w", err)
+		}
+		if err := u.ensureThemeContentType(themePath); err != nil {
+			return fmt.Errorf("update content types: %w", err)
+		}
+	}
+	return nil
+}
+
+// setThemeFontCollection replaces the latin, ea and cs fonts of the
+// <a:majorFont> or <a:minorFont> collection of a theme with the non-empty
+// entries of fonts. The script-specific <a:font> entries are kept.
+func setThemeFontCollection(themeXML, collection string, fonts [3]string) (string, error) {
+	openTag, closeTag := "<a:"+collection+">", "</a:"+collection+">"
+	start := strings.Index(themeXML, openTag)
+	if start == -1 {
+		return "", NewMalformedXMLError(fmt.Sprintf("theme has no %s", collection))
+	}
+	innerStart := start + len(openTag)
+	end := strings.Index(themeXML[innerStart:], closeTag)
+	if end == -1 {
+		return "", NewMalformedXMLError(fmt.Sprintf("malformed %s in theme", collection))
+	}
+	inner := themeXML[innerStart : innerStart+end]
+
+	// CT_FontCollection requires latin, ea and cs, in that order, before the
+	// script fonts.
+	current := make(map[string]string)
+	rest := inner
+	for _, m := range themeFontSlotPattern.FindAllStringSubmatch(inner, -1) {
+		current[m[1]] = m[0]
+		rest = strings.Replace(rest, m[0], "", 1)
+	}
+	var buf strings.Builder
+	for i, slot := range []string{"latin", "ea", "cs"} {
+		switch {
+		case fonts[i] != "":
+			fmt.Fprintf(&buf, `<a:%s typeface="%s"/>`, slot, xmlEscape(fonts[i]))
+		case current[slot] != "":
+			buf.WriteString(current[slot])
+		default:
+			fmt.Fprintf(&buf, `<a:%s typeface=""/>`, slot)
+		}
+	}
+	buf.WriteString(rest)
+
+	return themeXML[:innerStart] + buf.String() + themeXML[innerStart+end:], nil
+}
+
+// themeFontAttrs returns the w:rFonts attributes that select the theme fonts
+// of ref, or "" for an unknown reference.
+func themeFontAttrs(ref ThemeFontRef) string {
+	if ref != ThemeMajor && ref != ThemeMinor {
+		return ""
+	}
+	return fmt.Sprintf(`w:asciiTheme="%[1]sHAnsi" w:eastAsiaTheme="%[1]sEastAsia" w:hAnsiTheme="%[1]sHAnsi" w:cstheme="%[1]sBidi"`, ref)
+}
+
+// validateThemeFontRef reports an error for a ThemeFontRef other than
+// ThemeMajor, ThemeMinor or empty.
+func validateThemeFontRef(field string, ref ThemeFontRef) error {
+	if ref != "" && themeFontAttrs(ref) == "" {
+		return NewValidationError(field, fmt.Sprintf("invalid theme font %q: expected %q or %q", ref, ThemeMajor, ThemeMinor))
+	}
+	return nil
+}
+
+func validateThemeColors(colors ThemeColors) error {
+	for _, slot := range themeColorSlots {
+		value := *slot.field(&colors)
+		if value == "" {
+			return NewValidationError("Colors."+slot.element, "theme color is required")
+		}
+		if normalizeHexColor(value) == "" {
+			return invalidColorError("Colors."+slot.element, value)
+		}
+	}
+	return nil
+}
+
+// themePartPath returns the path of the theme part referenced by
+// document.xml.rels, defaulting to word/theme/theme1.xml when the document has
+// no theme relationship. Targets are resolved relative to word/ unless they
+// start with "/", which makes them relative to the package root.
+func (u *Updater) themePartPath() (string, error) {
+	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
+	raw, err := os.ReadFile(relsPath)
+	if err != nil {
+		return "", NewFileReadError("document.xml.rels", err)
+	}
+	partName := "word/theme/theme1.xml"
+	if rel := themeRelTargetPattern.Find(raw); rel != nil {
+		if m := relTargetAttrPattern.FindSubmatch(rel); m != nil {
+			target := string(m[1])
+			if strings.HasPrefix(target, "/") {
+				partName = path.Clean(strings.TrimPrefix(target, "/"))
+			} else {
+				partName = path.Join("word", target)
+			}
+			if partName == ".." || strings.HasPrefix(partName, "../") {
+				return "", NewMalformedXMLError(fmt.Sprintf("theme relationship target %q is outside the package", target))
+			}
+		}
+	}
+	return filepath.Join(u.tempDir, filepath.FromSlash(partName)), nil
+}
+
+// themeSlotXML returns the <a:name> element of a color scheme, or a srgbClr
+// element with fallback when it is missing.
+func themeSlotXML(scheme, name, fallback string) string {
+	start := strings.Index(scheme, "<a:"+name+">")
+	if start != -1 {
+		closeTag := "</a:" + name + ">"
+		if end := strings.Index(scheme[start:], closeTag); end != -1 {
+			return scheme[start : start+end+len(closeTag)]
+		}
+	}
+	if fallback == "" {
+		return ""
+	}
+	return fmt.Sprintf(`<a:%s><a:srgbClr val="%s"/></a:%s>`, name, fallback, name)
+}
+
+// generateClrSchemeXML creates the <a:clrScheme> element for theme.
+func generateClrSchemeXML(theme ThemeDefinition, hlinkXML, folHlinkXML string) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, `<a:clrScheme name="%s">`, xmlEscape(theme.Name))
+	for _, slot := range themeColorSlots {
+		color := normalizeHexColor(*slot.field(&theme.Colors))
+		fmt.Fprintf(&buf, `<a:%s><a:srgbClr val="%s"/></a:%s>`, slot.element, color, slot.element)
+	}
+	buf.WriteString(hlinkXML)
+	buf.WriteString(folHlinkXML)
+	buf.WriteString(`</a:clrScheme>`)
+	return buf.String()
+}
+
+// generateThemeXML creates a complete theme part with the given colors and the
+// standard Office font and format schemes.
+func generateThemeXML(theme ThemeDefinition) string {
+	clrScheme := generateClrSchemeXML(theme,
+		`<a:hlink><a:srgbClr val="0563C1"/></a:hlink>`,
+		`<a:folHlink><a:srgbClr val="954F72"/></a:folHlink>`)
+
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+		fmt.Sprintf(`<a:theme xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" name="%s">`, xmlEscape(theme.Name)) +
+		`<a:themeElements>` + clrScheme +
+		`<a:fontScheme name="Office">` +
+		`<a:majorFont><a:latin typeface="Calibri Light"/><a:ea typeface=""/><a:cs typeface=""/></a:majorFont>` +
+		`<a:minorFont><a:latin typeface="Calibri"/><a:ea typeface=""/><a:cs typeface=""/></a:minorFont>` +
+		`</a:fontScheme>` +
+		`<a:fmtScheme name="Office">` +
+		`<a:fillStyleLst>` +
+		`<a:solidFill><a:schemeClr val="phClr"/></a:solidFill>` +
+		`<a:solidFill><a:schemeClr val="phClr"><a:tint val="50000"/></a:schemeClr></a:solidFill>` +
+		`<a:solidFill><a:schemeClr val="phClr"><a:shade val="80000"/></a:schemeClr></a:solidFill>` +
+		`</a:fillStyleLst>` +
+		`<a:lnStyleLst>` +
+		`<a:ln w="6350"><a:solidFill><a:schemeClr val="phClr"/></a:solidFill></a:ln>` +
+		`<a:ln w="12700"><a:solidFill><a:schemeClr val="phClr"/></a:solidFill></a:ln>` +
+		`<a:ln w="19050"><a:solidFill><a:schemeClr val="phClr"/></a:solidFill></a:ln>` +
+		`</a:lnStyleLst>` +
+		`<a:effectStyleLst>` +
+		`<a:effectStyle><a:effectLst/></a:effectStyle>` +
+		`<a:effectStyle><a:effectLst/></a:effectStyle>` +
+		`<a:effectStyle><a:effectLst/></a:effectStyle>` +
+		`</a:effectStyleLst>` +
+		`<a:bgFillStyleLst>` +
+		`<a:solidFill><a:schemeClr val="phClr"/></a:solidFill>` +
+		`<a:solidFill><a:schemeClr val="phClr"><a:tint val="95000"/></a:schemeClr></a:solidFill>` +
+		`<a:solidFill><a:schemeClr val="phClr"><a:shade val="80000"/></a:schemeClr></a:solidFill>` +
+		`</a:bgFillStyleLst>` +
+		`</a:fmtScheme>` +
+		`</a:themeElements><a:objectDefaults/><a:extraClrSchemeLst/>` +
+		`</a:theme>`
+}
+
+// ensureThemeRelationship adds a relationship to the theme part at themePath to
+// document.xml.rels if the document has no theme relationship.
+func (u *Updater) ensureThemeRelationship(themePath string) error {
+	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
+	data, err := os.ReadFile(relsPath)
+	if err != nil {
+		return NewFileReadError("document.xml.rels", err)
+	}
+
+	content := string(data)
+	if themeRelTargetPattern.MatchString(content) {
+		return nil
+	}
+
+	relID, err := getNextRelIDFromFile(relsPath)
+	if err != nil {
+		return fmt.Errorf("find next relationship id: %w", err)
+	}
+
+	target, err := filepath.Rel(filepath.Join(u.tempDir, "word"), themePath)
+	if err != nil {
+		return NewFileWriteError("theme relationship", err)
+	}
+	themeRel := fmt.Sprintf(`<Relationship Id="%s" Type="%s" Target="%s"/>`,
+		relID, themeRelationshipType, xmlEscape(filepath.ToSlash(target)))
+	content = strings.Replace(content, "</Relationships>", themeRel+"</Relationships>", 1)
+
+	return atomicWriteFile(relsPath, []byte(content), 0o644)
+}
+
+// ensureThemeContentType adds an override for the theme part at themePath to
+// [Content_Types].xml if not present.
+func (u *Updater) ensureThemeContentType(themePath string) error {
+	contentTypesPath := filepath.Join(u.tempDir, "[Content_Types].xml")
+	data, err := os.ReadFile(contentTypesPath)
+	if err != nil {
+		return NewFileReadError("[Content_Types].xml", err)
+	}
+
+	rel, err := filepath.Rel(u.tempDir, themePath)
+	if err != nil {
+		return NewFileWriteError("theme content type", err)
+	}
+	partName := xmlEscape("/" + filepath.ToSlash(rel))
+
+	content := string(data)
+	if strings.Contains(content, `PartName="`+partName+`"`) {
+		return nil
+	}
+
+	override := fmt.Sprintf(`<Override PartName="%s" ContentType="%s"/>`, partName, themeContentType)
+	content = strings.Replace(content, "</Types>", override+"</Types>", 1)
+
+	return atomicWriteFile(contentTypesPath, []byte(content), 0o644)
+}
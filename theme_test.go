@@ -0,0 +1,265 @@
+package godocx
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var testThemeColors = ThemeColors{
+	Dark1: "000000", Light1: "FFFFFF", Dark2: "1F2A44", Light2: "E7E6E6",
+	Accent1: "#0B5FFF", Accent2: "FF6B00", Accent3: "2CA02C",
+	Accent4: "D62728", Accent5: "9467BD", Accent6: "8C564B",
+}
+
+func TestSetDocumentTheme_CreatesThemePart(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	if err := u.SetDocumentTheme(ThemeDefinition{Name: "Brand", Colors: testThemeColors}); err != nil {
+		t.Fatalf("SetDocumentTheme: %v", err)
+	}
+
+	themeXML := readTempFile(t, u, "word/theme/theme1.xml")
+	for _, want := range []string{
+		`<a:theme xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" name="Brand">`,
+		`<a:clrScheme name="Brand"><a:dk1><a:srgbClr val="000000"/></a:dk1>`,
+		`<a:accent1><a:srgbClr val="0B5FFF"/></a:accent1>`,
+		`<a:hlink><a:srgbClr val="0563C1"/></a:hlink>`,
+		`<a:fontScheme name="Office">`,
+		`<a:fmtScheme name="Office">`,
+	} {
+		if !strings.Contains(themeXML, want) {
+			t.Errorf("expected %s in theme, got: %s", want, themeXML)
+		}
+	}
+
+	rels := readTempFile(t, u, "word/_rels/document.xml.rels")
+	if !strings.Contains(rels, `relationships/theme" Target="theme/theme1.xml"`) {
+		t.Errorf("expected theme relationship, got: %s", rels)
+	}
+	ct := readTempFile(t, u, "[Content_Types].xml")
+	if !strings.Contains(ct, `PartName="/word/theme/theme1.xml"`) {
+		t.Errorf("expected theme content type, got: %s", ct)
+	}
+
+	got, err := u.GetDocumentTheme()
+	if err != nil {
+		t.Fatalf("GetDocumentTheme: %v", err)
+	}
+	want := testThemeColors
+	want.Accent1 = "0B5FFF"
+	if got == nil || got.Name != "Brand" || got.Colors != want {
+		t.Errorf("GetDocumentTheme() = %+v, want name Brand and colors %+v", got, want)
+	}
+}
+
+func TestSetDocumentTheme_ReplacesExistingColorScheme(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	existing := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<a:theme xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" name="Office Theme"><a:themeElements>` +
+		`<a:clrScheme name="Office"><a:dk1><a:sysClr val="windowText" lastClr="000000"/></a:dk1>` +
+		`<a:lt1><a:sysClr val="window" lastClr="FFFFFF"/></a:lt1>` +
+		`<a:dk2><a:srgbClr val="44546A"/></a:dk2><a:lt2><a:srgbClr val="E7E6E6"/></a:lt2>` +
+		`<a:accent1><a:srgbClr val="4472C4"/></a:accent1><a:accent2><a:srgbClr val="ED7D31"/></a:accent2>` +
+		`<a:accent3><a:srgbClr val="A5A5A5"/></a:accent3><a:accent4><a:srgbClr val="FFC000"/></a:accent4>` +
+		`<a:accent5><a:srgbClr val="5B9BD5"/></a:accent5><a:accent6><a:srgbClr val="70AD47"/></a:accent6>` +
+		`<a:hlink><a:srgbClr val="112233"/></a:hlink><a:folHlink><a:srgbClr val="445566"/></a:folHlink></a:clrScheme>` +
+		`<a:fontScheme name="Custom Fonts"><a:majorFont><a:latin typeface="Georgia"/></a:majorFont></a:fontScheme>` +
+		`</a:themeElements></a:theme>`
+	themeDir := filepath.Join(u.TempDir(), "word", "theme")
+	if err := os.MkdirAll(themeDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(themeDir, "theme1.xml"), []byte(existing), 0o644); err != nil {
+		t.Fatalf("write theme: %v", err)
+	}
+
+	before, err := u.GetDocumentTheme()
+	if err != nil {
+		t.Fatalf("GetDocumentTheme: %v", err)
+	}
+	if before.Name != "Office Theme" || before.Colors.Dark1 != "000000" || before.Colors.Accent1 != "4472C4" {
+		t.Errorf("unexpected existing theme: %+v", before)
+	}
+
+	if err := u.SetDocumentTheme(ThemeDefinition{Name: "Brand", Colors: testThemeColors}); err != nil {
+		t.Fatalf("SetDocumentTheme: %v", err)
+	}
+
+	themeXML := readTempFile(t, u, "word/theme/theme1.xml")
+	if !strings.Contains(themeXML, `<a:accent1><a:srgbClr val="0B5FFF"/></a:accent1>`) {
+		t.Errorf("expected new accent1, got: %s", themeXML)
+	}
+	if !strings.Contains(themeXML, `<a:hlink><a:srgbClr val="112233"/></a:hlink>`) {
+		t.Errorf("expected existing hyperlink color to be kept, got: %s", themeXML)
+	}
+	if !strings.Contains(themeXML, `<a:latin typeface="Georgia"/>`) {
+		t.Errorf("expected existing font scheme to be kept, got: %s", themeXML)
+	}
+	if !strings.Contains(themeXML, `name="Brand"><a:themeElements>`) {
+		t.Errorf("expected theme to be renamed, got: %s", themeXML)
+	}
+}
+
+func TestSetDocumentTheme_FollowsThemeRelationship(t *testing.T) {
+	for _, tt := range []struct{ target, part string }{
+		{"theme/brand.xml", "word/theme/brand.xml"},
+		{"/customXml/theme.xml", "customXml/theme.xml"},
+	} {
+		t.Run(tt.target, func(t *testing.T) {
+			u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+			relsPath := filepath.Join(u.TempDir(), "word", "_rels", "document.xml.rels")
+			rels := readTempFile(t, u, "word/_rels/document.xml.rels")
+			rels = strings.Replace(rels, "</Relationships>",
+				`<Relationship Id="rIdTheme" Type="`+themeRelationshipType+`" Target="`+tt.target+`"/></Relationships>`, 1)
+			if err := os.WriteFile(relsPath, []byte(rels), 0o644); err != nil {
+				t.Fatalf("write rels: %v", err)
+			}
+
+			if err := u.SetDocumentTheme(ThemeDefinition{Name: "Brand", Colors: testThemeColors}); err != nil {
+				t.Fatalf("SetDocumentTheme: %v", err)
+			}
+			if themeXML := readTempFile(t, u, tt.part); !strings.Contains(themeXML, `name="Brand"`) {
+				t.Errorf("expected theme written to %s, got: %s", tt.part, themeXML)
+			}
+			if _, err := os.Stat(filepath.Join(u.TempDir(), "word", "theme", "theme1.xml")); !os.IsNotExist(err) {
+				t.Errorf("expected no word/theme/theme1.xml, stat err = %v", err)
+			}
+			if got := readTempFile(t, u, "word/_rels/document.xml.rels"); strings.Count(got, "/relationships/theme\"") != 1 {
+				t.Errorf("expected a single theme relationship, got: %s", got)
+			}
+			if ct := readTempFile(t, u, "[Content_Types].xml"); !strings.Contains(ct, `PartName="/`+tt.part+`"`) {
+				t.Errorf("expected content type override for /%s, got: %s", tt.part, ct)
+			}
+
+			got, err := u.GetDocumentTheme()
+			if err != nil || got == nil || got.Name != "Brand" {
+				t.Errorf("GetDocumentTheme() = %+v, %v; want theme Brand", got, err)
+			}
+		})
+	}
+}
+
+func TestGetDocumentTheme_NoTheme(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	theme, err := u.GetDocumentTheme()
+	if err != nil {
+		t.Fatalf("GetDocumentTheme: %v", err)
+	}
+	if theme != nil {
+		t.Errorf("expected nil theme, got %+v", theme)
+	}
+}
+
+func TestDocumentTheme_ReadErrorIsFileRead(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+	// A directory in place of the theme part makes reading it fail.
+	if err := os.MkdirAll(filepath.Join(u.TempDir(), "word", "theme", "theme1.xml"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	_, getErr := u.GetDocumentTheme()
+	setErr := u.SetDocumentTheme(ThemeDefinition{Colors: testThemeColors})
+	fontsErr := u.SetThemeFonts(ThemeFontOptions{MajorFontLatin: "Georgia"})
+	for name, err := range map[string]error{
+		"GetDocumentTheme": getErr,
+		"SetDocumentTheme": setErr,
+		"SetThemeFonts":    fontsErr,
+	} {
+		var docxErr *DocxError
+		if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeInvalidFile {
+			t.Errorf("%s: expected a file read DocxError, got %v", name, err)
+		}
+	}
+}
+
+func TestSetDocumentTheme_Validation(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	colors := testThemeColors
+	colors.Accent3 = ""
+	if err := u.SetDocumentTheme(ThemeDefinition{Colors: colors}); err == nil {
+		t.Error("expected error for missing color")
+	}
+	colors.Accent3 = "green"
+	if err := u.SetDocumentTheme(ThemeDefinition{Colors: colors}); err == nil {
+		t.Error("expected error for invalid color")
+	}
+}
+
+func TestSetThemeFonts(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	// Without a theme part, an Office theme is created.
+	if err := u.SetThemeFonts(ThemeFontOptions{MajorFontLatin: "Georgia", MinorFontLatin: "Segoe UI", MinorFontEA: "Yu Gothic"}); err != nil {
+		t.Fatalf("SetThemeFonts: %v", err)
+	}
+	themeXML := readTempFile(t, u, "word/theme/theme1.xml")
+	for _, want := range []string{
+		`<a:majorFont><a:latin typeface="Georgia"/><a:ea typeface=""/><a:cs typeface=""/></a:majorFont>`,
+		`<a:minorFont><a:latin typeface="Segoe UI"/><a:ea typeface="Yu Gothic"/><a:cs typeface=""/></a:minorFont>`,
+		`<a:accent1><a:srgbClr val="4472C4"/></a:accent1>`,
+	} {
+		if !strings.Contains(themeXML, want) {
+			t.Errorf("expected %s in theme, got: %s", want, themeXML)
+		}
+	}
+	if rels := readTempFile(t, u, "word/_rels/document.xml.rels"); !strings.Contains(rels, `Target="theme/theme1.xml"`) {
+		t.Errorf("expected theme relationship, got: %s", rels)
+	}
+
+	// An existing theme keeps its other fonts and script font entries.
+	existing := strings.Replace(themeXML, `<a:cs typeface=""/></a:majorFont>`,
+		`<a:cs typeface=""/><a:font script="Jpan" typeface="MS Gothic"/></a:majorFont>`, 1)
+	existing = strings.Replace(existing, `<a:latin typeface="Georgia"/>`, `<a:latin typeface="Georgia" panose="02040502050405020303"/>`, 1)
+	if err := os.WriteFile(filepath.Join(u.TempDir(), "word", "theme", "theme1.xml"), []byte(existing), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.SetThemeFonts(ThemeFontOptions{MajorFontCS: "Arial"}); err != nil {
+		t.Fatalf("SetThemeFonts: %v", err)
+	}
+	themeXML = readTempFile(t, u, "word/theme/theme1.xml")
+	want := `<a:majorFont><a:latin typeface="Georgia" panose="02040502050405020303"/><a:ea typeface=""/><a:cs typeface="Arial"/>` +
+		`<a:font script="Jpan" typeface="MS Gothic"/></a:majorFont>`
+	if !strings.Contains(themeXML, want) {
+		t.Errorf("expected %s in theme, got: %s", want, themeXML)
+	}
+
+	if err := u.SetThemeFonts(ThemeFontOptions{}); err == nil {
+		t.Error("expected error for empty options")
+	}
+}
+
+func TestThemeFontRef(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	if err := u.InsertParagraph(ParagraphOptions{
+		Runs:     []RunOptions{{Text: "Themed", ThemeFont: ThemeMajor}},
+		Position: PositionEnd,
+	}); err != nil {
+		t.Fatalf("InsertParagraph: %v", err)
+	}
+	want := `<w:rFonts w:asciiTheme="majorHAnsi" w:eastAsiaTheme="majorEastAsia" w:hAnsiTheme="majorHAnsi" w:cstheme="majorBidi"/>`
+	if docXML := readDocXML(t, u); !strings.Contains(docXML, want) {
+		t.Errorf("expected %s in document, got: %s", want, docXML)
+	}
+
+	if err := u.AddStyle(StyleDefinition{ID: "BodyText2", FontFamily: "Calibri", ThemeFont: ThemeMinor}); err != nil {
+		t.Fatalf("AddStyle: %v", err)
+	}
+	want = `<w:rFonts w:ascii="Calibri" w:hAnsi="Calibri" w:cs="Calibri" w:asciiTheme="minorHAnsi"`
+	if styles := readTempFile(t, u, "word/styles.xml"); !strings.Contains(styles, want) {
+		t.Errorf("expected %s in styles, got: %s", want, styles)
+	}
+
+	if err := u.InsertParagraph(ParagraphOptions{Runs: []RunOptions{{Text: "x", ThemeFont: "heading"}}}); err == nil {
+		t.Error("expected error for invalid theme font")
+	}
+	if err := u.AddStyle(StyleDefinition{ID: "Bad", ThemeFont: "body"}); err == nil {
+		t.Error("expected error for invalid theme font")
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 // TOCOptions defines options for Table of Contents
@@ -21,8 +25,48 @@ type TOCOptions struct {
 
 	// Anchor text for position-based insertion
 	Anchor string
+
+	// EntryStyles adds paragraphs of custom styles to the TOC at the given
+	// levels (\t switch), in addition to the outline levels.
+	EntryStyles []TOCEntryStyle
+
+	// TabLeader fills the space between entry text and page number.
+	// When set, the TOC1-TOC9 entry styles are written with a matching
+	// right-aligned tab stop (default: Word's dotted leader).
+	TabLeader TOCTabLeader
+
+	// PageNumberAlignment places page numbers at the right margin or
+	// directly after the entry text (default: TOCPageAlignRight).
+	PageNumberAlignment TOCPageAlign
+}
+
+// TOCEntryStyle maps a paragraph style to a TOC level.
+type TOCEntryStyle struct {
+	// Level is the TOC level (1-9); entries at level n use style "TOCn".
+	Level int
+
+	// StyleName is the name of the paragraph style to collect.
+	StyleName string
 }
 
+// TOCTabLeader defines the leader between TOC entry text and page number.
+type TOCTabLeader string
+
+const (
+	TOCTabLeaderDots      TOCTabLeader = "dots"
+	TOCTabLeaderDashes    TOCTabLeader = "dashes"
+	TOCTabLeaderUnderline TOCTabLeader = "underline"
+	TOCTabLeaderNone      TOCTabLeader = "none"
+)
+
+// TOCPageAlign defines where TOC page numbers are placed.
+type TOCPageAlign string
+
+const (
+	TOCPageAlignRight TOCPageAlign = "right"
+	TOCPageAlignLeft  TOCPageAlign = "left"
+)
+
 // DefaultTOCOptions returns default TOC options
 func DefaultTOCOptions() TOCOptions {
 	return TOCOptions{
@@ -37,19 +81,22 @@ func DefaultTOCOptions() TOCOptions {
 // is opened in Word and the user updates the field (Ctrl+A, F9).
 func (u *Updater) InsertTOC(opts TOCOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	if opts.OutlineLevels == "" {
 		opts.OutlineLevels = "1-3"
 	}
+	if err := validateTOCOptions(opts); err != nil {
+		return err
+	}
 
 	tocXML := generateTOCXML(opts)
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	updated, err := insertTOCAtPosition(raw, tocXML, opts)
@@ -57,30 +104,324 @@ func (u *Updater) InsertTOC(opts TOCOptions) error {
 		return fmt.Errorf("insert TOC: %w", err)
 	}
 
-	if err := os.WriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+	var stylesXML []byte
+	var newStylesPart bool
+	if opts.TabLeader != "" || opts.PageNumberAlignment != "" {
+		defs := generateTOCStyleDefinitions(opts, bodyTextWidth(raw))
+		if stylesXML, newStylesPart, err = u.tocStylesXML(defs); err != nil {
+			return fmt.Errorf("write TOC styles: %w", err)
+		}
+	}
+
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+
+	if stylesXML != nil {
+		if err := u.writeTOCStyles(stylesXML, newStylesPart); err != nil {
+			// Put the document back so it does not refer to missing styles
+			_ = atomicWriteFile(docPath, raw, 0o644)
+			return fmt.Errorf("write TOC styles: %w", err)
+		}
 	}
 
 	return nil
 }
 
-// generateTOCXML creates the XML for a Table of Contents field.
-// The output includes an optional title paragraph followed by the TOC field paragraph.
-func generateTOCXML(opts TOCOptions) []byte {
-	var buf bytes.Buffer
+// validateTOCOptions checks the TOC entry styles, leader and alignment.
+func validateTOCOptions(opts TOCOptions) error {
+	for i, es := range opts.EntryStyles {
+		if es.Level < 1 || es.Level > 9 {
+			return NewValidationError(fmt.Sprintf("EntryStyles[%d].Level", i), "TOC level must be between 1 and 9")
+		}
+		if strings.TrimSpace(es.StyleName) == "" {
+			return NewValidationError(fmt.Sprintf("EntryStyles[%d].StyleName", i), "style name cannot be empty")
+		}
+		if strings.ContainsAny(es.StyleName, `,"`) {
+			return NewValidationError(fmt.Sprintf("EntryStyles[%d].StyleName", i), "style name cannot contain commas or quotes")
+		}
+	}
+	switch opts.TabLeader {
+	case "", TOCTabLeaderDots, TOCTabLeaderDashes, TOCTabLeaderUnderline, TOCTabLeaderNone:
+	default:
+		return NewValidationError("TabLeader", fmt.Sprintf("unsupported TOC tab leader %q", opts.TabLeader))
+	}
+	switch opts.PageNumberAlignment {
+	case "", TOCPageAlignRight:
+	case TOCPageAlignLeft:
+		if opts.TabLeader != "" && opts.TabLeader != TOCTabLeaderNone {
+			return NewValidationError("TabLeader", "tab leader requires right-aligned page numbers")
+		}
+	default:
+		return NewValidationError("PageNumberAlignment", fmt.Sprintf("unsupported page number alignment %q", opts.PageNumberAlignment))
+	}
+	return nil
+}
 
-	// Title paragraph comes BEFORE the TOC field
-	if opts.Title != "" {
-		buf.Write(generateTOCTitleXML(opts.Title))
+// InsertTableOfContents is an alias for InsertTOC.
+func (u *Updater) InsertTableOfContents(opts TOCOptions) error {
+	return u.InsertTOC(opts)
+}
+
+// TOFOptions defines options for a table of figures.
+type TOFOptions struct {
+	// Title for the list (no title paragraph when empty)
+	Title string
+
+	// Position where to insert the list
+	Position InsertPosition
+
+	// Anchor text for position-based insertion
+	Anchor string
+}
+
+// TOTOptions defines options for a table of tables.
+type TOTOptions = TOFOptions
+
+// InsertTableOfFigures inserts a list of all figures, built from the
+// "Figure" captions added with AddCaption or InsertImage. Like InsertTOC it
+// is populated when the user updates fields in Word.
+func (u *Updater) InsertTableOfFigures(opts TOFOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	return u.insertCaptionTOC(CaptionFigure, opts)
+}
+
+// InsertTableOfTables inserts a list of all tables, built from the "Table"
+// captions. Like InsertTOC it is populated when the user updates fields.
+func (u *Updater) InsertTableOfTables(opts TOTOptions) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	return u.insertCaptionTOC(CaptionTable, opts)
+}
+
+// insertCaptionTOC inserts a TOC field listing the captions of one type.
+func (u *Updater) insertCaptionTOC(captionType CaptionType, opts TOFOptions) error {
+	// \c "Figure" - list the paragraphs numbered with the Figure SEQ field
+	fieldInstr := fmt.Sprintf(` TOC \h \z \c "%s" `, captionType)
+	placeholder := fmt.Sprintf("Update this field to show Table of %ss", captionType)
+	tofXML := generateTOCFieldXML(opts.Title, fieldInstr, placeholder)
+
+	docPath := filepath.Join(u.tempDir, "word", "document.xml")
+	raw, err := os.ReadFile(docPath)
+	if err != nil {
+		return NewFileReadError("document.xml", err)
+	}
+
+	updated, err := insertTOCAtPosition(raw, tofXML, TOCOptions{Position: opts.Position, Anchor: opts.Anchor})
+	if err != nil {
+		return fmt.Errorf("insert table of %ss: %w", strings.ToLower(string(captionType)), err)
 	}
 
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
+	}
+
+	return nil
+}
+
+// generateTOCXML creates the XML for a Table of Contents field.
+// The output includes an optional title paragraph followed by the TOC field paragraph.
+func generateTOCXML(opts TOCOptions) []byte {
 	// Build the TOC field instruction.
 	// Word field switches use single backslashes:
 	//   \o "1-3" - include outline levels 1-3
 	//   \h       - make entries hyperlinks
 	//   \z       - hide tab leaders in Web Layout view
 	//   \u       - use applied paragraph outline level
+	//   \t "..." - also include paragraphs of custom styles at given levels
+	//   \p " "   - separate entry and page number with a space instead of a tab
 	fieldInstr := fmt.Sprintf(` TOC \o "%s" \h \z \u `, opts.OutlineLevels)
+	if len(opts.EntryStyles) > 0 {
+		pairs := make([]string, 0, len(opts.EntryStyles))
+		for _, es := range opts.EntryStyles {
+			pairs = append(pairs, fmt.Sprintf("%s,%d", es.StyleName, es.Level))
+		}
+		fieldInstr += fmt.Sprintf(`\t "%s" `, strings.Join(pairs, ","))
+	}
+	if opts.PageNumberAlignment == TOCPageAlignLeft {
+		fieldInstr += `\p " " `
+	}
+
+	return generateTOCFieldXML(opts.Title, fieldInstr, "Update this field to show Table of Contents")
+}
+
+// defaultTOCTextWidth is the text width of a Letter page with 1" margins.
+const defaultTOCTextWidth = 9360
+
+var (
+	sectPgSzWidthPattern  = regexp.MustCompile(`<w:pgSz\b[^>]*\bw:w="(\d+)"`)
+	sectPgMarLeftPattern  = regexp.MustCompile(`<w:pgMar\b[^>]*\bw:left="(\d+)"`)
+	sectPgMarRightPattern = regexp.MustCompile(`<w:pgMar\b[^>]*\bw:right="(\d+)"`)
+)
+
+// bodyTextWidth returns the width between the margins of the document's
+// final section in twips, falling back to defaultTOCTextWidth.
+func bodyTextWidth(docXML []byte) int {
+	idx := bytes.LastIndex(docXML, []byte("<w:sectPr"))
+	if idx == -1 {
+		return defaultTOCTextWidth
+	}
+	sectPr := docXML[idx:]
+	attr := func(re *regexp.Regexp) int {
+		m := re.FindSubmatch(sectPr)
+		if m == nil {
+			return -1
+		}
+		n, _ := strconv.Atoi(string(m[1]))
+		return n
+	}
+	width, left, right := attr(sectPgSzWidthPattern), attr(sectPgMarLeftPattern), attr(sectPgMarRightPattern)
+	if width <= 0 || left < 0 || right < 0 || width-left-right <= 0 {
+		return defaultTOCTextWidth
+	}
+	return width - left - right
+}
+
+// generateTOCStyleDefinitions returns the TOC1..TOCn entry styles for the
+// deepest level the TOC can show, indented like Word's built-in styles and
+// with a right tab stop carrying the page number leader.
+func generateTOCStyleDefinitions(opts TOCOptions, textWidth int) []StyleDefinition {
+	maxLevel := 9
+	var from, to int
+	if n, _ := fmt.Sscanf(opts.OutlineLevels, "%d-%d", &from, &to); n == 2 && to >= 1 && to <= 9 {
+		maxLevel = to
+	}
+	for _, es := range opts.EntryStyles {
+		maxLevel = max(maxLevel, es.Level)
+	}
+
+	leader := TabLeaderDot
+	switch opts.TabLeader {
+	case TOCTabLeaderDashes:
+		leader = TabLeaderDash
+	case TOCTabLeaderUnderline:
+		leader = TabLeaderUnderscore
+	case TOCTabLeaderNone:
+		leader = TabLeaderNone
+	}
+
+	defs := make([]StyleDefinition, 0, maxLevel)
+	for level := 1; level <= maxLevel; level++ {
+		def := StyleDefinition{
+			ID:         fmt.Sprintf("TOC%d", level),
+			Name:       fmt.Sprintf("toc %d", level),
+			Type:       StyleTypeParagraph,
+			BasedOn:    "Normal",
+			NextStyle:  "Normal",
+			SpaceAfter: 100,
+			IndentLeft: (level - 1) * 220,
+		}
+		if opts.PageNumberAlignment != TOCPageAlignLeft {
+			def.TabStops = []TabStop{{Position: textWidth, Alignment: TabAlignRight, Leader: leader}}
+		}
+		defs = append(defs, def)
+	}
+	return defs
+}
+
+// tocStylesXML returns styles.xml with the TOC entry styles in defs. Missing
+// styles are added whole; existing ones only get the page number tab of their
+// definition merged in, keeping the template's fonts, indents and spacing.
+// The bool reports whether styles.xml does not exist yet.
+func (u *Updater) tocStylesXML(defs []StyleDefinition) ([]byte, bool, error) {
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "styles.xml"))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, false, NewFileReadError("styles.xml", err)
+		}
+		var styles bytes.Buffer
+		for i, def := range defs {
+			if i > 0 {
+				styles.WriteString("\n")
+			}
+			styles.Write(generateStyleXML(def))
+		}
+		return generateStylesDocument(styles.Bytes()), true, nil
+	}
+
+	updated := raw
+	for _, def := range defs {
+		existing := findStyleBlock(string(updated), def.ID)
+		if existing == "" {
+			if updated, err = injectStyle(updated, generateStyleXML(def)); err != nil {
+				return nil, false, err
+			}
+			continue
+		}
+		merged, err := mergeTOCTabStops(existing, def.TabStops)
+		if err != nil {
+			return nil, false, err
+		}
+		updated = bytes.Replace(updated, []byte(existing), []byte(merged), 1)
+	}
+	return updated, false, nil
+}
+
+// mergeTOCTabStops replaces the right-aligned tab stops of an existing style
+// definition, which carry the page numbers, with tabs. Its other properties
+// are left untouched.
+func mergeTOCTabStops(block string, tabs []TabStop) (string, error) {
+	openTag := styleOpenTagPattern.FindString(block)
+	if openTag == "" || !strings.HasSuffix(block, "</w:style>") {
+		return "", NewMalformedXMLError("malformed TOC style definition")
+	}
+
+	children := splitXMLChildren([]byte(styleBody(block)))
+	var pPr, tabStops []xmlChild
+	for _, c := range children {
+		if c.name == "w:pPr" {
+			pPr = elementChildren(c)
+		}
+	}
+	for _, c := range pPr {
+		if c.name == "w:tabs" {
+			tabStops = elementChildren(c)
+		}
+	}
+	tabStops = slices.DeleteFunc(tabStops, func(c xmlChild) bool {
+		m := styleTabValPattern.FindSubmatch(c.xml)
+		return m != nil && string(m[1]) == string(TabAlignRight)
+	})
+	if tabsXML := generateTabsXML(tabs); tabsXML != "" {
+		tabStops = append(tabStops, elementChildren(splitXMLChildren([]byte(tabsXML))[0])...)
+	}
+
+	pPr = upsertOrderedChild(pPr, "w:tabs", wrapProperties("w:tabs", tabStops), pPrChildOrder)
+	children = upsertOrderedChild(children, "w:pPr", wrapProperties("w:pPr", pPr), styleChildOrder)
+
+	var buf strings.Builder
+	buf.WriteString(openTag)
+	for _, c := range children {
+		buf.Write(c.xml)
+	}
+	buf.WriteString("</w:style>")
+	return buf.String(), nil
+}
+
+// writeTOCStyles writes styles.xml, registering the part when it is new.
+func (u *Updater) writeTOCStyles(stylesXML []byte, newPart bool) error {
+	if err := atomicWriteFile(filepath.Join(u.tempDir, "word", "styles.xml"), stylesXML, 0o644); err != nil {
+		return NewXMLWriteError("styles.xml", err)
+	}
+	if newPart {
+		return u.ensureStylesRelationship()
+	}
+	return nil
+}
+
+// generateTOCFieldXML creates an optional title paragraph followed by a
+// paragraph holding a TOC-type field with the given instruction and
+// placeholder result text.
+func generateTOCFieldXML(title, fieldInstr, placeholder string) []byte {
+	var buf bytes.Buffer
+
+	// Title paragraph comes BEFORE the TOC field
+	if title != "" {
+		buf.Write(generateTOCTitleXML(title))
+	}
 
 	// TOC field paragraph
 	buf.WriteString("<w:p>")
@@ -93,7 +434,7 @@ func generateTOCXML(opts TOCOptions) []byte {
 
 	// Field instruction
 	buf.WriteString("<w:r>")
-	buf.WriteString(fmt.Sprintf(`<w:instrText xml:space="preserve">%s</w:instrText>`, xmlEscape(fieldInstr)))
+	buf.WriteString(fmt.Sprintf(`<w:instrText xml:space="preserve">%s</w:instrText>`, xmlEscapeContent(fieldInstr)))
 	buf.WriteString("</w:r>")
 
 	// Field separate (marks end of instruction, start of result)
@@ -104,7 +445,7 @@ func generateTOCXML(opts TOCOptions) []byte {
 	// Placeholder result text
 	buf.WriteString("<w:r>")
 	buf.WriteString("<w:rPr><w:i/></w:rPr>")
-	buf.WriteString("<w:t>Update this field to show Table of Contents</w:t>")
+	buf.WriteString(fmt.Sprintf("<w:t>%s</w:t>", xmlEscapeContent(placeholder)))
 	buf.WriteString("</w:r>")
 
 	// Field end
@@ -131,7 +472,7 @@ func generateTOCTitleXML(title string) []byte {
 	buf.WriteString("<w:b/>")
 	buf.WriteString(`<w:sz w:val="44"/>`)
 	buf.WriteString("</w:rPr>")
-	buf.WriteString(fmt.Sprintf(`<w:t xml:space="preserve">%s</w:t>`, xmlEscape(title)))
+	buf.WriteString(fmt.Sprintf(`<w:t xml:space="preserve">%s</w:t>`, xmlEscapeContent(title)))
 	buf.WriteString("</w:r>")
 	buf.WriteString("</w:p>")
 
@@ -152,7 +493,7 @@ func insertTOCAtPosition(docXML, tocXML []byte, opts TOCOptions) ([]byte, error)
 	case PositionEnd:
 		bodyEnd := bytes.Index(docXML, []byte("</w:body>"))
 		if bodyEnd == -1 {
-			return nil, fmt.Errorf("could not find </w:body> tag")
+			return nil, NewMalformedXMLError("could not find </w:body> tag")
 		}
 		if sectPrPos := bytes.LastIndex(docXML[:bodyEnd], []byte("<w:sectPr")); sectPrPos != -1 {
 			insertPos = sectPrPos
@@ -161,7 +502,7 @@ func insertTOCAtPosition(docXML, tocXML []byte, opts TOCOptions) ([]byte, error)
 		}
 	case PositionAfterText:
 		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionAfterText")
+			return nil, NewValidationError("anchor", "anchor text required for PositionAfterText")
 		}
 		_, insertPos, err = findParagraphRangeByAnchor(docXML, opts.Anchor)
 		if err != nil {
@@ -169,14 +510,21 @@ func insertTOCAtPosition(docXML, tocXML []byte, opts TOCOptions) ([]byte, error)
 		}
 	case PositionBeforeText:
 		if opts.Anchor == "" {
-			return nil, fmt.Errorf("anchor text required for PositionBeforeText")
+			return nil, NewValidationError("anchor", "anchor text required for PositionBeforeText")
 		}
 		insertPos, _, err = findParagraphRangeByAnchor(docXML, opts.Anchor)
 		if err != nil {
 			return nil, err
 		}
 	default:
-		return nil, fmt.Errorf("invalid insert position")
+		n, ok := opts.Position.paragraphIndex()
+		if !ok {
+			return nil, NewValidationError("position", "invalid insert position")
+		}
+		insertPos, err = paragraphIndexOffset(docXML, n)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	result := make([]byte, 0, len(docXML)+len(tocXML))
@@ -187,78 +535,169 @@ func insertTOCAtPosition(docXML, tocXML []byte, opts TOCOptions) ([]byte, error)
 	return result, nil
 }
 
-// UpdateTOC marks an existing Table of Contents for update.
-// When the document is opened in Word, it will prompt the user to
-// update the TOC field to reflect the current document headings.
+// UpdateTOC marks the tables of contents, lists of figures and tables (TOC
+// fields with \c) and indexes (INDEX fields) of the document for update.
+// When the document is opened in Word, it will prompt the user to update
+// the fields to reflect the current headings, captions and index entries.
 func (u *Updater) UpdateTOC() error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	updated := markTOCForUpdate(raw)
 
-	if err := os.WriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+	if err := atomicWriteFile(docPath, updated, 0o644); err != nil {
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
 }
 
-// markTOCForUpdate finds the TOC field's begin fldChar and adds the
-// w:dirty="true" attribute, which tells Word to recalculate the TOC
-// when the document is opened.
-func markTOCForUpdate(docXML []byte) []byte {
-	// Find instrText containing a TOC field code
-	tocIdx := bytes.Index(docXML, []byte(">TOC"))
-	if tocIdx == -1 {
-		tocIdx = bytes.Index(docXML, []byte("> TOC"))
-	}
-	if tocIdx == -1 {
-		return docXML
+// UpdateAllFields marks every field of the document body, headers, footers,
+// footnotes and endnotes for update, so that Word recalculates page
+// references, cross-references and computed fields when the document is
+// opened. Use it before saving a final document.
+func (u *Updater) UpdateAllFields() error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
 	}
 
-	// Find the nearest fldChar begin before the TOC instrText
-	searchArea := docXML[:tocIdx]
-	oldBegin := []byte(`w:fldCharType="begin"/>`)
-	beginIdx := bytes.LastIndex(searchArea, oldBegin)
-	if beginIdx == -1 {
-		return docXML
+	parts := []string{filepath.Join(u.tempDir, "word", "document.xml")}
+	for _, pattern := range []string{"header*.xml", "footer*.xml", "footnotes.xml", "endnotes.xml"} {
+		matches, _ := filepath.Glob(filepath.Join(u.tempDir, "word", pattern))
+		parts = append(parts, matches...)
 	}
 
-	// Check if dirty attribute already present
-	region := docXML[beginIdx:tocIdx]
-	if bytes.Contains(region, []byte("w:dirty")) {
-		return docXML
+	for _, partPath := range parts {
+		name := filepath.Base(partPath)
+		raw, err := os.ReadFile(partPath)
+		if err != nil {
+			return NewFileReadError(name, err)
+		}
+		updated := markFieldsForUpdate(raw, func(string) bool { return true })
+		if bytes.Equal(updated, raw) {
+			continue
+		}
+		if err := atomicWriteFile(partPath, updated, 0o644); err != nil {
+			return NewXMLWriteError(name, err)
+		}
 	}
+	return nil
+}
 
-	// Replace begin fldChar with dirty version
-	newBegin := []byte(`w:fldCharType="begin" w:dirty="true"/>`)
+// markTOCForUpdate marks every TOC field (tables of contents, figures and
+// tables alike) and INDEX field for update.
+func markTOCForUpdate(docXML []byte) []byte {
+	return markFieldsForUpdate(docXML, func(instr string) bool {
+		name, _, _ := strings.Cut(strings.TrimSpace(instr), " ")
+		return strings.EqualFold(name, "TOC") || strings.EqualFold(name, "INDEX")
+	})
+}
 
-	result := make([]byte, 0, len(docXML)+20)
-	result = append(result, docXML[:beginIdx]...)
-	result = append(result, newBegin...)
-	result = append(result, docXML[beginIdx+len(oldBegin):]...)
+// markFieldsForUpdate adds the w:dirty="true" attribute, which tells Word to
+// recalculate the field when the document is opened, to the begin fldChar of
+// every complex field and to every simple field whose instruction satisfies
+// match.
+func markFieldsForUpdate(docXML []byte, match func(instr string) bool) []byte {
+	type openField struct {
+		begin      []int // start and end of the begin fldChar tag
+		instr      strings.Builder
+		collecting bool
+	}
+	var dirty [][]int // tags to mark, in document order
+	var open []*openField
+	decide := func(f *openField) {
+		if match(f.instr.String()) {
+			dirty = append(dirty, f.begin)
+		}
+	}
 
-	return result
+	for _, m := range fieldTokenPattern.FindAllSubmatchIndex(docXML, -1) {
+		kind := ""
+		if m[2] != -1 {
+			kind = string(docXML[m[2]:m[3]])
+		}
+		switch {
+		case kind == "begin":
+			end := bytes.IndexByte(docXML[m[0]:], '>')
+			if end == -1 {
+				continue
+			}
+			open = append(open, &openField{begin: []int{m[0], m[0] + end + 1}, collecting: true})
+		case kind == "separate":
+			if n := len(open); n > 0 && open[n-1].collecting {
+				open[n-1].collecting = false
+				decide(open[n-1])
+			}
+		case kind == "end":
+			if n := len(open); n > 0 {
+				if open[n-1].collecting {
+					decide(open[n-1])
+				}
+				open = open[:n-1]
+			}
+		case m[6] != -1:
+			if match(xmlUnescape(string(docXML[m[6]:m[7]]))) {
+				end := bytes.IndexByte(docXML[m[0]:], '>')
+				if end != -1 {
+					dirty = append(dirty, []int{m[0], m[0] + end + 1})
+				}
+			}
+		default:
+			if n := len(open); n > 0 && open[n-1].collecting {
+				open[n-1].instr.WriteString(xmlUnescape(string(docXML[m[4]:m[5]])))
+			}
+		}
+	}
+	// Fields left open at the end of the part are decided on what was read.
+	for _, f := range open {
+		if f.collecting {
+			decide(f)
+		}
+	}
+	if len(dirty) == 0 {
+		return docXML
+	}
+	slices.SortFunc(dirty, func(a, b []int) int { return a[0] - b[0] })
+
+	var buf bytes.Buffer
+	buf.Grow(len(docXML) + len(dirty)*len(` w:dirty="true"`))
+	last := 0
+	for _, tag := range dirty {
+		elem := docXML[tag[0]:tag[1]]
+		if bytes.Contains(elem, []byte("w:dirty=")) {
+			continue
+		}
+		closeAt := tag[1] - 1
+		if elem[len(elem)-2] == '/' {
+			closeAt--
+		}
+		buf.Write(docXML[last:closeAt])
+		buf.WriteString(` w:dirty="true"`)
+		last = closeAt
+	}
+	buf.Write(docXML[last:])
+	return buf.Bytes()
 }
 
-// GetTOCEntries extracts TOC entries from the document.
-// Returns a slice of TOCEntry with level and text.
+// GetTOCEntries extracts the entries of a populated TOC from the document:
+// their level, text and, when the entry ends in a tab followed by a number
+// as Word writes it, their page number.
 func (u *Updater) GetTOCEntries() ([]TOCEntry, error) {
 	if u == nil {
-		return nil, fmt.Errorf("updater is nil")
+		return nil, NewValidationError("updater", "updater is nil")
 	}
 
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return nil, fmt.Errorf("read document.xml: %w", err)
+		return nil, NewFileReadError("document.xml", err)
 	}
 
 	return parseTOCEntries(raw), nil
@@ -267,8 +706,12 @@ func (u *Updater) GetTOCEntries() ([]TOCEntry, error) {
 // TOCEntry represents an entry in the Table of Contents
 type TOCEntry struct {
 	Level int    // Heading level (1-9)
-	Text  string // Entry text
-	Page  int    // Page number (if available)
+	Text  string // Entry text, without the page number
+	Page  int    // Page number (0 if the entry has none)
+
+	// FieldResult is the text of the entry as displayed, with tabs kept as
+	// "\t", e.g. "1.2\tScope\t4".
+	FieldResult string
 }
 
 // parseTOCEntries extracts TOC entries from document XML by looking
@@ -307,12 +750,9 @@ func parseTOCEntries(docXML []byte) []TOCEntry {
 		}
 
 		if level > 0 {
-			text := extractParagraphPlainText(paraXML)
-			if text != "" {
-				entries = append(entries, TOCEntry{
-					Level: level,
-					Text:  text,
-				})
+			if entry, ok := parseTOCEntry(paraXML); ok {
+				entry.Level = level
+				entries = append(entries, entry)
 			}
 		}
 
@@ -321,3 +761,27 @@ func parseTOCEntries(docXML []byte) []TOCEntry {
 
 	return entries
 }
+
+// parseTOCEntry extracts the text and page number of a TOC paragraph. The page
+// number is the number after the last tab, which Word writes as the result of
+// a PAGEREF field.
+func parseTOCEntry(paraXML []byte) (TOCEntry, bool) {
+	// Skip the paragraph properties so tab stop definitions are not read as tabs.
+	if end := bytes.Index(paraXML, []byte("</w:pPr>")); end != -1 {
+		paraXML = paraXML[end+len("</w:pPr>"):]
+	}
+	result := extractParagraphText(paraXML, '\t')
+	if strings.TrimSpace(result) == "" {
+		return TOCEntry{}, false
+	}
+
+	entry := TOCEntry{Text: result, FieldResult: result}
+	if i := strings.LastIndexByte(result, '\t'); i != -1 {
+		if page, err := strconv.Atoi(strings.TrimSpace(result[i+1:])); err == nil {
+			entry.Text = result[:i]
+			entry.Page = page
+		}
+	}
+	entry.Text = strings.TrimSpace(strings.ReplaceAll(entry.Text, "\t", " "))
+	return entry, true
+}
@@ -2,6 +2,10 @@ package godocx
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -123,6 +127,231 @@ func TestMarkTOCForUpdate_AlreadyDirty(t *testing.T) {
 	}
 }
 
+func TestMarkTOCForUpdate_AllTOCFields(t *testing.T) {
+	field := func(instr string) string {
+		return `<w:p><w:r><w:fldChar w:fldCharType="begin"/></w:r>` +
+			`<w:r><w:instrText xml:space="preserve">` + instr + `</w:instrText></w:r>` +
+			`<w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>`
+	}
+	docXML := []byte(`<w:body>` + field(` TOC \o "1-3" `) + field(` PAGE `) +
+		field(` TOC \h \z \c "Figure" `) + field(`TOC \c "Table"`) + `</w:body>`)
+
+	result := markTOCForUpdate(docXML)
+
+	if count := bytes.Count(result, []byte(`fldCharType="begin" w:dirty="true"`)); count != 3 {
+		t.Errorf("expected 3 dirty TOC fields, got %d", count)
+	}
+	if !bytes.Contains(result, []byte(`<w:fldChar w:fldCharType="begin"/></w:r><w:r><w:instrText xml:space="preserve"> PAGE `)) {
+		t.Error("non-TOC field should not be marked dirty")
+	}
+}
+
+func TestMarkTOCForUpdate_IndexAndSplitInstructions(t *testing.T) {
+	docXML := []byte(`<w:body>` +
+		// An INDEX field whose instruction Word split over two runs
+		`<w:p><w:r><w:fldChar w:fldCharType="begin"/></w:r>` +
+		`<w:r><w:instrText xml:space="preserve"> IND</w:instrText></w:r><w:r><w:instrText xml:space="preserve">EX \c "2" </w:instrText></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="separate"/></w:r><w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>` +
+		// An XE field must not be mistaken for an INDEX field
+		`<w:p><w:r><w:fldChar w:fldCharType="begin"/></w:r><w:r><w:instrText> XE "INDEX" </w:instrText></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>` +
+		`<w:p><w:fldSimple w:instr=" TOC \c &quot;Table&quot; "><w:r><w:t>x</w:t></w:r></w:fldSimple></w:p>` +
+		`</w:body>`)
+
+	result := string(markTOCForUpdate(docXML))
+
+	if count := strings.Count(result, `w:dirty="true"`); count != 2 {
+		t.Errorf("expected 2 dirty fields, got %d: %s", count, result)
+	}
+	if !strings.HasPrefix(result, `<w:body><w:p><w:r><w:fldChar w:fldCharType="begin" w:dirty="true"/>`) {
+		t.Errorf("expected the INDEX field to be marked: %s", result)
+	}
+	if !strings.Contains(result, `<w:fldSimple w:instr=" TOC \c &quot;Table&quot; " w:dirty="true">`) {
+		t.Errorf("expected the simple TOC field to be marked: %s", result)
+	}
+}
+
+func TestUpdateAllFields(t *testing.T) {
+	body := `<w:p><w:r><w:fldChar w:fldCharType="begin"/></w:r><w:r><w:instrText> PAGEREF _Ref1 \h </w:instrText></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="separate"/></w:r><w:r><w:t>2</w:t></w:r><w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>` +
+		`<w:p><w:fldSimple w:instr=" NUMPAGES "><w:r><w:t>3</w:t></w:r></w:fldSimple></w:p>` +
+		`<w:p><w:r><w:fldChar w:fldCharType="begin" w:dirty="true"/></w:r><w:r><w:instrText> DATE </w:instrText></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+	if err := u.SetFooter(HeaderFooterContent{PageNumber: true}, DefaultFooterOptions()); err != nil {
+		t.Fatalf("SetFooter: %v", err)
+	}
+
+	if err := u.UpdateAllFields(); err != nil {
+		t.Fatalf("UpdateAllFields: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if n := strings.Count(docXML, `w:dirty="true"`); n != 3 {
+		t.Errorf("expected 3 dirty fields in the body, got %d: %s", n, docXML)
+	}
+	footer := readTempFile(t, u, "word/footer3.xml")
+	if !strings.Contains(footer, `w:dirty="true"`) {
+		t.Errorf("expected the footer page field to be marked: %s", footer)
+	}
+}
+
+func TestInsertTableOfFiguresAndTables(t *testing.T) {
+	body := `<w:p><w:r><w:t>Intro</w:t></w:r></w:p><w:sectPr/>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	if err := u.InsertTableOfFigures(TOFOptions{Title: "List of Figures", Position: PositionEnd}); err != nil {
+		t.Fatalf("InsertTableOfFigures: %v", err)
+	}
+	if err := u.InsertTableOfTables(TOTOptions{Position: PositionAfterText, Anchor: "Intro"}); err != nil {
+		t.Fatalf("InsertTableOfTables: %v", err)
+	}
+	if err := u.InsertTableOfContents(TOCOptions{Position: PositionBeginning, OutlineLevels: "1-2"}); err != nil {
+		t.Fatalf("InsertTableOfContents: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	toc := strings.Index(docXML, `TOC \o "1-2"`)
+	intro := strings.Index(docXML, "<w:t>Intro</w:t>")
+	tot := strings.Index(docXML, `TOC \h \z \c "Table"`)
+	title := strings.Index(docXML, "List of Figures")
+	tof := strings.Index(docXML, `TOC \h \z \c "Figure"`)
+	if toc == -1 || intro == -1 || tot == -1 || title == -1 || tof == -1 {
+		t.Fatalf("missing fields in document:\n%s", docXML)
+	}
+	if !(toc < intro && intro < tot && tot < title && title < tof && tof < strings.Index(docXML, "<w:sectPr/>")) {
+		t.Errorf("unexpected field order: toc=%d intro=%d tot=%d title=%d tof=%d", toc, intro, tot, title, tof)
+	}
+	if !strings.Contains(docXML, "Update this field to show Table of Figures") {
+		t.Error("missing figures placeholder text")
+	}
+
+	if err := u.UpdateTOC(); err != nil {
+		t.Fatalf("UpdateTOC: %v", err)
+	}
+	if count := strings.Count(readDocXML(t, u), `w:dirty="true"`); count != 3 {
+		t.Errorf("expected UpdateTOC to mark 3 fields dirty, got %d", count)
+	}
+}
+
+func TestInsertTOC_LeadersAndEntryStyles(t *testing.T) {
+	body := `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>` +
+		`<w:sectPr><w:pgSz w:w="11906" w:h="16838"/><w:pgMar w:top="1440" w:right="1134" w:bottom="1440" w:left="1134"/></w:sectPr>`
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, body))
+
+	err := u.InsertTOC(TOCOptions{
+		OutlineLevels: "1-2",
+		Position:      PositionBeginning,
+		EntryStyles:   []TOCEntryStyle{{Level: 3, StyleName: "Appendix Title"}},
+		TabLeader:     TOCTabLeaderDashes,
+	})
+	if err != nil {
+		t.Fatalf("InsertTOC: %v", err)
+	}
+
+	docXML := readDocXML(t, u)
+	if !strings.Contains(docXML, `TOC \o "1-2" \h \z \u \t "Appendix Title,3" `) {
+		t.Errorf("expected \\t switch in field instruction:\n%s", docXML)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "styles.xml"))
+	if err != nil {
+		t.Fatalf("read styles.xml: %v", err)
+	}
+	styles := string(raw)
+	for level := 1; level <= 3; level++ {
+		block := findStyleBlock(styles, fmt.Sprintf("TOC%d", level))
+		if block == "" {
+			t.Fatalf("missing TOC%d style:\n%s", level, styles)
+		}
+		if !strings.Contains(block, `<w:tab w:val="right" w:leader="hyphen" w:pos="9638"/>`) {
+			t.Errorf("TOC%d should have a dashed right tab at the margin:\n%s", level, block)
+		}
+	}
+	if !strings.Contains(findStyleBlock(styles, "TOC3"), `<w:ind w:left="440"/>`) {
+		t.Errorf("TOC3 should be indented:\n%s", findStyleBlock(styles, "TOC3"))
+	}
+	if findStyleBlock(styles, "TOC4") != "" {
+		t.Error("no style expected beyond the deepest TOC level")
+	}
+
+	// A second TOC replaces the entry styles instead of duplicating them.
+	if err := u.InsertTOC(TOCOptions{OutlineLevels: "1-2", Position: PositionEnd, PageNumberAlignment: TOCPageAlignLeft}); err != nil {
+		t.Fatalf("InsertTOC: %v", err)
+	}
+	if !strings.Contains(readDocXML(t, u), `\p " " `) {
+		t.Error("expected \\p switch for left-aligned page numbers")
+	}
+	raw, err = os.ReadFile(filepath.Join(u.tempDir, "word", "styles.xml"))
+	if err != nil {
+		t.Fatalf("read styles.xml: %v", err)
+	}
+	if count := strings.Count(string(raw), `w:styleId="TOC1"`); count != 1 {
+		t.Errorf("expected one TOC1 style, got %d", count)
+	}
+	if strings.Contains(findStyleBlock(string(raw), "TOC1"), "<w:tabs>") {
+		t.Error("left-aligned page numbers should not use a tab stop")
+	}
+}
+
+func TestInsertTOC_MergesTabIntoExistingStyles(t *testing.T) {
+	docXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` +
+		`<w:p><w:r><w:t>Intro</w:t></w:r></w:p>` +
+		`<w:sectPr><w:pgSz w:w="12240" w:h="15840"/><w:pgMar w:top="1440" w:right="1440" w:bottom="1440" w:left="1440"/></w:sectPr>` +
+		`</w:body></w:document>`
+	toc2 := `<w:style w:type="paragraph" w:styleId="TOC2"><w:name w:val="toc 2"/><w:basedOn w:val="Normal"/>` +
+		`<w:pPr><w:tabs><w:tab w:val="left" w:pos="880"/><w:tab w:val="right" w:leader="dot" w:pos="8000"/></w:tabs>` +
+		`<w:spacing w:after="60"/><w:ind w:left="240"/></w:pPr>` +
+		`<w:rPr><w:rFonts w:ascii="Georgia" w:hAnsi="Georgia"/><w:i/></w:rPr></w:style>`
+	stylesXML := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` + toc2 + `</w:styles>`
+	u := newUpdaterFromFixture(t, buildIntegrationDocxFromParts(t, docXML, stylesXML, ""))
+
+	if err := u.InsertTOC(TOCOptions{OutlineLevels: "1-2", Position: PositionBeginning, TabLeader: TOCTabLeaderDashes}); err != nil {
+		t.Fatalf("InsertTOC: %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "styles.xml"))
+	if err != nil {
+		t.Fatalf("read styles.xml: %v", err)
+	}
+	want := `<w:style w:type="paragraph" w:styleId="TOC2"><w:name w:val="toc 2"/><w:basedOn w:val="Normal"/>` +
+		`<w:pPr><w:tabs><w:tab w:val="left" w:pos="880"/><w:tab w:val="right" w:leader="hyphen" w:pos="9360"/></w:tabs>` +
+		`<w:spacing w:after="60"/><w:ind w:left="240"/></w:pPr>` +
+		`<w:rPr><w:rFonts w:ascii="Georgia" w:hAnsi="Georgia"/><w:i/></w:rPr></w:style>`
+	if got := findStyleBlock(string(raw), "TOC2"); got != want {
+		t.Errorf("existing TOC2 style:\n got %s\nwant %s", got, want)
+	}
+	if !strings.Contains(findStyleBlock(string(raw), "TOC1"), `<w:tab w:val="right" w:leader="hyphen" w:pos="9360"/>`) {
+		t.Errorf("missing TOC1 style should be created:\n%s", raw)
+	}
+}
+
+func TestInsertTOC_InvalidStyleOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts TOCOptions
+	}{
+		{"level out of range", TOCOptions{EntryStyles: []TOCEntryStyle{{Level: 10, StyleName: "Custom"}}}},
+		{"empty style name", TOCOptions{EntryStyles: []TOCEntryStyle{{Level: 1}}}},
+		{"style name with comma", TOCOptions{EntryStyles: []TOCEntryStyle{{Level: 1, StyleName: "A,B"}}}},
+		{"unknown leader", TOCOptions{TabLeader: "stars"}},
+		{"unknown alignment", TOCOptions{PageNumberAlignment: "center"}},
+		{"leader with left alignment", TOCOptions{TabLeader: TOCTabLeaderDots, PageNumberAlignment: TOCPageAlignLeft}},
+	}
+
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var docxErr *DocxError
+			if err := u.InsertTOC(tt.opts); !errors.As(err, &docxErr) || docxErr.Code != ErrCodeValidation {
+				t.Errorf("InsertTOC: got %v, want validation error", err)
+			}
+		})
+	}
+}
+
 func TestParseTOCEntries(t *testing.T) {
 	docXML := []byte(`<w:body>` +
 		`<w:p><w:pPr><w:pStyle w:val="TOC1"/></w:pPr><w:r><w:t>Chapter 1</w:t></w:r></w:p>` +
@@ -148,6 +377,46 @@ func TestParseTOCEntries(t *testing.T) {
 	}
 }
 
+func TestParseTOCEntries_PageNumbers(t *testing.T) {
+	// A TOC as populated by Word: hyperlinked entries whose page number is the
+	// result of a PAGEREF field after a right-aligned tab.
+	entry := func(style, anchor, text, page string, first bool) string {
+		var b strings.Builder
+		b.WriteString(`<w:p><w:pPr><w:pStyle w:val="` + style + `"/><w:tabs><w:tab w:val="right" w:leader="dot" w:pos="9350"/></w:tabs></w:pPr>`)
+		if first {
+			b.WriteString(`<w:r><w:fldChar w:fldCharType="begin"/></w:r><w:r><w:instrText xml:space="preserve"> TOC \o "1-5" \h \z \u </w:instrText></w:r><w:r><w:fldChar w:fldCharType="separate"/></w:r>`)
+		}
+		b.WriteString(`<w:hyperlink w:anchor="` + anchor + `" w:history="1"><w:r><w:t>` + text + `</w:t></w:r>`)
+		b.WriteString(`<w:r><w:tab/></w:r><w:r><w:fldChar w:fldCharType="begin"/></w:r>`)
+		b.WriteString(`<w:r><w:instrText xml:space="preserve"> PAGEREF ` + anchor + ` \h </w:instrText></w:r>`)
+		b.WriteString(`<w:r><w:fldChar w:fldCharType="separate"/></w:r><w:r><w:t>` + page + `</w:t></w:r><w:r><w:fldChar w:fldCharType="end"/></w:r></w:hyperlink></w:p>`)
+		return b.String()
+	}
+	docXML := []byte(`<w:body>` +
+		entry("TOC1", "_Toc1", "1</w:t></w:r><w:r><w:tab/></w:r><w:r><w:t>Introduction", "1", true) +
+		entry("TOC2", "_Toc2", "Scope &amp; Purpose", "3", false) +
+		entry("TOC4", "_Toc3", "Detail", "12", false) +
+		entry("TOC5", "_Toc4", "Appendix note", "xiv", false) +
+		`<w:p><w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>` +
+		`</w:body>`)
+
+	entries := parseTOCEntries(docXML)
+	want := []TOCEntry{
+		{Level: 1, Text: "1 Introduction", Page: 1, FieldResult: "1\tIntroduction\t1"},
+		{Level: 2, Text: "Scope & Purpose", Page: 3, FieldResult: "Scope & Purpose\t3"},
+		{Level: 4, Text: "Detail", Page: 12, FieldResult: "Detail\t12"},
+		{Level: 5, Text: "Appendix note xiv", Page: 0, FieldResult: "Appendix note\txiv"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d TOC entries, got %d: %+v", len(want), len(entries), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+}
+
 func TestGenerateWatermarkShapeXML(t *testing.T) {
 	opts := DefaultWatermarkOptions()
 	result := generateWatermarkShapeXML(opts)
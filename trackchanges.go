@@ -2,6 +2,7 @@ package godocx
 
 import (
 	"bytes"
+	"crypto/rand"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -55,10 +56,10 @@ type TrackedDeleteOptions struct {
 // that can be accepted or rejected by the reviewer.
 func (u *Updater) InsertTrackedText(opts TrackedInsertOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if opts.Text == "" {
-		return fmt.Errorf("text cannot be empty")
+		return NewValidationError("text", "text cannot be empty")
 	}
 	if opts.Author == "" {
 		opts.Author = "Author"
@@ -73,7 +74,7 @@ func (u *Updater) InsertTrackedText(opts TrackedInsertOptions) error {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	startID := getNextRevisionID(raw)
@@ -85,7 +86,7 @@ func (u *Updater) InsertTrackedText(opts TrackedInsertOptions) error {
 	}
 
 	if err := os.WriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
@@ -96,10 +97,10 @@ func (u *Updater) InsertTrackedText(opts TrackedInsertOptions) error {
 // and can be accepted or rejected by the reviewer.
 func (u *Updater) DeleteTrackedText(opts TrackedDeleteOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if opts.Anchor == "" {
-		return fmt.Errorf("anchor text cannot be empty")
+		return NewValidationError("anchor", "anchor text cannot be empty")
 	}
 	if opts.Author == "" {
 		opts.Author = "Author"
@@ -111,7 +112,7 @@ func (u *Updater) DeleteTrackedText(opts TrackedDeleteOptions) error {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return fmt.Errorf("read document.xml: %w", err)
+		return NewFileReadError("document.xml", err)
 	}
 
 	startID := getNextRevisionID(raw)
@@ -121,7 +122,7 @@ func (u *Updater) DeleteTrackedText(opts TrackedDeleteOptions) error {
 	}
 
 	if err := os.WriteFile(docPath, updated, 0o644); err != nil {
-		return fmt.Errorf("write document.xml: %w", err)
+		return NewXMLWriteError("document.xml", err)
 	}
 
 	return nil
@@ -306,3 +307,116 @@ func convertRunsToDeletedWithID(paraXML, author, dateStr string, startID int) st
 
 	return result.String()
 }
+
+// SetRevisionInfo records a programmatic modification of the document the
+// way Word records a save: author becomes the last modified by property and
+// date the modification time in docProps/core.xml, the revision number is
+// incremented, and a new revision save ID (rsid) is added to
+// word/settings.xml. A zero date means now.
+func (u *Updater) SetRevisionInfo(author string, date time.Time) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	if strings.TrimSpace(author) == "" {
+		return NewValidationError("author", "author cannot be empty")
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	content := u.generateDefaultCoreXML()
+	if raw, err := os.ReadFile(filepath.Join(u.tempDir, "docProps", "core.xml")); err == nil {
+		content = string(raw)
+	}
+	content = u.updateCoreProperty(content, "cp:lastModifiedBy", author)
+	content = u.updateCoreDateProperty(content, "dcterms:modified", date.UTC().Format(time.RFC3339))
+	if n, err := strconv.Atoi(strings.TrimSpace(u.extractCoreProperty(content, "cp:revision"))); err == nil {
+		content = u.updateCoreProperty(content, "cp:revision", strconv.Itoa(n+1))
+	}
+	if err := u.writePropertiesPart(corePropertiesPart, corePropertiesContentType, corePropertiesRelType, content); err != nil {
+		return &DocxError{
+			Code:    "PROPERTIES_ERROR",
+			Message: "failed to write core properties",
+			Err:     err,
+		}
+	}
+
+	rsid := newRevisionSaveID()
+	return u.updateSettings(func(children []xmlChild) []xmlChild {
+		var ids []xmlChild
+		for _, child := range children {
+			if child.name == "w:rsids" {
+				ids = elementChildren(child)
+			}
+		}
+		var b strings.Builder
+		b.WriteString("<w:rsids>")
+		// CT_DocRsids starts with the rsidRoot of the original editing session.
+		if len(ids) == 0 || ids[0].name != "w:rsidRoot" {
+			fmt.Fprintf(&b, `<w:rsidRoot w:val="%s"/>`, rsid)
+		}
+		for _, id := range ids {
+			b.Write(id.xml)
+		}
+		fmt.Fprintf(&b, `<w:rsid w:val="%s"/>`, rsid)
+		b.WriteString("</w:rsids>")
+		return upsertOrderedChild(children, "w:rsids", b.String(), settingsChildOrder)
+	})
+}
+
+// EnableTrackRevisions turns Word's Track Changes on or off through
+// <w:trackRevisions/> in word/settings.xml, so that edits made later in Word
+// are recorded as revisions. Content added by the Updater is never wrapped
+// in revision markup; use InsertTrackedText and DeleteTrackedText for
+// programmatic revisions.
+func (u *Updater) EnableTrackRevisions(enabled bool) error {
+	if u == nil {
+		return NewValidationError("updater", "updater is nil")
+	}
+	elemXML := ""
+	if enabled {
+		elemXML = "<w:trackRevisions/>"
+	}
+	return u.updateSettings(func(children []xmlChild) []xmlChild {
+		return upsertOrderedChild(children, "w:trackRevisions", elemXML, settingsChildOrder)
+	})
+}
+
+// IsTrackRevisionsEnabled reports whether Word's Track Changes is on for the
+// document.
+func (u *Updater) IsTrackRevisionsEnabled() (bool, error) {
+	if u == nil {
+		return false, NewValidationError("updater", "updater is nil")
+	}
+	raw, err := os.ReadFile(filepath.Join(u.tempDir, "word", "settings.xml"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, NewFileReadError("settings.xml", err)
+	}
+	tag := trackRevisionsPattern.Find(raw)
+	if tag == nil {
+		return false, nil
+	}
+	if m := onOffValPattern.FindSubmatch(tag); m != nil {
+		switch string(m[1]) {
+		case "0", "false", "off":
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+var (
+	trackRevisionsPattern = regexp.MustCompile(`<w:trackRevisions\b[^>]*>`)
+	onOffValPattern       = regexp.MustCompile(`\bw:val="([^"]*)"`)
+)
+
+// newRevisionSaveID returns a random revision save ID, an 8-digit hex value
+// as Word writes them.
+func newRevisionSaveID() string {
+	var b [4]byte
+	_, _ = rand.Read(b[:])
+	return fmt.Sprintf("%08X", b[:])
+}
@@ -1,6 +1,7 @@
 package godocx
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -142,7 +143,8 @@ func TestInsertTrackedText_Validation(t *testing.T) {
 func TestInsertTrackedText_EmptyText(t *testing.T) {
 	u := &Updater{}
 	err := u.InsertTrackedText(TrackedInsertOptions{})
-	if err == nil || !strings.Contains(err.Error(), "text cannot be empty") {
+	var docxErr *DocxError
+	if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeValidation || !strings.Contains(err.Error(), "text cannot be empty") {
 		t.Error("expected 'text cannot be empty' error")
 	}
 }
@@ -158,7 +160,77 @@ func TestDeleteTrackedText_Validation(t *testing.T) {
 func TestDeleteTrackedText_EmptyAnchor(t *testing.T) {
 	u := &Updater{}
 	err := u.DeleteTrackedText(TrackedDeleteOptions{})
-	if err == nil || !strings.Contains(err.Error(), "anchor text cannot be empty") {
+	var docxErr *DocxError
+	if !errors.As(err, &docxErr) || docxErr.Code != ErrCodeValidation || !strings.Contains(err.Error(), "anchor text cannot be empty") {
 		t.Error("expected 'anchor text cannot be empty' error")
 	}
 }
+
+func TestTrackRevisionsSetting(t *testing.T) {
+	u := newUpdaterFromFixture(t, buildIntegrationFixture(t, `<w:p><w:r><w:t>Intro</w:t></w:r></w:p>`))
+
+	if on, err := u.IsTrackRevisionsEnabled(); err != nil || on {
+		t.Fatalf("IsTrackRevisionsEnabled = %v, %v; want false", on, err)
+	}
+	if err := u.EnableTrackRevisions(true); err != nil {
+		t.Fatalf("EnableTrackRevisions: %v", err)
+	}
+	if on, err := u.IsTrackRevisionsEnabled(); err != nil || !on {
+		t.Fatalf("IsTrackRevisionsEnabled = %v, %v; want true", on, err)
+	}
+	if settings := readTempFile(t, u, "word/settings.xml"); strings.Count(settings, "<w:trackRevisions/>") != 1 {
+		t.Errorf("expected one <w:trackRevisions/>:\n%s", settings)
+	}
+
+	// Content generated while tracking is on is final, not a revision.
+	if err := u.AddText("Generated", PositionEnd); err != nil {
+		t.Fatalf("AddText: %v", err)
+	}
+	if doc := readDocXML(t, u); strings.Contains(doc, "<w:ins ") {
+		t.Errorf("generated content should not be tracked:\n%s", doc)
+	}
+
+	if err := u.EnableTrackRevisions(false); err != nil {
+		t.Fatalf("EnableTrackRevisions(false): %v", err)
+	}
+	if on, _ := u.IsTrackRevisionsEnabled(); on {
+		t.Error("expected track revisions off")
+	}
+}
+
+func TestSetRevisionInfo(t *testing.T) {
+	u, err := NewBlank()
+	if err != nil {
+		t.Fatalf("NewBlank: %v", err)
+	}
+	defer u.Cleanup()
+	if err := u.SetCoreProperties(CoreProperties{Revision: "4"}); err != nil {
+		t.Fatalf("SetCoreProperties: %v", err)
+	}
+
+	date := time.Date(2026, 3, 2, 9, 30, 0, 0, time.FixedZone("CET", 3600))
+	if err := u.SetRevisionInfo("Build Bot", date); err != nil {
+		t.Fatalf("SetRevisionInfo: %v", err)
+	}
+	if err := u.SetRevisionInfo("Build Bot", date); err != nil {
+		t.Fatalf("second SetRevisionInfo: %v", err)
+	}
+
+	props, err := u.GetCoreProperties()
+	if err != nil {
+		t.Fatalf("GetCoreProperties: %v", err)
+	}
+	if props.LastModifiedBy != "Build Bot" || props.Revision != "6" || !props.Modified.Equal(date) {
+		t.Errorf("core properties = %+v", props)
+	}
+
+	settings := readTempFile(t, u, "word/settings.xml")
+	rsids := settings[strings.Index(settings, "<w:rsids>"):]
+	if !strings.HasPrefix(rsids, "<w:rsids><w:rsidRoot w:val=\"") || strings.Count(rsids, "<w:rsid w:val=") != 2 || strings.Count(rsids, "<w:rsidRoot") != 1 {
+		t.Errorf("expected a root and one rsid per call:\n%s", settings)
+	}
+
+	if err := u.SetRevisionInfo(" ", date); err == nil {
+		t.Error("expected error for empty author")
+	}
+}
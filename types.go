@@ -15,6 +15,10 @@ type SeriesData struct {
 	Name   string
 	Values []float64
 	Color  string // Hex color code (e.g., "FF0000" for red) - optional
+	// FormatCode is the number format of the cached values (e.g. "0.00%").
+	// GetChartData fills it from <c:numCache><c:formatCode>; UpdateChart
+	// writes it back so that a read-modify-write keeps the format.
+	FormatCode string
 }
 
 // ImageOptions defines options for image insertion
@@ -61,6 +65,31 @@ const (
 	SectionBreakOddPage SectionBreakType = "oddPage"
 )
 
+// BreakKind defines the kind of break inserted by InsertPageBreak
+type BreakKind string
+
+const (
+	// BreakPage continues the text on the next page (default)
+	BreakPage BreakKind = "page"
+	// BreakColumn continues the text in the next column of a multi-column section
+	BreakColumn BreakKind = "column"
+	// BreakTextWrap continues the text below any floating objects it wraps around
+	BreakTextWrap BreakKind = "textWrapping"
+)
+
+// ConditionalBreakKind selects the page on which InsertPageBreak continues
+// the text in double-sided layouts
+type ConditionalBreakKind string
+
+const (
+	// BreakNextPage continues the text on the next page (default)
+	BreakNextPage ConditionalBreakKind = "nextPage"
+	// BreakEvenPage continues the text on the next even (left-hand) page
+	BreakEvenPage ConditionalBreakKind = "evenPage"
+	// BreakOddPage continues the text on the next odd (right-hand) page
+	BreakOddPage ConditionalBreakKind = "oddPage"
+)
+
 // BreakOptions defines options for inserting breaks
 type BreakOptions struct {
 	// Position where to insert the break
@@ -69,6 +98,16 @@ type BreakOptions struct {
 	// Anchor text for position-based insertion (for PositionAfterText/PositionBeforeText)
 	Anchor string
 
+	// Kind of break (only used by InsertPageBreak, default: BreakPage)
+	Kind BreakKind
+
+	// ConditionalBreak selects the page a page break continues on (only used
+	// by InsertPageBreak with Kind BreakPage, default: BreakNextPage). Word
+	// only supports even and odd page breaks as section breaks, so these
+	// start a new section with the page layout, headers and footers of the
+	// current one.
+	ConditionalBreak ConditionalBreakKind
+
 	// Type of section break (only used for section breaks)
 	SectionType SectionBreakType
 
@@ -76,6 +115,10 @@ type BreakOptions struct {
 	PageLayout *PageLayoutOptions
 }
 
+// SectionBreakOptions configures InsertSectionBreak. It is the same type as
+// BreakOptions; LandscapeSection and PortraitSection build common values.
+type SectionBreakOptions = BreakOptions
+
 // PageOrientation defines page orientation
 type PageOrientation string
 
@@ -17,7 +17,7 @@ const maxExtractedFileSize = 256 << 20 // 256 MiB
 func extractZip(zipPath, destDir string) error {
 	r, err := zip.OpenReader(zipPath)
 	if err != nil {
-		return fmt.Errorf("open zip: %w", err)
+		return NewInvalidFileError("open docx archive", err)
 	}
 	defer r.Close()
 
@@ -30,29 +30,29 @@ func extractZip(zipPath, destDir string) error {
 		cleanTarget := filepath.Clean(target)
 		if !strings.HasPrefix(cleanTarget+string(os.PathSeparator), cleanDest+string(os.PathSeparator)) &&
 			cleanTarget != cleanDest {
-			return fmt.Errorf("zip entry %s escapes target directory", f.Name)
+			return NewInvalidFileError(fmt.Sprintf("zip entry %s escapes target directory", f.Name), nil)
 		}
 
 		if f.FileInfo().IsDir() {
 			if err := os.MkdirAll(target, 0755); err != nil {
-				return fmt.Errorf("create dir %s: %w", target, err)
+				return NewFileWriteError(target, err)
 			}
 			continue
 		}
 
 		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
-			return fmt.Errorf("create parent dir for %s: %w", target, err)
+			return NewFileWriteError(filepath.Dir(target), err)
 		}
 
 		rc, err := f.Open()
 		if err != nil {
-			return fmt.Errorf("open zip entry %s: %w", f.Name, err)
+			return NewInvalidFileError(fmt.Sprintf("open zip entry %s", f.Name), err)
 		}
 
 		out, err := os.Create(target)
 		if err != nil {
 			rc.Close()
-			return fmt.Errorf("create file %s: %w", target, err)
+			return NewFileWriteError(target, err)
 		}
 
 		// Limit decompressed size to guard against zip-bomb payloads.
@@ -61,52 +61,42 @@ func extractZip(zipPath, destDir string) error {
 		if copyErr != nil {
 			out.Close()
 			rc.Close()
-			return fmt.Errorf("copy zip entry %s: %w", f.Name, copyErr)
+			return NewInvalidFileError(fmt.Sprintf("copy zip entry %s", f.Name), copyErr)
 		}
 		if n > maxExtractedFileSize {
 			out.Close()
 			rc.Close()
-			return fmt.Errorf("zip entry %s exceeds maximum allowed size (%d bytes)", f.Name, maxExtractedFileSize)
+			return NewInvalidFileError(fmt.Sprintf("zip entry %s exceeds maximum allowed size (%d bytes)", f.Name, maxExtractedFileSize), nil)
 		}
 
 		if err := out.Close(); err != nil {
 			rc.Close()
-			return fmt.Errorf("close file %s: %w", target, err)
+			return NewFileWriteError(target, err)
 		}
 
 		if err := rc.Close(); err != nil {
-			return fmt.Errorf("close zip entry %s: %w", f.Name, err)
+			return NewInvalidFileError(fmt.Sprintf("close zip entry %s", f.Name), err)
 		}
 	}
 
 	return nil
 }
 
-func createZipFromDir(sourceDir, outZipPath string) error {
-	out, err := os.Create(outZipPath)
-	if err != nil {
-		return fmt.Errorf("create output zip: %w", err)
-	}
-
-	if err := writeZipFromDir(sourceDir, out); err != nil {
-		out.Close()
-		return err
-	}
-
-	if err := out.Close(); err != nil {
-		return fmt.Errorf("close output zip: %w", err)
-	}
-
-	return nil
-}
-
 // writeZipFromDir writes a zip archive of sourceDir to the given writer.
 func writeZipFromDir(sourceDir string, w io.Writer) error {
+	return writeZipFromDirReplacing(sourceDir, w, nil, nil)
+}
+
+// writeZipFromDirReplacing is writeZipFromDir, except that entries named in
+// replace (by slash-separated path) are written with the given content
+// instead of the file's content. onEntry, when non-nil, is called after each
+// entry has been written.
+func writeZipFromDirReplacing(sourceDir string, w io.Writer, replace map[string][]byte, onEntry func()) error {
 	zw := zip.NewWriter(w)
 
 	walkErr := filepath.WalkDir(sourceDir, func(path string, d fs.DirEntry, walkErr error) error {
 		if walkErr != nil {
-			return walkErr
+			return NewFileReadError(path, walkErr)
 		}
 		if path == sourceDir || d.IsDir() {
 			return nil
@@ -114,29 +104,42 @@ func writeZipFromDir(sourceDir string, w io.Writer) error {
 
 		rel, err := filepath.Rel(sourceDir, path)
 		if err != nil {
-			return fmt.Errorf("relative path for %s: %w", path, err)
+			return NewFileReadError(path, err)
 		}
 
 		zipPath := filepath.ToSlash(rel)
 		ew, err := zw.Create(zipPath)
 		if err != nil {
-			return fmt.Errorf("create zip entry %s: %w", zipPath, err)
+			return NewFileWriteError(zipPath, err)
+		}
+
+		if content, ok := replace[zipPath]; ok {
+			if _, err := ew.Write(content); err != nil {
+				return NewFileWriteError(zipPath, err)
+			}
+			if onEntry != nil {
+				onEntry()
+			}
+			return nil
 		}
 
 		f, err := os.Open(path)
 		if err != nil {
-			return fmt.Errorf("open source file %s: %w", path, err)
+			return NewFileReadError(path, err)
 		}
 
 		if _, err := io.Copy(ew, f); err != nil {
 			f.Close()
-			return fmt.Errorf("write zip entry %s: %w", zipPath, err)
+			return NewFileWriteError(zipPath, err)
 		}
 
 		if err := f.Close(); err != nil {
-			return fmt.Errorf("close source file %s: %w", path, err)
+			return NewFileReadError(path, err)
 		}
 
+		if onEntry != nil {
+			onEntry()
+		}
 		return nil
 	})
 	if walkErr != nil {
@@ -145,7 +148,7 @@ func writeZipFromDir(sourceDir string, w io.Writer) error {
 	}
 
 	if err := zw.Close(); err != nil {
-		return fmt.Errorf("close zip writer: %w", err)
+		return NewFileWriteError("zip archive", err)
 	}
 
 	return nil
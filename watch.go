@@ -0,0 +1,105 @@
+package godocx
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// watchPollInterval is how often WatchAndReload checks the file for changes.
+var watchPollInterval = 500 * time.Millisecond
+
+// WatchAndReload opens the DOCX at path and reloads it whenever the file's
+// modification time or size changes on disk.
+//
+// onChange is called from a background goroutine, first with the initially
+// loaded document and then after every reload. Calls are never concurrent. An
+// Updater passed to onChange stays valid only until the next successful reload
+// or until stop is called, after which its workspace is cleaned up. If a
+// reload fails (for example because the file is still being written),
+// onChange receives the error and the previous Updater remains current; the
+// reload is retried on every poll until it succeeds, and an error that
+// repeats is reported only once.
+//
+// The returned stop function cancels the watcher, waits for any running
+// onChange call to return, and cleans up the current Updater. It is safe to
+// call more than once, but must not be called from within onChange.
+func WatchAndReload(path string, onChange func(*Updater, error)) (stop func(), err error) {
+	if path == "" {
+		return nil, NewValidationError("path", "docx path is required")
+	}
+	if onChange == nil {
+		return nil, NewValidationError("onChange", "callback is required")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, NewFileReadError("docx", err)
+	}
+	current, err := New(path)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		defer func() { current.Cleanup() }()
+
+		onChange(current, nil)
+
+		lastMod, lastSize := info.ModTime(), info.Size()
+		ticker := time.NewTicker(watchPollInterval)
+		defer ticker.Stop()
+
+		// lastErr is the message of the last reported error, so a failure
+		// that persists across polls is reported only once.
+		var lastErr string
+		report := func(err error) {
+			if err.Error() != lastErr {
+				lastErr = err.Error()
+				onChange(nil, err)
+			}
+		}
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			info, err := os.Stat(path)
+			if err != nil {
+				report(NewFileReadError("docx", err))
+				continue
+			}
+			if info.ModTime().Equal(lastMod) && info.Size() == lastSize {
+				continue
+			}
+
+			next, err := New(path)
+			if err != nil {
+				// Keep the old modification time and size, so the reload
+				// is retried on the next poll even if the file does not
+				// change again.
+				report(err)
+				continue
+			}
+			lastMod, lastSize = info.ModTime(), info.Size()
+			lastErr = ""
+			current.Cleanup()
+			current = next
+			onChange(current, nil)
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			<-finished
+		})
+	}, nil
+}
@@ -0,0 +1,136 @@
+package godocx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatchAndReload(t *testing.T) {
+	defer func(interval time.Duration) { watchPollInterval = interval }(watchPollInterval)
+	watchPollInterval = 10 * time.Millisecond
+
+	path := filepath.Join(t.TempDir(), "watched.docx")
+	if err := os.WriteFile(path, buildIntegrationFixture(t, `<w:p><w:r><w:t>First</w:t></w:r></w:p>`), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+
+	type event struct {
+		u   *Updater
+		err error
+	}
+	events := make(chan event, 4)
+	stop, err := WatchAndReload(path, func(u *Updater, err error) { events <- event{u, err} })
+	if err != nil {
+		t.Fatalf("WatchAndReload: %v", err)
+	}
+	defer stop()
+
+	next := func() event {
+		t.Helper()
+		select {
+		case ev := <-events:
+			if ev.err != nil {
+				t.Fatalf("unexpected reload error: %v", ev.err)
+			}
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for onChange")
+			return event{}
+		}
+	}
+
+	first := next()
+	if got := readDocXML(t, first.u); !strings.Contains(got, "First") {
+		t.Fatalf("expected initial document, got: %s", got)
+	}
+	firstDir := first.u.TempDir()
+
+	if err := os.WriteFile(path, buildIntegrationFixture(t, `<w:p><w:r><w:t>Second version</w:t></w:r></w:p>`), 0o644); err != nil {
+		t.Fatalf("rewrite fixture: %v", err)
+	}
+	second := next()
+	if got := readDocXML(t, second.u); !strings.Contains(got, "Second version") {
+		t.Fatalf("expected reloaded document, got: %s", got)
+	}
+	if _, err := os.Stat(firstDir); !os.IsNotExist(err) {
+		t.Errorf("expected previous updater to be cleaned up, stat err = %v", err)
+	}
+
+	stop()
+	stop()
+	if _, err := os.Stat(second.u.TempDir()); !os.IsNotExist(err) {
+		t.Errorf("expected current updater to be cleaned up on stop, stat err = %v", err)
+	}
+}
+
+func TestWatchAndReload_ReportsRepeatedErrorOnce(t *testing.T) {
+	defer func(interval time.Duration) { watchPollInterval = interval }(watchPollInterval)
+	watchPollInterval = 10 * time.Millisecond
+
+	path := filepath.Join(t.TempDir(), "watched.docx")
+	if err := os.WriteFile(path, buildIntegrationFixture(t, `<w:p><w:r><w:t>First</w:t></w:r></w:p>`), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	reloaded := make(chan *Updater, 4)
+	stop, err := WatchAndReload(path, func(u *Updater, err error) {
+		if err != nil {
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+			return
+		}
+		reloaded <- u
+	})
+	if err != nil {
+		t.Fatalf("WatchAndReload: %v", err)
+	}
+	defer stop()
+	<-reloaded
+
+	// A half-written file fails to load on every poll but is reported once.
+	if err := os.WriteFile(path, []byte("not a zip"), 0o644); err != nil {
+		t.Fatalf("write broken file: %v", err)
+	}
+	time.Sleep(20 * watchPollInterval)
+	mu.Lock()
+	if len(errs) != 1 {
+		t.Errorf("got %d error callbacks for one broken file, want 1: %v", len(errs), errs)
+	}
+	mu.Unlock()
+
+	if err := os.WriteFile(path, buildIntegrationFixture(t, `<w:p><w:r><w:t>Fixed</w:t></w:r></w:p>`), 0o644); err != nil {
+		t.Fatalf("rewrite fixture: %v", err)
+	}
+	select {
+	case u := <-reloaded:
+		if got := readDocXML(t, u); !strings.Contains(got, "Fixed") {
+			t.Errorf("expected reloaded document, got: %s", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reload after the file was fixed")
+	}
+}
+
+func TestWatchAndReload_Errors(t *testing.T) {
+	if _, err := WatchAndReload("", func(*Updater, error) {}); err == nil {
+		t.Error("expected error for empty path")
+	}
+	if _, err := WatchAndReload(filepath.Join(t.TempDir(), "missing.docx"), func(*Updater, error) {}); err == nil {
+		t.Error("expected error for missing file")
+	}
+
+	path := filepath.Join(t.TempDir(), "doc.docx")
+	if err := os.WriteFile(path, buildIntegrationFixture(t, ``), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+	if _, err := WatchAndReload(path, nil); err == nil {
+		t.Error("expected error for nil callback")
+	}
+}
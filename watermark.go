@@ -43,10 +43,10 @@ func DefaultWatermarkOptions() WatermarkOptions {
 // If a default header already exists, the watermark is injected into it.
 func (u *Updater) SetTextWatermark(opts WatermarkOptions) error {
 	if u == nil {
-		return fmt.Errorf("updater is nil")
+		return NewValidationError("updater", "updater is nil")
 	}
 	if opts.Text == "" {
-		return fmt.Errorf("watermark text cannot be empty")
+		return NewValidationError("text", "watermark text cannot be empty")
 	}
 
 	// Apply defaults
@@ -64,9 +64,11 @@ func (u *Updater) SetTextWatermark(opts WatermarkOptions) error {
 	}
 
 	// Normalize color
-	if c := normalizeHexColor(opts.Color); c != "" {
-		opts.Color = c
+	color := normalizeHexColor(opts.Color)
+	if color == "" {
+		return invalidColorError("Color", opts.Color)
 	}
+	opts.Color = color
 
 	// Generate watermark paragraph XML
 	watermarkXML := generateWatermarkShapeXML(opts)
@@ -97,7 +99,7 @@ func (u *Updater) findDefaultHeaderFile() (string, error) {
 	docPath := filepath.Join(u.tempDir, "word", "document.xml")
 	raw, err := os.ReadFile(docPath)
 	if err != nil {
-		return "", fmt.Errorf("read document.xml: %w", err)
+		return "", NewFileReadError("document.xml", err)
 	}
 
 	// Find headerReference with type="default"
@@ -113,7 +115,7 @@ func (u *Updater) findDefaultHeaderFile() (string, error) {
 	relsPath := filepath.Join(u.tempDir, "word", "_rels", "document.xml.rels")
 	relsRaw, err := os.ReadFile(relsPath)
 	if err != nil {
-		return "", fmt.Errorf("read document.xml.rels: %w", err)
+		return "", NewFileReadError("document.xml.rels", err)
 	}
 
 	targetPattern := regexp.MustCompile(fmt.Sprintf(`<Relationship Id="%s"[^>]*Target="([^"]+)"`, regexp.QuoteMeta(relID)))
@@ -130,7 +132,7 @@ func (u *Updater) injectWatermarkIntoHeader(headerFile string, watermarkXML []by
 	headerPath := filepath.Join(u.tempDir, "word", headerFile)
 	raw, err := os.ReadFile(headerPath)
 	if err != nil {
-		return fmt.Errorf("read header %s: %w", headerFile, err)
+		return NewFileReadError(fmt.Sprintf("header %s", headerFile), err)
 	}
 
 	content := string(raw)
@@ -141,18 +143,18 @@ func (u *Updater) injectWatermarkIntoHeader(headerFile string, watermarkXML []by
 	// Find the first '>' that's part of the <w:hdr...> opening tag
 	hdrIdx := strings.Index(content, "<w:hdr")
 	if hdrIdx == -1 {
-		return fmt.Errorf("could not find <w:hdr> element")
+		return NewMalformedXMLError("could not find <w:hdr> element")
 	}
 	hdrCloseIdx := strings.Index(content[hdrIdx:], ">")
 	if hdrCloseIdx == -1 {
-		return fmt.Errorf("malformed <w:hdr> element")
+		return NewMalformedXMLError("malformed <w:hdr> element")
 	}
 	insertPos := hdrIdx + hdrCloseIdx + 1
 
 	updated := content[:insertPos] + "\n" + string(watermarkXML) + content[insertPos:]
 
 	if err := os.WriteFile(headerPath, []byte(updated), 0o644); err != nil {
-		return fmt.Errorf("write header: %w", err)
+		return NewFileWriteError("header", err)
 	}
 
 	return nil
@@ -186,7 +188,7 @@ func (u *Updater) createWatermarkHeader(watermarkXML []byte) error {
 	buf.WriteString(`</w:hdr>`)
 
 	if err := os.WriteFile(headerPath, []byte(buf.String()), 0o644); err != nil {
-		return fmt.Errorf("write header: %w", err)
+		return NewFileWriteError("header", err)
 	}
 
 	// Add relationship